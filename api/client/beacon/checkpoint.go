@@ -21,7 +21,11 @@ import (
 	"golang.org/x/mod/semver"
 )
 
-var errCheckpointBlockMismatch = errors.New("mismatch between checkpoint sync state and block")
+var (
+	errCheckpointBlockMismatch = errors.New("mismatch between checkpoint sync state and block")
+	errCheckpointRootMismatch  = errors.New("checkpoint sync block does not match the finalized checkpoint root")
+	errCheckpointStateMismatch = errors.New("checkpoint sync state does not match the agreed state root")
+)
 
 // OriginData represents the BeaconState and ReadOnlySignedBeaconBlock necessary to start an empty Beacon Node
 // using Checkpoint Sync.
@@ -63,16 +67,68 @@ func fname(prefix string, vu *detect.VersionedUnmarshaler, slot primitives.Slot,
 	return fmt.Sprintf("%s_%s_%s_%d-%#x.ssz", prefix, vu.Config.ConfigName, version.String(vu.Fork), slot, root)
 }
 
-// DownloadFinalizedData downloads the most recently finalized state, and the block most recently applied to that state.
-// This pair can be used to initialize a new beacon node via checkpoint sync.
-func DownloadFinalizedData(ctx context.Context, client *Client) (*OriginData, error) {
-	sb, err := client.GetState(ctx, IdFinalized)
+// DownloadFinalizedData asks each provider for its finalized checkpoint and the root of the state at its first slot
+// and, once at least quorum of them agree on the epoch, block root and state root, downloads the checkpoint state and
+// the block most recently applied to that state from one of the agreeing providers. The download is rejected unless
+// the block and the state match the agreed roots. This pair can be used to initialize a new beacon node via
+// checkpoint sync. A quorum of 0 requires all the providers to agree.
+// ErrNoQuorum is returned, along with the checkpoint reported by each provider, if the providers do not agree.
+func DownloadFinalizedData(ctx context.Context, clients []*Client, quorum int) (*OriginData, error) {
+	results := queryProviders(ctx, clients, func(ctx context.Context, c *Client) (checkpointKey, error) {
+		cp, err := c.GetFinalizedCheckpoint(ctx, IdHead)
+		if err != nil {
+			return checkpointKey{}, err
+		}
+		slot, err := slots.EpochStart(cp.Epoch)
+		if err != nil {
+			return checkpointKey{}, errors.Wrapf(err, "error computing first slot of finalized epoch=%d", cp.Epoch)
+		}
+		sr, err := c.GetStateRoot(ctx, IdFromSlot(slot))
+		if err != nil {
+			return checkpointKey{}, err
+		}
+		return checkpointKey{epoch: cp.Epoch, root: bytesutil.ToBytes32(cp.Root), stateRoot: sr}, nil
+	})
+	cp, agreeing, err := agreedValue(results, quorum)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := slots.EpochStart(cp.epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error computing first slot of finalized epoch=%d", cp.epoch)
+	}
+	log.WithField("epoch", cp.epoch).WithField("root", hexutil.Encode(cp.root[:])).
+		WithField("stateRoot", hexutil.Encode(cp.stateRoot[:])).WithField("providers", len(agreeing)).
+		Info("Checkpoint sync providers agree on the finalized checkpoint")
+
+	for _, c := range agreeing {
+		od, dlErr := downloadOriginData(ctx, c, IdFromSlot(slot))
+		switch {
+		case dlErr != nil:
+		case od.br != cp.root:
+			dlErr = errors.Wrapf(errCheckpointRootMismatch, "agreed root = %#x, block root = %#x", cp.root, od.br)
+		case od.sr != cp.stateRoot:
+			dlErr = errors.Wrapf(errCheckpointStateMismatch, "agreed state root = %#x, state root = %#x", cp.stateRoot, od.sr)
+		}
+		if dlErr != nil {
+			log.WithError(dlErr).WithField("provider", c.NodeURL()).Warn("Could not download checkpoint sync data from provider")
+			err = dlErr
+			continue
+		}
+		return od, nil
+	}
+	return nil, errors.Wrapf(err, "could not download the finalized checkpoint from any of the %d agreeing providers", len(agreeing))
+}
+
+// downloadOriginData downloads the state identified by stateId, and the block most recently applied to that state.
+func downloadOriginData(ctx context.Context, client *Client, stateId StateOrBlockId) (*OriginData, error) {
+	sb, err := client.GetState(ctx, stateId)
 	if err != nil {
 		return nil, err
 	}
 	vu, err := detect.FromState(sb)
 	if err != nil {
-		return nil, errors.Wrap(err, "error detecting chain config for finalized state")
+		return nil, errors.Wrap(err, "error detecting chain config for checkpoint state")
 	}
 
 	log.WithFields(logrus.Fields{
@@ -82,7 +138,7 @@ func DownloadFinalizedData(ctx context.Context, client *Client) (*OriginData, er
 
 	s, err := vu.UnmarshalBeaconState(sb)
 	if err != nil {
-		return nil, errors.Wrap(err, "error unmarshaling finalized state to correct version")
+		return nil, errors.Wrap(err, "error unmarshaling checkpoint state to correct version")
 	}
 
 	slot := s.LatestBlockHeader().Slot
@@ -109,7 +165,7 @@ func DownloadFinalizedData(ctx context.Context, client *Client) (*OriginData, er
 	}
 	sr, err := s.HashTreeRoot(ctx)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to compute htr for finalized state at slot=%d", s.Slot())
+		return nil, errors.Wrapf(err, "failed to compute htr for checkpoint state at slot=%d", s.Slot())
 	}
 
 	log.
@@ -145,11 +201,30 @@ func (wsd *WeakSubjectivityData) CheckpointString() string {
 	return fmt.Sprintf("%#x:%d", wsd.BlockRoot, wsd.Epoch)
 }
 
-// ComputeWeakSubjectivityCheckpoint attempts to use the prysm weak_subjectivity api
+// ComputeWeakSubjectivityCheckpoint obtains the current weak subjectivity checkpoint from each provider, and returns
+// it once at least quorum of them agree on its epoch, block root and state root. A quorum of 0 requires all the
+// providers to agree.
+// ErrNoQuorum is returned, along with the checkpoint reported by each provider, if the providers do not agree.
+func ComputeWeakSubjectivityCheckpoint(ctx context.Context, clients []*Client, quorum int) (*WeakSubjectivityData, error) {
+	results := queryProviders(ctx, clients, func(ctx context.Context, c *Client) (WeakSubjectivityData, error) {
+		ws, err := computeWeakSubjectivityCheckpoint(ctx, c)
+		if err != nil {
+			return WeakSubjectivityData{}, err
+		}
+		return *ws, nil
+	})
+	ws, _, err := agreedValue(results, quorum)
+	if err != nil {
+		return nil, err
+	}
+	return &ws, nil
+}
+
+// computeWeakSubjectivityCheckpoint attempts to use the prysm weak_subjectivity api
 // to obtain the current weak_subjectivity checkpoint.
 // For non-prysm nodes, the same computation will be performed with extra steps,
 // using the head state downloaded from the beacon node api.
-func ComputeWeakSubjectivityCheckpoint(ctx context.Context, client *Client) (*WeakSubjectivityData, error) {
+func computeWeakSubjectivityCheckpoint(ctx context.Context, client *Client) (*WeakSubjectivityData, error) {
 	ws, err := client.GetWeakSubjectivity(ctx)
 	if err != nil {
 		// a 404/405 is expected if querying an endpoint that doesn't support the weak subjectivity checkpoint api
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	blocktest "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks/testing"
@@ -90,7 +91,7 @@ func TestFallbackVersionCheck(t *testing.T) {
 	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
 	require.NoError(t, err)
 	ctx := context.Background()
-	_, err = ComputeWeakSubjectivityCheckpoint(ctx, c)
+	_, err = ComputeWeakSubjectivityCheckpoint(ctx, []*Client{c}, 1)
 	require.ErrorIs(t, err, errUnsupportedPrysmCheckpointVersion)
 }
 
@@ -204,7 +205,7 @@ func TestDownloadWeakSubjectivityCheckpoint(t *testing.T) {
 	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
 	require.NoError(t, err)
 
-	wsd, err := ComputeWeakSubjectivityCheckpoint(ctx, c)
+	wsd, err := ComputeWeakSubjectivityCheckpoint(ctx, []*Client{c}, 1)
 	require.NoError(t, err)
 	require.Equal(t, expectedWSD.Epoch, wsd.Epoch)
 	require.Equal(t, expectedWSD.StateRoot, wsd.StateRoot)
@@ -296,7 +297,7 @@ func TestDownloadBackwardsCompatibleCombined(t *testing.T) {
 	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
 	require.NoError(t, err)
 
-	wsPub, err := ComputeWeakSubjectivityCheckpoint(ctx, c)
+	wsPub, err := ComputeWeakSubjectivityCheckpoint(ctx, []*Client{c}, 1)
 	require.NoError(t, err)
 
 	wsPriv, err := computeBackwardsCompatible(ctx, c)
@@ -436,11 +437,26 @@ func TestDownloadFinalizedData(t *testing.T) {
 
 	ms, err := st.MarshalSSZ()
 	require.NoError(t, err)
+	mcp, err := json.Marshal(&structs.GetFinalityCheckpointsResponse{Data: &structs.FinalityCheckpoints{
+		Finalized: structs.CheckpointFromConsensus(&ethpb.Checkpoint{Epoch: epoch, Root: br[:]}),
+	}})
+	require.NoError(t, err)
+	agreedStateRoot := sr
 
 	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
 		res := &http.Response{Request: req}
 		switch req.URL.Path {
-		case renderGetStatePath(IdFinalized):
+		case getFinalityCheckpointsTpl(IdHead):
+			res.StatusCode = http.StatusOK
+			res.Body = io.NopCloser(bytes.NewBuffer(mcp))
+		case getStateRootTpl(IdFromSlot(slot)):
+			msr, err := json.Marshal(&structs.GetStateRootResponse{Data: &structs.StateRoot{Root: fmt.Sprintf("%#x", agreedStateRoot)}})
+			if err != nil {
+				return nil, err
+			}
+			res.StatusCode = http.StatusOK
+			res.Body = io.NopCloser(bytes.NewBuffer(msr))
+		case renderGetStatePath(IdFromSlot(slot)):
 			res.StatusCode = http.StatusOK
 			res.Body = io.NopCloser(bytes.NewBuffer(ms))
 		case renderGetBlockPath(IdFromSlot(b.Block().Slot())):
@@ -457,7 +473,7 @@ func TestDownloadFinalizedData(t *testing.T) {
 	require.NoError(t, err)
 	// sanity check before we go through checkpoint
 	// make sure we can download the state and unmarshal it with the VersionedUnmarshaler
-	sb, err := c.GetState(ctx, IdFinalized)
+	sb, err := c.GetState(ctx, IdFromSlot(slot))
 	require.NoError(t, err)
 	require.Equal(t, true, bytes.Equal(sb, ms))
 	vu, err := detect.FromState(sb)
@@ -474,10 +490,15 @@ func TestDownloadFinalizedData(t *testing.T) {
 		br: br,
 		sr: sr,
 	}
-	od, err := DownloadFinalizedData(ctx, c)
+	od, err := DownloadFinalizedData(ctx, []*Client{c}, 1)
 	require.NoError(t, err)
 	require.Equal(t, true, bytes.Equal(expected.sb, od.sb))
 	require.Equal(t, true, bytes.Equal(expected.bb, od.bb))
 	require.Equal(t, expected.br, od.br)
 	require.Equal(t, expected.sr, od.sr)
+
+	// The downloaded state must match the state root the providers agree on.
+	agreedStateRoot = [32]byte{'s'}
+	_, err = DownloadFinalizedData(ctx, []*Client{c}, 0)
+	require.ErrorIs(t, err, errCheckpointStateMismatch)
 }
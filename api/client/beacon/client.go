@@ -26,15 +26,17 @@ import (
 )
 
 const (
-	getSignedBlockPath       = "/eth/v2/beacon/blocks"
-	getBlockRootPath         = "/eth/v1/beacon/blocks/{{.Id}}/root"
-	getForkForStatePath      = "/eth/v1/beacon/states/{{.Id}}/fork"
-	getWeakSubjectivityPath  = "/prysm/v1/beacon/weak_subjectivity"
-	getForkSchedulePath      = "/eth/v1/config/fork_schedule"
-	getConfigSpecPath        = "/eth/v1/config/spec"
-	getStatePath             = "/eth/v2/debug/beacon/states"
-	getNodeVersionPath       = "/eth/v1/node/version"
-	changeBLStoExecutionPath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	getSignedBlockPath         = "/eth/v2/beacon/blocks"
+	getBlockRootPath           = "/eth/v1/beacon/blocks/{{.Id}}/root"
+	getForkForStatePath        = "/eth/v1/beacon/states/{{.Id}}/fork"
+	getStateRootPath           = "/eth/v1/beacon/states/{{.Id}}/root"
+	getFinalityCheckpointsPath = "/eth/v1/beacon/states/{{.Id}}/finality_checkpoints"
+	getWeakSubjectivityPath    = "/prysm/v1/beacon/weak_subjectivity"
+	getForkSchedulePath        = "/eth/v1/config/fork_schedule"
+	getConfigSpecPath          = "/eth/v1/config/spec"
+	getStatePath               = "/eth/v2/debug/beacon/states"
+	getNodeVersionPath         = "/eth/v1/node/version"
+	changeBLStoExecutionPath   = "/eth/v1/beacon/pool/bls_to_execution_changes"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -98,6 +100,19 @@ func NewClient(host string, opts ...client.ClientOpt) (*Client, error) {
 	return &Client{c}, nil
 }
 
+// NewClients returns a Client for each of the given hosts, all of them using the same options.
+func NewClients(hosts []string, opts ...client.ClientOpt) ([]*Client, error) {
+	clients := make([]*Client, len(hosts))
+	for i, h := range hosts {
+		c, err := NewClient(h, opts...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to parse beacon node url or hostname - %s", h)
+		}
+		clients[i] = c
+	}
+	return clients, nil
+}
+
 // GetBlock retrieves the SignedBeaconBlock for the given block id.
 // Block identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
 // <slot>, <hex encoded blockRoot with 0x prefix>. Variables of type StateOrBlockId are exported by this package
@@ -136,6 +151,31 @@ func (c *Client) GetBlockRoot(ctx context.Context, blockId StateOrBlockId) ([32]
 	return bytesutil.ToBytes32(rs), nil
 }
 
+var getStateRootTpl = idTemplate(getStateRootPath)
+
+// GetStateRoot retrieves the hash_tree_root of the BeaconState for the given state id.
+// State identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
+// <slot>, <hex encoded stateRoot with 0x prefix>. Variables of type StateOrBlockId are exported by this package
+// for the named identifiers.
+func (c *Client) GetStateRoot(ctx context.Context, stateId StateOrBlockId) ([32]byte, error) {
+	b, err := c.Get(ctx, getStateRootTpl(stateId))
+	if err != nil {
+		return [32]byte{}, errors.Wrapf(err, "error requesting state root by id = %s", stateId)
+	}
+	sr := &structs.GetStateRootResponse{}
+	if err := json.Unmarshal(b, sr); err != nil {
+		return [32]byte{}, errors.Wrap(err, "error decoding json data from get state root response")
+	}
+	if sr.Data == nil {
+		return [32]byte{}, errors.New("state root missing from get state root response")
+	}
+	rs, err := hexutil.Decode(sr.Data.Root)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, fmt.Sprintf("error decoding hex-encoded value %s", sr.Data.Root))
+	}
+	return bytesutil.ToBytes32(rs), nil
+}
+
 var getForkTpl = idTemplate(getForkForStatePath)
 
 // GetFork queries the Beacon Node API for the Fork from the state identified by stateId.
@@ -157,6 +197,27 @@ func (c *Client) GetFork(ctx context.Context, stateId StateOrBlockId) (*ethpb.Fo
 	return fr.ToConsensus()
 }
 
+var getFinalityCheckpointsTpl = idTemplate(getFinalityCheckpointsPath)
+
+// GetFinalizedCheckpoint queries the Beacon Node API for the finalized Checkpoint of the state identified by stateId.
+// Block identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
+// <slot>, <hex encoded blockRoot with 0x prefix>. Variables of type StateOrBlockId are exported by this package
+// for the named identifiers.
+func (c *Client) GetFinalizedCheckpoint(ctx context.Context, stateId StateOrBlockId) (*ethpb.Checkpoint, error) {
+	body, err := c.Get(ctx, getFinalityCheckpointsTpl(stateId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting finality checkpoints by state id = %s", stateId)
+	}
+	fc := &structs.GetFinalityCheckpointsResponse{}
+	if err := json.Unmarshal(body, fc); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetFinalizedCheckpoint")
+	}
+	if fc.Data == nil || fc.Data.Finalized == nil {
+		return nil, errors.New("finalized checkpoint missing from finality checkpoints response")
+	}
+	return fc.Data.Finalized.ToConsensus()
+}
+
 // GetForkSchedule retrieve all forks, past present and future, of which this node is aware.
 func (c *Client) GetForkSchedule(ctx context.Context) (forks.OrderedSchedule, error) {
 	body, err := c.Get(ctx, getForkSchedulePath)
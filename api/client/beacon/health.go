@@ -48,7 +48,12 @@ func (n *NodeHealthTracker) CheckHealth(ctx context.Context) bool {
 	if isStatusChanged {
 		// Update the health status
 		n.isHealthy = &newStatus
-		// Send the new status to the health channel
+		// Send the new status to the health channel, replacing a previous update that was not consumed
+		// so that trackers nobody listens to do not block.
+		select {
+		case <-n.healthChan:
+		default:
+		}
 		n.healthChan <- newStatus
 	}
 	return newStatus
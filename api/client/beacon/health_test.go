@@ -116,3 +116,21 @@ func TestNodeHealth_Concurrency(t *testing.T) {
 
 	wg.Wait() // Wait for all goroutines to finish
 }
+
+func TestNodeHealth_UnconsumedUpdates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := healthTesting.NewMockHealthClient(ctrl)
+	n := NewNodeHealthTracker(client)
+
+	client.EXPECT().IsHealthy(gomock.Any()).Return(true)
+	n.CheckHealth(context.Background())
+	// Status changes are not blocked by updates nobody consumed, only the latest update is kept.
+	for _, healthy := range []bool{false, true, false} {
+		client.EXPECT().IsHealthy(gomock.Any()).Return(healthy)
+		n.CheckHealth(context.Background())
+	}
+	if status := <-n.HealthUpdates(); status {
+		t.Errorf("HealthUpdates() sent status %v, want false", status)
+	}
+}
@@ -0,0 +1,112 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// ErrNoQuorum is returned when not enough checkpoint sync providers agree on the same checkpoint.
+var ErrNoQuorum = errors.New("checkpoint sync providers did not reach quorum")
+
+// quorumValue is a value that providers are expected to agree on, which can be described in a report.
+type quorumValue interface {
+	comparable
+	fmt.Stringer
+}
+
+// checkpointKey identifies a finalized checkpoint by its epoch, block root and the root of the state at its first slot.
+type checkpointKey struct {
+	epoch     primitives.Epoch
+	root      [32]byte
+	stateRoot [32]byte
+}
+
+func (k checkpointKey) String() string {
+	return fmt.Sprintf("epoch=%d root=%#x state_root=%#x", k.epoch, k.root, k.stateRoot)
+}
+
+func (wsd WeakSubjectivityData) String() string {
+	return fmt.Sprintf("epoch=%d block_root=%#x state_root=%#x", wsd.Epoch, wsd.BlockRoot, wsd.StateRoot)
+}
+
+// IsMajorityQuorum returns true if a quorum is more than half of the providers.
+func IsMajorityQuorum(quorum, providers int) bool {
+	return 2*quorum > providers
+}
+
+// providerResult holds the value returned by a single provider, or the error the provider failed with.
+type providerResult[T quorumValue] struct {
+	client *Client
+	value  T
+	err    error
+}
+
+// queryProviders runs query against every client concurrently, and returns the results in the order of the clients.
+func queryProviders[T quorumValue](ctx context.Context, clients []*Client, query func(context.Context, *Client) (T, error)) []providerResult[T] {
+	results := make([]providerResult[T], len(clients))
+	var wg sync.WaitGroup
+	for i, c := range clients {
+		wg.Add(1)
+		go func(i int, c *Client) {
+			defer wg.Done()
+			v, err := query(ctx, c)
+			results[i] = providerResult[T]{client: c, value: v, err: err}
+		}(i, c)
+	}
+	wg.Wait()
+	return results
+}
+
+// agreedValue returns the value that at least quorum providers agree on, along with the clients of these providers.
+// A quorum of 0 requires all the providers to agree. The quorum must be a majority of the providers, so that no two
+// values can reach it.
+// When no value reaches the quorum, the error wraps ErrNoQuorum and reports the answer of every provider.
+func agreedValue[T quorumValue](results []providerResult[T], quorum int) (T, []*Client, error) {
+	var best T
+	if len(results) == 0 {
+		return best, nil, errors.New("no checkpoint sync provider configured")
+	}
+	if quorum == 0 {
+		quorum = len(results)
+	}
+	if quorum < 1 || quorum > len(results) {
+		return best, nil, errors.Errorf("invalid quorum %d for %d checkpoint sync providers", quorum, len(results))
+	}
+	if !IsMajorityQuorum(quorum, len(results)) {
+		return best, nil, errors.Errorf("invalid quorum %d, it must be a majority of the %d checkpoint sync providers", quorum, len(results))
+	}
+	if len(results) == 1 && results[0].err != nil {
+		// A single provider has nothing to be compared with, its error is more useful than a report.
+		return best, nil, results[0].err
+	}
+	votes := make(map[T][]*Client)
+	for _, r := range results {
+		if r.err != nil {
+			continue
+		}
+		votes[r.value] = append(votes[r.value], r.client)
+		if len(votes[r.value]) > len(votes[best]) {
+			best = r.value
+		}
+	}
+	if len(votes[best]) >= quorum {
+		return best, votes[best], nil
+	}
+
+	report := make([]string, 0, len(results))
+	for _, r := range results {
+		if r.err != nil {
+			report = append(report, fmt.Sprintf("%s: error: %v", r.client.NodeURL(), r.err))
+			continue
+		}
+		report = append(report, fmt.Sprintf("%s: %s (%d/%d)", r.client.NodeURL(), r.value, len(votes[r.value]), len(results)))
+	}
+	var zero T
+	return zero, nil, errors.Wrapf(ErrNoQuorum, "%d of %d providers required to agree, got:\n  %s",
+		quorum, len(results), strings.Join(report, "\n  "))
+}
@@ -0,0 +1,73 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestAgreedValue(t *testing.T) {
+	hosts := []string{"http://a:3500", "http://b:3500", "http://c:3500"}
+	clients := make([]*Client, len(hosts))
+	for i, h := range hosts {
+		c, err := NewClient(h)
+		require.NoError(t, err)
+		clients[i] = c
+	}
+	good := checkpointKey{epoch: 10, root: [32]byte{'a'}}
+	bad := checkpointKey{epoch: 10, root: [32]byte{'b'}}
+	answers := func(values map[string]checkpointKey) []providerResult[checkpointKey] {
+		return queryProviders(context.Background(), clients, func(_ context.Context, c *Client) (checkpointKey, error) {
+			v, ok := values[c.NodeURL()]
+			if !ok {
+				return checkpointKey{}, errors.New("connection refused")
+			}
+			return v, nil
+		})
+	}
+
+	t.Run("quorum reached", func(t *testing.T) {
+		results := answers(map[string]checkpointKey{hosts[0]: good, hosts[1]: bad, hosts[2]: good})
+		cp, agreeing, err := agreedValue(results, 2)
+		require.NoError(t, err)
+		assert.Equal(t, good, cp)
+		require.Equal(t, 2, len(agreeing))
+		assert.Equal(t, hosts[0], agreeing[0].NodeURL())
+		assert.Equal(t, hosts[2], agreeing[1].NodeURL())
+	})
+	t.Run("provider errors count against the quorum", func(t *testing.T) {
+		results := answers(map[string]checkpointKey{hosts[0]: good, hosts[2]: good})
+		_, _, err := agreedValue(results, 3)
+		require.ErrorIs(t, err, ErrNoQuorum)
+		assert.ErrorContains(t, hosts[1]+": error: connection refused", err)
+	})
+	t.Run("disagreement is reported", func(t *testing.T) {
+		results := answers(map[string]checkpointKey{hosts[0]: good, hosts[1]: bad, hosts[2]: good})
+		_, _, err := agreedValue(results, 3)
+		require.ErrorIs(t, err, ErrNoQuorum)
+		assert.ErrorContains(t, hosts[0]+": "+good.String()+" (2/3)", err)
+		assert.ErrorContains(t, hosts[1]+": "+bad.String()+" (1/3)", err)
+	})
+	t.Run("invalid quorum", func(t *testing.T) {
+		results := answers(map[string]checkpointKey{hosts[0]: good, hosts[1]: good, hosts[2]: good})
+		_, _, err := agreedValue(results, 4)
+		assert.ErrorContains(t, "invalid quorum 4", err)
+		_, _, err = agreedValue(results, -1)
+		assert.ErrorContains(t, "invalid quorum -1", err)
+		// Two values could otherwise both reach a quorum of a minority of the providers.
+		_, _, err = agreedValue(results, 1)
+		assert.ErrorContains(t, "invalid quorum 1, it must be a majority", err)
+	})
+	t.Run("zero quorum requires all providers", func(t *testing.T) {
+		results := answers(map[string]checkpointKey{hosts[0]: good, hosts[1]: good, hosts[2]: good})
+		_, agreeing, err := agreedValue(results, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 3, len(agreeing))
+		results = answers(map[string]checkpointKey{hosts[0]: good, hosts[1]: bad, hosts[2]: good})
+		_, _, err = agreedValue(results, 0)
+		require.ErrorIs(t, err, ErrNoQuorum)
+	})
+}
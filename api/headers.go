@@ -8,5 +8,6 @@ const (
 	JsonMediaType                 = "application/json"
 	OctetStreamMediaType          = "application/octet-stream"
 	EventStreamMediaType          = "text/event-stream"
+	CsvMediaType                  = "text/csv"
 	KeepAlive                     = "keep-alive"
 )
@@ -153,6 +153,49 @@ type Randao struct {
 	Randao string `json:"randao"`
 }
 
+type GetPendingDepositsResponse struct {
+	Version             string                   `json:"version"`
+	ExecutionOptimistic bool                     `json:"execution_optimistic"`
+	Finalized           bool                     `json:"finalized"`
+	Data                []*PendingBalanceDeposit `json:"data"`
+}
+
+type GetPendingPartialWithdrawalsResponse struct {
+	Version             string                      `json:"version"`
+	ExecutionOptimistic bool                        `json:"execution_optimistic"`
+	Finalized           bool                        `json:"finalized"`
+	Data                []*PendingPartialWithdrawal `json:"data"`
+}
+
+type GetPendingConsolidationsResponse struct {
+	Version             string                  `json:"version"`
+	ExecutionOptimistic bool                    `json:"execution_optimistic"`
+	Finalized           bool                    `json:"finalized"`
+	Data                []*PendingConsolidation `json:"data"`
+}
+
+type GetChurnResponse struct {
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+	Finalized           bool   `json:"finalized"`
+	Data                *Churn `json:"data"`
+}
+
+type Churn struct {
+	Epoch                          string `json:"epoch"`
+	TotalActiveBalance             string `json:"total_active_balance"`
+	BalanceChurnLimit              string `json:"balance_churn_limit"`
+	ActivationExitChurnLimit       string `json:"activation_exit_churn_limit"`
+	ConsolidationChurnLimit        string `json:"consolidation_churn_limit"`
+	DepositBalanceToConsume        string `json:"deposit_balance_to_consume"`
+	ExitBalanceToConsume           string `json:"exit_balance_to_consume"`
+	EarliestExitEpoch              string `json:"earliest_exit_epoch"`
+	ConsolidationBalanceToConsume  string `json:"consolidation_balance_to_consume"`
+	EarliestConsolidationEpoch     string `json:"earliest_consolidation_epoch"`
+	PendingDepositsCount           string `json:"pending_deposits_count"`
+	PendingPartialWithdrawalsCount string `json:"pending_partial_withdrawals_count"`
+	PendingConsolidationsCount     string `json:"pending_consolidations_count"`
+}
+
 type GetSyncCommitteeResponse struct {
 	ExecutionOptimistic bool                     `json:"execution_optimistic"`
 	Finalized           bool                     `json:"finalized"`
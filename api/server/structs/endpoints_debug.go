@@ -55,3 +55,98 @@ type ForkChoiceNodeExtraData struct {
 	ExecutionOptimistic      bool   `json:"execution_optimistic"`
 	TimeStamp                string `json:"timestamp"`
 }
+
+type GetAttestationPackingResponse struct {
+	Data *AttestationPacking `json:"data"`
+}
+
+type AttestationPacking struct {
+	Slot   string              `json:"slot"`
+	Packed *PackedAttestations `json:"packed"`
+	Naive  *PackedAttestations `json:"naive"`
+}
+
+type PackedAttestations struct {
+	Reward       string               `json:"reward"`
+	Attestations []*PackedAttestation `json:"attestations"`
+}
+
+type PackedAttestation struct {
+	Data            *AttestationData `json:"data"`
+	AggregationBits string           `json:"aggregation_bits"`
+	CommitteeBits   string           `json:"committee_bits,omitempty"`
+	Attesters       string           `json:"attesters"`
+	Reward          string           `json:"reward"`
+}
+
+type GetForkChoiceExplanationResponse struct {
+	Data *ForkChoiceExplanation `json:"data"`
+}
+
+type ForkChoiceExplanation struct {
+	Slot                     string                   `json:"slot"`
+	BlockRoot                string                   `json:"block_root"`
+	ParentRoot               string                   `json:"parent_root"`
+	Weight                   string                   `json:"weight"`
+	Balance                  string                   `json:"balance"`
+	VoteBalance              string                   `json:"vote_balance"`
+	Votes                    string                   `json:"votes"`
+	PendingVoteBalance       string                   `json:"pending_vote_balance"`
+	PendingVotes             string                   `json:"pending_votes"`
+	SlashedVotes             string                   `json:"slashed_votes"`
+	ChildrenWeight           string                   `json:"children_weight"`
+	Children                 []*ForkChoiceChildWeight `json:"children"`
+	ProposerBoost            string                   `json:"proposer_boost"`
+	DescendantProposerBoost  string                   `json:"descendant_proposer_boost"`
+	CommitteeWeight          string                   `json:"committee_weight"`
+	JustifiedEpoch           string                   `json:"justified_epoch"`
+	UnrealizedJustifiedEpoch string                   `json:"unrealized_justified_epoch"`
+	FinalizedEpoch           string                   `json:"finalized_epoch"`
+	UnrealizedFinalizedEpoch string                   `json:"unrealized_finalized_epoch"`
+	JustifiedCheckpoint      *Checkpoint              `json:"justified_checkpoint"`
+	FinalizedCheckpoint      *Checkpoint              `json:"finalized_checkpoint"`
+	ViableForHead            bool                     `json:"viable_for_head"`
+	LeadsToViableHead        bool                     `json:"leads_to_viable_head"`
+	Canonical                bool                     `json:"canonical"`
+	Head                     bool                     `json:"head"`
+	BestDescendant           string                   `json:"best_descendant,omitempty"`
+	ExecutionOptimistic      bool                     `json:"execution_optimistic"`
+	TimeStamp                string                   `json:"timestamp"`
+	ArrivedEarly             bool                     `json:"arrived_early"`
+	WeakHead                 bool                     `json:"weak_head"`
+	StrongParent             bool                     `json:"strong_parent"`
+}
+
+type ForkChoiceChildWeight struct {
+	BlockRoot string `json:"block_root"`
+	Weight    string `json:"weight"`
+}
+
+type SimulateForkChoiceRequest struct {
+	Blocks       []*ForkChoiceSimulatedBlock       `json:"blocks"`
+	Attestations []*ForkChoiceSimulatedAttestation `json:"attestations"`
+}
+
+type ForkChoiceSimulatedBlock struct {
+	Slot           string `json:"slot"`
+	BlockRoot      string `json:"block_root"`
+	ParentRoot     string `json:"parent_root"`
+	JustifiedEpoch string `json:"justified_epoch,omitempty"`
+	FinalizedEpoch string `json:"finalized_epoch,omitempty"`
+	ProposerBoost  bool   `json:"proposer_boost"`
+}
+
+type ForkChoiceSimulatedAttestation struct {
+	ValidatorIndices []string `json:"validator_indices"`
+	BlockRoot        string   `json:"block_root"`
+	TargetEpoch      string   `json:"target_epoch"`
+}
+
+type SimulateForkChoiceResponse struct {
+	Data *ForkChoiceSimulation `json:"data"`
+}
+
+type ForkChoiceSimulation struct {
+	PreviousHeadRoot string                 `json:"previous_head_root"`
+	Head             *ForkChoiceExplanation `json:"head"`
+}
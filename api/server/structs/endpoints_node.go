@@ -71,3 +71,19 @@ type AddrRequest struct {
 type PeersResponse struct {
 	Peers []*Peer `json:"peers"`
 }
+
+type GetHealthDetailResponse struct {
+	Data *HealthDetail `json:"data"`
+}
+
+type HealthDetail struct {
+	ExecutionEndpoints []*ExecutionEndpointStatus `json:"execution_endpoints"`
+}
+
+type ExecutionEndpointStatus struct {
+	Endpoint   string `json:"endpoint"`
+	Primary    bool   `json:"primary"`
+	Status     string `json:"status"`
+	LastError  string `json:"last_error,omitempty"`
+	LastUpdate string `json:"last_update"`
+}
@@ -0,0 +1,48 @@
+package structs
+
+type GetSlasherAttesterSlashingsResponse struct {
+	Data []*AttesterSlashing `json:"data"`
+}
+
+type GetSlasherProposerSlashingsResponse struct {
+	Data []*ProposerSlashing `json:"data"`
+}
+
+type CheckSlashableAttestationResponse struct {
+	Data []*AttesterSlashing `json:"data"`
+}
+
+type CheckSlashableBlockResponse struct {
+	Data []*ProposerSlashing `json:"data"`
+}
+
+type GetSlasherAttestationRecordResponse struct {
+	Data *SlasherAttestationRecord `json:"data"`
+}
+
+type SlasherAttestationRecord struct {
+	DataRoot    string              `json:"data_root"`
+	Attestation *IndexedAttestation `json:"attestation"`
+}
+
+type GetSlasherProposalRecordResponse struct {
+	Data *SlasherProposalRecord `json:"data"`
+}
+
+type SlasherProposalRecord struct {
+	HeaderRoot   string                   `json:"header_root"`
+	SignedHeader *SignedBeaconBlockHeader `json:"signed_header"`
+}
+
+type GetSlasherValidatorSpansResponse struct {
+	Data *SlasherValidatorSpans `json:"data"`
+}
+
+type SlasherValidatorSpans struct {
+	ValidatorIndex string `json:"validator_index"`
+	Epoch          string `json:"epoch"`
+	MinSpan        string `json:"min_span"`
+	MaxSpan        string `json:"max_span"`
+	MinTarget      string `json:"min_target,omitempty"`
+	MaxTarget      string `json:"max_target,omitempty"`
+}
@@ -117,4 +117,48 @@ type GetValidatorPerformanceResponse struct {
 	BalancesAfterEpochTransition  []uint64 `json:"balances_after_epoch_transition,omitempty"`
 	MissingValidators             [][]byte `json:"missing_validators,omitempty"`
 	InactivityScores              []uint64 `json:"inactivity_scores,omitempty"`
+	InclusionSlots                []uint64 `json:"inclusion_slots,omitempty"`
+}
+
+type GetValidatorMonitorHistoryResponse struct {
+	Data []*ValidatorMonitorEpoch `json:"data"`
+}
+
+type ValidatorMonitorEpoch struct {
+	Epoch                 string `json:"epoch"`
+	Balance               string `json:"balance"`
+	BalanceChange         string `json:"balance_change"`
+	AttestationIncluded   bool   `json:"attestation_included"`
+	InclusionDistance     string `json:"inclusion_distance"`
+	CorrectSource         bool   `json:"correct_source"`
+	CorrectTarget         bool   `json:"correct_target"`
+	CorrectHead           bool   `json:"correct_head"`
+	SyncCommitteeExpected string `json:"sync_committee_expected"`
+	SyncCommitteeIncluded string `json:"sync_committee_included"`
+	ProposedBlocks        string `json:"proposed_blocks"`
+}
+
+type GetProposalAuditResponse struct {
+	Data *ProposalAudit `json:"data"`
+}
+
+type ProposalAudit struct {
+	Slot                 string               `json:"slot"`
+	ProposerIndex        string               `json:"proposer_index"`
+	BuilderBoostFactor   string               `json:"builder_boost_factor"`
+	LocalBlockValueBoost string               `json:"local_block_value_boost"`
+	BuilderSkipReason    string               `json:"builder_skip_reason,omitempty"`
+	Candidates           []*ProposalCandidate `json:"candidates"`
+	Blinded              bool                 `json:"blinded"`
+	Reveal               string               `json:"reveal"`
+	RevealError          string               `json:"reveal_error,omitempty"`
+}
+
+type ProposalCandidate struct {
+	Source     string `json:"source"`
+	BlockHash  string `json:"block_hash,omitempty"`
+	Value      string `json:"value,omitempty"`
+	DurationMs string `json:"duration_ms"`
+	Selected   bool   `json:"selected"`
+	Reason     string `json:"reason,omitempty"`
 }
@@ -47,6 +47,8 @@ type ForkchoiceFetcher interface {
 	ReceivedBlocksLastEpoch() (uint64, error)
 	InsertNode(context.Context, state.BeaconState, [32]byte) error
 	ForkChoiceDump(context.Context) (*forkchoice.Dump, error)
+	ForkChoiceExplain(context.Context, [32]byte) (*forkchoice.Explanation, error)
+	ForkChoiceSimulate(context.Context, *forkchoice.Simulation) (*forkchoice.SimulationResult, error)
 	NewSlot(context.Context, primitives.Slot) error
 	ProposerBoost() [32]byte
 }
@@ -269,6 +271,8 @@ func (s *Service) HeadETH1Data() *ethpb.Eth1Data {
 
 // GenesisTime returns the genesis time of beacon chain.
 func (s *Service) GenesisTime() time.Time {
+	s.genesisTimeLock.RLock()
+	defer s.genesisTimeLock.RUnlock()
 	return s.genesisTime
 }
 
@@ -511,6 +515,8 @@ func (s *Service) Ancestor(ctx context.Context, root []byte, slot primitives.Slo
 
 // SetGenesisTime sets the genesis time of beacon chain.
 func (s *Service) SetGenesisTime(t time.Time) {
+	s.genesisTimeLock.Lock()
+	defer s.genesisTimeLock.Unlock()
 	s.genesisTime = t
 }
 
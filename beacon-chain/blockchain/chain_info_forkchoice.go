@@ -64,6 +64,25 @@ func (s *Service) ForkChoiceDump(ctx context.Context) (*forkchoice.Dump, error)
 	return s.cfg.ForkChoiceStore.ForkChoiceDump(ctx)
 }
 
+// ForkChoiceExplain returns the weight breakdown of the given root from forkchoice
+func (s *Service) ForkChoiceExplain(ctx context.Context, root [32]byte) (*forkchoice.Explanation, error) {
+	s.cfg.ForkChoiceStore.RLock()
+	defer s.cfg.ForkChoiceStore.RUnlock()
+	return s.cfg.ForkChoiceStore.Explain(ctx, root)
+}
+
+// ForkChoiceSimulate returns the head of a copy of forkchoice after applying the simulation. Only the copy is taken
+// under the forkchoice lock.
+func (s *Service) ForkChoiceSimulate(ctx context.Context, sim *forkchoice.Simulation) (*forkchoice.SimulationResult, error) {
+	s.cfg.ForkChoiceStore.RLock()
+	simulator, err := s.cfg.ForkChoiceStore.Simulator()
+	s.cfg.ForkChoiceStore.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return simulator.Simulate(ctx, sim)
+}
+
 // NewSlot returns the corresponding value from forkchoice
 func (s *Service) NewSlot(ctx context.Context, slot primitives.Slot) error {
 	s.cfg.ForkChoiceStore.Lock()
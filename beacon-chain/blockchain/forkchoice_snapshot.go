@@ -0,0 +1,167 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// runForkChoiceSnapshots saves a snapshot of fork choice to the database at the start of every epoch, so that it can
+// be restored on the next restart instead of being rebuilt from the finalized checkpoint.
+func (s *Service) runForkChoiceSnapshots() {
+	ticker := slots.NewSlotTicker(s.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if !slots.IsEpochStart(slot) {
+				continue
+			}
+			if err := s.saveForkChoiceSnapshot(s.ctx); err != nil {
+				log.WithError(err).Error("Could not save forkchoice snapshot")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// saveForkChoiceSnapshot saves a snapshot of fork choice to the database.
+func (s *Service) saveForkChoiceSnapshot(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveForkChoiceSnapshot")
+	defer span.End()
+
+	s.cfg.ForkChoiceStore.RLock()
+	snapshot, err := s.cfg.ForkChoiceStore.Snapshot()
+	s.cfg.ForkChoiceStore.RUnlock()
+	if err != nil {
+		return errors.Wrap(err, "could not snapshot forkchoice")
+	}
+	// The snapshot is encoded, and compressed by the database, without holding the fork choice lock.
+	enc, err := snapshot.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "could not encode forkchoice snapshot")
+	}
+	return s.cfg.BeaconDB.SaveForkChoiceSnapshot(ctx, enc)
+}
+
+// restoreForkChoiceSnapshot restores fork choice from the snapshot saved in the database and inserts the blocks of the
+// canonical chain imported since the snapshot was taken. It returns false if there is no snapshot to restore, and an
+// error if the snapshot is stale, corrupt or cannot be reconciled with the database. Fork choice is only replaced once
+// the restored store is known to be consistent, so that the caller can fall back to rebuilding it.
+// The caller is responsible for holding the fork choice lock.
+func (s *Service) restoreForkChoiceSnapshot(ctx context.Context, justified, finalized *ethpb.Checkpoint) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.restoreForkChoiceSnapshot")
+	defer span.End()
+
+	snapshot, err := s.cfg.BeaconDB.ForkChoiceSnapshot(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get forkchoice snapshot")
+	}
+	if len(snapshot) == 0 {
+		return false, nil
+	}
+	fc := doublylinkedtree.New()
+	fc.SetBalancesByRooter(s.cfg.StateGen.ActiveNonSlashedBalancesByRoot)
+	if err := fc.RestoreSnapshot(ctx, snapshot); err != nil {
+		return false, err
+	}
+	fc.SetGenesisTime(uint64(s.GenesisTime().Unix()))
+	if err := s.checkForkChoiceSnapshot(ctx, fc, finalized); err != nil {
+		return false, errors.Wrap(err, "stale forkchoice snapshot")
+	}
+	if err := s.reconcileForkChoiceSnapshot(ctx, fc, justified, finalized); err != nil {
+		return false, errors.Wrap(err, "could not insert blocks imported since the forkchoice snapshot")
+	}
+	reconciled, err := fc.Snapshot()
+	if err != nil {
+		return false, err
+	}
+	enc, err := reconciled.Marshal()
+	if err != nil {
+		return false, err
+	}
+	if err := s.cfg.ForkChoiceStore.RestoreSnapshot(ctx, enc); err != nil {
+		return false, err
+	}
+	log.WithField("nodeCount", fc.NodeCount()).Info("Restored forkchoice from snapshot")
+	return true, nil
+}
+
+// checkForkChoiceSnapshot verifies that a restored snapshot is on the finalized checkpoint of the database and that
+// the blocks of all its nodes are in the database.
+func (s *Service) checkForkChoiceSnapshot(ctx context.Context, fc *doublylinkedtree.ForkChoice, finalized *ethpb.Checkpoint) error {
+	fcp := fc.FinalizedCheckpoint()
+	if fcp.Epoch != finalized.Epoch || fcp.Root != bytesutil.ToBytes32(finalized.Root) {
+		return errors.Errorf("snapshot finalized checkpoint (%d, %#x) does not match database finalized checkpoint (%d, %#x)",
+			fcp.Epoch, fcp.Root, finalized.Epoch, finalized.Root)
+	}
+	if !fc.HasNode(s.ensureRootNotZeros(fcp.Root)) {
+		return errors.Errorf("snapshot does not contain the finalized block %#x", fcp.Root)
+	}
+	dump, err := fc.ForkChoiceDump(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not dump forkchoice snapshot")
+	}
+	for _, n := range dump.ForkChoiceNodes {
+		if !s.cfg.BeaconDB.HasBlock(ctx, bytesutil.ToBytes32(n.BlockRoot)) {
+			return errors.Errorf("block %#x of the snapshot is not in the database", n.BlockRoot)
+		}
+	}
+	return nil
+}
+
+// reconcileForkChoiceSnapshot inserts the blocks between the last node of the snapshot and the head block of the
+// database into the restored fork choice.
+func (s *Service) reconcileForkChoiceSnapshot(ctx context.Context, fc *doublylinkedtree.ForkChoice, justified, finalized *ethpb.Checkpoint) error {
+	headBlock, err := s.cfg.BeaconDB.HeadBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head block")
+	}
+	if headBlock == nil || headBlock.IsNil() {
+		return nil
+	}
+	headRoot, err := headBlock.Block().HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute head block root")
+	}
+	if fc.HasNode(headRoot) {
+		return nil
+	}
+	fSlot, err := slots.EpochStart(finalized.Epoch)
+	if err != nil {
+		return err
+	}
+	pendingNodes := []*forkchoicetypes.BlockAndCheckpoints{{Block: headBlock.Block(),
+		JustifiedCheckpoint: justified, FinalizedCheckpoint: finalized}}
+	root := headBlock.Block().ParentRoot()
+	for !fc.HasNode(root) {
+		b, err := s.getBlock(ctx, root)
+		if err != nil {
+			return err
+		}
+		if b.Block().Slot() <= fSlot {
+			return ErrNotDescendantOfFinalized
+		}
+		pendingNodes = append(pendingNodes, &forkchoicetypes.BlockAndCheckpoints{Block: b.Block(),
+			JustifiedCheckpoint: justified, FinalizedCheckpoint: finalized})
+		root = b.Block().ParentRoot()
+	}
+	// InsertChain inserts every block but the head one, which is inserted with its post state.
+	if err := fc.InsertChain(ctx, pendingNodes); err != nil {
+		return err
+	}
+	st, err := s.cfg.StateGen.StateByRoot(ctx, headRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	return fc.InsertNode(ctx, st, headRoot)
+}
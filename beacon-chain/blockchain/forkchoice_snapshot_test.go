@@ -0,0 +1,130 @@
+package blockchain
+
+import (
+	"testing"
+
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// forkChoiceSnapshotTestService starts a service on a finalized block F and saves a snapshot of fork choice with the
+// chain F <- A, while the database also has the block B on top of A as its head.
+func forkChoiceSnapshotTestService(t *testing.T) (*Service, *testServiceRequirements, [3][32]byte) {
+	resetFn := features.InitWithReset(&features.Flags{EnableForkChoiceSnapshots: true})
+	t.Cleanup(resetFn)
+
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	finalizedSlot := params.BeaconConfig().SlotsPerEpoch*2 + 1
+	finalizedBlock := util.NewBeaconBlock()
+	finalizedBlock.Block.Slot = finalizedSlot
+	finalizedBlock.Block.ParentRoot = bytesutil.PadTo(genesisRoot[:], 32)
+	finalizedRoot, err := finalizedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	finalizedState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, finalizedState.SetSlot(finalizedSlot))
+	require.NoError(t, finalizedState.SetGenesisValidatorsRoot(params.BeaconConfig().ZeroHash[:]))
+
+	blockA := util.NewBeaconBlock()
+	blockA.Block.Slot = finalizedSlot + 1
+	blockA.Block.ParentRoot = finalizedRoot[:]
+	rootA, err := blockA.Block.HashTreeRoot()
+	require.NoError(t, err)
+	blockB := util.NewBeaconBlock()
+	blockB.Block.Slot = finalizedSlot + 2
+	blockB.Block.ParentRoot = rootA[:]
+	rootB, err := blockB.Block.HashTreeRoot()
+	require.NoError(t, err)
+	stateB := finalizedState.Copy()
+	require.NoError(t, stateB.SetSlot(blockB.Block.Slot))
+	require.NoError(t, stateB.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{Slot: blockB.Block.Slot, ParentRoot: rootA[:], StateRoot: make([]byte, 32), BodyRoot: make([]byte, 32)}))
+
+	c, tr := minimalTestService(t, WithFinalizedStateAtStartUp(finalizedState))
+	ctx, beaconDB, stateGen := tr.ctx, tr.db, tr.sg
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	util.SaveBlock(t, ctx, beaconDB, finalizedBlock)
+	util.SaveBlock(t, ctx, beaconDB, blockA)
+	util.SaveBlock(t, ctx, beaconDB, blockB)
+	require.NoError(t, beaconDB.SaveState(ctx, finalizedState, genesisRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, finalizedState, finalizedRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, stateB, rootB))
+	require.NoError(t, stateGen.SaveState(ctx, finalizedRoot, finalizedState))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: slots.ToEpoch(finalizedSlot), Root: finalizedRoot[:]}))
+	require.NoError(t, c.StartFromSavedState(finalizedState))
+
+	wsbA, err := blocks.NewSignedBeaconBlock(blockA)
+	require.NoError(t, err)
+	wsbB, err := blocks.NewSignedBeaconBlock(blockB)
+	require.NoError(t, err)
+	cp := &ethpb.Checkpoint{Epoch: slots.ToEpoch(finalizedSlot), Root: finalizedRoot[:]}
+	require.NoError(t, c.cfg.ForkChoiceStore.InsertChain(ctx, []*forkchoicetypes.BlockAndCheckpoints{
+		{Block: wsbB.Block(), JustifiedCheckpoint: cp, FinalizedCheckpoint: cp},
+		{Block: wsbA.Block(), JustifiedCheckpoint: cp, FinalizedCheckpoint: cp},
+	}))
+	require.NoError(t, c.saveForkChoiceSnapshot(ctx))
+	require.NoError(t, beaconDB.SaveHeadBlockRoot(ctx, rootB))
+
+	// Start again from an empty fork choice.
+	fcs := doublylinkedtree.New()
+	fcs.SetBalancesByRooter(stateGen.ActiveNonSlashedBalancesByRoot)
+	c.cfg.ForkChoiceStore = fcs
+	c.clockSetter = startup.NewClockSynchronizer()
+	return c, tr, [3][32]byte{finalizedRoot, rootA, rootB}
+}
+
+func TestService_StartFromSavedState_RestoresForkChoiceSnapshot(t *testing.T) {
+	c, _, roots := forkChoiceSnapshotTestService(t)
+	require.NoError(t, c.StartFromSavedState(c.cfg.FinalizedStateAtStartUp))
+
+	for _, root := range roots {
+		assert.Equal(t, true, c.cfg.ForkChoiceStore.HasNode(root))
+	}
+	ancestor, err := c.cfg.ForkChoiceStore.AncestorRoot(c.ctx, roots[2], params.BeaconConfig().SlotsPerEpoch*2+2)
+	require.NoError(t, err)
+	assert.Equal(t, roots[1], ancestor)
+}
+
+func TestService_StartFromSavedState_StaleForkChoiceSnapshot(t *testing.T) {
+	c, tr, roots := forkChoiceSnapshotTestService(t)
+	ctx := tr.ctx
+	finalized, err := tr.db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	justified, err := tr.db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+
+	stale := &ethpb.Checkpoint{Epoch: finalized.Epoch + 1, Root: finalized.Root}
+	restored, err := c.restoreForkChoiceSnapshot(ctx, justified, stale)
+	require.ErrorContains(t, "stale forkchoice snapshot", err)
+	assert.Equal(t, false, restored)
+	assert.Equal(t, 0, c.cfg.ForkChoiceStore.NodeCount())
+
+	// A corrupt snapshot is discarded and fork choice is rebuilt from the finalized checkpoint.
+	require.NoError(t, tr.db.SaveForkChoiceSnapshot(ctx, []byte("corrupt")))
+	require.NoError(t, c.StartFromSavedState(c.cfg.FinalizedStateAtStartUp))
+	assert.Equal(t, 1, c.cfg.ForkChoiceStore.NodeCount())
+	assert.Equal(t, true, c.cfg.ForkChoiceStore.HasNode(roots[0]))
+	snapshot, err := tr.db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(snapshot))
+}
+
+func TestService_StartFromSavedState_NoForkChoiceSnapshot(t *testing.T) {
+	c, tr, roots := forkChoiceSnapshotTestService(t)
+	require.NoError(t, tr.db.DeleteForkChoiceSnapshot(tr.ctx))
+	require.NoError(t, c.StartFromSavedState(c.cfg.FinalizedStateAtStartUp))
+	assert.Equal(t, 1, c.cfg.ForkChoiceStore.NodeCount())
+	assert.Equal(t, true, c.cfg.ForkChoiceStore.HasNode(roots[0]))
+}
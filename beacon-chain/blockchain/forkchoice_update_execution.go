@@ -133,7 +133,7 @@ func (s *Service) shouldOverrideFCU(newHeadRoot [32]byte, proposingSlot primitiv
 			return true
 		}
 		secs, err := slots.SecondsSinceSlotStart(currentSlot,
-			uint64(s.genesisTime.Unix()), uint64(time.Now().Unix()))
+			uint64(s.GenesisTime().Unix()), uint64(time.Now().Unix()))
 		if err != nil {
 			log.WithError(err).Error("could not compute seconds since slot start")
 		}
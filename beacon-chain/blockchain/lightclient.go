@@ -6,17 +6,23 @@ import (
 	"fmt"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/v5/proto/migration"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
 const (
-	finalityBranchNumOfLeaves = 6
+	finalityBranchNumOfLeaves  = 6
+	executionBranchNumOfLeaves = 4
 )
 
 // CreateLightClientFinalityUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/full-node.md#create_light_client_finality_update
@@ -55,6 +61,66 @@ func CreateLightClientOptimisticUpdate(update *ethpbv2.LightClientUpdate) *ethpb
 	}
 }
 
+// NewLightClientBootstrapFromBeaconState - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/full-node.md#create_light_client_bootstrap
+// def create_light_client_bootstrap(state: BeaconState) -> LightClientBootstrap:
+//
+//	assert compute_epoch_at_slot(state.slot) >= ALTAIR_FORK_EPOCH
+//	assert state.slot == state.latest_block_header.slot
+//
+//	return LightClientBootstrap(
+//	    header=BeaconBlockHeader(
+//	        slot=state.latest_block_header.slot,
+//	        proposer_index=state.latest_block_header.proposer_index,
+//	        parent_root=state.latest_block_header.parent_root,
+//	        state_root=hash_tree_root(state),
+//	        body_root=state.latest_block_header.body_root,
+//	    ),
+//	    current_sync_committee=state.current_sync_committee,
+//	    current_sync_committee_branch=compute_merkle_proof_for_state(state, CURRENT_SYNC_COMMITTEE_INDEX)
+//	)
+func NewLightClientBootstrapFromBeaconState(ctx context.Context, state state.BeaconState) (*ethpbv2.LightClientBootstrap, error) {
+	// assert compute_epoch_at_slot(state.slot) >= ALTAIR_FORK_EPOCH
+	if slots.ToEpoch(state.Slot()) < params.BeaconConfig().AltairForkEpoch {
+		return nil, fmt.Errorf("light client bootstrap is not supported before Altair, invalid slot %d", state.Slot())
+	}
+
+	// assert state.slot == state.latest_block_header.slot
+	latestBlockHeader := state.LatestBlockHeader()
+	if state.Slot() != latestBlockHeader.Slot {
+		return nil, fmt.Errorf("state slot %d not equal to latest block header slot %d", state.Slot(), latestBlockHeader.Slot)
+	}
+
+	currentSyncCommittee, err := state.CurrentSyncCommittee()
+	if err != nil {
+		return nil, fmt.Errorf("could not get current sync committee %v", err)
+	}
+
+	currentSyncCommitteeBranch, err := state.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get current sync committee proof %v", err)
+	}
+
+	stateRoot, err := state.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get state root %v", err)
+	}
+
+	return &ethpbv2.LightClientBootstrap{
+		Header: &ethpbv1.BeaconBlockHeader{
+			Slot:          latestBlockHeader.Slot,
+			ProposerIndex: latestBlockHeader.ProposerIndex,
+			ParentRoot:    latestBlockHeader.ParentRoot,
+			StateRoot:     stateRoot[:],
+			BodyRoot:      latestBlockHeader.BodyRoot,
+		},
+		CurrentSyncCommittee: &ethpbv2.SyncCommittee{
+			Pubkeys:         currentSyncCommittee.Pubkeys,
+			AggregatePubkey: currentSyncCommittee.AggregatePubkey,
+		},
+		CurrentSyncCommitteeBranch: currentSyncCommitteeBranch,
+	}, nil
+}
+
 func NewLightClientOptimisticUpdateFromBeaconState(
 	ctx context.Context,
 	state state.BeaconState,
@@ -226,6 +292,300 @@ func NewLightClientFinalityUpdateFromBeaconState(
 	return result, nil
 }
 
+// NewLightClientUpdateFromBeaconState - implements https://github.com/ethereum/consensus-specs/blob/d70dcd9926a4bbe987f1b4e65c3e05bd029fcfb8/specs/altair/light-client/full-node.md#create_light_client_update
+// It extends the finality update of the block with the next sync committee of the attested state,
+// which is only useful to a light client if the update is signed by the current sync committee:
+//
+//	# `next_sync_committee` is only useful if the message is signed by the current sync committee
+//	if update_attested_period == update_signature_period:
+//	    next_sync_committee = attested_state.next_sync_committee
+//	    next_sync_committee_branch = compute_merkle_proof_for_state(attested_state, NEXT_SYNC_COMMITTEE_INDEX)
+//	else:
+//	    next_sync_committee = SyncCommittee()
+//	    next_sync_committee_branch = [Bytes32() for _ in range(floorlog2(NEXT_SYNC_COMMITTEE_INDEX))]
+func NewLightClientUpdateFromBeaconState(
+	ctx context.Context,
+	state state.BeaconState,
+	block interfaces.ReadOnlySignedBeaconBlock,
+	attestedState state.BeaconState,
+	finalizedBlock interfaces.ReadOnlySignedBeaconBlock) (*ethpbv2.LightClientUpdate, error) {
+	result, err := NewLightClientFinalityUpdateFromBeaconState(ctx, state, block, attestedState, finalizedBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	// update_signature_period = compute_sync_committee_period(compute_epoch_at_slot(block.message.slot))
+	updateSignaturePeriod := slots.SyncCommitteePeriod(slots.ToEpoch(block.Block().Slot()))
+
+	// update_attested_period = compute_sync_committee_period(compute_epoch_at_slot(attested_header.slot))
+	updateAttestedPeriod := slots.SyncCommitteePeriod(slots.ToEpoch(result.AttestedHeader.Slot))
+
+	if updateAttestedPeriod == updateSignaturePeriod {
+		nextSyncCommittee, err := attestedState.NextSyncCommittee()
+		if err != nil {
+			return nil, fmt.Errorf("could not get next sync committee %v", err)
+		}
+		result.NextSyncCommittee = &ethpbv2.SyncCommittee{
+			Pubkeys:         nextSyncCommittee.Pubkeys,
+			AggregatePubkey: nextSyncCommittee.AggregatePubkey,
+		}
+		result.NextSyncCommitteeBranch, err = attestedState.NextSyncCommitteeProof(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get next sync committee proof %v", err)
+		}
+	} else {
+		syncCommitteeSize := params.BeaconConfig().SyncCommitteeSize
+		pubKeys := make([][]byte, syncCommitteeSize)
+		for i := uint64(0); i < syncCommitteeSize; i++ {
+			pubKeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+		}
+		result.NextSyncCommittee = &ethpbv2.SyncCommittee{
+			Pubkeys:         pubKeys,
+			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+		}
+		result.NextSyncCommitteeBranch = make([][]byte, fieldparams.NextSyncCommitteeBranchDepth)
+		for i := 0; i < fieldparams.NextSyncCommitteeBranchDepth; i++ {
+			result.NextSyncCommitteeBranch[i] = make([]byte, fieldparams.RootLength)
+		}
+	}
+
+	return result, nil
+}
+
+// NewLightClientHeaderCapella - implements https://github.com/ethereum/consensus-specs/blob/d70dcd9926a4bbe987f1b4e65c3e05bd029fcfb8/specs/capella/light-client/full-node.md#block_to_light_client_header
+// It returns the Capella light client header of the beacon header of a block. The execution payload header and
+// execution branch are empty when the block is from before Capella, or nil for the empty finalized header of an
+// update without finality.
+func NewLightClientHeaderCapella(header *ethpbv1.BeaconBlockHeader, block interfaces.ReadOnlySignedBeaconBlock) (*ethpbv2.LightClientHeaderCapella, error) {
+	if block == nil || block.IsNil() || block.Version() < version.Capella {
+		return &ethpbv2.LightClientHeaderCapella{
+			Beacon:          header,
+			Execution:       emptyPayloadHeaderCapella(),
+			ExecutionBranch: emptyExecutionBranch(),
+		}, nil
+	}
+	if block.Version() > version.Capella {
+		return nil, fmt.Errorf("cannot create a Capella light client header for a %s block", version.String(block.Version()))
+	}
+	payload, err := block.Block().Body().Execution()
+	if err != nil {
+		return nil, fmt.Errorf("could not get execution payload %v", err)
+	}
+	var execution *enginev1.ExecutionPayloadHeaderCapella
+	if payload.IsBlinded() {
+		var ok bool
+		execution, ok = payload.Proto().(*enginev1.ExecutionPayloadHeaderCapella)
+		if !ok {
+			return nil, fmt.Errorf("unexpected execution payload header type %T", payload.Proto())
+		}
+	} else {
+		execution, err = blocks.PayloadToHeaderCapella(payload)
+		if err != nil {
+			return nil, fmt.Errorf("could not get execution payload header %v", err)
+		}
+	}
+	executionBranch, err := blocks.PayloadProof(block.Block().Body())
+	if err != nil {
+		return nil, fmt.Errorf("could not get execution payload proof %v", err)
+	}
+	return &ethpbv2.LightClientHeaderCapella{
+		Beacon:          header,
+		Execution:       execution,
+		ExecutionBranch: executionBranch,
+	}, nil
+}
+
+// NewLightClientHeaderDeneb - implements https://github.com/ethereum/consensus-specs/blob/d70dcd9926a4bbe987f1b4e65c3e05bd029fcfb8/specs/deneb/light-client/full-node.md#block_to_light_client_header
+// It returns the Deneb light client header of the beacon header of a block. The blob gas fields are zero for
+// Capella blocks, and the execution payload header and execution branch are empty when the block is from before
+// Capella, or nil for the empty finalized header of an update without finality.
+func NewLightClientHeaderDeneb(header *ethpbv1.BeaconBlockHeader, block interfaces.ReadOnlySignedBeaconBlock) (*ethpbv2.LightClientHeaderDeneb, error) {
+	if block == nil || block.IsNil() || block.Version() < version.Deneb {
+		capellaHeader, err := NewLightClientHeaderCapella(header, block)
+		if err != nil {
+			return nil, err
+		}
+		e := capellaHeader.Execution
+		return &ethpbv2.LightClientHeaderDeneb{
+			Beacon: header,
+			Execution: &enginev1.ExecutionPayloadHeaderDeneb{
+				ParentHash:       e.ParentHash,
+				FeeRecipient:     e.FeeRecipient,
+				StateRoot:        e.StateRoot,
+				ReceiptsRoot:     e.ReceiptsRoot,
+				LogsBloom:        e.LogsBloom,
+				PrevRandao:       e.PrevRandao,
+				BlockNumber:      e.BlockNumber,
+				GasLimit:         e.GasLimit,
+				GasUsed:          e.GasUsed,
+				Timestamp:        e.Timestamp,
+				ExtraData:        e.ExtraData,
+				BaseFeePerGas:    e.BaseFeePerGas,
+				BlockHash:        e.BlockHash,
+				TransactionsRoot: e.TransactionsRoot,
+				WithdrawalsRoot:  e.WithdrawalsRoot,
+			},
+			ExecutionBranch: capellaHeader.ExecutionBranch,
+		}, nil
+	}
+	if block.Version() > version.Deneb {
+		return nil, fmt.Errorf("cannot create a Deneb light client header for a %s block", version.String(block.Version()))
+	}
+	payload, err := block.Block().Body().Execution()
+	if err != nil {
+		return nil, fmt.Errorf("could not get execution payload %v", err)
+	}
+	var execution *enginev1.ExecutionPayloadHeaderDeneb
+	if payload.IsBlinded() {
+		var ok bool
+		execution, ok = payload.Proto().(*enginev1.ExecutionPayloadHeaderDeneb)
+		if !ok {
+			return nil, fmt.Errorf("unexpected execution payload header type %T", payload.Proto())
+		}
+	} else {
+		execution, err = blocks.PayloadToHeaderDeneb(payload)
+		if err != nil {
+			return nil, fmt.Errorf("could not get execution payload header %v", err)
+		}
+	}
+	executionBranch, err := blocks.PayloadProof(block.Block().Body())
+	if err != nil {
+		return nil, fmt.Errorf("could not get execution payload proof %v", err)
+	}
+	return &ethpbv2.LightClientHeaderDeneb{
+		Beacon:          header,
+		Execution:       execution,
+		ExecutionBranch: executionBranch,
+	}, nil
+}
+
+// LightClientHeaderCapellaFromDeneb returns the Capella light client header of a Deneb light client header. The
+// Deneb form holds the light client header of a Capella block with zero blob gas fields.
+func LightClientHeaderCapellaFromDeneb(header *ethpbv2.LightClientHeaderDeneb) *ethpbv2.LightClientHeaderCapella {
+	e := header.Execution
+	return &ethpbv2.LightClientHeaderCapella{
+		Beacon: header.Beacon,
+		Execution: &enginev1.ExecutionPayloadHeaderCapella{
+			ParentHash:       e.ParentHash,
+			FeeRecipient:     e.FeeRecipient,
+			StateRoot:        e.StateRoot,
+			ReceiptsRoot:     e.ReceiptsRoot,
+			LogsBloom:        e.LogsBloom,
+			PrevRandao:       e.PrevRandao,
+			BlockNumber:      e.BlockNumber,
+			GasLimit:         e.GasLimit,
+			GasUsed:          e.GasUsed,
+			Timestamp:        e.Timestamp,
+			ExtraData:        e.ExtraData,
+			BaseFeePerGas:    e.BaseFeePerGas,
+			BlockHash:        e.BlockHash,
+			TransactionsRoot: e.TransactionsRoot,
+			WithdrawalsRoot:  e.WithdrawalsRoot,
+		},
+		ExecutionBranch: header.ExecutionBranch,
+	}
+}
+
+func emptyPayloadHeaderCapella() *enginev1.ExecutionPayloadHeaderCapella {
+	return &enginev1.ExecutionPayloadHeaderCapella{
+		ParentHash:       make([]byte, fieldparams.RootLength),
+		FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:        make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+		LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:       make([]byte, fieldparams.RootLength),
+		ExtraData:        make([]byte, 0),
+		BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+		BlockHash:        make([]byte, fieldparams.RootLength),
+		TransactionsRoot: make([]byte, fieldparams.RootLength),
+		WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+	}
+}
+
+func emptyExecutionBranch() [][]byte {
+	branch := make([][]byte, executionBranchNumOfLeaves)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return branch
+}
+
+// IsBetterUpdate - implements https://github.com/ethereum/consensus-specs/blob/d70dcd9926a4bbe987f1b4e65c3e05bd029fcfb8/specs/altair/light-client/sync-protocol.md#is_better_update
+// It reports whether newUpdate should replace oldUpdate as the best update of a sync committee period.
+func IsBetterUpdate(newUpdate, oldUpdate *ethpbv2.LightClientUpdate) bool {
+	// Compare supermajority (> 2/3) sync committee participation
+	maxActiveParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Len()
+	newNumActiveParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	oldNumActiveParticipants := oldUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	newHasSupermajority := newNumActiveParticipants*3 >= maxActiveParticipants*2
+	oldHasSupermajority := oldNumActiveParticipants*3 >= maxActiveParticipants*2
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	// Compare presence of relevant sync committee
+	newHasRelevantSyncCommittee := isSyncCommitteeUpdate(newUpdate) &&
+		syncCommitteePeriodAtSlot(newUpdate.AttestedHeader.Slot) == syncCommitteePeriodAtSlot(newUpdate.SignatureSlot)
+	oldHasRelevantSyncCommittee := isSyncCommitteeUpdate(oldUpdate) &&
+		syncCommitteePeriodAtSlot(oldUpdate.AttestedHeader.Slot) == syncCommitteePeriodAtSlot(oldUpdate.SignatureSlot)
+	if newHasRelevantSyncCommittee != oldHasRelevantSyncCommittee {
+		return newHasRelevantSyncCommittee
+	}
+
+	// Compare indication of any finality
+	newHasFinality := isFinalityUpdate(newUpdate)
+	oldHasFinality := isFinalityUpdate(oldUpdate)
+	if newHasFinality != oldHasFinality {
+		return newHasFinality
+	}
+
+	// Compare sync committee finality
+	if newHasFinality {
+		newHasSyncCommitteeFinality := syncCommitteePeriodAtSlot(newUpdate.FinalizedHeader.Slot) == syncCommitteePeriodAtSlot(newUpdate.AttestedHeader.Slot)
+		oldHasSyncCommitteeFinality := syncCommitteePeriodAtSlot(oldUpdate.FinalizedHeader.Slot) == syncCommitteePeriodAtSlot(oldUpdate.AttestedHeader.Slot)
+		if newHasSyncCommitteeFinality != oldHasSyncCommitteeFinality {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	// Tiebreaker 1: Sync committee participation beyond supermajority
+	if newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	// Tiebreaker 2: Prefer older data (fewer changes to best)
+	if newUpdate.AttestedHeader.Slot != oldUpdate.AttestedHeader.Slot {
+		return newUpdate.AttestedHeader.Slot < oldUpdate.AttestedHeader.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+// isSyncCommitteeUpdate reports whether the update carries a next sync committee.
+func isSyncCommitteeUpdate(update *ethpbv2.LightClientUpdate) bool {
+	return !isEmptyBranch(update.NextSyncCommitteeBranch)
+}
+
+// isFinalityUpdate reports whether the update carries a finalized header.
+func isFinalityUpdate(update *ethpbv2.LightClientUpdate) bool {
+	return !isEmptyBranch(update.FinalityBranch)
+}
+
+func isEmptyBranch(branch [][]byte) bool {
+	for _, root := range branch {
+		if len(bytes.TrimLeft(root, "\x00")) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func syncCommitteePeriodAtSlot(slot primitives.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
 func NewLightClientUpdateFromFinalityUpdate(update *ethpbv2.LightClientFinalityUpdate) *ethpbv2.LightClientUpdate {
 	return &ethpbv2.LightClientUpdate{
 		AttestedHeader:  update.AttestedHeader,
@@ -1,14 +1,17 @@
 package blockchain
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -158,3 +161,190 @@ func TestLightClient_NewLightClientFinalityUpdateFromBeaconState(t *testing.T) {
 		require.DeepSSZEqual(t, zeroHash, leaf, "Leaf is not zero")
 	}
 }
+
+func TestLightClient_NewLightClientUpdateFromBeaconState(t *testing.T) {
+	l := newTestLc(t).setupTest()
+
+	update, err := NewLightClientUpdateFromBeaconState(l.ctx, l.state, l.block, l.attestedState, nil)
+	require.NoError(t, err)
+	require.NotNil(t, update, "update is nil")
+
+	l.checkSyncAggregate(update)
+	l.checkAttestedHeader(update)
+
+	// The attested header and the block are in the same sync committee period.
+	nextSyncCommittee, err := l.attestedState.NextSyncCommittee()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, nextSyncCommittee.Pubkeys, update.NextSyncCommittee.Pubkeys, "Next sync committee is not equal")
+	nextSyncCommitteeBranch, err := l.attestedState.NextSyncCommitteeProof(l.ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, nextSyncCommitteeBranch, update.NextSyncCommitteeBranch, "Next sync committee branch is not equal")
+
+	_, err = update.MarshalSSZ()
+	require.NoError(t, err)
+}
+
+func TestLightClient_IsBetterUpdate(t *testing.T) {
+	period := primitives.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	nonZeroBranch := func(depth int) [][]byte {
+		branch := make([][]byte, depth)
+		for i := range branch {
+			branch[i] = bytes.Repeat([]byte{1}, 32)
+		}
+		return branch
+	}
+	newUpdate := func(participants uint64, attestedSlot, signatureSlot primitives.Slot, syncCommittee, finality bool) *ethpbv2.LightClientUpdate {
+		bits := bitfield.NewBitvector512()
+		for i := uint64(0); i < participants; i++ {
+			bits.SetBitAt(i, true)
+		}
+		update := &ethpbv2.LightClientUpdate{
+			AttestedHeader:          &v1.BeaconBlockHeader{Slot: attestedSlot},
+			FinalizedHeader:         &v1.BeaconBlockHeader{Slot: attestedSlot},
+			NextSyncCommitteeBranch: make([][]byte, 5),
+			FinalityBranch:          make([][]byte, finalityBranchNumOfLeaves),
+			SyncAggregate:           &v1.SyncAggregate{SyncCommitteeBits: bits},
+			SignatureSlot:           signatureSlot,
+		}
+		if syncCommittee {
+			update.NextSyncCommitteeBranch = nonZeroBranch(5)
+		}
+		if finality {
+			update.FinalityBranch = nonZeroBranch(finalityBranchNumOfLeaves)
+		}
+		return update
+	}
+
+	tests := []struct {
+		name      string
+		newUpdate *ethpbv2.LightClientUpdate
+		oldUpdate *ethpbv2.LightClientUpdate
+		want      bool
+	}{
+		{
+			name:      "supermajority wins",
+			newUpdate: newUpdate(400, 10, 11, false, false),
+			oldUpdate: newUpdate(300, 10, 11, true, true),
+			want:      true,
+		},
+		{
+			name:      "more participants without supermajority",
+			newUpdate: newUpdate(200, 10, 11, false, false),
+			oldUpdate: newUpdate(300, 10, 11, true, true),
+			want:      false,
+		},
+		{
+			name:      "relevant sync committee",
+			newUpdate: newUpdate(400, 10, 11, true, false),
+			oldUpdate: newUpdate(500, 10, 11, false, true),
+			want:      true,
+		},
+		{
+			name:      "sync committee signed by the next period is not relevant",
+			newUpdate: newUpdate(500, period-1, period, true, true),
+			oldUpdate: newUpdate(400, 10, 11, true, false),
+			want:      false,
+		},
+		{
+			name:      "finality",
+			newUpdate: newUpdate(400, 10, 11, true, true),
+			oldUpdate: newUpdate(500, 10, 11, true, false),
+			want:      true,
+		},
+		{
+			name:      "more participants beyond supermajority",
+			newUpdate: newUpdate(500, 10, 11, true, true),
+			oldUpdate: newUpdate(400, 10, 11, true, true),
+			want:      true,
+		},
+		{
+			name:      "older attested header",
+			newUpdate: newUpdate(400, 9, 12, true, true),
+			oldUpdate: newUpdate(400, 10, 11, true, true),
+			want:      true,
+		},
+		{
+			name:      "same update",
+			newUpdate: newUpdate(400, 10, 11, true, true),
+			oldUpdate: newUpdate(400, 10, 11, true, true),
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsBetterUpdate(tt.newUpdate, tt.oldUpdate))
+		})
+	}
+}
+
+func TestLightClient_NewLightClientHeaderCapella(t *testing.T) {
+	blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+	require.NoError(t, err)
+	header := &v1.BeaconBlockHeader{Slot: blk.Block().Slot(), ParentRoot: make([]byte, 32), StateRoot: make([]byte, 32), BodyRoot: make([]byte, 32)}
+
+	lcHeader, err := NewLightClientHeaderCapella(header, blk)
+	require.NoError(t, err)
+	require.Equal(t, header, lcHeader.Beacon)
+	payload, err := blk.Block().Body().Execution()
+	require.NoError(t, err)
+	require.DeepEqual(t, payload.BlockHash(), lcHeader.Execution.BlockHash)
+	payloadRoot, err := payload.HashTreeRoot()
+	require.NoError(t, err)
+	executionRoot, err := lcHeader.Execution.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, payloadRoot, executionRoot)
+	bodyRoot, err := blk.Block().Body().HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, true, trie.VerifyMerkleProof(bodyRoot[:], executionRoot[:], 9, lcHeader.ExecutionBranch))
+
+	// Headers without a Capella block have an empty execution payload header.
+	altairBlock, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockAltair())
+	require.NoError(t, err)
+	for _, b := range []interfaces.ReadOnlySignedBeaconBlock{nil, altairBlock} {
+		lcHeader, err = NewLightClientHeaderCapella(header, b)
+		require.NoError(t, err)
+		require.DeepEqual(t, make([]byte, 32), lcHeader.Execution.BlockHash)
+		require.Equal(t, 4, len(lcHeader.ExecutionBranch))
+		_, err = lcHeader.MarshalSSZ()
+		require.NoError(t, err)
+	}
+
+	denebBlock, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockDeneb())
+	require.NoError(t, err)
+	_, err = NewLightClientHeaderCapella(header, denebBlock)
+	require.ErrorContains(t, "cannot create a Capella light client header", err)
+}
+
+func TestLightClient_NewLightClientHeaderDeneb(t *testing.T) {
+	blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockDeneb())
+	require.NoError(t, err)
+	header := &v1.BeaconBlockHeader{Slot: blk.Block().Slot(), ParentRoot: make([]byte, 32), StateRoot: make([]byte, 32), BodyRoot: make([]byte, 32)}
+
+	lcHeader, err := NewLightClientHeaderDeneb(header, blk)
+	require.NoError(t, err)
+	payload, err := blk.Block().Body().Execution()
+	require.NoError(t, err)
+	payloadRoot, err := payload.HashTreeRoot()
+	require.NoError(t, err)
+	executionRoot, err := lcHeader.Execution.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, payloadRoot, executionRoot)
+	bodyRoot, err := blk.Block().Body().HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, true, trie.VerifyMerkleProof(bodyRoot[:], executionRoot[:], 9, lcHeader.ExecutionBranch))
+
+	// Capella blocks keep their execution payload, with zero blob gas fields.
+	capellaBlock, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+	require.NoError(t, err)
+	lcHeader, err = NewLightClientHeaderDeneb(header, capellaBlock)
+	require.NoError(t, err)
+	capellaPayload, err := capellaBlock.Block().Body().Execution()
+	require.NoError(t, err)
+	require.DeepEqual(t, capellaPayload.BlockHash(), lcHeader.Execution.BlockHash)
+	require.Equal(t, uint64(0), lcHeader.Execution.BlobGasUsed)
+	bodyRoot, err = capellaBlock.Block().Body().HashTreeRoot()
+	require.NoError(t, err)
+	capellaPayloadRoot, err := capellaPayload.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, true, trie.VerifyMerkleProof(bodyRoot[:], capellaPayloadRoot[:], 9, lcHeader.ExecutionBranch))
+}
@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	mathutil "github.com/prysmaticlabs/prysm/v5/math"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
@@ -29,7 +31,7 @@ import (
 
 // CurrentSlot returns the current slot based on time.
 func (s *Service) CurrentSlot() primitives.Slot {
-	return slots.CurrentSlot(uint64(s.genesisTime.Unix()))
+	return slots.CurrentSlot(uint64(s.GenesisTime().Unix()))
 }
 
 // getFCUArgs returns the arguments to call forkchoice update
@@ -41,7 +43,7 @@ func (s *Service) getFCUArgs(cfg *postBlockProcessConfig, fcuArgs *fcuConfig) er
 		return nil
 	}
 	slot := cfg.signed.Block().Slot()
-	if slots.WithinVotingWindow(uint64(s.genesisTime.Unix()), slot) {
+	if slots.WithinVotingWindow(uint64(s.GenesisTime().Unix()), slot) {
 		return nil
 	}
 	return s.computePayloadAttributes(cfg, fcuArgs)
@@ -124,9 +126,87 @@ func (s *Service) sendLightClientFeeds(cfg *postBlockProcessConfig) {
 
 		// LightClientFinalityUpdate needs super majority
 		s.tryPublishLightClientFinalityUpdate(cfg.ctx, cfg.signed, finalized, cfg.postState)
+
+		if err := s.saveLightClientUpdate(cfg.ctx, cfg.signed, cfg.postState); err != nil {
+			log.WithError(err).Error("Failed to save light client update")
+		}
 	}
 }
 
+// saveLightClientUpdate saves the light client update of the block when it is better than the update
+// saved for the sync committee period of its attested header.
+func (s *Service) saveLightClientUpdate(ctx context.Context, signed interfaces.ReadOnlySignedBeaconBlock,
+	postState state.BeaconState) error {
+	attestedRoot := signed.Block().ParentRoot()
+	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, attestedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested state")
+	}
+
+	finalizedBlock := s.lightClientFinalizedBlock(ctx, attestedState)
+	update, err := NewLightClientUpdateFromBeaconState(
+		ctx,
+		postState,
+		signed,
+		attestedState,
+		finalizedBlock,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not create light client update")
+	}
+
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(update.AttestedHeader.Slot))
+	best, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	if err != nil {
+		return errors.Wrapf(err, "could not get light client update of period %d", period)
+	}
+	if best != nil && !IsBetterUpdate(update, best) {
+		return nil
+	}
+	if slots.ToEpoch(update.AttestedHeader.Slot) >= params.BeaconConfig().CapellaForkEpoch {
+		attestedBlock, err := s.cfg.BeaconDB.Block(ctx, attestedRoot)
+		if err != nil {
+			return errors.Wrap(err, "could not get attested block")
+		}
+		if err := s.saveLightClientHeader(ctx, update.AttestedHeader, attestedBlock); err != nil {
+			return err
+		}
+		if !bytes.Equal(update.FinalizedHeader.BodyRoot, params.BeaconConfig().ZeroHash[:]) {
+			if err := s.saveLightClientHeader(ctx, update.FinalizedHeader, finalizedBlock); err != nil {
+				return err
+			}
+		}
+	}
+	return s.cfg.BeaconDB.SaveLightClientUpdate(ctx, period, update)
+}
+
+// saveLightClientHeader saves the light client header of a block of a saved light client update, so that the update
+// can be served in the form of its fork once the block is pruned.
+func (s *Service) saveLightClientHeader(ctx context.Context, header *ethpbv1.BeaconBlockHeader, blk interfaces.ReadOnlySignedBeaconBlock) error {
+	if blk == nil || blk.IsNil() {
+		return errors.Errorf("could not find block of light client header at slot %d", header.Slot)
+	}
+	lcHeader, err := NewLightClientHeaderDeneb(header, blk)
+	if err != nil {
+		return errors.Wrap(err, "could not create light client header")
+	}
+	return s.cfg.BeaconDB.SaveLightClientHeader(ctx, lcHeader)
+}
+
+// lightClientFinalizedBlock returns the block of the finalized checkpoint of the attested state, or nil
+// if it is not in the database.
+func (s *Service) lightClientFinalizedBlock(ctx context.Context, attestedState state.BeaconState) interfaces.ReadOnlySignedBeaconBlock {
+	finalizedCheckPoint := attestedState.FinalizedCheckpoint()
+	if finalizedCheckPoint == nil {
+		return nil
+	}
+	finalizedBlock, err := s.cfg.BeaconDB.Block(ctx, bytesutil.ToBytes32(finalizedCheckPoint.Root))
+	if err != nil {
+		return nil
+	}
+	return finalizedBlock
+}
+
 func (s *Service) tryPublishLightClientFinalityUpdate(ctx context.Context, signed interfaces.ReadOnlySignedBeaconBlock, finalized *forkchoicetypes.Checkpoint, postState state.BeaconState) {
 	if finalized.Epoch <= s.lastPublishedLightClientEpoch {
 		return
@@ -165,23 +245,12 @@ func (s *Service) sendLightClientFinalityUpdate(ctx context.Context, signed inte
 		return 0, errors.Wrap(err, "could not get attested state")
 	}
 
-	// Get finalized block
-	var finalizedBlock interfaces.ReadOnlySignedBeaconBlock
-	finalizedCheckPoint := attestedState.FinalizedCheckpoint()
-	if finalizedCheckPoint != nil {
-		finalizedRoot := bytesutil.ToBytes32(finalizedCheckPoint.Root)
-		finalizedBlock, err = s.cfg.BeaconDB.Block(ctx, finalizedRoot)
-		if err != nil {
-			finalizedBlock = nil
-		}
-	}
-
 	update, err := NewLightClientFinalityUpdateFromBeaconState(
 		ctx,
 		postState,
 		signed,
 		attestedState,
-		finalizedBlock,
+		s.lightClientFinalizedBlock(ctx, attestedState),
 	)
 
 	if err != nil {
@@ -299,7 +368,7 @@ func (s *Service) getBlockPreState(ctx context.Context, b interfaces.ReadOnlyBea
 	}
 
 	// Verify block slot time is not from the future.
-	if err := slots.VerifyTime(uint64(s.genesisTime.Unix()), b.Slot(), params.BeaconConfig().MaximumGossipClockDisparityDuration()); err != nil {
+	if err := slots.VerifyTime(uint64(s.GenesisTime().Unix()), b.Slot(), params.BeaconConfig().MaximumGossipClockDisparityDuration()); err != nil {
 		return nil, err
 	}
 
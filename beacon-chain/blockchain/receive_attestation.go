@@ -41,7 +41,7 @@ func (s *Service) AttestationTargetState(ctx context.Context, target *ethpb.Chec
 	if err != nil {
 		return nil, err
 	}
-	if err := slots.ValidateClock(ss, uint64(s.genesisTime.Unix())); err != nil {
+	if err := slots.ValidateClock(ss, uint64(s.GenesisTime().Unix())); err != nil {
 		return nil, err
 	}
 	// We acquire the lock here instead than on gettAttPreState because that function gets called from UpdateHead that holds a write lock
@@ -70,9 +70,9 @@ func (s *Service) spawnProcessAttestationsRoutine() {
 			log.WithError(err).Error("spawnProcessAttestationsRoutine failed to receive genesis data")
 			return
 		}
-		if s.genesisTime.IsZero() {
+		if s.GenesisTime().IsZero() {
 			log.Warn("ProcessAttestations routine waiting for genesis time")
-			for s.genesisTime.IsZero() {
+			for s.GenesisTime().IsZero() {
 				if err := s.ctx.Err(); err != nil {
 					log.WithError(err).Error("Giving up waiting for genesis time")
 					return
@@ -88,7 +88,7 @@ func (s *Service) spawnProcessAttestationsRoutine() {
 		}
 
 		reorgInterval := time.Second*time.Duration(params.BeaconConfig().SecondsPerSlot) - reorgLateBlockCountAttestations
-		ticker := slots.NewSlotTickerWithIntervals(s.genesisTime, []time.Duration{0, reorgInterval})
+		ticker := slots.NewSlotTickerWithIntervals(s.GenesisTime(), []time.Duration{0, reorgInterval})
 		for {
 			select {
 			case <-s.ctx.Done():
@@ -171,7 +171,7 @@ func (s *Service) processAttestations(ctx context.Context, disparity time.Durati
 		// This delays consideration in the fork choice until their slot is in the past.
 		// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/fork-choice.md#validate_on_attestation
 		nextSlot := a.GetData().Slot + 1
-		if err := slots.VerifyTime(uint64(s.genesisTime.Unix()), nextSlot, disparity); err != nil {
+		if err := slots.VerifyTime(uint64(s.GenesisTime().Unix()), nextSlot, disparity); err != nil {
 			continue
 		}
 
@@ -185,7 +185,7 @@ func (s *Service) processAttestations(ctx context.Context, disparity time.Durati
 			log.WithError(err).Error("Could not delete fork choice attestation in pool")
 		}
 
-		if !helpers.VerifyCheckpointEpoch(a.GetData().Target, s.genesisTime) {
+		if !helpers.VerifyCheckpointEpoch(a.GetData().Target, s.GenesisTime()) {
 			continue
 		}
 
@@ -53,6 +53,7 @@ type Service struct {
 	ctx                           context.Context
 	cancel                        context.CancelFunc
 	genesisTime                   time.Time
+	genesisTimeLock               sync.RWMutex
 	head                          *head
 	headLock                      sync.RWMutex
 	originBlockRoot               [32]byte // genesis root, or weak subjectivity checkpoint root, depending on how the node is initialized
@@ -212,6 +213,9 @@ func (s *Service) Start() {
 	}
 	s.spawnProcessAttestationsRoutine()
 	go s.runLateBlockTasks()
+	if features.Get().EnableForkChoiceSnapshots {
+		go s.runForkChoiceSnapshots()
+	}
 }
 
 // Stop the blockchain service's main event loop and associated goroutines.
@@ -230,6 +234,12 @@ func (s *Service) Stop() error {
 	} else {
 		s.headLock.RUnlock()
 	}
+	// Save fork choice so that it does not have to be rebuilt in the following run.
+	if features.Get().EnableForkChoiceSnapshots && s.cfg.ForkChoiceStore != nil {
+		if err := s.saveForkChoiceSnapshot(s.ctx); err != nil {
+			log.WithError(err).Error("Could not save forkchoice snapshot")
+		}
+	}
 	// Save initial sync cached blocks to the DB before stop.
 	return s.cfg.BeaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks())
 }
@@ -258,7 +268,7 @@ func (s *Service) Status() error {
 // StartFromSavedState initializes the blockchain using a previously saved finalized checkpoint.
 func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 	log.Info("Blockchain data already exists in DB, initializing...")
-	s.genesisTime = time.Unix(int64(saved.GenesisTime()), 0) // lint:ignore uintcast -- Genesis time will not exceed int64 in your lifetime.
+	s.SetGenesisTime(time.Unix(int64(saved.GenesisTime()), 0)) // lint:ignore uintcast -- Genesis time will not exceed int64 in your lifetime.
 	s.cfg.AttService.SetGenesisTime(saved.GenesisTime())
 
 	originRoot, err := s.originRootFromSavedState(s.ctx)
@@ -270,7 +280,7 @@ func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 	if err := s.initializeHeadFromDB(s.ctx); err != nil {
 		return errors.Wrap(err, "could not set up chain info")
 	}
-	spawnCountdownIfPreGenesis(s.ctx, s.genesisTime, s.cfg.BeaconDB)
+	spawnCountdownIfPreGenesis(s.ctx, s.GenesisTime(), s.cfg.BeaconDB)
 
 	justified, err := s.cfg.BeaconDB.JustifiedCheckpoint(s.ctx)
 	if err != nil {
@@ -287,10 +297,45 @@ func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 		return errNilFinalizedCheckpoint
 	}
 
-	fRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalized.Root))
 	s.cfg.ForkChoiceStore.Lock()
 	defer s.cfg.ForkChoiceStore.Unlock()
-	if err := s.cfg.ForkChoiceStore.UpdateJustifiedCheckpoint(s.ctx, &forkchoicetypes.Checkpoint{Epoch: justified.Epoch,
+	restored := false
+	if features.Get().EnableForkChoiceSnapshots {
+		restored, err = s.restoreForkChoiceSnapshot(s.ctx, justified, finalized)
+		if err != nil {
+			log.WithError(err).Warn("Could not restore forkchoice from snapshot, rebuilding it from the finalized checkpoint")
+			// The snapshot is not retried on the next start, a new one is saved at the next epoch.
+			if err := s.cfg.BeaconDB.DeleteForkChoiceSnapshot(s.ctx); err != nil {
+				log.WithError(err).Error("Could not delete forkchoice snapshot")
+			}
+		}
+	}
+	if !restored {
+		if err := s.initializeForkChoiceFromFinalized(s.ctx, justified, finalized); err != nil {
+			return err
+		}
+	}
+
+	// not attempting to save initial sync blocks here, because there shouldn't be any until
+	// after the statefeed.Initialized event is fired (below)
+	if err := s.wsVerifier.VerifyWeakSubjectivity(s.ctx, finalized.Epoch); err != nil {
+		// Exit run time if the node failed to verify weak subjectivity checkpoint.
+		return errors.Wrap(err, "could not verify initial checkpoint provided for chain sync")
+	}
+
+	vr := bytesutil.ToBytes32(saved.GenesisValidatorsRoot())
+	if err := s.clockSetter.SetClock(startup.NewClock(s.GenesisTime(), vr)); err != nil {
+		return errors.Wrap(err, "failed to initialize blockchain service")
+	}
+
+	return nil
+}
+
+// initializeForkChoiceFromFinalized initializes fork choice with the justified and finalized checkpoints and the
+// finalized block only. The caller is responsible for holding the fork choice lock.
+func (s *Service) initializeForkChoiceFromFinalized(ctx context.Context, justified, finalized *ethpb.Checkpoint) error {
+	fRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalized.Root))
+	if err := s.cfg.ForkChoiceStore.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Epoch: justified.Epoch,
 		Root: bytesutil.ToBytes32(justified.Root)}); err != nil {
 		return errors.Wrap(err, "could not update forkchoice's justified checkpoint")
 	}
@@ -298,38 +343,26 @@ func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 		Root: bytesutil.ToBytes32(finalized.Root)}); err != nil {
 		return errors.Wrap(err, "could not update forkchoice's finalized checkpoint")
 	}
-	s.cfg.ForkChoiceStore.SetGenesisTime(uint64(s.genesisTime.Unix()))
+	s.cfg.ForkChoiceStore.SetGenesisTime(uint64(s.GenesisTime().Unix()))
 
-	st, err := s.cfg.StateGen.StateByRoot(s.ctx, fRoot)
+	st, err := s.cfg.StateGen.StateByRoot(ctx, fRoot)
 	if err != nil {
 		return errors.Wrap(err, "could not get finalized checkpoint state")
 	}
-	if err := s.cfg.ForkChoiceStore.InsertNode(s.ctx, st, fRoot); err != nil {
+	if err := s.cfg.ForkChoiceStore.InsertNode(ctx, st, fRoot); err != nil {
 		return errors.Wrap(err, "could not insert finalized block to forkchoice")
 	}
 	if !features.Get().EnableStartOptimistic {
-		lastValidatedCheckpoint, err := s.cfg.BeaconDB.LastValidatedCheckpoint(s.ctx)
+		lastValidatedCheckpoint, err := s.cfg.BeaconDB.LastValidatedCheckpoint(ctx)
 		if err != nil {
 			return errors.Wrap(err, "could not get last validated checkpoint")
 		}
 		if bytes.Equal(finalized.Root, lastValidatedCheckpoint.Root) {
-			if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(s.ctx, fRoot); err != nil {
+			if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, fRoot); err != nil {
 				return errors.Wrap(err, "could not set finalized block as validated")
 			}
 		}
 	}
-	// not attempting to save initial sync blocks here, because there shouldn't be any until
-	// after the statefeed.Initialized event is fired (below)
-	if err := s.wsVerifier.VerifyWeakSubjectivity(s.ctx, finalized.Epoch); err != nil {
-		// Exit run time if the node failed to verify weak subjectivity checkpoint.
-		return errors.Wrap(err, "could not verify initial checkpoint provided for chain sync")
-	}
-
-	vr := bytesutil.ToBytes32(saved.GenesisValidatorsRoot())
-	if err := s.clockSetter.SetClock(startup.NewClock(s.genesisTime, vr)); err != nil {
-		return errors.Wrap(err, "failed to initialize blockchain service")
-	}
-
 	return nil
 }
 
@@ -467,7 +500,7 @@ func (s *Service) initializeBeaconChain(
 	eth1data *ethpb.Eth1Data) (state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.Service.initializeBeaconChain")
 	defer span.End()
-	s.genesisTime = genesisTime
+	s.SetGenesisTime(genesisTime)
 	unixTime := uint64(genesisTime.Unix())
 
 	genesisState, err := transition.OptimizedGenesisBeaconState(unixTime, preGenesisState, eth1data)
@@ -524,7 +557,7 @@ func (s *Service) saveGenesisData(ctx context.Context, genesisState state.Beacon
 	if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, genesisBlkRoot); err != nil {
 		return errors.Wrap(err, "Could not set optimistic status of genesis block to false")
 	}
-	s.cfg.ForkChoiceStore.SetGenesisTime(uint64(s.genesisTime.Unix()))
+	s.cfg.ForkChoiceStore.SetGenesisTime(uint64(s.GenesisTime().Unix()))
 
 	if err := s.setHead(&head{
 		genesisBlkRoot,
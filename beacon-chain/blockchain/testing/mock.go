@@ -591,6 +591,26 @@ func (s *ChainService) ForkChoiceDump(ctx context.Context) (*forkchoice2.Dump, e
 	return nil, nil
 }
 
+// ForkChoiceExplain mocks the same method in the chain service
+func (s *ChainService) ForkChoiceExplain(ctx context.Context, root [32]byte) (*forkchoice2.Explanation, error) {
+	if s.ForkChoiceStore != nil {
+		return s.ForkChoiceStore.Explain(ctx, root)
+	}
+	return nil, nil
+}
+
+// ForkChoiceSimulate mocks the same method in the chain service
+func (s *ChainService) ForkChoiceSimulate(ctx context.Context, sim *forkchoice2.Simulation) (*forkchoice2.SimulationResult, error) {
+	if s.ForkChoiceStore != nil {
+		simulator, err := s.ForkChoiceStore.Simulator()
+		if err != nil {
+			return nil, err
+		}
+		return simulator.Simulate(ctx, sim)
+	}
+	return nil, nil
+}
+
 // NewSlot mocks the same method in the chain service
 func (s *ChainService) NewSlot(ctx context.Context, slot primitives.Slot) error {
 	if s.ForkChoiceStore != nil {
@@ -27,4 +27,26 @@ var (
 			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
 		},
 	)
+	relayRequestLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "builder_relay_request_latency_milliseconds",
+			Help:    "Captures RPC latency per relay and builder API method in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay", "method"},
+	)
+	relayRequestFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_request_failures_total",
+			Help: "Number of failed or invalid responses per relay and builder API method",
+		},
+		[]string{"relay", "method"},
+	)
+	relayBidsSelected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_bids_selected_total",
+			Help: "Number of times a relay returned the highest value bid",
+		},
+		[]string{"relay"},
+	)
 )
@@ -1,11 +1,15 @@
 package builder
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/container/slice"
 	"github.com/urfave/cli/v2"
 )
 
@@ -14,24 +18,48 @@ type Option func(s *Service) error
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
 	endpoint := c.String(flags.MevRelayEndpoint.Name)
-	var client *builder.Client
-	if endpoint != "" {
-		var err error
-		client, err = builder.NewClient(endpoint)
+	extraEndpoints := slice.SplitCommaSeparated(c.StringSlice(flags.MevExtraRelayEndpoints.Name))
+	if endpoint == "" && len(extraEndpoints) > 0 {
+		return nil, fmt.Errorf("--%s specified, but not --%s", flags.MevExtraRelayEndpoints.Name, flags.MevRelayEndpoint.Name)
+	}
+	endpoints := append([]string{endpoint}, extraEndpoints...)
+	clients := make([]builder.BuilderClient, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if endpoint == "" {
+			continue
+		}
+		client, err := builder.NewClient(endpoint)
 		if err != nil {
 			return nil, err
 		}
+		clients = append(clients, client)
 	}
 	opts := []Option{
-		WithBuilderClient(client),
+		WithBuilderClients(clients...),
+		WithRelayTimeout(c.Duration(flags.MevRelayTimeout.Name)),
 	}
 	return opts, nil
 }
 
-// WithBuilderClient sets the builder client for the beacon chain builder service.
+// WithBuilderClient adds a builder client for the beacon chain builder service.
+// It can be used multiple times to configure several relays.
 func WithBuilderClient(client builder.BuilderClient) Option {
+	return WithBuilderClients(client)
+}
+
+// WithBuilderClients adds builder clients for the beacon chain builder service.
+// Headers are requested from every client and the highest value bid is used.
+func WithBuilderClients(clients ...builder.BuilderClient) Option {
+	return func(s *Service) error {
+		s.cfg.builderClients = append(s.cfg.builderClients, clients...)
+		return nil
+	}
+}
+
+// WithRelayTimeout sets the maximum time to wait for a single relay to return a header.
+func WithRelayTimeout(timeout time.Duration) Option {
 	return func(s *Service) error {
-		s.cfg.builderClient = client
+		s.cfg.relayTimeout = timeout
 		return nil
 	}
 }
@@ -0,0 +1,291 @@
+package builder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	getHeaderMethod          = "get_header"
+	submitBlindedBlockMethod = "submit_blinded_block"
+	registerValidatorMethod  = "register_validator"
+	statusMethod             = "status"
+)
+
+var errNoValidBid = errors.New("no relay returned a valid bid")
+
+// servedHeader tracks which relays returned a given execution payload header, so that the
+// signed blinded block can be routed back to them.
+type servedHeader struct {
+	slot   primitives.Slot
+	relays []builder.BuilderClient
+}
+
+// relayBid is the outcome of requesting a header from a single relay.
+type relayBid struct {
+	relay     builder.BuilderClient
+	bid       builder.SignedBid
+	value     *big.Int
+	blockHash [32]byte
+	duration  time.Duration
+	err       error
+}
+
+// bestHeader requests a header from every configured relay in parallel and returns the
+// valid bid with the highest value. Ties are broken in favor of the relay configured first.
+func (s *Service) bestHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error) {
+	results := make([]*relayBid, len(s.relays))
+	var wg sync.WaitGroup
+	for i, r := range s.relays {
+		wg.Add(1)
+		go func(i int, r builder.BuilderClient) {
+			defer wg.Done()
+			results[i] = s.relayHeader(ctx, r, slot, parentHash, pubKey)
+		}(i, r)
+	}
+	wg.Wait()
+
+	var best *relayBid
+	errs := make([]string, 0)
+	for _, res := range results {
+		if res.err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", res.relay.NodeURL(), res.err))
+			continue
+		}
+		if best == nil || res.value.Cmp(best.value) > 0 {
+			best = res
+		}
+	}
+	if best == nil {
+		s.servedHeadersLock.Lock()
+		s.relayBids[slot] = relayBidCandidates(results, nil)
+		s.servedHeadersLock.Unlock()
+		if len(results) == 1 {
+			return nil, results[0].err
+		}
+		return nil, errors.Wrap(errNoValidBid, strings.Join(errs, "; "))
+	}
+
+	served := &servedHeader{slot: slot}
+	for _, res := range results {
+		if res.err == nil && res.blockHash == best.blockHash {
+			served.relays = append(served.relays, res.relay)
+		}
+	}
+	s.servedHeadersLock.Lock()
+	for h, sh := range s.servedHeaders {
+		if sh.slot+1 < slot {
+			delete(s.servedHeaders, h)
+		}
+	}
+	s.servedHeaders[best.blockHash] = served
+	for bidSlot := range s.relayBids {
+		if bidSlot+1 < slot {
+			delete(s.relayBids, bidSlot)
+		}
+	}
+	s.relayBids[slot] = relayBidCandidates(results, best)
+	s.servedHeadersLock.Unlock()
+
+	relayBidsSelected.WithLabelValues(best.relay.NodeURL()).Inc()
+	if len(s.relays) > 1 {
+		log.WithFields(log.Fields{
+			"slot":          slot,
+			"relay":         best.relay.NodeURL(),
+			"blockHash":     fmt.Sprintf("%#x", best.blockHash),
+			"weiValue":      best.value.String(),
+			"servingRelays": len(served.relays),
+			"failedRelays":  len(errs),
+			"queriedRelays": len(s.relays),
+		}).Debug("Selected highest value relay bid")
+	}
+	return best.bid, nil
+}
+
+// relayBidCandidates describes the bid of every relay as a proposal candidate. The best bid is left without
+// a reason, as it is compared with the local payload by the proposer.
+func relayBidCandidates(results []*relayBid, best *relayBid) []*cache.ProposalCandidate {
+	candidates := make([]*cache.ProposalCandidate, len(results))
+	for i, res := range results {
+		c := &cache.ProposalCandidate{
+			Source:   res.relay.NodeURL(),
+			Duration: res.duration,
+		}
+		switch {
+		case res.err != nil:
+			c.Reason = res.err.Error()
+		case res == best:
+			c.BlockHash = bytesutil.SafeCopyBytes(res.blockHash[:])
+			c.Value = new(big.Int).Set(res.value)
+		default:
+			c.BlockHash = bytesutil.SafeCopyBytes(res.blockHash[:])
+			c.Value = new(big.Int).Set(res.value)
+			c.Reason = "lower value than the best relay bid"
+		}
+		candidates[i] = c
+	}
+	return candidates
+}
+
+// relayHeader requests a header from a single relay, bounded by the configured relay timeout,
+// and validates the returned bid.
+func (s *Service) relayHeader(ctx context.Context, r builder.BuilderClient, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) *relayBid {
+	if s.cfg.relayTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.cfg.relayTimeout)
+		defer cancel()
+	}
+	res := &relayBid{relay: r}
+	start := time.Now()
+	res.bid, res.err = r.GetHeader(ctx, slot, parentHash, pubKey)
+	res.duration = time.Since(start)
+	relayRequestLatency.WithLabelValues(r.NodeURL(), getHeaderMethod).Observe(float64(res.duration.Milliseconds()))
+	if res.err == nil {
+		res.value, res.blockHash, res.err = validateBid(res.bid, parentHash)
+	}
+	if res.err != nil {
+		relayRequestFailures.WithLabelValues(r.NodeURL(), getHeaderMethod).Inc()
+		log.WithError(res.err).WithFields(log.Fields{
+			"slot":  slot,
+			"relay": r.NodeURL(),
+		}).Debug("Relay did not return a valid header")
+	}
+	return res
+}
+
+// validateBid performs the checks needed to compare bids across relays, returning the bid value
+// and the block hash of the payload header the bid commits to.
+func validateBid(signedBid builder.SignedBid, parentHash [32]byte) (*big.Int, [32]byte, error) {
+	if signedBid == nil || signedBid.IsNil() {
+		return nil, [32]byte{}, errors.New("nil builder bid")
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "could not get bid")
+	}
+	if bid == nil || bid.IsNil() {
+		return nil, [32]byte{}, errors.New("nil builder bid")
+	}
+	v := primitives.WeiToBigInt(bid.Value())
+	if v == nil || v.Sign() == 0 {
+		return nil, [32]byte{}, errors.New("bid has zero value")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "could not get bid header")
+	}
+	if header == nil || header.IsNil() {
+		return nil, [32]byte{}, errors.New("nil bid header")
+	}
+	if !bytes.Equal(header.ParentHash(), parentHash[:]) {
+		return nil, [32]byte{}, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), parentHash)
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	if err := signing.VerifySigningRoot(bid, bid.Pubkey(), signedBid.Signature(), d); err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "could not verify builder signature")
+	}
+	return v, bytesutil.ToBytes32(header.BlockHash()), nil
+}
+
+// submitBlindedBlock sends the signed blinded block to the relays that served its header, or to
+// every configured relay if the header was not obtained through this service. The first
+// successfully revealed payload is returned.
+func (s *Service) submitBlindedBlock(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	relays := s.relays
+	if b != nil && !b.IsNil() {
+		if h, err := b.Block().Body().Execution(); err == nil && h != nil && !h.IsNil() {
+			s.servedHeadersLock.Lock()
+			if sh, ok := s.servedHeaders[bytesutil.ToBytes32(h.BlockHash())]; ok {
+				relays = sh.relays
+			}
+			s.servedHeadersLock.Unlock()
+		}
+	}
+
+	type result struct {
+		relay   builder.BuilderClient
+		payload interfaces.ExecutionData
+		bundle  *v1.BlobsBundle
+		err     error
+	}
+	results := make(chan *result, len(relays))
+	for _, r := range relays {
+		go func(r builder.BuilderClient) {
+			start := time.Now()
+			payload, bundle, err := r.SubmitBlindedBlock(ctx, b)
+			relayRequestLatency.WithLabelValues(r.NodeURL(), submitBlindedBlockMethod).Observe(float64(time.Since(start).Milliseconds()))
+			if err != nil {
+				relayRequestFailures.WithLabelValues(r.NodeURL(), submitBlindedBlockMethod).Inc()
+			}
+			results <- &result{relay: r, payload: payload, bundle: bundle, err: err}
+		}(r)
+	}
+
+	var lastErr error
+	for range relays {
+		res := <-results
+		if res.err == nil {
+			return res.payload, res.bundle, nil
+		}
+		log.WithError(res.err).WithField("relay", res.relay.NodeURL()).Warn("Relay failed to reveal payload for blinded block")
+		lastErr = res.err
+	}
+	return nil, nil, lastErr
+}
+
+// registerValidator sends the registrations to every configured relay. It only fails if no relay
+// accepted them.
+func (s *Service) registerValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
+	errs := make([]error, len(s.relays))
+	var wg sync.WaitGroup
+	for i, r := range s.relays {
+		wg.Add(1)
+		go func(i int, r builder.BuilderClient) {
+			defer wg.Done()
+			start := time.Now()
+			errs[i] = r.RegisterValidator(ctx, reg)
+			relayRequestLatency.WithLabelValues(r.NodeURL(), registerValidatorMethod).Observe(float64(time.Since(start).Milliseconds()))
+		}(i, r)
+	}
+	wg.Wait()
+
+	var lastErr error
+	failed := 0
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		failed++
+		lastErr = err
+		relayRequestFailures.WithLabelValues(s.relays[i].NodeURL(), registerValidatorMethod).Inc()
+		if len(s.relays) > 1 {
+			log.WithError(err).WithField("relay", s.relays[i].NodeURL()).Warn("Relay failed to register validators")
+		}
+	}
+	if failed == len(s.relays) {
+		return lastErr
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package builder
 import (
 	"context"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -29,50 +30,62 @@ type BlockBuilder interface {
 	GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error)
 	RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error
 	RegistrationByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error)
+	RelayBids(slot primitives.Slot) []*cache.ProposalCandidate
 	Configured() bool
 }
 
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderClient builder.BuilderClient
-	beaconDB      db.HeadAccessDatabase
-	headFetcher   blockchain.HeadFetcher
+	builderClients []builder.BuilderClient
+	relayTimeout   time.Duration
+	beaconDB       db.HeadAccessDatabase
+	headFetcher    blockchain.HeadFetcher
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
 type Service struct {
 	cfg               *config
-	c                 builder.BuilderClient
+	relays            []builder.BuilderClient
 	ctx               context.Context
 	cancel            context.CancelFunc
 	registrationCache *cache.RegistrationCache
+	servedHeadersLock sync.Mutex
+	servedHeaders     map[[32]byte]*servedHeader
+	relayBids         map[primitives.Slot][]*cache.ProposalCandidate
 }
 
 // NewService instantiates a new service.
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    &config{},
+		ctx:           ctx,
+		cancel:        cancel,
+		cfg:           &config{},
+		servedHeaders: make(map[[32]byte]*servedHeader),
+		relayBids:     make(map[primitives.Slot][]*cache.ProposalCandidate),
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderClient != nil && !reflect.ValueOf(s.cfg.builderClient).IsNil() {
-		s.c = s.cfg.builderClient
+	for _, c := range s.cfg.builderClients {
+		if c == nil || reflect.ValueOf(c).IsNil() {
+			continue
+		}
+		s.relays = append(s.relays, c)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			log.WithError(err).Error("Failed to check builder status")
+		if err := c.Status(ctx); err != nil {
+			log.WithError(err).WithField("endpoint", c.NodeURL()).Error("Failed to check builder status")
 		} else {
-			log.WithField("endpoint", s.c.NodeURL()).Info("Builder has been configured")
-			log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
-				"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+			log.WithField("endpoint", c.NodeURL()).Info("Builder has been configured")
 		}
 	}
+	if len(s.relays) > 0 {
+		log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
+			"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+	}
 	return s, nil
 }
 
@@ -95,11 +108,11 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlyS
 	defer func() {
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if !s.Configured() {
 		return nil, nil, ErrNoBuilder
 	}
 
-	return s.c.SubmitBlindedBlock(ctx, b)
+	return s.submitBlindedBlock(ctx, b)
 }
 
 // GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
@@ -110,20 +123,32 @@ func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHas
 	defer func() {
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if !s.Configured() {
 		tracing.AnnotateError(span, ErrNoBuilder)
 		return nil, ErrNoBuilder
 	}
 
-	h, err := s.c.GetHeader(ctx, slot, parentHash, pubKey)
+	h, err := s.bestHeader(ctx, slot, parentHash, pubKey)
 	tracing.AnnotateError(span, err)
 	return h, err
 }
 
+// RelayBids returns the bid of every relay for the last header requested at the given slot.
+func (s *Service) RelayBids(slot primitives.Slot) []*cache.ProposalCandidate {
+	s.servedHeadersLock.Lock()
+	defer s.servedHeadersLock.Unlock()
+	bids := make([]*cache.ProposalCandidate, len(s.relayBids[slot]))
+	for i, b := range s.relayBids[slot] {
+		bid := *b
+		bids[i] = &bid
+	}
+	return bids
+}
+
 // Status retrieves the status of the builder relay network.
 func (s *Service) Status() error {
 	// Return early if builder isn't initialized in service.
-	if s.c == nil {
+	if !s.Configured() {
 		return nil
 	}
 
@@ -139,7 +164,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 	defer func() {
 		registerValidatorLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if !s.Configured() {
 		return ErrNoBuilder
 	}
 
@@ -164,7 +189,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 		valid = append(valid, r)
 		indexToRegistration[nx] = r.Message
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
+	if err := s.registerValidator(ctx, valid); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
 
@@ -191,9 +216,9 @@ func (s *Service) RegistrationByValidatorID(ctx context.Context, id primitives.V
 	}
 }
 
-// Configured returns true if the user has configured a builder client.
+// Configured returns true if the user has configured at least one builder client.
 func (s *Service) Configured() bool {
-	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
+	return len(s.relays) > 0
 }
 
 func (s *Service) pollRelayerStatus(ctx context.Context) {
@@ -202,9 +227,10 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
-					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
+			for _, r := range s.relays {
+				if err := r.Status(ctx); err != nil {
+					relayRequestFailures.WithLabelValues(r.NodeURL(), statusMethod).Inc()
+					log.WithError(err).WithField("endpoint", r.NodeURL()).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
 				}
 			}
 		case <-ctx.Done():
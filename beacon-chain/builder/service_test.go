@@ -2,16 +2,29 @@ package builder
 
 import (
 	"context"
+	"errors"
+	"math/big"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	buildertesting "github.com/prysmaticlabs/prysm/v5/api/client/builder/testing"
 	blockchainTesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	dbtesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
 )
 
 func Test_NewServiceWithBuilder(t *testing.T) {
@@ -68,3 +81,234 @@ func Test_BuilderMethodsWithouClient(t *testing.T) {
 	err = s.RegisterValidator(context.Background(), nil)
 	assert.ErrorContains(t, ErrNoBuilder.Error(), err)
 }
+
+func Test_NewServiceWithMultipleBuilders(t *testing.T) {
+	s, err := NewService(context.Background(), WithBuilderClients(&buildertesting.MockClient{}, nil, &buildertesting.MockClient{}))
+	require.NoError(t, err)
+	assert.Equal(t, true, s.Configured())
+	assert.Equal(t, 2, len(s.relays))
+}
+
+func Test_GetHeader_HighestBid(t *testing.T) {
+	parentHash := bytesutil.ToBytes32([]byte("parent"))
+	low := &relayStub{url: "low", bid: signedTestBid(t, parentHash, []byte("low"), 1)}
+	high := &relayStub{url: "high", bid: signedTestBid(t, parentHash, []byte("high"), 2)}
+	wrongParent := &relayStub{url: "wrong", bid: signedTestBid(t, [32]byte{'x'}, []byte("wrong"), 3)}
+	failing := &relayStub{url: "failing", err: errors.New("bad")}
+	s, err := NewService(context.Background(), WithBuilderClients(low, failing, high, wrongParent))
+	require.NoError(t, err)
+
+	bid, err := s.GetHeader(context.Background(), 1, parentHash, [48]byte{})
+	require.NoError(t, err)
+	m, err := bid.Message()
+	require.NoError(t, err)
+	assert.Equal(t, 0, big.NewInt(2).Cmp(primitives.WeiToBigInt(m.Value())))
+
+	// Every relay bid is kept for the audit of the proposal, in the order of the relays.
+	bids := s.RelayBids(1)
+	require.Equal(t, 4, len(bids))
+	assert.Equal(t, "low", bids[0].Source)
+	assert.Equal(t, "lower value than the best relay bid", bids[0].Reason)
+	assert.Equal(t, "bad", bids[1].Reason)
+	assert.Equal(t, true, bids[1].Value == nil)
+	assert.Equal(t, "", bids[2].Reason)
+	assert.Equal(t, 0, big.NewInt(2).Cmp(bids[2].Value))
+	assert.StringContains(t, "incorrect parent hash", bids[3].Reason)
+	assert.Equal(t, 0, len(s.RelayBids(2)))
+
+	// The blinded block must only be submitted to the relay that served the winning header.
+	blk := blindedBlockWithHash(t, []byte("high"))
+	_, _, err = s.SubmitBlindedBlock(context.Background(), blk)
+	require.NoError(t, err)
+	assert.Equal(t, 1, high.submitted())
+	assert.Equal(t, 0, low.submitted())
+	assert.Equal(t, 0, failing.submitted())
+	assert.Equal(t, 0, wrongParent.submitted())
+}
+
+func Test_GetHeader_SameHeaderFromSeveralRelays(t *testing.T) {
+	parentHash := bytesutil.ToBytes32([]byte("parent"))
+	a := &relayStub{url: "a", bid: signedTestBid(t, parentHash, []byte("same"), 2)}
+	b := &relayStub{url: "b", bid: signedTestBid(t, parentHash, []byte("same"), 2)}
+	c := &relayStub{url: "c", bid: signedTestBid(t, parentHash, []byte("other"), 1)}
+	s, err := NewService(context.Background(), WithBuilderClients(a, b, c))
+	require.NoError(t, err)
+
+	_, err = s.GetHeader(context.Background(), 1, parentHash, [48]byte{})
+	require.NoError(t, err)
+	b.submitErr = errors.New("bad")
+	_, _, err = s.SubmitBlindedBlock(context.Background(), blindedBlockWithHash(t, []byte("same")))
+	require.NoError(t, err)
+	waitForSubmissions(t, b, 1)
+	assert.Equal(t, 1, a.submitted())
+	assert.Equal(t, 0, c.submitted())
+}
+
+func Test_GetHeader_RelayTimeout(t *testing.T) {
+	parentHash := bytesutil.ToBytes32([]byte("parent"))
+	slow := &relayStub{url: "slow", bid: signedTestBid(t, parentHash, []byte("slow"), 10), delay: time.Second}
+	fast := &relayStub{url: "fast", bid: signedTestBid(t, parentHash, []byte("fast"), 1)}
+	s, err := NewService(context.Background(), WithBuilderClients(slow, fast), WithRelayTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+
+	bid, err := s.GetHeader(context.Background(), 1, parentHash, [48]byte{})
+	require.NoError(t, err)
+	m, err := bid.Message()
+	require.NoError(t, err)
+	assert.Equal(t, 0, big.NewInt(1).Cmp(primitives.WeiToBigInt(m.Value())))
+}
+
+func Test_GetHeader_NoValidBid(t *testing.T) {
+	parentHash := bytesutil.ToBytes32([]byte("parent"))
+	s, err := NewService(context.Background(), WithBuilderClients(
+		&relayStub{url: "a", err: errors.New("bad")},
+		&relayStub{url: "b", bid: signedTestBid(t, parentHash, []byte("b"), 0)},
+	))
+	require.NoError(t, err)
+
+	_, err = s.GetHeader(context.Background(), 1, parentHash, [48]byte{})
+	require.ErrorIs(t, err, errNoValidBid)
+	assert.ErrorContains(t, "zero value", err)
+}
+
+func Test_SubmitBlindedBlock_UnknownHeader(t *testing.T) {
+	a := &relayStub{url: "a", submitErr: errors.New("bad")}
+	b := &relayStub{url: "b"}
+	s, err := NewService(context.Background(), WithBuilderClients(a, b))
+	require.NoError(t, err)
+
+	_, _, err = s.SubmitBlindedBlock(context.Background(), blindedBlockWithHash(t, []byte("unknown")))
+	require.NoError(t, err)
+	waitForSubmissions(t, a, 1)
+	assert.Equal(t, 1, b.submitted())
+
+	b.submitErr = errors.New("also bad")
+	_, _, err = s.SubmitBlindedBlock(context.Background(), blindedBlockWithHash(t, []byte("unknown")))
+	assert.ErrorContains(t, "bad", err)
+}
+
+func Test_RegisterValidator_MultipleRelays(t *testing.T) {
+	ctx := context.Background()
+	headFetcher := &blockchainTesting.ChainService{}
+	ok := &relayStub{url: "ok"}
+	failing := &relayStub{url: "failing", registerErr: errors.New("bad")}
+	s, err := NewService(ctx, WithRegistrationCache(), WithHeadFetcher(headFetcher), WithBuilderClients(ok, failing))
+	require.NoError(t, err)
+	pubkey := bytesutil.ToBytes48([]byte("pubkey"))
+	reg := &eth.ValidatorRegistrationV1{Pubkey: pubkey[:], Timestamp: uint64(time.Now().UTC().Unix()), FeeRecipient: make([]byte, 20)}
+	require.NoError(t, s.RegisterValidator(ctx, []*eth.SignedValidatorRegistrationV1{{Message: reg}}))
+	assert.Equal(t, 1, ok.registered())
+	assert.Equal(t, 1, failing.registered())
+
+	ok.registerErr = errors.New("also bad")
+	err = s.RegisterValidator(ctx, []*eth.SignedValidatorRegistrationV1{{Message: reg}})
+	assert.ErrorContains(t, "could not register validator(s)", err)
+}
+
+type relayStub struct {
+	sync.Mutex
+	url           string
+	bid           builder.SignedBid
+	err           error
+	delay         time.Duration
+	submitErr     error
+	registerErr   error
+	submitCount   int
+	registerCount int
+}
+
+func (r *relayStub) NodeURL() string {
+	return r.url
+}
+
+func (r *relayStub) GetHeader(ctx context.Context, _ primitives.Slot, _ [32]byte, _ [48]byte) (builder.SignedBid, error) {
+	if r.delay > 0 {
+		select {
+		case <-time.After(r.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return r.bid, r.err
+}
+
+func (r *relayStub) RegisterValidator(_ context.Context, _ []*eth.SignedValidatorRegistrationV1) error {
+	r.Lock()
+	defer r.Unlock()
+	r.registerCount++
+	return r.registerErr
+}
+
+func (r *relayStub) SubmitBlindedBlock(_ context.Context, _ interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	r.Lock()
+	defer r.Unlock()
+	r.submitCount++
+	if r.submitErr != nil {
+		return nil, nil, r.submitErr
+	}
+	ed, err := blocks.WrappedExecutionPayloadCapella(&v1.ExecutionPayloadCapella{})
+	return ed, nil, err
+}
+
+func (*relayStub) Status(_ context.Context) error {
+	return nil
+}
+
+func (r *relayStub) submitted() int {
+	r.Lock()
+	defer r.Unlock()
+	return r.submitCount
+}
+
+func (r *relayStub) registered() int {
+	r.Lock()
+	defer r.Unlock()
+	return r.registerCount
+}
+
+func waitForSubmissions(t *testing.T, r *relayStub, want int) {
+	for i := 0; i < 100; i++ {
+		if r.submitted() == want {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("relay %s received %d submissions, wanted %d", r.url, r.submitted(), want)
+}
+
+func signedTestBid(t *testing.T, parentHash [32]byte, blockHash []byte, value uint64) builder.SignedBid {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	bid := &eth.BuilderBidCapella{
+		Header: &v1.ExecutionPayloadHeaderCapella{
+			ParentHash:       parentHash[:],
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			ExtraData:        make([]byte, 0),
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        bytesutil.PadTo(blockHash, fieldparams.RootLength),
+			TransactionsRoot: bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
+			WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+		},
+		Pubkey: sk.PublicKey().Marshal(),
+		Value:  bytesutil.PadTo(bytesutil.ReverseByteOrder(new(big.Int).SetUint64(value).Bytes()), 32),
+	}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, domain)
+	require.NoError(t, err)
+	sb, err := builder.WrappedSignedBuilderBidCapella(&eth.SignedBuilderBidCapella{Message: bid, Signature: sk.Sign(sr[:]).Marshal()})
+	require.NoError(t, err)
+	return sb
+}
+
+func blindedBlockWithHash(t *testing.T, blockHash []byte) interfaces.ReadOnlySignedBeaconBlock {
+	b := util.NewBlindedBeaconBlockCapella()
+	b.Block.Body.ExecutionPayloadHeader.BlockHash = bytesutil.PadTo(blockHash, fieldparams.RootLength)
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return blk
+}
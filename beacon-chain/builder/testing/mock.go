@@ -36,6 +36,7 @@ type MockBuilderService struct {
 	RegistrationCache     *cache.RegistrationCache
 	ErrGetHeader          error
 	ErrRegisterValidator  error
+	Candidates            []*cache.ProposalCandidate
 	Cfg                   *Config
 }
 
@@ -96,6 +97,11 @@ func (s *MockBuilderService) RegistrationByValidatorID(ctx context.Context, id p
 	return nil, cache.ErrNotFoundRegistration
 }
 
+// RelayBids for mocking.
+func (s *MockBuilderService) RelayBids(primitives.Slot) []*cache.ProposalCandidate {
+	return s.Candidates
+}
+
 // RegisterValidator for mocking.
 func (s *MockBuilderService) RegisterValidator(context.Context, []*ethpb.SignedValidatorRegistrationV1) error {
 	return s.ErrRegisterValidator
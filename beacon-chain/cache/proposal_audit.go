@@ -0,0 +1,241 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// proposalAuditSlots is the number of slots for which proposal audits are kept, about a day on mainnet.
+const proposalAuditSlots = primitives.Slot(8192)
+
+// LocalPayloadSource is the source of the execution payload built by the local execution client.
+const LocalPayloadSource = "local"
+
+// RevealStatus is whether the builder revealed the payload of a blinded block proposal.
+type RevealStatus int
+
+const (
+	// RevealNotApplicable is the status of proposals built with a local payload.
+	RevealNotApplicable RevealStatus = iota
+	// RevealPending is the status of blinded blocks which were not signed and submitted yet.
+	RevealPending
+	// RevealDelivered is the status of blinded blocks for which the builder returned the payload.
+	RevealDelivered
+	// RevealMissed is the status of blinded blocks for which the builder did not return a valid payload.
+	RevealMissed
+)
+
+// String returns the name of the reveal status, as used by the API.
+func (s RevealStatus) String() string {
+	switch s {
+	case RevealPending:
+		return "pending"
+	case RevealDelivered:
+		return "delivered"
+	case RevealMissed:
+		return "missed"
+	default:
+		return "not_applicable"
+	}
+}
+
+// ProposalCandidate is an execution payload which was considered for a block proposal: the payload of the
+// local execution client, or the bid of a relay.
+type ProposalCandidate struct {
+	// Source is LocalPayloadSource, or the URL of the relay which returned the bid.
+	Source    string
+	BlockHash []byte
+	// Value is the value of the payload in wei, nil when no payload was returned.
+	Value *big.Int
+	// Duration is the time taken to get the payload.
+	Duration time.Duration
+	Selected bool
+	// Reason is why the candidate was selected or rejected.
+	Reason string
+}
+
+// ProposalAudit records how the execution payload of a block proposal was chosen, and whether the
+// builder delivered the payload of the blinded block.
+type ProposalAudit struct {
+	Slot                 primitives.Slot
+	ProposerIndex        primitives.ValidatorIndex
+	BuilderBoostFactor   primitives.Gwei
+	LocalBlockValueBoost uint64
+	// BuilderSkipReason is why no bid was requested from the builder, empty when bids were requested.
+	BuilderSkipReason string
+	Candidates        []*ProposalCandidate
+	Blinded           bool
+	Reveal            RevealStatus
+	RevealError       string
+}
+
+// Select marks the candidate of the given source as selected, and every other candidate as rejected with
+// the given reason, unless they already have one.
+func (a *ProposalAudit) Select(source, reason, rejectReason string) {
+	if a == nil {
+		return
+	}
+	for _, c := range a.Candidates {
+		if c.Source == source {
+			c.Selected = true
+			c.Reason = reason
+		} else if c.Reason == "" {
+			c.Reason = rejectReason
+		}
+	}
+}
+
+// copy returns a copy of the audit, so that it can be read while the cache is updated.
+func (a *ProposalAudit) copy() *ProposalAudit {
+	cp := *a
+	cp.Candidates = make([]*ProposalCandidate, len(a.Candidates))
+	for i, c := range a.Candidates {
+		candidate := *c
+		cp.Candidates[i] = &candidate
+	}
+	return &cp
+}
+
+// ProposalAuditDB persists proposal audits, so that they are kept across restarts.
+type ProposalAuditDB interface {
+	ProposalAudit(ctx context.Context, slot primitives.Slot) ([]byte, error)
+	SaveProposalAudit(ctx context.Context, slot primitives.Slot, audit []byte) error
+	DeleteProposalAuditsBefore(ctx context.Context, slot primitives.Slot) error
+	LastBuilderRevealMiss(ctx context.Context) (primitives.Slot, bool, error)
+	SaveLastBuilderRevealMiss(ctx context.Context, slot primitives.Slot) error
+}
+
+// ProposalAuditCache keeps the audit of the recent block proposals of the node, and the last slot at which
+// a builder did not reveal the payload of a blinded block. When it is backed by a database, the audits and
+// the last miss are persisted as well.
+type ProposalAuditCache struct {
+	lock           sync.RWMutex
+	audits         map[primitives.Slot]*ProposalAudit
+	lastRevealMiss primitives.Slot
+	revealMissed   bool
+	db             ProposalAuditDB
+}
+
+// NewProposalAuditCache creates a new proposal audit cache, which only keeps the audits in memory.
+func NewProposalAuditCache() *ProposalAuditCache {
+	return &ProposalAuditCache{
+		audits: make(map[primitives.Slot]*ProposalAudit),
+	}
+}
+
+// LoadProposalAuditCache creates a proposal audit cache backed by the database, restoring the last slot at
+// which a builder did not reveal the payload of a blinded block.
+func LoadProposalAuditCache(ctx context.Context, db ProposalAuditDB) (*ProposalAuditCache, error) {
+	c := NewProposalAuditCache()
+	c.db = db
+	slot, missed, err := db.LastBuilderRevealMiss(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get last builder reveal miss")
+	}
+	c.lastRevealMiss, c.revealMissed = slot, missed
+	return c, nil
+}
+
+// Add saves the audit of a proposal, replacing any previous audit of the same slot, and prunes the old audits.
+func (c *ProposalAuditCache) Add(ctx context.Context, audit *ProposalAudit) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.audits[audit.Slot] = audit
+	for slot := range c.audits {
+		if slot+proposalAuditSlots < audit.Slot {
+			delete(c.audits, slot)
+		}
+	}
+	if c.db == nil {
+		return nil
+	}
+	if err := c.save(ctx, audit); err != nil {
+		return err
+	}
+	if audit.Slot > proposalAuditSlots {
+		return c.db.DeleteProposalAuditsBefore(ctx, audit.Slot-proposalAuditSlots)
+	}
+	return nil
+}
+
+// Audit returns the audit of the proposal at the given slot, or nil if there is none.
+func (c *ProposalAuditCache) Audit(ctx context.Context, slot primitives.Slot) (*ProposalAudit, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	audit, err := c.audit(ctx, slot)
+	if err != nil || audit == nil {
+		return nil, err
+	}
+	return audit.copy(), nil
+}
+
+// RecordReveal records whether the builder revealed the payload of the blinded block at the given slot.
+// A nil revealErr means the payload was delivered.
+func (c *ProposalAuditCache) RecordReveal(ctx context.Context, slot primitives.Slot, revealErr error) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if revealErr != nil && (!c.revealMissed || slot > c.lastRevealMiss) {
+		c.lastRevealMiss = slot
+		c.revealMissed = true
+		if c.db != nil {
+			if err := c.db.SaveLastBuilderRevealMiss(ctx, slot); err != nil {
+				return errors.Wrap(err, "could not save last builder reveal miss")
+			}
+		}
+	}
+	audit, err := c.audit(ctx, slot)
+	if err != nil || audit == nil {
+		return err
+	}
+	if revealErr != nil {
+		audit.Reveal = RevealMissed
+		audit.RevealError = revealErr.Error()
+	} else {
+		audit.Reveal = RevealDelivered
+		audit.RevealError = ""
+	}
+	if c.db == nil {
+		return nil
+	}
+	c.audits[slot] = audit
+	return c.save(ctx, audit)
+}
+
+// LastRevealMiss returns the last slot at which a builder did not reveal the payload of a blinded block.
+func (c *ProposalAuditCache) LastRevealMiss() (primitives.Slot, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.lastRevealMiss, c.revealMissed
+}
+
+// audit returns the audit of the proposal at the given slot from memory, or else from the database.
+// The caller must hold the lock.
+func (c *ProposalAuditCache) audit(ctx context.Context, slot primitives.Slot) (*ProposalAudit, error) {
+	if audit, ok := c.audits[slot]; ok || c.db == nil {
+		return audit, nil
+	}
+	enc, err := c.db.ProposalAudit(ctx, slot)
+	if err != nil || len(enc) == 0 {
+		return nil, err
+	}
+	audit := &ProposalAudit{}
+	if err := json.Unmarshal(enc, audit); err != nil {
+		return nil, errors.Wrapf(err, "could not decode proposal audit of slot %d", slot)
+	}
+	return audit, nil
+}
+
+// save persists the audit in the database. The caller must hold the lock.
+func (c *ProposalAuditCache) save(ctx context.Context, audit *ProposalAudit) error {
+	enc, err := json.Marshal(audit)
+	if err != nil {
+		return errors.Wrapf(err, "could not encode proposal audit of slot %d", audit.Slot)
+	}
+	return c.db.SaveProposalAudit(ctx, audit.Slot, enc)
+}
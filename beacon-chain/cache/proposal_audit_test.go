@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestProposalAudit_Select(t *testing.T) {
+	audit := &ProposalAudit{Candidates: []*ProposalCandidate{
+		{Source: LocalPayloadSource, Value: big.NewInt(1)},
+		{Source: "relay-a", Value: big.NewInt(2)},
+		{Source: "relay-b", Reason: "timeout"},
+	}}
+	audit.Select("relay-a", "higher value", "lower value")
+	assert.Equal(t, false, audit.Candidates[0].Selected)
+	assert.Equal(t, "lower value", audit.Candidates[0].Reason)
+	assert.Equal(t, true, audit.Candidates[1].Selected)
+	assert.Equal(t, "higher value", audit.Candidates[1].Reason)
+	assert.Equal(t, "timeout", audit.Candidates[2].Reason)
+
+	var nilAudit *ProposalAudit
+	nilAudit.Select(LocalPayloadSource, "", "")
+}
+
+func TestProposalAuditCache_AddAndAudit(t *testing.T) {
+	ctx := context.Background()
+	c := NewProposalAuditCache()
+	audit, err := c.Audit(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, true, audit == nil)
+
+	require.NoError(t, c.Add(ctx, &ProposalAudit{Slot: 1, Candidates: []*ProposalCandidate{{Source: LocalPayloadSource}}}))
+	audit, err = c.Audit(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(audit.Candidates))
+
+	// The returned audit is a copy.
+	audit.Candidates[0].Selected = true
+	audit, err = c.Audit(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, false, audit.Candidates[0].Selected)
+
+	// Old audits are pruned.
+	require.NoError(t, c.Add(ctx, &ProposalAudit{Slot: proposalAuditSlots + 2}))
+	audit, err = c.Audit(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, audit == nil)
+	audit, err = c.Audit(ctx, proposalAuditSlots+2)
+	require.NoError(t, err)
+	assert.Equal(t, true, audit != nil)
+}
+
+func TestProposalAuditCache_RecordReveal(t *testing.T) {
+	ctx := context.Background()
+	c := NewProposalAuditCache()
+	_, missed := c.LastRevealMiss()
+	require.Equal(t, false, missed)
+
+	require.NoError(t, c.Add(ctx, &ProposalAudit{Slot: 10, Blinded: true, Reveal: RevealPending}))
+	require.NoError(t, c.Add(ctx, &ProposalAudit{Slot: 11, Blinded: true, Reveal: RevealPending}))
+	require.NoError(t, c.RecordReveal(ctx, 10, nil))
+	require.NoError(t, c.RecordReveal(ctx, 11, errors.New("builder timeout")))
+
+	audit, err := c.Audit(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, RevealDelivered, audit.Reveal)
+	assert.Equal(t, "delivered", audit.Reveal.String())
+	audit, err = c.Audit(ctx, 11)
+	require.NoError(t, err)
+	assert.Equal(t, RevealMissed, audit.Reveal)
+	assert.Equal(t, "builder timeout", audit.RevealError)
+
+	// A miss is recorded even without the audit of the proposal, and the latest miss is kept.
+	require.NoError(t, c.RecordReveal(ctx, 20, errors.New("bad payload")))
+	require.NoError(t, c.RecordReveal(ctx, 15, errors.New("bad payload")))
+	slot, missed := c.LastRevealMiss()
+	require.Equal(t, true, missed)
+	assert.Equal(t, primitives.Slot(20), slot)
+}
+
+// mapProposalAuditDB is an in-memory ProposalAuditDB.
+type mapProposalAuditDB struct {
+	audits         map[primitives.Slot][]byte
+	lastRevealMiss *primitives.Slot
+}
+
+func (db *mapProposalAuditDB) ProposalAudit(_ context.Context, slot primitives.Slot) ([]byte, error) {
+	return db.audits[slot], nil
+}
+
+func (db *mapProposalAuditDB) SaveProposalAudit(_ context.Context, slot primitives.Slot, audit []byte) error {
+	db.audits[slot] = audit
+	return nil
+}
+
+func (db *mapProposalAuditDB) DeleteProposalAuditsBefore(_ context.Context, slot primitives.Slot) error {
+	for s := range db.audits {
+		if s < slot {
+			delete(db.audits, s)
+		}
+	}
+	return nil
+}
+
+func (db *mapProposalAuditDB) LastBuilderRevealMiss(_ context.Context) (primitives.Slot, bool, error) {
+	if db.lastRevealMiss == nil {
+		return 0, false, nil
+	}
+	return *db.lastRevealMiss, true, nil
+}
+
+func (db *mapProposalAuditDB) SaveLastBuilderRevealMiss(_ context.Context, slot primitives.Slot) error {
+	db.lastRevealMiss = &slot
+	return nil
+}
+
+func TestLoadProposalAuditCache(t *testing.T) {
+	ctx := context.Background()
+	db := &mapProposalAuditDB{audits: make(map[primitives.Slot][]byte)}
+	c, err := LoadProposalAuditCache(ctx, db)
+	require.NoError(t, err)
+	require.NoError(t, c.Add(ctx, &ProposalAudit{
+		Slot:       10,
+		Candidates: []*ProposalCandidate{{Source: "relay-a", Value: big.NewInt(5), Selected: true}},
+		Blinded:    true,
+		Reveal:     RevealPending,
+	}))
+	require.NoError(t, c.RecordReveal(ctx, 10, errors.New("builder timeout")))
+
+	// The audits and the last miss survive a restart.
+	c, err = LoadProposalAuditCache(ctx, db)
+	require.NoError(t, err)
+	slot, missed := c.LastRevealMiss()
+	require.Equal(t, true, missed)
+	assert.Equal(t, primitives.Slot(10), slot)
+	audit, err := c.Audit(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(audit.Candidates))
+	assert.Equal(t, "relay-a", audit.Candidates[0].Source)
+	assert.Equal(t, int64(5), audit.Candidates[0].Value.Int64())
+	assert.Equal(t, RevealMissed, audit.Reveal)
+	assert.Equal(t, "builder timeout", audit.RevealError)
+
+	// Old audits are pruned from the database.
+	require.NoError(t, c.Add(ctx, &ProposalAudit{Slot: proposalAuditSlots + 11}))
+	audit, err = c.Audit(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, audit == nil)
+}
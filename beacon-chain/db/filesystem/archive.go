@@ -0,0 +1,283 @@
+package filesystem
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"path"
+	"sort"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// A blob archive is a single file holding blob sidecars for a range of slots. It is laid out as:
+//
+//	header:  magic (8 bytes) | version (uint32)
+//	records: snappy compressed ssz encoded BlobSidecar, one after the other
+//	index:   entry count (uint64) | entries of root (32 bytes), slot, index, offset, length (uint64 each)
+//	footer:  offset of the index (uint64) | magic (8 bytes)
+//
+// All integers are little endian. The index at the end of the file allows a reader to find any sidecar
+// without scanning the records, while the writer only needs to hold the index in memory.
+const (
+	archiveVersion    uint32 = 1
+	archiveHeaderSize        = 12
+	archiveFooterSize        = 16
+	archiveEntrySize         = 32 + 8*4
+)
+
+var archiveMagic = [8]byte{'p', 'r', 'y', 's', 'm', 'b', 'l', 'b'}
+
+var (
+	errNotBlobArchive        = errors.New("not a blob archive")
+	errArchiveVersion        = errors.New("unsupported blob archive version")
+	errArchiveCorrupt        = errors.New("blob archive is corrupt")
+	errArchiveWriterClosed   = errors.New("blob archive writer is closed")
+	errArchiveSidecarMissing = errors.New("blob sidecar not found in archive")
+)
+
+// BlobArchiveEntry describes the location of a blob sidecar in a blob archive.
+type BlobArchiveEntry struct {
+	Root   [32]byte
+	Slot   primitives.Slot
+	Index  uint64
+	offset uint64
+	length uint64
+}
+
+// BlobArchiveWriter writes blob sidecars to a blob archive. Close must be called once all sidecars
+// are written, in order to append the index.
+type BlobArchiveWriter struct {
+	w       io.Writer
+	offset  uint64
+	entries []BlobArchiveEntry
+	closed  bool
+}
+
+// NewBlobArchiveWriter writes the archive header to w and returns a writer for the archive records.
+func NewBlobArchiveWriter(w io.Writer) (*BlobArchiveWriter, error) {
+	header := make([]byte, archiveHeaderSize)
+	copy(header, archiveMagic[:])
+	binary.LittleEndian.PutUint32(header[8:], archiveVersion)
+	if _, err := w.Write(header); err != nil {
+		return nil, errors.Wrap(err, "could not write blob archive header")
+	}
+	return &BlobArchiveWriter{w: w, offset: archiveHeaderSize}, nil
+}
+
+// Write appends a blob sidecar to the archive.
+func (a *BlobArchiveWriter) Write(sc blocks.ROBlob) error {
+	if a.closed {
+		return errArchiveWriterClosed
+	}
+	enc, err := sc.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize sidecar data")
+	}
+	record := snappy.Encode(nil, enc)
+	if _, err := a.w.Write(record); err != nil {
+		return errors.Wrapf(err, "could not write blob sidecar for root %#x at index %d", sc.BlockRoot(), sc.Index)
+	}
+	a.entries = append(a.entries, BlobArchiveEntry{
+		Root:   sc.BlockRoot(),
+		Slot:   sc.Slot(),
+		Index:  sc.Index,
+		offset: a.offset,
+		length: uint64(len(record)),
+	})
+	a.offset += uint64(len(record))
+	return nil
+}
+
+// Count returns the number of sidecars written to the archive.
+func (a *BlobArchiveWriter) Count() int {
+	return len(a.entries)
+}
+
+// Close writes the index and footer of the archive. It does not close the underlying writer.
+func (a *BlobArchiveWriter) Close() error {
+	if a.closed {
+		return errArchiveWriterClosed
+	}
+	a.closed = true
+	buf := make([]byte, 8, 8+len(a.entries)*archiveEntrySize+archiveFooterSize)
+	binary.LittleEndian.PutUint64(buf, uint64(len(a.entries)))
+	for _, e := range a.entries {
+		buf = append(buf, e.Root[:]...)
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(e.Slot))
+		buf = binary.LittleEndian.AppendUint64(buf, e.Index)
+		buf = binary.LittleEndian.AppendUint64(buf, e.offset)
+		buf = binary.LittleEndian.AppendUint64(buf, e.length)
+	}
+	buf = binary.LittleEndian.AppendUint64(buf, a.offset)
+	buf = append(buf, archiveMagic[:]...)
+	if _, err := a.w.Write(buf); err != nil {
+		return errors.Wrap(err, "could not write blob archive index")
+	}
+	return nil
+}
+
+// BlobArchive reads blob sidecars from a blob archive.
+type BlobArchive struct {
+	r       io.ReaderAt
+	entries []BlobArchiveEntry
+}
+
+// OpenBlobArchive reads the index of the blob archive of the given size.
+func OpenBlobArchive(r io.ReaderAt, size int64) (*BlobArchive, error) {
+	if size < archiveHeaderSize+8+archiveFooterSize {
+		return nil, errNotBlobArchive
+	}
+	header := make([]byte, archiveHeaderSize)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, errors.Wrap(err, "could not read blob archive header")
+	}
+	if !bytes.Equal(header[:8], archiveMagic[:]) {
+		return nil, errNotBlobArchive
+	}
+	if v := binary.LittleEndian.Uint32(header[8:]); v != archiveVersion {
+		return nil, errors.Wrapf(errArchiveVersion, "version=%d", v)
+	}
+	footer := make([]byte, archiveFooterSize)
+	if _, err := r.ReadAt(footer, size-archiveFooterSize); err != nil {
+		return nil, errors.Wrap(err, "could not read blob archive footer")
+	}
+	if !bytes.Equal(footer[8:], archiveMagic[:]) {
+		return nil, errors.Wrap(errArchiveCorrupt, "footer not found, the archive may be truncated")
+	}
+	indexOffset := binary.LittleEndian.Uint64(footer)
+	if indexOffset < archiveHeaderSize || indexOffset > uint64(size-archiveFooterSize-8) {
+		return nil, errors.Wrapf(errArchiveCorrupt, "index offset %d out of bounds", indexOffset)
+	}
+	index := make([]byte, uint64(size-archiveFooterSize)-indexOffset)
+	if _, err := r.ReadAt(index, int64(indexOffset)); err != nil {
+		return nil, errors.Wrap(err, "could not read blob archive index")
+	}
+	count := binary.LittleEndian.Uint64(index)
+	if count != uint64(len(index)-8)/archiveEntrySize || uint64(len(index)-8)%archiveEntrySize != 0 {
+		return nil, errors.Wrapf(errArchiveCorrupt, "index size does not match its %d entries", count)
+	}
+	entries := make([]BlobArchiveEntry, count)
+	for i := range entries {
+		b := index[8+i*archiveEntrySize:]
+		e := BlobArchiveEntry{
+			Slot:   primitives.Slot(binary.LittleEndian.Uint64(b[32:])),
+			Index:  binary.LittleEndian.Uint64(b[40:]),
+			offset: binary.LittleEndian.Uint64(b[48:]),
+			length: binary.LittleEndian.Uint64(b[56:]),
+		}
+		copy(e.Root[:], b[:32])
+		if e.offset < archiveHeaderSize || e.length > indexOffset || e.offset > indexOffset-e.length {
+			return nil, errors.Wrapf(errArchiveCorrupt, "record for root %#x at index %d out of bounds", e.Root, e.Index)
+		}
+		entries[i] = e
+	}
+	return &BlobArchive{r: r, entries: entries}, nil
+}
+
+// Entries returns the index of the archive, in the order the sidecars were written.
+func (a *BlobArchive) Entries() []BlobArchiveEntry {
+	return a.entries
+}
+
+// Get returns the blob sidecar for the given block root and index.
+func (a *BlobArchive) Get(root [32]byte, idx uint64) (blocks.ROBlob, error) {
+	for _, e := range a.entries {
+		if e.Root == root && e.Index == idx {
+			return a.Read(e)
+		}
+	}
+	return blocks.ROBlob{}, errors.Wrapf(errArchiveSidecarMissing, "root=%#x, index=%d", root, idx)
+}
+
+// Read returns the blob sidecar at the given entry of the archive index. The block root of the sidecar
+// is checked against the one recorded in the index.
+func (a *BlobArchive) Read(e BlobArchiveEntry) (blocks.ROBlob, error) {
+	record := make([]byte, e.length)
+	if _, err := a.r.ReadAt(record, int64(e.offset)); err != nil {
+		return blocks.ROBlob{}, errors.Wrapf(err, "could not read blob sidecar for root %#x at index %d", e.Root, e.Index)
+	}
+	enc, err := snappy.Decode(nil, record)
+	if err != nil {
+		return blocks.ROBlob{}, errors.Wrapf(errArchiveCorrupt, "could not decompress blob sidecar for root %#x at index %d: %v", e.Root, e.Index, err)
+	}
+	s := &ethpb.BlobSidecar{}
+	if err := s.UnmarshalSSZ(enc); err != nil {
+		return blocks.ROBlob{}, errors.Wrapf(err, "could not decode blob sidecar for root %#x at index %d", e.Root, e.Index)
+	}
+	ro, err := blocks.NewROBlob(s)
+	if err != nil {
+		return blocks.ROBlob{}, err
+	}
+	if ro.BlockRoot() != e.Root || ro.Index != e.Index || ro.Slot() != e.Slot {
+		return blocks.ROBlob{}, errors.Wrapf(errArchiveCorrupt, "blob sidecar does not match index entry for root %#x at index %d", e.Root, e.Index)
+	}
+	return ro, nil
+}
+
+// ArchiveRange writes all the blob sidecars in storage with a slot in the range [start, end] to the archive,
+// ordered by slot, block root and index. It returns the number of sidecars written.
+func (bs *BlobStorage) ArchiveRange(w *BlobArchiveWriter, start, end primitives.Slot) (int, error) {
+	type rootSlot struct {
+		root [32]byte
+		slot primitives.Slot
+	}
+	dirs, err := listDir(bs.fs, ".")
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to list root blobs directory")
+	}
+	var roots []rootSlot
+	for _, dir := range filter(dirs, filterRoot) {
+		root, err := rootFromDir(dir)
+		if err != nil {
+			return 0, err
+		}
+		entries, err := listDir(bs.fs, dir)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to list blobs in directory %s", dir)
+		}
+		scFiles := filter(entries, filterSsz)
+		if len(scFiles) == 0 {
+			continue
+		}
+		slot, err := slotFromFile(path.Join(dir, scFiles[0]), bs.fs)
+		if err != nil {
+			return 0, errors.Wrapf(err, "slot could not be read from blob file %s", scFiles[0])
+		}
+		if slot < start || slot > end {
+			continue
+		}
+		roots = append(roots, rootSlot{root: root, slot: slot})
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		if roots[i].slot != roots[j].slot {
+			return roots[i].slot < roots[j].slot
+		}
+		return bytes.Compare(roots[i].root[:], roots[j].root[:]) < 0
+	})
+	written := 0
+	for _, r := range roots {
+		mask, err := bs.Indices(r.root)
+		if err != nil {
+			return written, err
+		}
+		for idx, ok := range mask {
+			if !ok {
+				continue
+			}
+			sc, err := bs.Get(r.root, uint64(idx))
+			if err != nil {
+				return written, errors.Wrapf(err, "could not read blob sidecar for root %#x at index %d", r.root, idx)
+			}
+			if err := w.Write(sc.ROBlob); err != nil {
+				return written, err
+			}
+			written++
+		}
+	}
+	return written, nil
+}
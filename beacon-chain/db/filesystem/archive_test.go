@@ -0,0 +1,139 @@
+package filesystem
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestBlobArchive_RoundTrip(t *testing.T) {
+	_, first := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 10, 2)
+	_, second := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 11, 1)
+	sidecars := append(first, second...)
+
+	buf := &bytes.Buffer{}
+	w, err := NewBlobArchiveWriter(buf)
+	require.NoError(t, err)
+	for _, sc := range sidecars {
+		require.NoError(t, w.Write(sc))
+	}
+	require.Equal(t, 3, w.Count())
+	require.NoError(t, w.Close())
+	require.ErrorIs(t, w.Close(), errArchiveWriterClosed)
+	require.ErrorIs(t, w.Write(sidecars[0]), errArchiveWriterClosed)
+
+	a, err := OpenBlobArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	entries := a.Entries()
+	require.Equal(t, 3, len(entries))
+	for i, e := range entries {
+		require.Equal(t, sidecars[i].BlockRoot(), e.Root)
+		require.Equal(t, sidecars[i].Slot(), e.Slot)
+		require.Equal(t, sidecars[i].Index, e.Index)
+		sc, err := a.Read(e)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, sidecars[i].BlobSidecar, sc.BlobSidecar)
+	}
+	sc, err := a.Get(second[0].BlockRoot(), 0)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, second[0].BlobSidecar, sc.BlobSidecar)
+	_, err = a.Get(second[0].BlockRoot(), 1)
+	require.ErrorIs(t, err, errArchiveSidecarMissing)
+}
+
+func TestOpenBlobArchive_Invalid(t *testing.T) {
+	_, sidecars := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 10, 1)
+	buf := &bytes.Buffer{}
+	w, err := NewBlobArchiveWriter(buf)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(sidecars[0]))
+	require.NoError(t, w.Close())
+	archive := buf.Bytes()
+
+	t.Run("too short", func(t *testing.T) {
+		_, err := OpenBlobArchive(bytes.NewReader(archive[:10]), 10)
+		require.ErrorIs(t, err, errNotBlobArchive)
+	})
+	t.Run("bad magic", func(t *testing.T) {
+		b := bytes.Clone(archive)
+		b[0] = 'x'
+		_, err := OpenBlobArchive(bytes.NewReader(b), int64(len(b)))
+		require.ErrorIs(t, err, errNotBlobArchive)
+	})
+	t.Run("unsupported version", func(t *testing.T) {
+		b := bytes.Clone(archive)
+		b[8] = 2
+		_, err := OpenBlobArchive(bytes.NewReader(b), int64(len(b)))
+		require.ErrorIs(t, err, errArchiveVersion)
+	})
+	t.Run("truncated", func(t *testing.T) {
+		b := archive[:len(archive)-1]
+		_, err := OpenBlobArchive(bytes.NewReader(b), int64(len(b)))
+		require.ErrorIs(t, err, errArchiveCorrupt)
+	})
+	t.Run("corrupt record", func(t *testing.T) {
+		b := bytes.Clone(archive)
+		b[archiveHeaderSize+100] ^= 0xff
+		a, err := OpenBlobArchive(bytes.NewReader(b), int64(len(b)))
+		require.NoError(t, err)
+		_, err = a.Read(a.Entries()[0])
+		require.NotNil(t, err)
+	})
+}
+
+func TestBlobStorage_ArchiveRange(t *testing.T) {
+	bs := NewEphemeralBlobStorage(t)
+	for _, slot := range []primitives.Slot{12, 10, 11, 14} {
+		_, sidecars := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, slot, 2)
+		verified, err := verification.BlobSidecarSliceNoop(sidecars)
+		require.NoError(t, err)
+		for _, sc := range verified {
+			require.NoError(t, bs.Save(sc))
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	w, err := NewBlobArchiveWriter(buf)
+	require.NoError(t, err)
+	n, err := bs.ArchiveRange(w, 11, 13)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+	require.NoError(t, w.Close())
+
+	a, err := OpenBlobArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	entries := a.Entries()
+	require.Equal(t, 4, len(entries))
+	want := []struct {
+		slot  primitives.Slot
+		index uint64
+	}{{11, 0}, {11, 1}, {12, 0}, {12, 1}}
+	for i, e := range entries {
+		require.Equal(t, want[i].slot, e.Slot)
+		require.Equal(t, want[i].index, e.Index)
+	}
+}
+
+func TestBlobStorage_ArchiveMode(t *testing.T) {
+	bs := NewEphemeralBlobStorage(t, WithArchiveMode(true))
+	require.Equal(t, true, bs.ArchiveMode())
+	_, old := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 1)
+	oldVerified, err := verification.BlobSidecarSliceNoop(old)
+	require.NoError(t, err)
+	require.NoError(t, bs.Save(oldVerified[0]))
+	// A blob far beyond the retention window would trigger a prune of the first one.
+	latest := bs.pruner.windowSize * 4
+	_, recent := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, latest, 1)
+	recentVerified, err := verification.BlobSidecarSliceNoop(recent)
+	require.NoError(t, err)
+	require.NoError(t, bs.Save(recentVerified[0]))
+	// Without archive mode, saving the recent blob would have moved the prune window past the old one.
+	_, err = bs.Get(oldVerified[0].BlockRoot(), 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), bs.pruner.prunedBefore.Load())
+	require.Equal(t, false, NewEphemeralBlobStorage(t).ArchiveMode())
+}
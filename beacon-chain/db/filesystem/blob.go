@@ -66,6 +66,25 @@ func WithSaveFsync(fsync bool) BlobStorageOption {
 	}
 }
 
+// WithObjectStore is an option that stores blobs in an S3-compatible object store. The base path is then
+// used as a local cache of the blobs saved or read by this node.
+func WithObjectStore(cfg ObjectStoreConfig) BlobStorageOption {
+	return func(b *BlobStorage) error {
+		b.objectStore = &cfg
+		return nil
+	}
+}
+
+// WithArchiveMode is an option that keeps blobs after the retention period instead of pruning them, so that
+// they can still be served. This is meant for nodes serving historical blobs, such as those imported from
+// a blob archive.
+func WithArchiveMode(archive bool) BlobStorageOption {
+	return func(b *BlobStorage) error {
+		b.archive = archive
+		return nil
+	}
+}
+
 // NewBlobStorage creates a new instance of the BlobStorage object. Note that the implementation of BlobStorage may
 // attempt to hold a file lock to guarantee exclusive control of the blob storage directory, so this should only be
 // initialized once per beacon node.
@@ -84,7 +103,25 @@ func NewBlobStorage(opts ...BlobStorageOption) (*BlobStorage, error) {
 		return nil, errors.Wrapf(err, "failed to create blob storage at %s", b.base)
 	}
 	b.fs = afero.NewBasePathFs(afero.NewOsFs(), b.base)
-	pruner, err := newBlobPruner(b.fs, b.retentionEpochs)
+	if b.objectStore != nil {
+		remote, err := newObjectFs(*b.objectStore)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create object store backend for blob storage")
+		}
+		size := b.objectStore.CacheSize
+		if size <= 0 {
+			size = DefaultObjectStoreCacheSize
+		}
+		b.fs, err = newCacheFs(remote, b.fs, size)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create local cache for blob storage")
+		}
+	}
+	var popts []prunerOpt
+	if b.archive {
+		popts = append(popts, withoutPruning())
+	}
+	pruner, err := newBlobPruner(b.fs, b.retentionEpochs, popts...)
 	if err != nil {
 		return nil, err
 	}
@@ -93,10 +130,13 @@ func NewBlobStorage(opts ...BlobStorageOption) (*BlobStorage, error) {
 }
 
 // BlobStorage is the concrete implementation of the filesystem backend for saving and retrieving BlobSidecars.
+// Blobs are kept either on the local filesystem or in an object store fronted by a local cache.
 type BlobStorage struct {
 	base            string
 	retentionEpochs primitives.Epoch
 	fsync           bool
+	archive         bool
+	objectStore     *ObjectStoreConfig
 	fs              afero.Fs
 	pruner          *blobPruner
 }
@@ -312,6 +352,11 @@ func (bs *BlobStorage) WithinRetentionPeriod(requested, current primitives.Epoch
 	return requested+bs.retentionEpochs >= current
 }
 
+// ArchiveMode returns true if blobs are kept after the retention period.
+func (bs *BlobStorage) ArchiveMode() bool {
+	return bs.archive
+}
+
 type blobNamer struct {
 	root  [32]byte
 	index uint64
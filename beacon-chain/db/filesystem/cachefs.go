@@ -0,0 +1,385 @@
+package filesystem
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/spf13/afero"
+	"github.com/spf13/afero/mem"
+)
+
+// cacheFs puts a local filesystem in front of a remote one. Writes, renames and removals go to both
+// filesystems, and files read in full from the remote filesystem are kept in the local one so that
+// later reads are served locally. Directory listings are the union of both filesystems: blobs pruned
+// from a shared remote store by another node remain visible locally until the local pruner removes them.
+//
+// Part files are only written locally. Renaming one uploads its content to the new name in a single
+// request, as renames in an object store are a copy followed by a delete. The local cache holds at most
+// size files, evicting the least recently used ones.
+type cacheFs struct {
+	remote afero.Fs
+	local  afero.Fs
+	cached *lru.Cache
+}
+
+var _ afero.Fs = &cacheFs{}
+
+// newCacheFs creates a cacheFs keeping at most size files in the local filesystem. Files already in the
+// local filesystem are added to the cache from the least to the most recently modified.
+func newCacheFs(remote, local afero.Fs, size int) (*cacheFs, error) {
+	c := &cacheFs{remote: remote, local: local}
+	c.cached = lruwrpr.NewWithEvict(size, c.evict)
+	var files []cachedFile
+	err := afero.Walk(local, ".", func(name string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && !filterPart(name) {
+			files = append(files, cachedFile{name: name, modTime: info.ModTime()})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list the local blob cache")
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+	for _, f := range files {
+		c.cached.Add(f.name, struct{}{})
+	}
+	return c, nil
+}
+
+type cachedFile struct {
+	name    string
+	modTime time.Time
+}
+
+// evict removes a file dropped from the cache from the local filesystem.
+func (c *cacheFs) evict(key, _ interface{}) {
+	name, ok := key.(string)
+	if !ok {
+		return
+	}
+	if err := c.local.Remove(name); err != nil && !os.IsNotExist(err) {
+		log.WithError(err).WithField("file", name).Warn("Could not remove blob from local cache")
+	}
+}
+
+// Name implements afero.Fs.
+func (*cacheFs) Name() string {
+	return "CacheFs"
+}
+
+// Create implements afero.Fs.
+func (c *cacheFs) Create(name string) (afero.File, error) {
+	return c.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+// Mkdir implements afero.Fs.
+func (c *cacheFs) Mkdir(name string, perm os.FileMode) error {
+	if err := c.remote.Mkdir(name, perm); err != nil {
+		return err
+	}
+	return c.local.Mkdir(name, perm)
+}
+
+// MkdirAll implements afero.Fs.
+func (c *cacheFs) MkdirAll(name string, perm os.FileMode) error {
+	if err := c.remote.MkdirAll(name, perm); err != nil {
+		return err
+	}
+	return c.local.MkdirAll(name, perm)
+}
+
+// Open implements afero.Fs.
+func (c *cacheFs) Open(name string) (afero.File, error) {
+	if fi, err := c.local.Stat(name); err == nil && !fi.IsDir() {
+		c.cached.Get(name)
+		return c.local.Open(name)
+	}
+	rf, err := c.remote.Open(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// The directory may only be left in the local cache.
+			if fi, lerr := c.local.Stat(name); lerr == nil && fi.IsDir() {
+				return c.local.Open(name)
+			}
+		}
+		return nil, err
+	}
+	fi, err := rf.Stat()
+	if err != nil {
+		return nil, closeAfter(rf, err)
+	}
+	if fi.IsDir() {
+		return c.unionDir(name, rf)
+	}
+	return &cacheFillFile{File: rf, fs: c, name: name}, nil
+}
+
+// OpenFile implements afero.Fs.
+func (c *cacheFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND) == 0 {
+		return c.Open(name)
+	}
+	if filterPart(name) {
+		return c.local.OpenFile(name, flag, perm)
+	}
+	rf, err := c.remote.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	lf, err := c.local.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, closeAfter(rf, err)
+	}
+	c.cached.Add(name, struct{}{})
+	return &cacheWriteFile{File: rf, local: lf}, nil
+}
+
+// Remove implements afero.Fs.
+func (c *cacheFs) Remove(name string) error {
+	if !filterPart(name) {
+		if err := c.remote.Remove(name); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := c.local.Remove(name); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	c.cached.Remove(name)
+	return nil
+}
+
+// RemoveAll implements afero.Fs.
+func (c *cacheFs) RemoveAll(name string) error {
+	if err := c.remote.RemoveAll(name); err != nil {
+		return err
+	}
+	if err := c.local.RemoveAll(name); err != nil {
+		return err
+	}
+	prefix := path.Clean(name) + "/"
+	for _, k := range c.cached.Keys() {
+		if n, ok := k.(string); ok && strings.HasPrefix(n, prefix) {
+			c.cached.Remove(n)
+		}
+	}
+	return nil
+}
+
+// Rename implements afero.Fs. Renaming a part file uploads it under the new name, other files are
+// renamed in both filesystems.
+func (c *cacheFs) Rename(oldname, newname string) error {
+	if filterPart(oldname) {
+		data, err := afero.ReadFile(c.local, oldname)
+		if err != nil {
+			return err
+		}
+		if err := afero.WriteFile(c.remote, newname, data, 0600); err != nil {
+			return err
+		}
+		if err := c.local.Rename(oldname, newname); err != nil {
+			return err
+		}
+		c.cached.Add(newname, struct{}{})
+		return nil
+	}
+	if err := c.remote.Rename(oldname, newname); err != nil {
+		return err
+	}
+	if err := c.local.Rename(oldname, newname); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	c.cached.Remove(oldname)
+	if _, err := c.local.Stat(newname); err == nil {
+		c.cached.Add(newname, struct{}{})
+	}
+	return nil
+}
+
+// Stat implements afero.Fs.
+func (c *cacheFs) Stat(name string) (os.FileInfo, error) {
+	lfi, lerr := c.local.Stat(name)
+	if lerr == nil && !lfi.IsDir() {
+		return lfi, nil
+	}
+	fi, err := c.remote.Stat(name)
+	if os.IsNotExist(err) && lerr == nil {
+		return lfi, nil
+	}
+	return fi, err
+}
+
+// Chmod implements afero.Fs.
+func (c *cacheFs) Chmod(name string, mode os.FileMode) error {
+	return c.remote.Chmod(name, mode)
+}
+
+// Chown implements afero.Fs.
+func (c *cacheFs) Chown(name string, uid, gid int) error {
+	return c.remote.Chown(name, uid, gid)
+}
+
+// Chtimes implements afero.Fs.
+func (c *cacheFs) Chtimes(name string, atime, mtime time.Time) error {
+	return c.remote.Chtimes(name, atime, mtime)
+}
+
+// unionDir returns a directory handle listing the entries of the remote directory along with
+// the entries only present in the local cache.
+func (c *cacheFs) unionDir(name string, rf afero.File) (afero.File, error) {
+	entries, err := rf.Readdir(-1)
+	if err := closeAfter(rf, err); err != nil {
+		return nil, err
+	}
+	byName := make(map[string]os.FileInfo, len(entries))
+	for _, e := range entries {
+		byName[e.Name()] = e
+	}
+	local, err := afero.ReadDir(c.local, name)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, e := range local {
+		if _, ok := byName[e.Name()]; !ok {
+			byName[e.Name()] = e
+		}
+	}
+	names := make([]string, 0, len(byName))
+	for n := range byName {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	dir := mem.CreateDir(name)
+	for _, n := range names {
+		var child *mem.FileData
+		if byName[n].IsDir() {
+			child = mem.CreateDir(path.Join(name, n))
+		} else {
+			child = mem.CreateFile(path.Join(name, n))
+		}
+		mem.AddToMemDir(dir, child)
+	}
+	return mem.NewReadOnlyFileHandle(dir), nil
+}
+
+// store saves a file read from the remote filesystem to the local cache. The file is written under
+// a temporary name and then renamed, so concurrent readers never see a partially written file.
+func (c *cacheFs) store(name string, data []byte) error {
+	if err := c.local.MkdirAll(path.Dir(name), directoryPermissions); err != nil {
+		return err
+	}
+	tmp := name + "." + partExt
+	if err := afero.WriteFile(c.local, tmp, data, 0600); err != nil {
+		return err
+	}
+	if err := c.local.Rename(tmp, name); err != nil {
+		if rerr := c.local.Remove(tmp); rerr != nil {
+			log.WithError(rerr).WithField("file", tmp).Debug("Could not remove temporary cache file")
+		}
+		return errors.Wrapf(err, "could not move %s into the local cache", name)
+	}
+	c.cached.Add(name, struct{}{})
+	return nil
+}
+
+// cacheFillFile wraps a file read from the remote filesystem. Once the file has been read sequentially
+// to the end, its content is stored in the local cache. Reads at an offset are not cached.
+type cacheFillFile struct {
+	afero.File
+	fs   *cacheFs
+	name string
+	buf  bytes.Buffer
+	done bool
+}
+
+func (f *cacheFillFile) Read(p []byte) (int, error) {
+	n, err := f.File.Read(p)
+	if f.done {
+		return n, err
+	}
+	f.buf.Write(p[:n])
+	if errors.Is(err, io.EOF) {
+		f.done = true
+		if serr := f.fs.store(f.name, f.buf.Bytes()); serr != nil {
+			log.WithError(serr).WithField("file", f.name).Warn("Could not store blob in local cache")
+		}
+		f.buf = bytes.Buffer{}
+	} else if err != nil {
+		f.done = true
+	}
+	return n, err
+}
+
+func (f *cacheFillFile) Seek(offset int64, whence int) (int64, error) {
+	// The buffered content no longer matches a sequential read of the file.
+	f.done = true
+	return f.File.Seek(offset, whence)
+}
+
+// cacheWriteFile writes to both the remote and the local filesystem.
+type cacheWriteFile struct {
+	afero.File
+	local afero.File
+}
+
+func (f *cacheWriteFile) Write(p []byte) (int, error) {
+	n, err := f.File.Write(p)
+	if err != nil {
+		return n, err
+	}
+	return f.local.Write(p)
+}
+
+func (f *cacheWriteFile) WriteAt(p []byte, off int64) (int, error) {
+	n, err := f.File.WriteAt(p, off)
+	if err != nil {
+		return n, err
+	}
+	return f.local.WriteAt(p, off)
+}
+
+func (f *cacheWriteFile) WriteString(s string) (int, error) {
+	return f.Write([]byte(s))
+}
+
+func (f *cacheWriteFile) Truncate(size int64) error {
+	if err := f.File.Truncate(size); err != nil {
+		return err
+	}
+	return f.local.Truncate(size)
+}
+
+func (f *cacheWriteFile) Sync() error {
+	if err := f.File.Sync(); err != nil {
+		return err
+	}
+	return f.local.Sync()
+}
+
+func (f *cacheWriteFile) Close() error {
+	err := f.File.Close()
+	if lerr := f.local.Close(); err == nil {
+		err = lerr
+	}
+	return err
+}
+
+// closeAfter closes the file, returning err if it is set or the error from closing the file otherwise.
+func closeAfter(f afero.File, err error) error {
+	if cerr := f.Close(); err == nil {
+		return cerr
+	}
+	return err
+}
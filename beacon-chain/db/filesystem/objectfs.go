@@ -0,0 +1,376 @@
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/spf13/afero/mem"
+)
+
+var errObjectReadOnly = errors.New("object opened for reading cannot be written")
+
+// objectFs adapts an S3-compatible object store to the afero.Fs interface, so that BlobStorage and the
+// pruner can use a bucket the same way they use a local directory. Object stores have no directories:
+// a directory is implied by the keys sharing its prefix, so creating one is a no-op and it disappears
+// along with the last object under it. Files opened for writing are buffered in memory and uploaded
+// as a whole when closed.
+type objectFs struct {
+	client *objectStoreClient
+	prefix string
+}
+
+var _ afero.Fs = &objectFs{}
+
+func newObjectFs(cfg ObjectStoreConfig) (*objectFs, error) {
+	client, err := newObjectStoreClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.Trim(cfg.Prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	return &objectFs{client: client, prefix: prefix}, nil
+}
+
+func (o *objectFs) clean(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
+
+func (o *objectFs) key(name string) string {
+	return o.prefix + o.clean(name)
+}
+
+// dirPrefix returns the key prefix shared by all objects under the directory with the given name.
+func (o *objectFs) dirPrefix(name string) string {
+	if o.clean(name) == "" {
+		return o.prefix
+	}
+	return o.key(name) + "/"
+}
+
+func (o *objectFs) isDir(ctx context.Context, name string) (bool, error) {
+	if o.clean(name) == "" {
+		return true, nil
+	}
+	objects, prefixes, err := o.client.list(ctx, o.dirPrefix(name), "/", 1)
+	if err != nil {
+		return false, err
+	}
+	return len(objects)+len(prefixes) > 0, nil
+}
+
+// Name implements afero.Fs.
+func (*objectFs) Name() string {
+	return "ObjectStoreFs"
+}
+
+// Create implements afero.Fs.
+func (o *objectFs) Create(name string) (afero.File, error) {
+	return o.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+// Mkdir implements afero.Fs. Directories are implied by object keys so this is a no-op.
+func (*objectFs) Mkdir(string, os.FileMode) error {
+	return nil
+}
+
+// MkdirAll implements afero.Fs. Directories are implied by object keys so this is a no-op.
+func (*objectFs) MkdirAll(string, os.FileMode) error {
+	return nil
+}
+
+// Open implements afero.Fs.
+func (o *objectFs) Open(name string) (afero.File, error) {
+	ctx := context.Background()
+	if o.clean(name) != "" {
+		info, err := o.client.head(ctx, o.key(name))
+		if err == nil {
+			return &objectFile{fs: o, name: name, info: info}, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	objects, prefixes, err := o.client.list(ctx, o.dirPrefix(name), "/", 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(objects)+len(prefixes) == 0 && o.clean(name) != "" {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	dir := mem.CreateDir(name)
+	for _, obj := range objects {
+		f := mem.CreateFile(path.Join(name, path.Base(obj.key)))
+		mem.SetModTime(f, obj.modTime)
+		mem.AddToMemDir(dir, f)
+	}
+	for _, p := range prefixes {
+		mem.AddToMemDir(dir, mem.CreateDir(path.Join(name, path.Base(p))))
+	}
+	return mem.NewReadOnlyFileHandle(dir), nil
+}
+
+// OpenFile implements afero.Fs. Files opened with any of the write flags are uploaded when closed,
+// replacing the existing object.
+func (o *objectFs) OpenFile(name string, flag int, _ os.FileMode) (afero.File, error) {
+	if flag&os.O_APPEND != 0 {
+		return nil, &os.PathError{Op: "open", Path: name, Err: syscall.EINVAL}
+	}
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC) == 0 {
+		return o.Open(name)
+	}
+	return &objectWriter{File: mem.NewFileHandle(mem.CreateFile(name)), fs: o, key: o.key(name)}, nil
+}
+
+// Remove implements afero.Fs. Removing a directory is a no-op, as it ceases to exist along with the
+// objects under it.
+func (o *objectFs) Remove(name string) error {
+	if o.clean(name) == "" {
+		return nil
+	}
+	return o.client.remove(context.Background(), o.key(name))
+}
+
+// RemoveAll implements afero.Fs.
+func (o *objectFs) RemoveAll(name string) error {
+	ctx := context.Background()
+	objects, _, err := o.client.list(ctx, o.dirPrefix(name), "", 0)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objects {
+		if err := o.client.remove(ctx, obj.key); err != nil {
+			return err
+		}
+	}
+	return o.Remove(name)
+}
+
+// Rename implements afero.Fs with a server side copy followed by the removal of the old object.
+func (o *objectFs) Rename(oldname, newname string) error {
+	ctx := context.Background()
+	if err := o.client.copy(ctx, o.key(oldname), o.key(newname)); err != nil {
+		return err
+	}
+	return o.client.remove(ctx, o.key(oldname))
+}
+
+// Stat implements afero.Fs.
+func (o *objectFs) Stat(name string) (os.FileInfo, error) {
+	ctx := context.Background()
+	if o.clean(name) != "" {
+		info, err := o.client.head(ctx, o.key(name))
+		if err == nil {
+			return &objectFileInfo{name: path.Base(name), size: info.size, modTime: info.modTime}, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	isDir, err := o.isDir(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !isDir {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: os.ErrNotExist}
+	}
+	return &objectFileInfo{name: path.Base(name), dir: true}, nil
+}
+
+// Chmod implements afero.Fs. Objects do not have a mode so this is a no-op.
+func (*objectFs) Chmod(string, os.FileMode) error {
+	return nil
+}
+
+// Chown implements afero.Fs. Objects do not have an owner so this is a no-op.
+func (*objectFs) Chown(string, int, int) error {
+	return nil
+}
+
+// Chtimes implements afero.Fs. Modification times are set by the object store so this is a no-op.
+func (*objectFs) Chtimes(string, time.Time, time.Time) error {
+	return nil
+}
+
+// objectFile is a read-only handle to an object. The content is downloaded on the first sequential
+// read, while ReadAt fetches only the requested range. This keeps reading the slot of a blob cheap.
+type objectFile struct {
+	fs     *objectFs
+	name   string
+	info   objectInfo
+	reader *bytes.Reader
+}
+
+var _ afero.File = &objectFile{}
+
+func (f *objectFile) load() error {
+	if f.reader != nil {
+		return nil
+	}
+	data, _, err := f.fs.client.get(context.Background(), f.info.key)
+	if err != nil {
+		return err
+	}
+	f.reader = bytes.NewReader(data)
+	return nil
+}
+
+func (f *objectFile) Read(p []byte) (int, error) {
+	if err := f.load(); err != nil {
+		return 0, err
+	}
+	return f.reader.Read(p)
+}
+
+func (f *objectFile) ReadAt(p []byte, off int64) (int, error) {
+	if f.reader != nil {
+		return f.reader.ReadAt(p, off)
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if off >= f.info.size {
+		return 0, io.EOF
+	}
+	h := http.Header{}
+	h.Set("Range", fmt.Sprintf("bytes=%d-%d", off, off+int64(len(p))-1))
+	resp, err := f.fs.client.do(context.Background(), http.MethodGet, f.info.key, nil, h, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer closeBody(resp)
+	if err := checkResponse(resp, f.info.key); err != nil {
+		return 0, err
+	}
+	if resp.StatusCode != http.StatusPartialContent {
+		// The store ignored the range header and sent the whole object.
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return 0, err
+		}
+		f.reader = bytes.NewReader(data)
+		return f.reader.ReadAt(p, off)
+	}
+	n, err := io.ReadFull(resp.Body, p)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (f *objectFile) Seek(offset int64, whence int) (int64, error) {
+	if err := f.load(); err != nil {
+		return 0, err
+	}
+	return f.reader.Seek(offset, whence)
+}
+
+func (f *objectFile) Stat() (os.FileInfo, error) {
+	return &objectFileInfo{name: path.Base(f.name), size: f.info.size, modTime: f.info.modTime}, nil
+}
+
+func (f *objectFile) Name() string {
+	return f.name
+}
+
+func (*objectFile) Close() error {
+	return nil
+}
+
+func (*objectFile) Sync() error {
+	return nil
+}
+
+func (f *objectFile) Readdir(int) ([]os.FileInfo, error) {
+	return nil, &os.PathError{Op: "readdir", Path: f.name, Err: syscall.ENOTDIR}
+}
+
+func (f *objectFile) Readdirnames(int) ([]string, error) {
+	return nil, &os.PathError{Op: "readdir", Path: f.name, Err: syscall.ENOTDIR}
+}
+
+func (*objectFile) Write([]byte) (int, error) {
+	return 0, errObjectReadOnly
+}
+
+func (*objectFile) WriteAt([]byte, int64) (int, error) {
+	return 0, errObjectReadOnly
+}
+
+func (*objectFile) WriteString(string) (int, error) {
+	return 0, errObjectReadOnly
+}
+
+func (*objectFile) Truncate(int64) error {
+	return errObjectReadOnly
+}
+
+// objectWriter buffers writes in memory and uploads the object when closed.
+type objectWriter struct {
+	*mem.File
+	fs     *objectFs
+	key    string
+	closed bool
+}
+
+func (f *objectWriter) Close() error {
+	if f.closed {
+		return afero.ErrFileClosed
+	}
+	f.closed = true
+	if err := f.File.Close(); err != nil {
+		return err
+	}
+	data, err := io.ReadAll(mem.NewReadOnlyFileHandle(f.File.Data()))
+	if err != nil {
+		return errors.Wrapf(err, "could not read buffered object %s", f.key)
+	}
+	return f.fs.client.put(context.Background(), f.key, data)
+}
+
+type objectFileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	dir     bool
+}
+
+var _ os.FileInfo = &objectFileInfo{}
+
+func (i *objectFileInfo) Name() string {
+	return i.name
+}
+
+func (i *objectFileInfo) Size() int64 {
+	return i.size
+}
+
+func (i *objectFileInfo) Mode() os.FileMode {
+	if i.dir {
+		return os.ModeDir | 0700
+	}
+	return 0600
+}
+
+func (i *objectFileInfo) ModTime() time.Time {
+	return i.modTime
+}
+
+func (i *objectFileInfo) IsDir() bool {
+	return i.dir
+}
+
+func (*objectFileInfo) Sys() interface{} {
+	return nil
+}
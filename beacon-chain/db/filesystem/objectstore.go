@@ -0,0 +1,389 @@
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	errNoObjectStoreEndpoint = errors.New("object store endpoint not specified")
+	errNoObjectStoreBucket   = errors.New("object store bucket not specified")
+)
+
+const (
+	objectStoreTimeout   = 30 * time.Second
+	objectStoreService   = "s3"
+	objectStoreAlgorithm = "AWS4-HMAC-SHA256"
+	amzDateFormat        = "20060102T150405Z"
+	amzShortDateFormat   = "20060102"
+	defaultRegion        = "us-east-1"
+)
+
+// DefaultObjectStoreCacheSize is the default number of blobs kept in the local cache of an object store,
+// about 512MiB of blobs.
+const DefaultObjectStoreCacheSize = 4096
+
+// ObjectStoreConfig describes an S3-compatible object store bucket used as the backend for blob storage.
+type ObjectStoreConfig struct {
+	// Endpoint is the base URL of the object store, e.g. https://s3.us-east-1.amazonaws.com or http://localhost:9000.
+	Endpoint string
+	// Bucket is the name of the bucket holding the blobs. Buckets are addressed path-style.
+	Bucket string
+	// Prefix is an optional key prefix, allowing several deployments to share a bucket.
+	Prefix string
+	// Region is used to sign requests. Defaults to us-east-1.
+	Region    string
+	AccessKey string
+	SecretKey string
+	// CacheSize is the number of blobs kept in the local cache. Defaults to DefaultObjectStoreCacheSize.
+	CacheSize int
+}
+
+// objectStoreClient implements the subset of the S3 API needed to store blobs, signing
+// requests with AWS signature version 4 when credentials are configured.
+type objectStoreClient struct {
+	endpoint *url.URL
+	bucket   string
+	region   string
+	access   string
+	secret   string
+	hc       *http.Client
+	now      func() time.Time
+}
+
+type objectInfo struct {
+	key     string
+	size    int64
+	modTime time.Time
+}
+
+func newObjectStoreClient(cfg ObjectStoreConfig) (*objectStoreClient, error) {
+	if cfg.Endpoint == "" {
+		return nil, errNoObjectStoreEndpoint
+	}
+	if cfg.Bucket == "" {
+		return nil, errNoObjectStoreBucket
+	}
+	u, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid object store endpoint %s", cfg.Endpoint)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("object store endpoint %s must use http or https", cfg.Endpoint)
+	}
+	region := cfg.Region
+	if region == "" {
+		region = defaultRegion
+	}
+	return &objectStoreClient{
+		endpoint: u,
+		bucket:   cfg.Bucket,
+		region:   region,
+		access:   cfg.AccessKey,
+		secret:   cfg.SecretKey,
+		hc:       &http.Client{Timeout: objectStoreTimeout},
+		now:      time.Now,
+	}, nil
+}
+
+func (c *objectStoreClient) get(ctx context.Context, key string) ([]byte, objectInfo, error) {
+	resp, err := c.do(ctx, http.MethodGet, key, nil, nil, nil)
+	if err != nil {
+		return nil, objectInfo{}, err
+	}
+	defer closeBody(resp)
+	if err := checkResponse(resp, key); err != nil {
+		return nil, objectInfo{}, err
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, objectInfo{}, errors.Wrapf(err, "could not read object %s", key)
+	}
+	info := infoFromResponse(key, resp)
+	info.size = int64(len(b))
+	return b, info, nil
+}
+
+func (c *objectStoreClient) head(ctx context.Context, key string) (objectInfo, error) {
+	resp, err := c.do(ctx, http.MethodHead, key, nil, nil, nil)
+	if err != nil {
+		return objectInfo{}, err
+	}
+	defer closeBody(resp)
+	if err := checkResponse(resp, key); err != nil {
+		return objectInfo{}, err
+	}
+	return infoFromResponse(key, resp), nil
+}
+
+func (c *objectStoreClient) put(ctx context.Context, key string, data []byte) error {
+	resp, err := c.do(ctx, http.MethodPut, key, nil, nil, data)
+	if err != nil {
+		return err
+	}
+	defer closeBody(resp)
+	return checkResponse(resp, key)
+}
+
+// copy performs a server-side copy of the object at src to dst.
+func (c *objectStoreClient) copy(ctx context.Context, src, dst string) error {
+	h := http.Header{}
+	h.Set("X-Amz-Copy-Source", "/"+c.bucket+"/"+uriEncode(src, false))
+	resp, err := c.do(ctx, http.MethodPut, dst, nil, h, nil)
+	if err != nil {
+		return err
+	}
+	defer closeBody(resp)
+	return checkResponse(resp, src)
+}
+
+// remove deletes the object with the given key. Deleting a missing object is not an error.
+func (c *objectStoreClient) remove(ctx context.Context, key string) error {
+	resp, err := c.do(ctx, http.MethodDelete, key, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer closeBody(resp)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return checkResponse(resp, key)
+}
+
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+}
+
+// list returns the objects with the given key prefix. If delimiter is not empty, keys containing the
+// delimiter after the prefix are rolled up and returned as common prefixes instead. At most limit
+// entries are returned if limit is positive.
+func (c *objectStoreClient) list(ctx context.Context, prefix, delimiter string, limit int) ([]objectInfo, []string, error) {
+	var objects []objectInfo
+	var prefixes []string
+	token := ""
+	for {
+		q := url.Values{}
+		q.Set("list-type", "2")
+		q.Set("prefix", prefix)
+		if delimiter != "" {
+			q.Set("delimiter", delimiter)
+		}
+		if limit > 0 {
+			q.Set("max-keys", strconv.Itoa(limit))
+		}
+		if token != "" {
+			q.Set("continuation-token", token)
+		}
+		resp, err := c.do(ctx, http.MethodGet, "", q, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		result := &listBucketResult{}
+		err = checkResponse(resp, prefix)
+		if err == nil {
+			err = xml.NewDecoder(resp.Body).Decode(result)
+		}
+		closeBody(resp)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not list objects with prefix %s", prefix)
+		}
+		for _, o := range result.Contents {
+			objects = append(objects, objectInfo{key: o.Key, size: o.Size, modTime: o.LastModified})
+		}
+		for _, p := range result.CommonPrefixes {
+			prefixes = append(prefixes, p.Prefix)
+		}
+		if limit > 0 && len(objects)+len(prefixes) >= limit {
+			return objects, prefixes, nil
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, prefixes, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func (c *objectStoreClient) do(ctx context.Context, method, key string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + c.bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawPath = strings.TrimSuffix(c.endpoint.EscapedPath(), "/") + "/" + uriEncode(c.bucket, false)
+	if key != "" {
+		u.RawPath += "/" + uriEncode(key, false)
+	}
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.ContentLength = int64(len(body))
+	c.sign(req, body)
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "object store request %s %s failed", method, key)
+	}
+	return resp, nil
+}
+
+// sign adds the AWS signature version 4 headers to the request. Requests are left unsigned
+// when no credentials are configured, which is useful for stores allowing anonymous access.
+func (c *objectStoreClient) sign(req *http.Request, body []byte) {
+	now := c.now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	sum := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(sum[:])
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if c.access == "" {
+		return
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		lk := strings.ToLower(k)
+		if strings.HasPrefix(lk, "x-amz-") {
+			headers[lk] = strings.TrimSpace(strings.Join(v, ","))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := strings.Join([]string{now.Format(amzShortDateFormat), c.region, objectStoreService, "aws4_request"}, "/")
+	crHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{objectStoreAlgorithm, amzDate, scope, hex.EncodeToString(crHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.secret), now.Format(amzShortDateFormat))
+	key = hmacSHA256(key, c.region)
+	key = hmacSHA256(key, objectStoreService)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		objectStoreAlgorithm, c.access, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	// Writes to a hash.Hash never return an error.
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// canonicalQuery encodes query parameters sorted by key, as required by signature version 4.
+func canonicalQuery(q url.Values) string {
+	if len(q) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range q[k] {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode percent-encodes every byte except the unreserved characters of RFC 3986. The slash
+// is kept as is unless encodeSlash is set.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// checkResponse converts unsuccessful responses to errors. Missing objects are reported with an error
+// satisfying os.IsNotExist, so the object store can stand in for a filesystem.
+func checkResponse(resp *http.Response, key string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return &os.PathError{Op: "open", Path: key, Err: os.ErrNotExist}
+	}
+	msg, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		msg = []byte(err.Error())
+	}
+	return fmt.Errorf("object store request for %s failed with status %d: %s", key, resp.StatusCode, strings.TrimSpace(string(msg)))
+}
+
+func infoFromResponse(key string, resp *http.Response) objectInfo {
+	info := objectInfo{key: key}
+	if resp.ContentLength > 0 {
+		info.size = resp.ContentLength
+	}
+	if t, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		info.modTime = t
+	}
+	return info
+}
+
+func closeBody(resp *http.Response) {
+	if err := resp.Body.Close(); err != nil {
+		log.WithError(err).Debug("Could not close object store response body")
+	}
+}
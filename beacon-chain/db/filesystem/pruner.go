@@ -35,6 +35,7 @@ type blobPruner struct {
 	cache        *blobStorageCache
 	cacheReady   chan struct{}
 	warmed       bool
+	disabled     bool
 	fs           afero.Fs
 }
 
@@ -46,6 +47,14 @@ func withWarmedCache() prunerOpt {
 	}
 }
 
+// withoutPruning keeps the pruner cache up to date without ever removing blobs.
+func withoutPruning() prunerOpt {
+	return func(p *blobPruner) error {
+		p.disabled = true
+		return nil
+	}
+}
+
 func newBlobPruner(fs afero.Fs, retain primitives.Epoch, opts ...prunerOpt) (*blobPruner, error) {
 	r, err := slots.EpochStart(retain + retentionBuffer)
 	if err != nil {
@@ -67,6 +76,9 @@ func (p *blobPruner) notify(root [32]byte, latest primitives.Slot, idx uint64) e
 	if err := p.cache.ensure(root, latest, idx); err != nil {
 		return err
 	}
+	if p.disabled {
+		return nil
+	}
 	pruned := uint64(windowMin(latest, p.windowSize))
 	if p.prunedBefore.Swap(pruned) == pruned {
 		return nil
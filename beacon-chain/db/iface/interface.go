@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
@@ -41,6 +42,7 @@ type ReadOnlyDatabase interface {
 	StateSummary(ctx context.Context, blockRoot [32]byte) (*ethpb.StateSummary, error)
 	HasStateSummary(ctx context.Context, blockRoot [32]byte) bool
 	HighestSlotStatesBelow(ctx context.Context, slot primitives.Slot) ([]state.ReadOnlyBeaconState, error)
+	StateArchiveProgress(ctx context.Context) ([32]byte, error)
 	// Checkpoint operations.
 	JustifiedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
 	FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
@@ -60,6 +62,16 @@ type ReadOnlyDatabase interface {
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillStatus(context.Context) (*dbval.BackfillStatus, error)
+	ForkChoiceSnapshot(ctx context.Context) ([]byte, error)
+
+	// Light client operations.
+	LightClientUpdate(ctx context.Context, period uint64) (*ethpbv2.LightClientUpdate, error)
+	LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) (map[uint64]*ethpbv2.LightClientUpdate, error)
+	LightClientHeader(ctx context.Context, blockRoot [32]byte) (*ethpbv2.LightClientHeaderDeneb, error)
+
+	// Proposal audit operations.
+	ProposalAudit(ctx context.Context, slot primitives.Slot) ([]byte, error)
+	LastBuilderRevealMiss(ctx context.Context) (primitives.Slot, bool, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -75,6 +87,8 @@ type NoHeadAccessDatabase interface {
 	// State related methods.
 	SaveState(ctx context.Context, state state.ReadOnlyBeaconState, blockRoot [32]byte) error
 	SaveStates(ctx context.Context, states []state.ReadOnlyBeaconState, blockRoots [][32]byte) error
+	SaveStateDiff(ctx context.Context, st state.ReadOnlyBeaconState, blockRoot [32]byte, base state.ReadOnlyBeaconState, baseRoot [32]byte) error
+	SaveStateArchiveProgress(ctx context.Context, root [32]byte) error
 	DeleteState(ctx context.Context, blockRoot [32]byte) error
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
 	SaveStateSummary(ctx context.Context, summary *ethpb.StateSummary) error
@@ -83,6 +97,9 @@ type NoHeadAccessDatabase interface {
 	SaveJustifiedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error
 	SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error
 	SaveLastValidatedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error
+	// Fork choice snapshot operations.
+	SaveForkChoiceSnapshot(ctx context.Context, snapshot []byte) error
+	DeleteForkChoiceSnapshot(ctx context.Context) error
 	// Deposit contract related handlers.
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// SaveExecutionChainData operations.
@@ -94,6 +111,16 @@ type NoHeadAccessDatabase interface {
 	SaveRegistrationsByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, regs []*ethpb.ValidatorRegistrationV1) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint primitives.Slot) error
+	DeleteHistoricalDataBeforeSlot(ctx context.Context, slot primitives.Slot, batchSize int) ([32]byte, int, error)
+
+	// Light client operations.
+	SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpbv2.LightClientUpdate) error
+	SaveLightClientHeader(ctx context.Context, header *ethpbv2.LightClientHeaderDeneb) error
+
+	// Proposal audit operations.
+	SaveProposalAudit(ctx context.Context, slot primitives.Slot, audit []byte) error
+	DeleteProposalAuditsBefore(ctx context.Context, slot primitives.Slot) error
+	SaveLastBuilderRevealMiss(ctx context.Context, slot primitives.Slot) error
 }
 
 // HeadAccessDatabase defines a struct with access to reading chain head data.
@@ -159,6 +186,18 @@ type SlasherDatabase interface {
 		ctx context.Context,
 		indices []primitives.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	SaveAttesterSlashings(
+		ctx context.Context, slashings []ethpb.AttSlashing,
+	) error
+	SaveProposerSlashings(
+		ctx context.Context, slashings []*ethpb.ProposerSlashing,
+	) error
+	AttesterSlashings(
+		ctx context.Context, filter *slashertypes.SlashingsFilter,
+	) ([]ethpb.AttSlashing, error)
+	ProposerSlashings(
+		ctx context.Context, filter *slashertypes.SlashingsFilter,
+	) ([]*ethpb.ProposerSlashing, error)
 	DatabasePath() string
 	ClearDB() error
 }
@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"path"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
@@ -44,75 +48,133 @@ func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride
 	backupPath := path.Join(backupsDir, fmt.Sprintf("prysm_beacondb_at_slot_%07d.backup", head.Block().Slot()))
 	log.WithField("backup", backupPath).Info("Writing backup database.")
 
-	copyDB, err := bolt.Open(
+	// The database file is copied page by page from a single read transaction, so the backup is a consistent
+	// snapshot and its pages match the pages of the database, which incremental backups rely on.
+	return s.db.View(func(tx *bolt.Tx) error {
+		return tx.CopyFile(backupPath, params.BeaconIoConfig().ReadWritePermissions)
+	})
+}
+
+// BackupReport summarizes the chain data found in a beacon database backup by VerifyBackup.
+type BackupReport struct {
+	// GenesisRoot is the root of the genesis block, or of the checkpoint sync origin block if the node was not
+	// synced from genesis.
+	GenesisRoot    [32]byte
+	FromOrigin     bool
+	FinalizedEpoch primitives.Epoch
+	FinalizedRoot  [32]byte
+}
+
+// VerifyBackup opens the beacon database backup at the given path read-only and sanity checks it: every page must
+// be reachable and consistent, the genesis (or checkpoint sync origin) block must be readable, and the block of
+// the finalized checkpoint must be readable with either its state or its state summary.
+func VerifyBackup(ctx context.Context, backupPath string) (*BackupReport, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.VerifyBackup")
+	defer span.End()
+
+	boltDB, err := bolt.Open(
 		backupPath,
 		params.BeaconIoConfig().ReadWritePermissions,
-		&bolt.Options{NoSync: true, Timeout: params.BeaconIoConfig().BoltTimeout, FreelistType: bolt.FreelistMapType},
+		&bolt.Options{ReadOnly: true, Timeout: params.BeaconIoConfig().BoltTimeout},
 	)
 	if err != nil {
-		return err
+		return nil, errors.Wrapf(err, "could not open backup %s", backupPath)
 	}
-	copyDB.AllocSize = boltAllocSize
-
 	defer func() {
-		if err := copyDB.Close(); err != nil {
+		if err := boltDB.Close(); err != nil {
 			log.WithError(err).Error("Failed to close backup database")
 		}
 	}()
-	// Prefetch all keys of buckets, and inner keys in a
-	// bucket to use less memory usage when backing up.
-	var bucketKeys [][]byte
-	bucketMap := make(map[string][][]byte)
-	err = s.db.View(func(tx *bolt.Tx) error {
-		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
-			newName := make([]byte, len(name))
-			copy(newName, name)
-			bucketKeys = append(bucketKeys, newName)
-			var innerKeys [][]byte
-			err := b.ForEach(func(k, v []byte) error {
-				if k == nil {
-					return nil
-				}
-				nKey := make([]byte, len(k))
-				copy(nKey, k)
-				innerKeys = append(innerKeys, nKey)
-				return nil
-			})
-			if err != nil {
-				return err
-			}
-			bucketMap[string(newName)] = innerKeys
+
+	report := &BackupReport{}
+	err = boltDB.View(func(tx *bolt.Tx) error {
+		if err := checkBoltIntegrity(tx); err != nil {
+			return err
+		}
+		bkt := tx.Bucket(blocksBucket)
+		if bkt == nil || tx.Bucket(checkpointBucket) == nil {
+			return errors.New("backup is not a beacon database")
+		}
+		root := bkt.Get(genesisBlockRootKey)
+		if root == nil {
+			root = bkt.Get(originCheckpointBlockRootKey)
+			report.FromOrigin = true
+		}
+		if root == nil {
+			return errors.New("neither the genesis nor the checkpoint sync origin block root were found")
+		}
+		report.GenesisRoot = bytesutil.ToBytes32(root)
+		if err := verifyBackupBlock(ctx, tx, report.GenesisRoot); err != nil {
+			return errors.Wrap(err, "could not read the genesis block")
+		}
+
+		enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+		if enc == nil {
+			return errors.New("finalized checkpoint not found")
+		}
+		cp := &ethpb.Checkpoint{}
+		if err := decode(ctx, enc, cp); err != nil {
+			return errors.Wrap(err, "could not decode the finalized checkpoint")
+		}
+		report.FinalizedEpoch = cp.Epoch
+		report.FinalizedRoot = bytesutil.ToBytes32(cp.Root)
+		if report.FinalizedRoot == params.BeaconConfig().ZeroHash || report.FinalizedRoot == report.GenesisRoot {
 			return nil
-		})
+		}
+		if err := verifyBackupBlock(ctx, tx, report.FinalizedRoot); err != nil {
+			return errors.Wrapf(err, "could not read the finalized block at epoch %d", cp.Epoch)
+		}
+		if !backupHasKey(tx, stateBucket, cp.Root) && !backupHasKey(tx, stateSummaryBucket, cp.Root) {
+			return errors.Errorf("no state nor state summary found for the finalized root %#x", cp.Root)
+		}
+		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// verifyBackupBlock checks that the block with the given root is in the database and can be decoded.
+func verifyBackupBlock(ctx context.Context, tx *bolt.Tx, root [32]byte) error {
+	enc := tx.Bucket(blocksBucket).Get(root[:])
+	if enc == nil {
+		return errors.Errorf("block %#x not found", root)
+	}
+	blk, err := unmarshalBlock(ctx, enc)
+	if err != nil {
+		return errors.Wrapf(err, "could not decode block %#x", root)
+	}
+	blkRoot, err := blk.Block().HashTreeRoot()
 	if err != nil {
 		return err
 	}
-	// Utilize much smaller writes, compared to
-	// writing for a whole bucket in a single transaction. Also
-	// prevent long-running read transactions, as Bolt doesn't
-	// handle those well.
-	for _, k := range bucketKeys {
-		log.Debugf("Copying bucket %s\n", k)
-		innerKeys := bucketMap[string(k)]
-		for _, ik := range innerKeys {
-			err = s.db.View(func(tx *bolt.Tx) error {
-				bkt := tx.Bucket(k)
-				return copyDB.Update(func(tx2 *bolt.Tx) error {
-					b2, err := tx2.CreateBucketIfNotExists(k)
-					if err != nil {
-						return err
-					}
-					return b2.Put(ik, bkt.Get(ik))
-				})
-			})
-			if err != nil {
-				return err
-			}
+	if blkRoot != root {
+		return errors.Errorf("block stored under root %#x has root %#x", root, blkRoot)
+	}
+	return nil
+}
+
+// backupHasKey returns true if the key is in the bucket. Backups do not contain the buckets which were empty.
+func backupHasKey(tx *bolt.Tx, bucket, key []byte) bool {
+	bkt := tx.Bucket(bucket)
+	return bkt != nil && bkt.Get(key) != nil
+}
+
+// checkBoltIntegrity walks every page of the database, reporting the first inconsistency found along with the
+// total number of inconsistencies.
+func checkBoltIntegrity(tx *bolt.Tx) error {
+	var first error
+	n := 0
+	// The channel must be drained before the transaction is closed.
+	for err := range tx.Check() {
+		if first == nil {
+			first = err
 		}
+		n++
+	}
+	if first != nil {
+		return errors.Wrapf(first, "database integrity check found %d errors, first error", n)
 	}
-	// Re-enable sync to allow bolt to fsync
-	// again.
-	copyDB.NoSync = false
 	return nil
 }
@@ -8,8 +8,11 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	bolt "go.etcd.io/bbolt"
 )
 
 func TestStore_Backup(t *testing.T) {
@@ -108,3 +111,60 @@ func TestStore_BackupMultipleBuckets(t *testing.T) {
 		require.Equal(t, nState.Slot(), i)
 	}
 }
+
+func TestVerifyBackup(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesis := util.NewBeaconBlock()
+	util.SaveBlock(t, ctx, db, genesis)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: 0, Root: genesisRoot[:]}))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, genesisRoot))
+
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 64
+	blk.Block.ParentRoot = genesisRoot[:]
+	util.SaveBlock(t, ctx, db, blk)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(64))
+	require.NoError(t, db.SaveState(ctx, st, root))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: root[:]}))
+
+	backup := func() string {
+		dir := t.TempDir()
+		require.NoError(t, db.Backup(ctx, dir, true))
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(files))
+		return filepath.Join(dir, files[0].Name())
+	}
+
+	report, err := VerifyBackup(ctx, backup())
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, report.GenesisRoot)
+	assert.Equal(t, false, report.FromOrigin)
+	assert.Equal(t, primitives.Epoch(2), report.FinalizedEpoch)
+	assert.Equal(t, root, report.FinalizedRoot)
+
+	garbage := filepath.Join(t.TempDir(), "garbage.backup")
+	require.NoError(t, os.WriteFile(garbage, make([]byte, 8192), 0600))
+	_, err = VerifyBackup(ctx, garbage)
+	require.ErrorContains(t, "could not open backup", err)
+
+	// A database without chain data is rejected.
+	emptyPath := filepath.Join(t.TempDir(), "empty.backup")
+	empty, err := bolt.Open(emptyPath, 0600, nil)
+	require.NoError(t, err)
+	require.NoError(t, empty.Update(func(tx *bolt.Tx) error {
+		return createBuckets(tx, blocksBucket, checkpointBucket)
+	}))
+	require.NoError(t, empty.Close())
+	_, err = VerifyBackup(ctx, emptyPath)
+	require.ErrorContains(t, "neither the genesis nor the checkpoint sync origin block root were found", err)
+}
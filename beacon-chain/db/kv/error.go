@@ -5,6 +5,9 @@ import "github.com/pkg/errors"
 // ErrDeleteJustifiedAndFinalized is raised when we attempt to delete a finalized block/state
 var ErrDeleteJustifiedAndFinalized = errors.New("cannot delete finalized block or state")
 
+// ErrDeleteDiffBase is raised when we attempt to delete a full state that state diffs are taken against
+var ErrDeleteDiffBase = errors.New("cannot delete the base state of state diffs")
+
 // ErrNotFound can be used directly, or as a wrapped DBError, whenever a db method needs to
 // indicate that a value couldn't be found.
 var ErrNotFound = errors.New("not found in db")
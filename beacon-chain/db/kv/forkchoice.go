@@ -0,0 +1,52 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveForkChoiceSnapshot saves a snapshot of the fork choice store, replacing the previous one.
+func (s *Store) SaveForkChoiceSnapshot(ctx context.Context, snapshot []byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveForkChoiceSnapshot")
+	defer span.End()
+	if len(snapshot) == 0 {
+		return errors.New("empty forkchoice snapshot")
+	}
+	enc := snappy.Encode(nil, snapshot)
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return tx.Bucket(chainMetadataBucket).Put(forkChoiceSnapshotKey, enc)
+	})
+}
+
+// ForkChoiceSnapshot returns the last saved snapshot of the fork choice store, or nil if there is none.
+func (s *Store) ForkChoiceSnapshot(ctx context.Context) ([]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ForkChoiceSnapshot")
+	defer span.End()
+	var snapshot []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(forkChoiceSnapshotKey)
+		if enc == nil {
+			return nil
+		}
+		var err error
+		snapshot, err = snappy.Decode(nil, enc)
+		return errors.Wrap(err, "could not snappy decode forkchoice snapshot")
+	})
+	return snapshot, err
+}
+
+// DeleteForkChoiceSnapshot deletes the saved snapshot of the fork choice store, if any.
+func (s *Store) DeleteForkChoiceSnapshot(ctx context.Context) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteForkChoiceSnapshot")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Delete(forkChoiceSnapshotKey)
+	})
+}
@@ -0,0 +1,31 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_ForkChoiceSnapshot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	snapshot, err := db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(snapshot))
+
+	require.NoError(t, db.SaveForkChoiceSnapshot(ctx, []byte("first")))
+	require.NoError(t, db.SaveForkChoiceSnapshot(ctx, []byte("second")))
+	snapshot, err = db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("second"), snapshot)
+
+	require.NoError(t, db.DeleteForkChoiceSnapshot(ctx))
+	snapshot, err = db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(snapshot))
+
+	assert.ErrorContains(t, "empty forkchoice snapshot", db.SaveForkChoiceSnapshot(ctx, nil))
+}
@@ -89,6 +89,7 @@ type Store struct {
 	blockCache          *ristretto.Cache
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
+	diffBaseCache       diffBaseCache
 	ctx                 context.Context
 }
 
@@ -113,11 +114,16 @@ var Buckets = [][]byte{
 	blockParentRootIndicesBucket,
 	finalizedBlockRootsIndexBucket,
 	blockRootValidatorHashesBucket,
+	stateDiffBaseIndicesBucket,
 	// Migrations
 	migrationsBucket,
 
 	feeRecipientBucket,
 	registrationBucket,
+	stateDiffBucket,
+	lightClientUpdatesBucket,
+	lightClientHeadersBucket,
+	proposalAuditsBucket,
 }
 
 // KVStoreOption is a functional option that modifies a kv.Store.
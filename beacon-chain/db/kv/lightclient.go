@@ -0,0 +1,123 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveLightClientUpdate stores the best light client update of a sync committee period, replacing
+// the update previously saved for the period.
+func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpbv2.LightClientUpdate) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientUpdate")
+	defer span.End()
+	if update == nil {
+		return errors.New("nil light client update")
+	}
+	enc, err := update.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal light client update")
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return tx.Bucket(lightClientUpdatesBucket).Put(lightClientPeriodKey(period), enc)
+	})
+}
+
+// LightClientUpdate returns the light client update saved for a sync committee period, or nil if
+// there is none.
+func (s *Store) LightClientUpdate(ctx context.Context, period uint64) (*ethpbv2.LightClientUpdate, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdate")
+	defer span.End()
+	var update *ethpbv2.LightClientUpdate
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(lightClientUpdatesBucket).Get(lightClientPeriodKey(period))
+		if enc == nil {
+			return nil
+		}
+		update = &ethpbv2.LightClientUpdate{}
+		return update.UnmarshalSSZ(enc)
+	})
+	return update, err
+}
+
+// LightClientUpdates returns the light client updates saved for the sync committee periods in
+// [startPeriod, endPeriod], keyed by period. Periods without an update are absent from the result.
+func (s *Store) LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) (map[uint64]*ethpbv2.LightClientUpdate, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdates")
+	defer span.End()
+	if startPeriod > endPeriod {
+		return nil, errors.Errorf("start period %d is greater than end period %d", startPeriod, endPeriod)
+	}
+	updates := make(map[uint64]*ethpbv2.LightClientUpdate)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(lightClientUpdatesBucket).Cursor()
+		for k, v := c.Seek(lightClientPeriodKey(startPeriod)); k != nil; k, v = c.Next() {
+			period := binary.BigEndian.Uint64(k)
+			if period > endPeriod {
+				break
+			}
+			update := &ethpbv2.LightClientUpdate{}
+			if err := update.UnmarshalSSZ(v); err != nil {
+				return errors.Wrapf(err, "could not unmarshal light client update of period %d", period)
+			}
+			updates[period] = update
+		}
+		return nil
+	})
+	return updates, err
+}
+
+// SaveLightClientHeader stores the light client header of a block, keyed by the root of its beacon header. Headers
+// are stored in their Deneb form, which holds the light client headers of all forks from Capella on, so that the light
+// client objects of a block can still be served once the block itself is pruned.
+func (s *Store) SaveLightClientHeader(ctx context.Context, header *ethpbv2.LightClientHeaderDeneb) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientHeader")
+	defer span.End()
+	if header == nil || header.Beacon == nil {
+		return errors.New("nil light client header")
+	}
+	root, err := header.Beacon.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not get beacon header root")
+	}
+	enc, err := header.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal light client header")
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return tx.Bucket(lightClientHeadersBucket).Put(root[:], enc)
+	})
+}
+
+// LightClientHeader returns the light client header saved for a block root, or nil if there is none.
+func (s *Store) LightClientHeader(ctx context.Context, blockRoot [32]byte) (*ethpbv2.LightClientHeaderDeneb, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LightClientHeader")
+	defer span.End()
+	var header *ethpbv2.LightClientHeaderDeneb
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(lightClientHeadersBucket).Get(blockRoot[:])
+		if enc == nil {
+			return nil
+		}
+		header = &ethpbv2.LightClientHeaderDeneb{}
+		return header.UnmarshalSSZ(enc)
+	})
+	return header, err
+}
+
+// lightClientPeriodKey encodes a period big endian so that the bucket is iterated in period order.
+func lightClientPeriodKey(period uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, period)
+	return key
+}
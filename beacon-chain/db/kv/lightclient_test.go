@@ -0,0 +1,144 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func testLightClientUpdate(signatureSlot primitives.Slot) *ethpbv2.LightClientUpdate {
+	header := func(slot primitives.Slot) *ethpbv1.BeaconBlockHeader {
+		return &ethpbv1.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, fieldparams.RootLength),
+			StateRoot:  make([]byte, fieldparams.RootLength),
+			BodyRoot:   make([]byte, fieldparams.RootLength),
+		}
+	}
+	branch := func(depth int) [][]byte {
+		b := make([][]byte, depth)
+		for i := range b {
+			b[i] = bytesutil.PadTo([]byte{byte(i)}, fieldparams.RootLength)
+		}
+		return b
+	}
+	pubkeys := make([][]byte, fieldparams.SyncCommitteeLength)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpbv2.LightClientUpdate{
+		AttestedHeader: header(signatureSlot - 1),
+		NextSyncCommittee: &ethpbv2.SyncCommittee{
+			Pubkeys:         pubkeys,
+			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+		},
+		NextSyncCommitteeBranch: branch(fieldparams.NextSyncCommitteeBranchDepth),
+		FinalizedHeader:         header(0),
+		FinalityBranch:          branch(6),
+		SyncAggregate: &ethpbv1.SyncAggregate{
+			SyncCommitteeBits:      make([]byte, fieldparams.SyncAggregateSyncCommitteeBytesLength),
+			SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		SignatureSlot: signatureSlot,
+	}
+}
+
+func TestStore_LightClientUpdate(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	update, err := db.LightClientUpdate(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpbv2.LightClientUpdate)(nil), update)
+
+	want := testLightClientUpdate(100)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 3, want))
+	update, err = db.LightClientUpdate(ctx, 3)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want, update)
+
+	// A better update replaces the one saved for the period.
+	want = testLightClientUpdate(200)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 3, want))
+	update, err = db.LightClientUpdate(ctx, 3)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want, update)
+
+	require.ErrorContains(t, "nil light client update", db.SaveLightClientUpdate(ctx, 4, nil))
+}
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	for _, period := range []uint64{1, 2, 4, 300} {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, testLightClientUpdate(primitives.Slot(period+1))))
+	}
+
+	updates, err := db.LightClientUpdates(ctx, 2, 299)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(updates))
+	assert.Equal(t, primitives.Slot(3), updates[2].SignatureSlot)
+	assert.Equal(t, primitives.Slot(5), updates[4].SignatureSlot)
+
+	updates, err = db.LightClientUpdates(ctx, 5, 5)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(updates))
+
+	_, err = db.LightClientUpdates(ctx, 3, 2)
+	require.ErrorContains(t, "start period 3 is greater than end period 2", err)
+}
+
+func TestStore_LightClientHeader(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	want := &ethpbv2.LightClientHeaderDeneb{
+		Beacon: &ethpbv1.BeaconBlockHeader{
+			Slot:       5,
+			ParentRoot: make([]byte, fieldparams.RootLength),
+			StateRoot:  make([]byte, fieldparams.RootLength),
+			BodyRoot:   bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
+		},
+		Execution: &enginev1.ExecutionPayloadHeaderDeneb{
+			ParentHash:       make([]byte, fieldparams.RootLength),
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			BlockNumber:      7,
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        bytesutil.PadTo([]byte{2}, fieldparams.RootLength),
+			TransactionsRoot: make([]byte, fieldparams.RootLength),
+			WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+		},
+		ExecutionBranch: [][]byte{
+			make([]byte, fieldparams.RootLength),
+			make([]byte, fieldparams.RootLength),
+			make([]byte, fieldparams.RootLength),
+			make([]byte, fieldparams.RootLength),
+		},
+	}
+	root, err := want.Beacon.HashTreeRoot()
+	require.NoError(t, err)
+
+	header, err := db.LightClientHeader(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpbv2.LightClientHeaderDeneb)(nil), header)
+
+	require.NoError(t, db.SaveLightClientHeader(ctx, want))
+	header, err = db.LightClientHeader(ctx, root)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want, header)
+
+	require.ErrorContains(t, "nil light client header", db.SaveLightClientHeader(ctx, nil))
+}
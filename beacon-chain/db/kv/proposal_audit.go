@@ -0,0 +1,96 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveProposalAudit stores the encoded audit of the block proposed by the node at a slot, replacing any
+// previous audit of the slot.
+func (s *Store) SaveProposalAudit(ctx context.Context, slot primitives.Slot, audit []byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveProposalAudit")
+	defer span.End()
+	if len(audit) == 0 {
+		return errors.New("empty proposal audit")
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return tx.Bucket(proposalAuditsBucket).Put(bytesutil.SlotToBytesBigEndian(slot), audit)
+	})
+}
+
+// ProposalAudit returns the encoded audit of the block proposed by the node at a slot, or nil if there is none.
+func (s *Store) ProposalAudit(ctx context.Context, slot primitives.Slot) ([]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ProposalAudit")
+	defer span.End()
+	var audit []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(proposalAuditsBucket).Get(bytesutil.SlotToBytesBigEndian(slot))
+		if enc == nil {
+			return nil
+		}
+		audit = make([]byte, len(enc))
+		copy(audit, enc)
+		return nil
+	})
+	return audit, err
+}
+
+// DeleteProposalAuditsBefore deletes the audits of the blocks proposed before the slot.
+func (s *Store) DeleteProposalAuditsBefore(ctx context.Context, slot primitives.Slot) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteProposalAuditsBefore")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// Keys are collected first, as bolt cursors do not support deleting while iterating.
+		bkt := tx.Bucket(proposalAuditsBucket)
+		var keys [][]byte
+		c := bkt.Cursor()
+		for k, _ := c.First(); k != nil && bytesutil.BytesToSlotBigEndian(k) < slot; k, _ = c.Next() {
+			keys = append(keys, k)
+		}
+		for _, k := range keys {
+			if err := bkt.Delete(k); err != nil {
+				return errors.Wrapf(err, "could not delete proposal audit of slot %d", bytesutil.BytesToSlotBigEndian(k))
+			}
+		}
+		return nil
+	})
+}
+
+// SaveLastBuilderRevealMiss records the last slot at which a builder did not reveal the payload of a blinded
+// block proposed by the node.
+func (s *Store) SaveLastBuilderRevealMiss(ctx context.Context, slot primitives.Slot) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveLastBuilderRevealMiss")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Put(lastBuilderRevealMissKey, bytesutil.SlotToBytesBigEndian(slot))
+	})
+}
+
+// LastBuilderRevealMiss returns the last slot at which a builder did not reveal the payload of a blinded block
+// proposed by the node, and false if no miss was recorded.
+func (s *Store) LastBuilderRevealMiss(ctx context.Context) (primitives.Slot, bool, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LastBuilderRevealMiss")
+	defer span.End()
+	var slot primitives.Slot
+	var ok bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(lastBuilderRevealMissKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		slot, ok = bytesutil.BytesToSlotBigEndian(enc), true
+		return nil
+	})
+	return slot, ok, err
+}
@@ -0,0 +1,53 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_ProposalAudit(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	audit, err := db.ProposalAudit(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(audit))
+
+	for _, slot := range []primitives.Slot{1, 2, 3} {
+		require.NoError(t, db.SaveProposalAudit(ctx, slot, []byte{byte(slot)}))
+	}
+	audit, err = db.ProposalAudit(ctx, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{2}, audit)
+
+	require.NoError(t, db.DeleteProposalAuditsBefore(ctx, 3))
+	for _, slot := range []primitives.Slot{1, 2} {
+		audit, err = db.ProposalAudit(ctx, slot)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(audit), "audit of slot %d was not deleted", slot)
+	}
+	audit, err = db.ProposalAudit(ctx, 3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{3}, audit)
+
+	assert.ErrorContains(t, "empty proposal audit", db.SaveProposalAudit(ctx, 4, nil))
+}
+
+func TestStore_LastBuilderRevealMiss(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, ok, err := db.LastBuilderRevealMiss(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	require.NoError(t, db.SaveLastBuilderRevealMiss(ctx, 42))
+	slot, ok, err := db.LastBuilderRevealMiss(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, primitives.Slot(42), slot)
+}
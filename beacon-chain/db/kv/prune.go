@@ -0,0 +1,186 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// DeleteHistoricalDataBeforeSlot deletes up to batchSize finalized blocks below the slot, along with their
+// states, state summaries and indices. Pruning never goes past the finalized checkpoint, and stops at the
+// latest full state saved at or below the slot so that the history which is left can still be regenerated.
+// The genesis and checkpoint sync origin blocks are never deleted.
+// It returns the root of the lowest block kept in the history, which is the zero root when there is
+// nothing to prune, and the number of blocks deleted, which is below batchSize once the history before
+// the slot is gone.
+func (s *Store) DeleteHistoricalDataBeforeSlot(ctx context.Context, slot primitives.Slot, batchSize int) ([32]byte, int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteHistoricalDataBeforeSlot")
+	defer span.End()
+
+	if batchSize <= 0 {
+		return [32]byte{}, 0, errors.New("batch size must be positive")
+	}
+	var lowestRoot [32]byte
+	deleted := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		cutoff, root, err := historyPruningCutoff(ctx, tx, slot)
+		if err != nil || cutoff == 0 {
+			return err
+		}
+		lowestRoot = root
+
+		kept := map[[32]byte]bool{root: true}
+		blocksBkt := tx.Bucket(blocksBucket)
+		if r := blocksBkt.Get(genesisBlockRootKey); r != nil {
+			kept[bytesutil.ToBytes32(r)] = true
+		}
+		if r := blocksBkt.Get(originCheckpointBlockRootKey); r != nil {
+			kept[bytesutil.ToBytes32(r)] = true
+		}
+
+		// The slot index is rewritten once the cursor is done, as bolt cursors do not support writes
+		// to the bucket being iterated.
+		indexUpdates := make(map[primitives.Slot][]byte)
+		c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+		for k, v := c.First(); k != nil && deleted < batchSize; k, v = c.Next() {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			blockSlot := bytesutil.BytesToSlotBigEndian(k)
+			if blockSlot >= cutoff {
+				break
+			}
+			roots, err := splitRoots(v)
+			if err != nil {
+				return errors.Wrapf(err, "corrupt value in block slot index for slot=%d", blockSlot)
+			}
+			left := make([]byte, 0, len(v))
+			for _, r := range roots {
+				if kept[r] || deleted >= batchSize {
+					left = append(left, r[:]...)
+					continue
+				}
+				if err := s.deleteHistoricalBlock(ctx, tx, r); err != nil {
+					return errors.Wrapf(err, "could not delete block %#x", r)
+				}
+				deleted++
+			}
+			if len(left) != len(v) {
+				indexUpdates[blockSlot] = left
+			}
+		}
+
+		bkt := tx.Bucket(blockSlotIndicesBucket)
+		for blockSlot, left := range indexUpdates {
+			key := bytesutil.SlotToBytesBigEndian(blockSlot)
+			if len(left) == 0 {
+				if err := bkt.Delete(key); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := bkt.Put(key, left); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return [32]byte{}, 0, err
+	}
+	return lowestRoot, deleted, nil
+}
+
+// historyPruningCutoff returns the slot below which history can be pruned, along with the block root of
+// the full state saved at that slot. It is the slot of the latest full state at or below both the
+// requested slot and the finalized checkpoint, or 0 when history cannot be pruned.
+func historyPruningCutoff(ctx context.Context, tx *bolt.Tx, slot primitives.Slot) (primitives.Slot, [32]byte, error) {
+	enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+	if enc == nil {
+		return 0, [32]byte{}, nil
+	}
+	finalized := &ethpb.Checkpoint{}
+	if err := decode(ctx, enc, finalized); err != nil {
+		return 0, [32]byte{}, err
+	}
+	finalizedSlot, err := slots.EpochStart(finalized.Epoch)
+	if err != nil {
+		return 0, [32]byte{}, err
+	}
+	if slot > finalizedSlot {
+		slot = finalizedSlot
+	}
+
+	c := tx.Bucket(stateSlotIndicesBucket).Cursor()
+	k, v := c.Seek(bytesutil.SlotToBytesBigEndian(slot))
+	if k == nil {
+		k, v = c.Last()
+	} else if bytesutil.BytesToSlotBigEndian(k) > slot {
+		k, v = c.Prev()
+	}
+	for ; k != nil; k, v = c.Prev() {
+		roots, err := splitRoots(v)
+		if err != nil {
+			return 0, [32]byte{}, errors.Wrapf(err, "corrupt value in state slot index for slot=%d", bytesutil.BytesToSlotBigEndian(k))
+		}
+		// State diffs are indexed along with the full states, only the latter can be a cutoff.
+		stateBkt := tx.Bucket(stateBucket)
+		full := make([][32]byte, 0, len(roots))
+		for _, r := range roots {
+			if len(stateBkt.Get(r[:])) > 0 {
+				full = append(full, r)
+			}
+		}
+		if len(full) == 0 {
+			continue
+		}
+		// Prefer the canonical state when states of several forks were saved at the slot.
+		root := full[0]
+		finalizedBkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		for _, r := range full {
+			if finalizedBkt.Get(r[:]) != nil {
+				root = r
+				break
+			}
+		}
+		return bytesutil.BytesToSlotBigEndian(k), root, nil
+	}
+	return 0, [32]byte{}, nil
+}
+
+// deleteHistoricalBlock deletes a finalized block along with its state, state summary and indices,
+// except for the block slot index which is left to the caller.
+func (s *Store) deleteHistoricalBlock(ctx context.Context, tx *bolt.Tx, root [32]byte) error {
+	// The diffs taken against the state are below the pruning cutoff as well, since the cutoff is a full
+	// state and diffs are taken against the latest full state. They go with their base.
+	for _, r := range stateDiffsOf(tx, root) {
+		if err := deleteStateDiff(ctx, tx, r); err != nil {
+			return err
+		}
+	}
+	// The state goes first, as its slot is looked up from the summary or the block.
+	if err := s.deleteState(ctx, tx, root); err != nil {
+		return err
+	}
+	if err := tx.Bucket(stateSummaryBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	s.stateSummaryCache.delete(root)
+	if err := tx.Bucket(blocksBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	if err := tx.Bucket(blockParentRootIndicesBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	if err := tx.Bucket(finalizedBlockRootsIndexBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	s.blockCache.Del(string(root[:]))
+	return nil
+}
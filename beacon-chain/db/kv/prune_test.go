@@ -0,0 +1,101 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestStore_DeleteHistoricalDataBeforeSlot(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	wsb, err := consensusblocks.NewSignedBeaconBlock(genesis)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wsb))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	// blks[i] is at slot i+1.
+	blks := makeBlocks(t, 0, slotsPerEpoch*4, genesisRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i := range blks {
+		roots[i], err = blks[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	rootAtSlot := func(slot uint64) [32]byte {
+		return roots[slot-1]
+	}
+
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, genesisRoot))
+	for _, slot := range []uint64{slotsPerEpoch, 2 * slotsPerEpoch, 3 * slotsPerEpoch} {
+		require.NoError(t, st.SetSlot(primitives.Slot(slot)))
+		require.NoError(t, db.SaveState(ctx, st, rootAtSlot(slot)))
+	}
+	// A diff taken against a full state in the pruned range goes with its base.
+	base, err := db.State(ctx, rootAtSlot(slotsPerEpoch))
+	require.NoError(t, err)
+	diff := base.Copy()
+	require.NoError(t, diff.SetSlot(primitives.Slot(slotsPerEpoch+8)))
+	require.NoError(t, db.SaveStateDiff(ctx, diff, rootAtSlot(slotsPerEpoch+8), base, rootAtSlot(slotsPerEpoch)))
+	require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: 5, Root: roots[4][:]}))
+	finalizedRoot := rootAtSlot(3 * slotsPerEpoch)
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 3, Root: finalizedRoot[:]}))
+
+	// Nothing below the first full state after genesis can be pruned.
+	lowest, deleted, err := db.DeleteHistoricalDataBeforeSlot(ctx, primitives.Slot(slotsPerEpoch-1), 10)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, lowest)
+	assert.Equal(t, 0, deleted)
+
+	// Pruning stops at the latest full state below the slot, batch by batch.
+	total := 0
+	for {
+		lowest, deleted, err = db.DeleteHistoricalDataBeforeSlot(ctx, primitives.Slot(2*slotsPerEpoch+5), 10)
+		require.NoError(t, err)
+		assert.Equal(t, rootAtSlot(2*slotsPerEpoch), lowest)
+		total += deleted
+		if deleted < 10 {
+			break
+		}
+	}
+	assert.Equal(t, int(2*slotsPerEpoch-1), total)
+	assert.Equal(t, true, db.HasBlock(ctx, genesisRoot), "genesis block was pruned")
+	assert.Equal(t, true, db.HasState(ctx, genesisRoot), "genesis state was pruned")
+	for slot := uint64(1); slot < 2*slotsPerEpoch; slot++ {
+		assert.Equal(t, false, db.HasBlock(ctx, rootAtSlot(slot)), "block at slot %d was not pruned", slot)
+		assert.Equal(t, false, db.IsFinalizedBlock(ctx, rootAtSlot(slot)), "block at slot %d is still in the finalized index", slot)
+	}
+	assert.Equal(t, false, db.HasState(ctx, rootAtSlot(slotsPerEpoch)))
+	assert.Equal(t, false, db.HasState(ctx, rootAtSlot(slotsPerEpoch+8)))
+	assert.Equal(t, false, db.HasStateSummary(ctx, roots[4]))
+	assert.Equal(t, true, db.HasBlock(ctx, rootAtSlot(2*slotsPerEpoch)))
+	assert.Equal(t, true, db.HasState(ctx, rootAtSlot(2*slotsPerEpoch)))
+	_, blockRoots, err := db.BlockRootsBySlot(ctx, primitives.Slot(slotsPerEpoch))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(blockRoots))
+	_, blockRoots, err = db.BlockRootsBySlot(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(blockRoots))
+
+	// Pruning never goes past the finalized checkpoint.
+	lowest, deleted, err = db.DeleteHistoricalDataBeforeSlot(ctx, primitives.Slot(10*slotsPerEpoch), 100)
+	require.NoError(t, err)
+	assert.Equal(t, finalizedRoot, lowest)
+	assert.Equal(t, int(slotsPerEpoch), deleted)
+	assert.Equal(t, true, db.HasBlock(ctx, finalizedRoot))
+	assert.Equal(t, true, db.HasBlock(ctx, rootAtSlot(4*slotsPerEpoch)))
+}
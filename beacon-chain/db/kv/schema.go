@@ -7,15 +7,19 @@ package kv
 // it easy to scan for keys that have a certain shard number as a prefix and return those
 // corresponding attestations.
 var (
-	blocksBucket          = []byte("blocks")
-	stateBucket           = []byte("state")
-	stateSummaryBucket    = []byte("state-summary")
-	chainMetadataBucket   = []byte("chain-metadata")
-	checkpointBucket      = []byte("check-point")
-	powchainBucket        = []byte("powchain")
-	stateValidatorsBucket = []byte("state-validators")
-	feeRecipientBucket    = []byte("fee-recipient")
-	registrationBucket    = []byte("registration")
+	blocksBucket             = []byte("blocks")
+	stateBucket              = []byte("state")
+	stateSummaryBucket       = []byte("state-summary")
+	chainMetadataBucket      = []byte("chain-metadata")
+	checkpointBucket         = []byte("check-point")
+	powchainBucket           = []byte("powchain")
+	stateValidatorsBucket    = []byte("state-validators")
+	feeRecipientBucket       = []byte("fee-recipient")
+	registrationBucket       = []byte("registration")
+	stateDiffBucket          = []byte("state-diff")
+	lightClientUpdatesBucket = []byte("light-client-updates")
+	lightClientHeadersBucket = []byte("light-client-headers")
+	proposalAuditsBucket     = []byte("proposal-audits")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -28,6 +32,7 @@ var (
 	stateSlotIndicesBucket         = []byte("state-slot-indices")
 	finalizedBlockRootsIndexBucket = []byte("finalized-block-roots-index")
 	blockRootValidatorHashesBucket = []byte("block-root-validator-hashes")
+	stateDiffBaseIndicesBucket     = []byte("state-diff-base-indices")
 
 	// Specific item keys.
 	headBlockRootKey           = []byte("head-root")
@@ -37,6 +42,8 @@ var (
 	finalizedCheckpointKey     = []byte("finalized-checkpoint")
 	powchainDataKey            = []byte("powchain-data")
 	lastValidatedCheckpointKey = []byte("last-validated-checkpoint")
+	stateArchiveProgressKey    = []byte("state-archive-progress")
+	lastBuilderRevealMissKey   = []byte("last-builder-reveal-miss")
 
 	// Below keys are used to identify objects are to be fork compatible.
 	// Objects that are only compatible with specific forks should be prefixed with such keys.
@@ -55,6 +62,8 @@ var (
 	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
 	// tracking data about an ongoing backfill
 	backfillStatusKey = []byte("backfill-status")
+	// snapshot of the fork choice store used to restore it on restart
+	forkChoiceSnapshotKey = []byte("forkchoice-snapshot")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
@@ -35,7 +35,8 @@ func (s *Store) State(ctx context.Context, blockRoot [32]byte) (state.BeaconStat
 	}
 
 	if len(enc) == 0 {
-		return nil, nil
+		// States of the finalized history may be stored as a diff against an earlier full state.
+		return s.stateFromDiff(ctx, blockRoot)
 	}
 	// get the validator entries of the state
 	valEntries, valErr := s.validatorEntries(ctx, blockRoot)
@@ -400,7 +401,7 @@ func (s *Store) HasState(ctx context.Context, blockRoot [32]byte) bool {
 	err := s.db.View(func(tx *bolt.Tx) error {
 		bkt := tx.Bucket(stateBucket)
 		stBytes := bkt.Get(blockRoot[:])
-		if len(stBytes) > 0 {
+		if len(stBytes) > 0 || len(tx.Bucket(stateDiffBucket).Get(blockRoot[:])) > 0 {
 			hasState = true
 		}
 		return nil
@@ -437,60 +438,77 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 			return err
 		}
 
-		bkt = tx.Bucket(stateBucket)
 		// Safeguard against deleting genesis, finalized, head state.
 		if bytes.Equal(blockRoot[:], finalized.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) || bytes.Equal(blockRoot[:], justified.Root) {
 			return ErrDeleteJustifiedAndFinalized
 		}
+		return s.deleteState(ctx, tx, blockRoot)
+	})
+}
 
-		// Nothing to delete if state doesn't exist.
-		enc = bkt.Get(blockRoot[:])
-		if enc == nil {
-			return nil
-		}
+// deleteState deletes the state of the block root, full or diff, along with its indices.
+// A full state that state diffs are taken against is not deleted, see ErrDeleteDiffBase.
+func (s *Store) deleteState(ctx context.Context, tx *bolt.Tx, blockRoot [32]byte) error {
+	if err := deleteStateDiff(ctx, tx, blockRoot); err != nil {
+		return err
+	}
 
-		slot, err := s.slotByBlockRoot(ctx, tx, blockRoot[:])
-		if err != nil {
-			return err
-		}
-		indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
-		if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
-			return errors.Wrap(err, "could not delete root for DB indices")
-		}
+	bkt := tx.Bucket(stateBucket)
+	// Nothing to delete if state doesn't exist.
+	enc := bkt.Get(blockRoot[:])
+	if enc == nil {
+		return nil
+	}
+	if len(stateDiffsOf(tx, blockRoot)) > 0 {
+		return ErrDeleteDiffBase
+	}
+	s.diffBaseCache.Lock()
+	if s.diffBaseCache.root == blockRoot {
+		s.diffBaseCache.base = nil
+	}
+	s.diffBaseCache.Unlock()
 
-		ok, err := s.isStateValidatorMigrationOver()
+	slot, err := s.slotByBlockRoot(ctx, tx, blockRoot[:])
+	if err != nil {
+		return err
+	}
+	indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
+	if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+		return errors.Wrap(err, "could not delete root for DB indices")
+	}
+
+	ok, err := s.isStateValidatorMigrationOver()
+	if err != nil {
+		return err
+	}
+	if ok {
+		// remove the validator entry keys for the corresponding state.
+		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
+		compressedValidatorHashes := idxBkt.Get(blockRoot[:])
+		err = idxBkt.Delete(blockRoot[:])
 		if err != nil {
 			return err
 		}
-		if ok {
-			// remove the validator entry keys for the corresponding state.
-			idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
-			compressedValidatorHashes := idxBkt.Get(blockRoot[:])
-			err = idxBkt.Delete(blockRoot[:])
-			if err != nil {
-				return err
-			}
 
-			// remove the respective validator entries from the cache.
-			if len(compressedValidatorHashes) == 0 {
-				return errors.Errorf("invalid compressed validator keys length")
-			}
-			validatorHashes, sErr := snappy.Decode(nil, compressedValidatorHashes)
-			if sErr != nil {
-				return errors.Wrap(sErr, "failed to uncompress validator keys")
-			}
-			if len(validatorHashes)%hashLength != 0 {
-				return errors.Errorf("invalid validator keys length: %d", len(validatorHashes))
-			}
-			for i := 0; i < len(validatorHashes); i += hashLength {
-				key := validatorHashes[i : i+hashLength]
-				s.validatorEntryCache.Del(key)
-				validatorEntryCacheDelete.Inc()
-			}
+		// remove the respective validator entries from the cache.
+		if len(compressedValidatorHashes) == 0 {
+			return errors.Errorf("invalid compressed validator keys length")
 		}
+		validatorHashes, sErr := snappy.Decode(nil, compressedValidatorHashes)
+		if sErr != nil {
+			return errors.Wrap(sErr, "failed to uncompress validator keys")
+		}
+		if len(validatorHashes)%hashLength != 0 {
+			return errors.Errorf("invalid validator keys length: %d", len(validatorHashes))
+		}
+		for i := 0; i < len(validatorHashes); i += hashLength {
+			key := validatorHashes[i : i+hashLength]
+			s.validatorEntryCache.Del(key)
+			validatorEntryCacheDelete.Inc()
+		}
+	}
 
-		return bkt.Delete(blockRoot[:])
-	})
+	return bkt.Delete(blockRoot[:])
 }
 
 // DeleteStates by block roots.
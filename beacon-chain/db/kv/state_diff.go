@@ -0,0 +1,480 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// A state diff is stored under the block root of the state as the root of the full base state, the
+// big endian slot of the state and the snappy compressed diff. The diff starts with a length prefixed
+// protobuf message of the state's type holding every field that was replaced, followed by one record
+// per field that was cleared or whose list elements changed. The diffs taken against a base are indexed
+// under the base root followed by the block root of the diff, so that the base is never deleted under
+// them. Diffs are indexed by slot along with the full states.
+const (
+	diffFieldClear byte = iota
+	diffFieldUint64List
+	diffFieldElementList
+)
+
+// stateDiffHeaderLength is the length of the base root and slot preceding a compressed state diff.
+const stateDiffHeaderLength = 32 + 8
+
+var (
+	errStateDiffVersion = errors.New("cannot diff states of different versions")
+	errStateDiffCorrupt = errors.New("state diff is corrupt")
+)
+
+// diffBaseCache keeps the most recently used base state, since consecutive historical state queries
+// almost always share the same base. The base is kept as a protobuf message that is never modified, so
+// that states are rebuilt from it without copying every field, see shallowCopyState.
+type diffBaseCache struct {
+	sync.Mutex
+	root [32]byte
+	base protoreflect.Message
+}
+
+// SaveStateDiff stores a state by its block root as a diff against base, which must already be saved
+// as a full state under baseRoot. State reconstructs the state transparently from the base and the diff.
+func (s *Store) SaveStateDiff(ctx context.Context, st state.ReadOnlyBeaconState, blockRoot [32]byte, base state.ReadOnlyBeaconState, baseRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+	if st == nil || st.IsNil() || base == nil || base.IsNil() {
+		return errors.New("nil state")
+	}
+	if st.Version() != base.Version() {
+		return errStateDiffVersion
+	}
+	diff, err := diffStates(base, st)
+	if err != nil {
+		return err
+	}
+	enc := make([]byte, 0, stateDiffHeaderLength+snappy.MaxEncodedLen(len(diff)))
+	enc = append(enc, baseRoot[:]...)
+	enc = append(enc, bytesutil.SlotToBytesBigEndian(st.Slot())...)
+	enc = append(enc, snappy.Encode(nil, diff)...)
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if len(tx.Bucket(stateBucket).Get(baseRoot[:])) == 0 {
+			return errors.Wrapf(ErrNotFoundState, "no full state saved for diff base %#x", baseRoot)
+		}
+		// Replace the diff and its index entries if one was already saved for the block root.
+		if err := deleteStateDiff(ctx, tx, blockRoot); err != nil {
+			return err
+		}
+		if err := tx.Bucket(stateDiffBaseIndicesBucket).Put(append(baseRoot[:], blockRoot[:]...), []byte{}); err != nil {
+			return err
+		}
+		indicesByBucket := createStateIndicesFromStateSlot(ctx, st.Slot())
+		if err := updateValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+			return errors.Wrap(err, "could not update DB indices")
+		}
+		return tx.Bucket(stateDiffBucket).Put(blockRoot[:], enc)
+	})
+}
+
+// deleteStateDiff deletes the diff saved for the block root, if any, along with its index entries.
+// The slot index entry is left to the full state of the block root if there is one.
+func deleteStateDiff(ctx context.Context, tx *bolt.Tx, blockRoot [32]byte) error {
+	bkt := tx.Bucket(stateDiffBucket)
+	enc := bkt.Get(blockRoot[:])
+	if len(enc) == 0 {
+		return nil
+	}
+	if len(enc) < stateDiffHeaderLength {
+		return errStateDiffCorrupt
+	}
+	key := append(bytes.Clone(enc[:len(blockRoot)]), blockRoot[:]...)
+	if err := tx.Bucket(stateDiffBaseIndicesBucket).Delete(key); err != nil {
+		return err
+	}
+	if len(tx.Bucket(stateBucket).Get(blockRoot[:])) == 0 {
+		slot := bytesutil.BytesToSlotBigEndian(enc[len(blockRoot):stateDiffHeaderLength])
+		indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
+		if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+			return errors.Wrap(err, "could not delete root for DB indices")
+		}
+	}
+	return bkt.Delete(blockRoot[:])
+}
+
+// stateDiffsOf returns the block roots of the diffs taken against the full state of the base root.
+func stateDiffsOf(tx *bolt.Tx, baseRoot [32]byte) [][32]byte {
+	var roots [][32]byte
+	c := tx.Bucket(stateDiffBaseIndicesBucket).Cursor()
+	for k, _ := c.Seek(baseRoot[:]); k != nil && bytes.HasPrefix(k, baseRoot[:]); k, _ = c.Next() {
+		roots = append(roots, bytesutil.ToBytes32(k[len(baseRoot):]))
+	}
+	return roots
+}
+
+// StateArchiveProgress returns the block root of the last snapshot reached while migrating the
+// finalized history of the database to the state archive.
+func (s *Store) StateArchiveProgress(ctx context.Context) ([32]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.StateArchiveProgress")
+	defer span.End()
+	var root [32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(stateArchiveProgressKey)
+		if len(enc) == 0 {
+			return errors.Wrap(ErrNotFound, "state archive progress not found")
+		}
+		root = bytesutil.ToBytes32(enc)
+		return nil
+	})
+	return root, err
+}
+
+// SaveStateArchiveProgress records the block root of the last snapshot reached while migrating the
+// finalized history of the database to the state archive.
+func (s *Store) SaveStateArchiveProgress(ctx context.Context, root [32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveStateArchiveProgress")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Put(stateArchiveProgressKey, root[:])
+	})
+}
+
+// stateFromDiff reconstructs a state saved with SaveStateDiff. It returns a nil state if there is
+// no diff for the block root.
+func (s *Store) stateFromDiff(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.stateFromDiff")
+	defer span.End()
+	var enc []byte
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		enc = bytes.Clone(tx.Bucket(stateDiffBucket).Get(blockRoot[:]))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(enc) == 0 {
+		return nil, nil
+	}
+	if len(enc) < stateDiffHeaderLength {
+		return nil, errStateDiffCorrupt
+	}
+	base, err := s.diffBase(ctx, bytesutil.ToBytes32(enc[:len(blockRoot)]))
+	if err != nil {
+		return nil, err
+	}
+	diff, err := snappy.Decode(nil, enc[stateDiffHeaderLength:])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress state diff")
+	}
+	dst := shallowCopyState(base)
+	if err := applyStateDiff(dst, diff); err != nil {
+		return nil, errors.Wrapf(err, "could not apply state diff for block root %#x", blockRoot)
+	}
+	return initializeStateFromProto(dst.Interface())
+}
+
+// diffBase returns the full state saved under the root as a protobuf message, which must not be modified.
+func (s *Store) diffBase(ctx context.Context, root [32]byte) (protoreflect.Message, error) {
+	s.diffBaseCache.Lock()
+	defer s.diffBaseCache.Unlock()
+	if s.diffBaseCache.base != nil && s.diffBaseCache.root == root {
+		return s.diffBaseCache.base, nil
+	}
+	enc, err := s.stateBytes(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if len(enc) == 0 {
+		return nil, errors.Wrapf(ErrNotFoundState, "no full state saved for diff base %#x", root)
+	}
+	valEntries, err := s.validatorEntries(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.unmarshalState(ctx, enc, valEntries)
+	if err != nil {
+		return nil, err
+	}
+	pb, ok := st.ToProtoUnsafe().(proto.Message)
+	if !ok {
+		return nil, errors.New("non valid inner state")
+	}
+	s.diffBaseCache.root = root
+	s.diffBaseCache.base = pb.ProtoReflect()
+	return s.diffBaseCache.base, nil
+}
+
+// shallowCopyState copies the top level fields of a state message. The lists of the copy are new, while
+// their elements and the other fields are shared with the original, as beacon states replace these
+// rather than modify them.
+func shallowCopyState(src protoreflect.Message) protoreflect.Message {
+	dst := src.New()
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !fd.IsList() {
+			dst.Set(fd, v)
+			return true
+		}
+		from, to := v.List(), dst.Mutable(fd).List()
+		for i := 0; i < from.Len(); i++ {
+			to.Append(from.Get(i))
+		}
+		return true
+	})
+	return dst
+}
+
+// diffStates encodes the changes needed to turn base into st. Lists of uint64 values, such as the
+// balances, are encoded as the delta of every element. Lists of bytes or messages, such as the
+// validators and the block roots, are encoded as the elements that changed. Every other field is
+// replaced as a whole when it changed.
+func diffStates(base, st state.ReadOnlyBeaconState) ([]byte, error) {
+	from, ok := base.ToProtoUnsafe().(proto.Message)
+	if !ok {
+		return nil, errors.New("non valid inner state")
+	}
+	to, ok := st.ToProtoUnsafe().(proto.Message)
+	if !ok {
+		return nil, errors.New("non valid inner state")
+	}
+	fm, tm := from.ProtoReflect(), to.ProtoReflect()
+	if fm.Descriptor().FullName() != tm.Descriptor().FullName() {
+		return nil, errStateDiffVersion
+	}
+
+	partial := tm.New()
+	var records []byte
+	fields := tm.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !tm.Has(fd) {
+			if fm.Has(fd) {
+				records = protowire.AppendVarint(records, uint64(fd.Number()))
+				records = append(records, diffFieldClear)
+			}
+			continue
+		}
+		if fd.IsList() && fd.Kind() == protoreflect.Uint64Kind {
+			if enc, changed := diffUint64List(fm.Get(fd).List(), tm.Get(fd).List()); changed {
+				records = protowire.AppendVarint(records, uint64(fd.Number()))
+				records = append(records, diffFieldUint64List)
+				records = append(records, enc...)
+			}
+			continue
+		}
+		if fd.IsList() && (fd.Kind() == protoreflect.BytesKind || fd.Kind() == protoreflect.MessageKind) {
+			enc, changed, err := diffElementList(fd, fm.Get(fd).List(), tm.Get(fd).List())
+			if err != nil {
+				return nil, err
+			}
+			if changed {
+				records = protowire.AppendVarint(records, uint64(fd.Number()))
+				records = append(records, diffFieldElementList)
+				records = append(records, enc...)
+			}
+			continue
+		}
+		if !fm.Has(fd) || !fm.Get(fd).Equal(tm.Get(fd)) {
+			partial.Set(fd, tm.Get(fd))
+		}
+	}
+
+	enc, err := proto.MarshalOptions{Deterministic: true}.Marshal(partial.Interface())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal replaced state fields")
+	}
+	return append(protowire.AppendBytes(nil, enc), records...), nil
+}
+
+func diffUint64List(from, to protoreflect.List) ([]byte, bool) {
+	changed := from.Len() != to.Len()
+	enc := protowire.AppendVarint(nil, uint64(to.Len()))
+	for i := 0; i < to.Len(); i++ {
+		var prev uint64
+		if i < from.Len() {
+			prev = from.Get(i).Uint()
+		}
+		delta := to.Get(i).Uint() - prev
+		changed = changed || delta != 0
+		enc = protowire.AppendVarint(enc, protowire.EncodeZigZag(int64(delta)))
+	}
+	return enc, changed
+}
+
+func diffElementList(fd protoreflect.FieldDescriptor, from, to protoreflect.List) ([]byte, bool, error) {
+	var elems []byte
+	count := 0
+	for i := 0; i < to.Len(); i++ {
+		v := to.Get(i)
+		if i < from.Len() && from.Get(i).Equal(v) {
+			continue
+		}
+		var enc []byte
+		if fd.Kind() == protoreflect.BytesKind {
+			enc = v.Bytes()
+		} else {
+			var err error
+			enc, err = proto.MarshalOptions{Deterministic: true}.Marshal(v.Message().Interface())
+			if err != nil {
+				return nil, false, errors.Wrapf(err, "could not marshal element %d of %s", i, fd.Name())
+			}
+		}
+		elems = protowire.AppendVarint(elems, uint64(i))
+		elems = protowire.AppendBytes(elems, enc)
+		count++
+	}
+	if count == 0 && from.Len() == to.Len() {
+		return nil, false, nil
+	}
+	enc := protowire.AppendVarint(nil, uint64(to.Len()))
+	enc = protowire.AppendVarint(enc, uint64(count))
+	return append(enc, elems...), true, nil
+}
+
+// applyStateDiff applies a diff produced by diffStates to a copy of the base state.
+func applyStateDiff(dst protoreflect.Message, diff []byte) error {
+	enc, n := protowire.ConsumeBytes(diff)
+	if n < 0 {
+		return errStateDiffCorrupt
+	}
+	partial := dst.New()
+	if err := proto.Unmarshal(enc, partial.Interface()); err != nil {
+		return errors.Wrap(err, "could not unmarshal replaced state fields")
+	}
+	partial.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		dst.Set(fd, v)
+		return true
+	})
+
+	fields := dst.Descriptor().Fields()
+	rest := diff[n:]
+	for len(rest) > 0 {
+		num, n := protowire.ConsumeVarint(rest)
+		if n < 0 || len(rest) == n {
+			return errStateDiffCorrupt
+		}
+		kind := rest[n]
+		rest = rest[n+1:]
+		fd := fields.ByNumber(protowire.Number(num))
+		if fd == nil {
+			return errors.Wrapf(errStateDiffCorrupt, "unknown field %d", num)
+		}
+		var err error
+		switch kind {
+		case diffFieldClear:
+			dst.Clear(fd)
+		case diffFieldUint64List:
+			rest, err = applyUint64List(dst.Mutable(fd).List(), rest)
+		case diffFieldElementList:
+			rest, err = applyElementList(fd, dst.Mutable(fd).List(), rest)
+		default:
+			err = errors.Wrapf(errStateDiffCorrupt, "unknown record kind %d", kind)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "field %s", fd.Name())
+		}
+	}
+	return nil
+}
+
+func applyUint64List(lst protoreflect.List, rest []byte) ([]byte, error) {
+	length, n := protowire.ConsumeVarint(rest)
+	if n < 0 {
+		return nil, errStateDiffCorrupt
+	}
+	rest = rest[n:]
+	for i := 0; i < int(length); i++ {
+		z, n := protowire.ConsumeVarint(rest)
+		if n < 0 {
+			return nil, errStateDiffCorrupt
+		}
+		rest = rest[n:]
+		delta := uint64(protowire.DecodeZigZag(z))
+		if i < lst.Len() {
+			lst.Set(i, protoreflect.ValueOfUint64(lst.Get(i).Uint()+delta))
+		} else {
+			lst.Append(protoreflect.ValueOfUint64(delta))
+		}
+	}
+	if lst.Len() > int(length) {
+		lst.Truncate(int(length))
+	}
+	return rest, nil
+}
+
+func applyElementList(fd protoreflect.FieldDescriptor, lst protoreflect.List, rest []byte) ([]byte, error) {
+	length, n := protowire.ConsumeVarint(rest)
+	if n < 0 {
+		return nil, errStateDiffCorrupt
+	}
+	rest = rest[n:]
+	count, n := protowire.ConsumeVarint(rest)
+	if n < 0 {
+		return nil, errStateDiffCorrupt
+	}
+	rest = rest[n:]
+	if lst.Len() > int(length) {
+		lst.Truncate(int(length))
+	}
+	for j := uint64(0); j < count; j++ {
+		idx, n := protowire.ConsumeVarint(rest)
+		if n < 0 {
+			return nil, errStateDiffCorrupt
+		}
+		rest = rest[n:]
+		enc, n := protowire.ConsumeBytes(rest)
+		if n < 0 {
+			return nil, errStateDiffCorrupt
+		}
+		rest = rest[n:]
+		var v protoreflect.Value
+		if fd.Kind() == protoreflect.BytesKind {
+			v = protoreflect.ValueOfBytes(bytes.Clone(enc))
+		} else {
+			v = lst.NewElement()
+			if err := proto.Unmarshal(enc, v.Message().Interface()); err != nil {
+				return nil, errors.Wrapf(err, "could not unmarshal element %d", idx)
+			}
+		}
+		switch {
+		case idx < uint64(lst.Len()):
+			lst.Set(int(idx), v)
+		case idx == uint64(lst.Len()):
+			lst.Append(v)
+		default:
+			return nil, errors.Wrapf(errStateDiffCorrupt, "element %d is out of order", idx)
+		}
+	}
+	if lst.Len() != int(length) {
+		return nil, errors.Wrapf(errStateDiffCorrupt, "expected %d elements, got %d", length, lst.Len())
+	}
+	return rest, nil
+}
+
+func initializeStateFromProto(pb proto.Message) (state.BeaconState, error) {
+	switch pbState := pb.(type) {
+	case *ethpb.BeaconState:
+		return statenative.InitializeFromProtoUnsafePhase0(pbState)
+	case *ethpb.BeaconStateAltair:
+		return statenative.InitializeFromProtoUnsafeAltair(pbState)
+	case *ethpb.BeaconStateBellatrix:
+		return statenative.InitializeFromProtoUnsafeBellatrix(pbState)
+	case *ethpb.BeaconStateCapella:
+		return statenative.InitializeFromProtoUnsafeCapella(pbState)
+	case *ethpb.BeaconStateDeneb:
+		return statenative.InitializeFromProtoUnsafeDeneb(pbState)
+	case *ethpb.BeaconStateElectra:
+		return statenative.InitializeFromProtoUnsafeElectra(pbState)
+	default:
+		return nil, errors.Errorf("unsupported state type %T", pb)
+	}
+}
@@ -0,0 +1,197 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_SaveStateDiff(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name string
+		base func() state.BeaconState
+	}{
+		{
+			name: "phase0",
+			base: func() state.BeaconState {
+				st, _ := util.DeterministicGenesisState(t, 64)
+				return st
+			},
+		},
+		{
+			name: "deneb",
+			base: func() state.BeaconState {
+				st, _ := util.DeterministicGenesisStateDeneb(t, 64)
+				return st
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := setupDB(t)
+			base := tc.base()
+			baseRoot := bytesutil.ToBytes32([]byte("base"))
+			require.NoError(t, db.SaveState(ctx, base, baseRoot))
+
+			st := base.Copy()
+			require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*3))
+			require.NoError(t, st.UpdateBalancesAtIndex(3, 1))
+			require.NoError(t, st.UpdateBalancesAtIndex(7, 64_000_000_000))
+			require.NoError(t, st.UpdateRandaoMixesAtIndex(2, [32]byte{'r'}))
+			require.NoError(t, st.UpdateBlockRootAtIndex(5, [32]byte{'b'}))
+			v, err := st.ValidatorAtIndex(1)
+			require.NoError(t, err)
+			v.Slashed = true
+			v.ExitEpoch = 10
+			require.NoError(t, st.UpdateValidatorAtIndex(1, v))
+			require.NoError(t, st.AppendValidator(&ethpb.Validator{
+				PublicKey:             bytesutil.PadTo([]byte{'n'}, fieldparams.BLSPubkeyLength),
+				WithdrawalCredentials: make([]byte, 32),
+				EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+			}))
+			require.NoError(t, st.AppendBalance(params.BeaconConfig().MaxEffectiveBalance))
+			if st.Version() != version.Phase0 {
+				require.NoError(t, st.AppendInactivityScore(0))
+				require.NoError(t, st.AppendCurrentParticipationBits(0))
+				require.NoError(t, st.AppendPreviousParticipationBits(0))
+			}
+			require.NoError(t, st.AppendEth1DataVotes(&ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)}))
+			root := bytesutil.ToBytes32([]byte("diff"))
+			require.NoError(t, db.SaveStateDiff(ctx, st, root, base, baseRoot))
+			require.Equal(t, true, db.HasState(ctx, root))
+
+			got, err := db.State(ctx, root)
+			require.NoError(t, err)
+			want, err := st.HashTreeRoot(ctx)
+			require.NoError(t, err)
+			gotRoot, err := got.HashTreeRoot(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, want, gotRoot)
+
+			// Rebuilt states share the cached base, which must not be modified through them.
+			require.NoError(t, got.UpdateBalancesAtIndex(0, 5))
+			require.NoError(t, got.UpdateRandaoMixesAtIndex(0, [32]byte{'m'}))
+			v, err = got.ValidatorAtIndex(0)
+			require.NoError(t, err)
+			v.ExitEpoch = 5
+			require.NoError(t, got.UpdateValidatorAtIndex(0, v))
+			got, err = db.State(ctx, root)
+			require.NoError(t, err)
+			gotRoot, err = got.HashTreeRoot(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, want, gotRoot)
+
+			// A diff where a list is emptied and values are cleared must also round trip.
+			cleared := st.Copy()
+			require.NoError(t, cleared.SetEth1DataVotes(nil))
+			require.NoError(t, cleared.SetEth1DepositIndex(0))
+			clearedRoot := bytesutil.ToBytes32([]byte("cleared"))
+			// Diffs are always taken against a full state.
+			require.ErrorIs(t, db.SaveStateDiff(ctx, cleared, clearedRoot, st, root), ErrNotFoundState)
+			fullRoot := bytesutil.ToBytes32([]byte("full"))
+			require.NoError(t, db.SaveState(ctx, st, fullRoot))
+			require.NoError(t, db.SaveStateDiff(ctx, cleared, clearedRoot, st, fullRoot))
+			got, err = db.State(ctx, clearedRoot)
+			require.NoError(t, err)
+			want, err = cleared.HashTreeRoot(ctx)
+			require.NoError(t, err)
+			gotRoot, err = got.HashTreeRoot(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, want, gotRoot)
+
+			require.NoError(t, db.DeleteState(ctx, clearedRoot))
+			require.Equal(t, false, db.HasState(ctx, clearedRoot))
+		})
+	}
+}
+
+func TestStore_SaveStateDiff_Errors(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	base, _ := util.DeterministicGenesisState(t, 8)
+	altair, _ := util.DeterministicGenesisStateAltair(t, 8)
+	baseRoot := bytesutil.ToBytes32([]byte("base"))
+
+	require.ErrorIs(t, db.SaveStateDiff(ctx, altair, [32]byte{'a'}, base, baseRoot), errStateDiffVersion)
+	require.ErrorIs(t, db.SaveStateDiff(ctx, base.Copy(), [32]byte{'a'}, base, baseRoot), ErrNotFoundState)
+}
+
+func TestStore_DeleteState_DiffBase(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	base, _ := util.DeterministicGenesisState(t, 8)
+	baseRoot := bytesutil.ToBytes32([]byte("base"))
+	require.NoError(t, db.SaveState(ctx, base, baseRoot))
+	st := base.Copy()
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	root := bytesutil.ToBytes32([]byte("diff"))
+	require.NoError(t, db.SaveStateDiff(ctx, st, root, base, baseRoot))
+
+	// The base cannot be deleted while a diff is taken against it.
+	require.ErrorIs(t, db.DeleteState(ctx, baseRoot), ErrDeleteDiffBase)
+	require.Equal(t, true, db.HasState(ctx, baseRoot))
+	_, err := db.State(ctx, root)
+	require.NoError(t, err)
+
+	require.NoError(t, db.DeleteState(ctx, root))
+	require.NoError(t, db.DeleteState(ctx, baseRoot))
+	require.Equal(t, false, db.HasState(ctx, baseRoot))
+}
+
+func TestStore_SaveStateDiff_SlotIndex(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	base, _ := util.DeterministicGenesisState(t, 8)
+	baseRoot := bytesutil.ToBytes32([]byte("base"))
+	require.NoError(t, db.SaveState(ctx, base, baseRoot))
+	st := base.Copy()
+	slot := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, st.SetSlot(slot))
+	root := bytesutil.ToBytes32([]byte("diff"))
+	require.NoError(t, db.SaveStateDiff(ctx, st, root, base, baseRoot))
+	// Saving the diff again does not index it twice.
+	require.NoError(t, db.SaveStateDiff(ctx, st, root, base, baseRoot))
+	require.DeepEqual(t, root[:], stateSlotIndex(t, db, slot))
+
+	// The diff is found as the highest state below a later slot.
+	states, err := db.HighestSlotStatesBelow(ctx, slot+1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(states))
+	require.Equal(t, slot, states[0].Slot())
+
+	require.NoError(t, db.DeleteState(ctx, root))
+	require.Equal(t, 0, len(stateSlotIndex(t, db, slot)))
+}
+
+func stateSlotIndex(t *testing.T, db *Store, slot primitives.Slot) []byte {
+	var roots []byte
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		roots = bytes.Clone(tx.Bucket(stateSlotIndicesBucket).Get(bytesutil.SlotToBytesBigEndian(slot)))
+		return nil
+	}))
+	return roots
+}
+
+func TestStore_StateArchiveProgress(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	_, err := db.StateArchiveProgress(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.NoError(t, db.SaveStateArchiveProgress(ctx, [32]byte{'p'}))
+	root, err := db.StateArchiveProgress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{'p'}, root)
+}
@@ -0,0 +1,17 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	historyPrunedBlocks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacon_db_history_pruned_blocks_total",
+		Help: "Number of finalized blocks pruned from the beacon DB along with their states.",
+	})
+	historyPrunedBeforeSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_db_history_pruned_before_slot",
+		Help: "Slot below which the finalized history of the beacon DB was pruned.",
+	})
+)
@@ -0,0 +1,216 @@
+// Package pruner defines a service that prunes the finalized history of the beacon DB which is older
+// than a configurable retention window.
+package pruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultBatchSize is the number of blocks deleted by a single database transaction.
+	defaultBatchSize = 256
+	// batchPause is the time given to other database writers between two batches.
+	batchPause = 100 * time.Millisecond
+)
+
+// Database defines the beacon DB methods needed to prune its history.
+type Database interface {
+	DeleteHistoricalDataBeforeSlot(ctx context.Context, slot primitives.Slot, batchSize int) ([32]byte, int, error)
+	Block(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
+}
+
+// HistoryTracker keeps track of the lowest block of the chain history held by the node.
+type HistoryTracker interface {
+	MarkPruned(ctx context.Context, lowest blocks.ROBlock) error
+}
+
+// HeadFetcher gives access to the head state, used to compute the weak subjectivity period.
+type HeadFetcher interface {
+	HeadStateReadOnly(ctx context.Context) (state.ReadOnlyBeaconState, error)
+}
+
+// Config options for the pruner service.
+type Config struct {
+	Database            Database
+	Tracker             HistoryTracker
+	HeadFetcher         HeadFetcher
+	ClockWaiter         startup.ClockWaiter
+	InitialSyncComplete chan struct{}
+	// RetentionEpochs is the number of epochs of finalized history to keep.
+	RetentionEpochs primitives.Epoch
+}
+
+// Service prunes the finalized blocks and states of the beacon DB that fall out of the retention window,
+// once per epoch. The window never goes below the weak subjectivity period.
+type Service struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	cfg          *Config
+	batchSize    int
+	prunedBefore primitives.Slot
+}
+
+// New creates a pruner service with the given config.
+func New(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg.Database == nil || cfg.Tracker == nil || cfg.HeadFetcher == nil || cfg.ClockWaiter == nil {
+		return nil, errors.New("incomplete pruner configuration")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:       ctx,
+		cancel:    cancel,
+		cfg:       cfg,
+		batchSize: defaultBatchSize,
+	}, nil
+}
+
+// Start the pruner service in the background.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the pruner service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the pruner service.
+func (*Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	clock, err := s.cfg.ClockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not start the history pruner while waiting for genesis")
+		return
+	}
+	if s.cfg.InitialSyncComplete != nil {
+		select {
+		case <-s.cfg.InitialSyncComplete:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+	log.WithField("retentionEpochs", s.cfg.RetentionEpochs).Info("Pruning finalized history older than the retention window")
+
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	s.prune(clock.CurrentSlot())
+	for {
+		select {
+		case slot := <-ticker.C():
+			if !slots.IsEpochStart(slot) {
+				continue
+			}
+			s.prune(slot)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) prune(current primitives.Slot) {
+	st, err := s.cfg.HeadFetcher.HeadStateReadOnly(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get the head state to compute the weak subjectivity period")
+		return
+	}
+	window, err := RetentionWindow(s.ctx, st, s.cfg.RetentionEpochs)
+	if err != nil {
+		log.WithError(err).Error("Could not compute the history retention window")
+		return
+	}
+	epoch := slots.ToEpoch(current)
+	if epoch <= window {
+		return
+	}
+	before, err := slots.EpochStart(epoch - window)
+	if err != nil {
+		log.WithError(err).Error("Could not compute the history pruning slot")
+		return
+	}
+	if before <= s.prunedBefore {
+		return
+	}
+
+	start := time.Now()
+	deleted, err := PruneBefore(s.ctx, s.cfg.Database, s.cfg.Tracker, before, s.batchSize, batchPause)
+	if err != nil {
+		log.WithError(err).Error("Could not prune finalized history")
+		return
+	}
+	s.prunedBefore = before
+	historyPrunedBeforeSlot.Set(float64(before))
+	if deleted > 0 {
+		log.WithFields(logrus.Fields{
+			"beforeSlot":    before,
+			"prunedBlocks":  deleted,
+			"pruneDuration": time.Since(start),
+		}).Info("Pruned finalized history")
+	}
+}
+
+// RetentionWindow returns the number of epochs of history to keep, which is the requested number of
+// epochs unless it is below the weak subjectivity period of the state or MIN_EPOCHS_FOR_BLOCK_REQUESTS.
+func RetentionWindow(ctx context.Context, st state.ReadOnlyBeaconState, retention primitives.Epoch) (primitives.Epoch, error) {
+	window := max(retention, helpers.MinEpochsForBlockRequests())
+	if st == nil || st.IsNil() {
+		return window, nil
+	}
+	wsPeriod, err := helpers.ComputeWeakSubjectivityPeriod(ctx, st, params.BeaconConfig())
+	if err != nil {
+		return 0, errors.Wrap(err, "could not compute the weak subjectivity period")
+	}
+	return max(window, wsPeriod), nil
+}
+
+// PruneBefore deletes the finalized history below the slot in batches of batchSize blocks, waiting for pause
+// between two batches, then moves the low end of the history tracked by the tracker to the lowest block kept.
+// It returns the number of blocks deleted.
+func PruneBefore(ctx context.Context, db Database, tracker HistoryTracker, slot primitives.Slot, batchSize int, pause time.Duration) (int, error) {
+	var lowest [32]byte
+	total := 0
+	for {
+		root, deleted, err := db.DeleteHistoricalDataBeforeSlot(ctx, slot, batchSize)
+		if err != nil {
+			return total, err
+		}
+		lowest = root
+		total += deleted
+		historyPrunedBlocks.Add(float64(deleted))
+		if deleted < batchSize {
+			break
+		}
+		select {
+		case <-time.After(pause):
+		case <-ctx.Done():
+			return total, ctx.Err()
+		}
+	}
+	if lowest == [32]byte{} {
+		return total, nil
+	}
+	blk, err := db.Block(ctx, lowest)
+	if err != nil {
+		return total, errors.Wrapf(err, "could not get the lowest block %#x", lowest)
+	}
+	rob, err := blocks.NewROBlockWithRoot(blk, lowest)
+	if err != nil {
+		return total, err
+	}
+	return total, errors.Wrap(tracker.MarkPruned(ctx, rob), "could not update the lowest available block")
+}
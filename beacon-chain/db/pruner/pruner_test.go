@@ -0,0 +1,137 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockTracker struct {
+	lowest *blocks.ROBlock
+}
+
+func (m *mockTracker) MarkPruned(_ context.Context, lowest blocks.ROBlock) error {
+	m.lowest = &lowest
+	return nil
+}
+
+type mockHeadFetcher struct {
+	st state.ReadOnlyBeaconState
+}
+
+func (m *mockHeadFetcher) HeadStateReadOnly(context.Context) (state.ReadOnlyBeaconState, error) {
+	return m.st, nil
+}
+
+type mockDatabase struct {
+	prunedBefore []primitives.Slot
+}
+
+func (m *mockDatabase) DeleteHistoricalDataBeforeSlot(_ context.Context, slot primitives.Slot, _ int) ([32]byte, int, error) {
+	m.prunedBefore = append(m.prunedBefore, slot)
+	return [32]byte{}, 0, nil
+}
+
+func (*mockDatabase) Block(context.Context, [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	return nil, nil
+}
+
+func TestRetentionWindow(t *testing.T) {
+	ctx := context.Background()
+	minEpochs := helpers.MinEpochsForBlockRequests()
+
+	window, err := RetentionWindow(ctx, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, minEpochs, window, "window below MIN_EPOCHS_FOR_BLOCK_REQUESTS was not raised")
+
+	window, err = RetentionWindow(ctx, nil, minEpochs+100)
+	require.NoError(t, err)
+	assert.Equal(t, minEpochs+100, window)
+
+	st, _ := util.DeterministicGenesisState(t, 64)
+	wsPeriod, err := helpers.ComputeWeakSubjectivityPeriod(ctx, st, params.BeaconConfig())
+	require.NoError(t, err)
+	window, err = RetentionWindow(ctx, st, 10)
+	require.NoError(t, err)
+	assert.Equal(t, max(minEpochs, wsPeriod), window)
+}
+
+func TestPruneBefore(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.SetupDB(t)
+
+	genesis := util.NewBeaconBlock()
+	util.SaveBlock(t, ctx, db, genesis)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, genesisRoot))
+
+	parent := genesisRoot
+	roots := make([][32]byte, 21)
+	for slot := primitives.Slot(1); slot <= 20; slot++ {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = parent[:]
+		util.SaveBlock(t, ctx, db, b)
+		parent, err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[slot] = parent
+	}
+	require.NoError(t, st.SetSlot(10))
+	require.NoError(t, db.SaveState(ctx, st, roots[10]))
+	require.NoError(t, st.SetSlot(20))
+	require.NoError(t, db.SaveState(ctx, st, roots[20]))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: roots[20][:]}))
+
+	tracker := &mockTracker{}
+	deleted, err := PruneBefore(ctx, db, tracker, 15, 3, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 9, deleted)
+	require.NotNil(t, tracker.lowest)
+	assert.Equal(t, roots[10], tracker.lowest.Root())
+	assert.Equal(t, false, db.HasBlock(ctx, roots[9]))
+	assert.Equal(t, true, db.HasBlock(ctx, roots[10]))
+}
+
+func TestService_Prune(t *testing.T) {
+	ctx := context.Background()
+	db := &mockDatabase{}
+	minEpochs := helpers.MinEpochsForBlockRequests()
+	s, err := New(ctx, &Config{
+		Database:        db,
+		Tracker:         &mockTracker{},
+		HeadFetcher:     &mockHeadFetcher{},
+		ClockWaiter:     nil,
+		RetentionEpochs: minEpochs + 2,
+	})
+	require.ErrorContains(t, "incomplete pruner configuration", err)
+	require.Equal(t, (*Service)(nil), s)
+
+	s = &Service{ctx: ctx, cfg: &Config{Database: db, Tracker: &mockTracker{}, HeadFetcher: &mockHeadFetcher{}, RetentionEpochs: minEpochs + 2}, batchSize: 10}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	// Nothing to prune within the retention window.
+	s.prune(primitives.Slot(minEpochs+2) * slotsPerEpoch)
+	assert.Equal(t, 0, len(db.prunedBefore))
+
+	s.prune(primitives.Slot(minEpochs+5) * slotsPerEpoch)
+	require.Equal(t, 1, len(db.prunedBefore))
+	assert.Equal(t, 3*slotsPerEpoch, db.prunedBefore[0])
+
+	// The same window is not pruned twice.
+	s.prune(primitives.Slot(minEpochs+5)*slotsPerEpoch + 1)
+	assert.Equal(t, 1, len(db.prunedBefore))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/io/prompt"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/backup"
 	"github.com/urfave/cli/v2"
 )
 
@@ -44,7 +45,16 @@ func Restore(cliCtx *cli.Context) error {
 	if err := file.MkdirAll(restoreDir); err != nil {
 		return err
 	}
-	if err := file.CopyFile(sourceFile, path.Join(restoreDir, kv.DatabaseFileName)); err != nil {
+	isDir, err := file.HasDir(sourceFile)
+	if err != nil {
+		return errors.Wrapf(err, "could not check if %s is a directory", sourceFile)
+	}
+	if isDir {
+		// A backup directory written by the backup service, which may be incremental.
+		if err := backup.Restore(sourceFile, restoreFile); err != nil {
+			return errors.Wrap(err, "could not restore backup")
+		}
+	} else if err := file.CopyFile(sourceFile, restoreFile); err != nil {
 		return err
 	}
 
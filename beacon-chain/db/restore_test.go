@@ -6,11 +6,13 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
@@ -69,3 +71,64 @@ func TestRestore(t *testing.T) {
 	assert.LogsContain(t, logHook, "Restore completed successfully")
 
 }
+
+func TestRestore_IncrementalBackup(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := kv.NewKVStore(ctx, t.TempDir())
+	require.NoError(t, err)
+	outputDir := path.Join(t.TempDir(), "backups")
+	svc, err := backup.NewService(ctx, &backup.Config{
+		Exporter:  db,
+		Database:  "beacondb",
+		OutputDir: outputDir,
+		FullEvery: 2,
+	})
+	require.NoError(t, err)
+	saveHead := func(slot primitives.Slot) {
+		head := util.NewBeaconBlock()
+		head.Block.Slot = slot
+		wsb, err := blocks.NewSignedBeaconBlock(head)
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, wsb))
+		root, err := head.Block.HashTreeRoot()
+		require.NoError(t, err)
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveState(ctx, st, root))
+		require.NoError(t, db.SaveHeadBlockRoot(ctx, root))
+	}
+	saveHead(5000)
+	require.NoError(t, svc.Backup(ctx, "", false))
+	saveHead(5001)
+	// Backup directories are named after their creation time, in milliseconds.
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, svc.Backup(ctx, "", false))
+	require.NoError(t, db.Close())
+
+	backups, err := os.ReadDir(outputDir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(backups))
+	incremental := path.Join(outputDir, backups[1].Name())
+	m, err := backup.ReadManifest(incremental)
+	require.NoError(t, err)
+	require.Equal(t, backups[0].Name(), m.Base)
+
+	restoreDir := t.TempDir()
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.RestoreSourceFileFlag.Name, "", "")
+	set.String(cmd.RestoreTargetDirFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.RestoreSourceFileFlag.Name, incremental))
+	require.NoError(t, set.Set(cmd.RestoreTargetDirFlag.Name, restoreDir))
+	require.NoError(t, Restore(cli.NewContext(&app, set, nil)))
+
+	restoredDb, err := kv.NewKVStore(ctx, path.Join(restoreDir, kv.BeaconNodeDbDirName))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, restoredDb.Close())
+	}()
+	headBlock, err := restoredDb.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(5001), headBlock.Block().Slot(), "Restored database has incorrect data")
+}
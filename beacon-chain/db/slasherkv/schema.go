@@ -24,4 +24,12 @@ var (
 	// value: (encoded) SignedBlockHeaderWrapper
 	proposalRecordsBucket = []byte("proposal-records")
 	slasherChunksBucket   = []byte("slasher-chunks")
+
+	// key: (encoded) highest Target Epoch + AttesterSlashing root
+	// value: version + (compressed) AttesterSlashing
+	attesterSlashingsBucket = []byte("attester-slashings")
+
+	// key: (encoded) Slot + ProposerSlashing root
+	// value: (compressed) ProposerSlashing
+	proposerSlashingsBucket = []byte("proposer-slashings")
 )
@@ -0,0 +1,233 @@
+package slasherkv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/slice"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveAttesterSlashings persists attester slashings detected by the slasher so they can be
+// queried later. Slashings are keyed by the highest target epoch of their two attestations.
+func (s *Store) SaveAttesterSlashings(ctx context.Context, slashings []ethpb.AttSlashing) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveAttesterSlashings")
+	defer span.End()
+
+	if len(slashings) == 0 {
+		return nil
+	}
+
+	keys := make([][]byte, len(slashings))
+	values := make([][]byte, len(slashings))
+	for i, slashing := range slashings {
+		if slashing == nil ||
+			slashing.FirstAttestation().GetData().GetTarget() == nil ||
+			slashing.SecondAttestation().GetData().GetTarget() == nil {
+			return errors.New("nil attester slashing")
+		}
+		root, err := slashing.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not hash attester slashing")
+		}
+		enc, err := encodeAttesterSlashing(slashing)
+		if err != nil {
+			return err
+		}
+		keys[i] = append(bytesutil.Uint64ToBytesBigEndian(uint64(attesterSlashingEpoch(slashing))), root[:]...)
+		values[i] = enc
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(attesterSlashingsBucket)
+		for i := range keys {
+			if err := bkt.Put(keys[i], values[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SaveProposerSlashings persists proposer slashings detected by the slasher so they can be
+// queried later. Slashings are keyed by the slot of the proposals.
+func (s *Store) SaveProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveProposerSlashings")
+	defer span.End()
+
+	if len(slashings) == 0 {
+		return nil
+	}
+
+	keys := make([][]byte, len(slashings))
+	values := make([][]byte, len(slashings))
+	for i, slashing := range slashings {
+		if slashing.GetHeader_1().GetHeader() == nil {
+			return errors.New("nil proposer slashing")
+		}
+		root, err := slashing.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not hash proposer slashing")
+		}
+		enc, err := slashing.MarshalSSZ()
+		if err != nil {
+			return errors.Wrap(err, "could not marshal proposer slashing")
+		}
+		keys[i] = append(bytesutil.Uint64ToBytesBigEndian(uint64(slashing.Header_1.Header.Slot)), root[:]...)
+		values[i] = snappy.Encode(nil, enc)
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(proposerSlashingsBucket)
+		for i := range keys {
+			if err := bkt.Put(keys[i], values[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// AttesterSlashings retrieves the persisted attester slashings matching the given filter,
+// ordered by epoch.
+func (s *Store) AttesterSlashings(
+	ctx context.Context, filter *slashertypes.SlashingsFilter,
+) ([]ethpb.AttSlashing, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.AttesterSlashings")
+	defer span.End()
+
+	if filter == nil {
+		return nil, errors.New("nil filter")
+	}
+	indices := filterIndices(filter)
+	slashings := make([]ethpb.AttSlashing, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(attesterSlashingsBucket).Cursor()
+		start := bytesutil.Uint64ToBytesBigEndian(uint64(filter.StartEpoch))
+		for k, v := c.Seek(start); k != nil; k, v = c.Next() {
+			if primitives.Epoch(bytesutil.BytesToUint64BigEndian(k[:8])) > filter.EndEpoch {
+				break
+			}
+			slashing, err := decodeAttesterSlashing(v)
+			if err != nil {
+				return err
+			}
+			if len(indices) > 0 {
+				slashed := slice.IntersectionUint64(
+					slashing.FirstAttestation().GetAttestingIndices(),
+					slashing.SecondAttestation().GetAttestingIndices(),
+				)
+				if len(slice.IntersectionUint64(slashed, indices)) == 0 {
+					continue
+				}
+			}
+			slashings = append(slashings, slashing)
+		}
+		return nil
+	})
+	return slashings, err
+}
+
+// ProposerSlashings retrieves the persisted proposer slashings matching the given filter,
+// ordered by slot.
+func (s *Store) ProposerSlashings(
+	ctx context.Context, filter *slashertypes.SlashingsFilter,
+) ([]*ethpb.ProposerSlashing, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ProposerSlashings")
+	defer span.End()
+
+	if filter == nil {
+		return nil, errors.New("nil filter")
+	}
+	startSlot, err := slots.EpochStart(filter.StartEpoch)
+	if err != nil {
+		return nil, err
+	}
+	endSlot, err := slots.EpochEnd(filter.EndEpoch)
+	if err != nil {
+		// The end epoch is too large to be converted, which means there is no upper bound.
+		endSlot = primitives.Slot(^uint64(0))
+	}
+	indices := filterIndices(filter)
+	slashings := make([]*ethpb.ProposerSlashing, 0)
+	err = s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(proposerSlashingsBucket).Cursor()
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(uint64(startSlot))); k != nil; k, v = c.Next() {
+			if primitives.Slot(bytesutil.BytesToUint64BigEndian(k[:8])) > endSlot {
+				break
+			}
+			dec, err := snappy.Decode(nil, v)
+			if err != nil {
+				return err
+			}
+			slashing := &ethpb.ProposerSlashing{}
+			if err := slashing.UnmarshalSSZ(dec); err != nil {
+				return err
+			}
+			if len(indices) > 0 && len(slice.IntersectionUint64([]uint64{uint64(slashing.Header_1.Header.ProposerIndex)}, indices)) == 0 {
+				continue
+			}
+			slashings = append(slashings, slashing)
+		}
+		return nil
+	})
+	return slashings, err
+}
+
+func filterIndices(filter *slashertypes.SlashingsFilter) []uint64 {
+	indices := make([]uint64, len(filter.ValidatorIndices))
+	for i, idx := range filter.ValidatorIndices {
+		indices[i] = uint64(idx)
+	}
+	return indices
+}
+
+// attesterSlashingEpoch returns the highest target epoch of the two attestations of a slashing.
+func attesterSlashingEpoch(slashing ethpb.AttSlashing) primitives.Epoch {
+	return max(
+		slashing.FirstAttestation().GetData().Target.Epoch,
+		slashing.SecondAttestation().GetData().Target.Epoch,
+	)
+}
+
+// Encode an attester slashing as a version byte followed by its compressed SSZ encoding, since
+// the SSZ layout of attester slashings changed in Electra.
+func encodeAttesterSlashing(slashing ethpb.AttSlashing) ([]byte, error) {
+	enc, err := slashing.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attester slashing")
+	}
+	return append([]byte{byte(slashing.Version())}, snappy.Encode(nil, enc)...), nil
+}
+
+func decodeAttesterSlashing(encoded []byte) (ethpb.AttSlashing, error) {
+	if len(encoded) < 1 {
+		return nil, errors.New("empty attester slashing record")
+	}
+	dec, err := snappy.Decode(nil, encoded[1:])
+	if err != nil {
+		return nil, err
+	}
+	var slashing ethpb.AttSlashing
+	switch v := int(encoded[0]); {
+	case v >= version.Electra:
+		slashing = &ethpb.AttesterSlashingElectra{}
+	case v == version.Phase0:
+		slashing = &ethpb.AttesterSlashing{}
+	default:
+		return nil, fmt.Errorf("unexpected attester slashing version %s", version.String(v))
+	}
+	if err := slashing.UnmarshalSSZ(dec); err != nil {
+		return nil, err
+	}
+	return slashing, nil
+}
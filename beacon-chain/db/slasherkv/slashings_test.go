@@ -0,0 +1,147 @@
+package slasherkv
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_AttesterSlashings_SaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	phase0Slashing := func(target primitives.Epoch, indices []uint64) ethpb.AttSlashing {
+		return &ethpb.AttesterSlashing{
+			Attestation_1: createAttestationWrapper(target-1, target, indices, []byte{1}).IndexedAttestation.(*ethpb.IndexedAttestation),
+			Attestation_2: createAttestationWrapper(target-1, target, indices, []byte{2}).IndexedAttestation.(*ethpb.IndexedAttestation),
+		}
+	}
+	first := createAttestationWrapper(8, 9, []uint64{4, 5}, []byte{1}).IndexedAttestation.(*ethpb.IndexedAttestation)
+	second := createAttestationWrapper(7, 10, []uint64{5, 6}, []byte{2}).IndexedAttestation.(*ethpb.IndexedAttestation)
+	electraSlashing := &ethpb.AttesterSlashingElectra{
+		Attestation_1: &ethpb.IndexedAttestationElectra{
+			AttestingIndices: first.AttestingIndices,
+			Data:             first.Data,
+			Signature:        first.Signature,
+		},
+		Attestation_2: &ethpb.IndexedAttestationElectra{
+			AttestingIndices: second.AttestingIndices,
+			Data:             second.Data,
+			Signature:        second.Signature,
+		},
+	}
+
+	slashings := []ethpb.AttSlashing{
+		phase0Slashing(3, []uint64{1, 2}),
+		phase0Slashing(5, []uint64{2, 3}),
+		electraSlashing,
+	}
+	require.NoError(t, beaconDB.SaveAttesterSlashings(ctx, slashings))
+	// Saving the same slashings again must not create duplicates.
+	require.NoError(t, beaconDB.SaveAttesterSlashings(ctx, slashings))
+
+	tests := []struct {
+		name   string
+		filter *slashertypes.SlashingsFilter
+		want   []ethpb.AttSlashing
+	}{
+		{
+			name:   "all",
+			filter: &slashertypes.SlashingsFilter{EndEpoch: math.MaxUint64},
+			want:   slashings,
+		},
+		{
+			name:   "epoch range",
+			filter: &slashertypes.SlashingsFilter{StartEpoch: 4, EndEpoch: 5},
+			want:   slashings[1:2],
+		},
+		{
+			name:   "electra slashing indexed by highest target",
+			filter: &slashertypes.SlashingsFilter{StartEpoch: 10, EndEpoch: 10},
+			want:   slashings[2:],
+		},
+		{
+			name:   "validator index",
+			filter: &slashertypes.SlashingsFilter{EndEpoch: math.MaxUint64, ValidatorIndices: []primitives.ValidatorIndex{2}},
+			want:   slashings[:2],
+		},
+		{
+			name: "validator not slashed in both attestations",
+			filter: &slashertypes.SlashingsFilter{
+				EndEpoch:         math.MaxUint64,
+				ValidatorIndices: []primitives.ValidatorIndex{4, 6},
+			},
+			want: []ethpb.AttSlashing{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := beaconDB.AttesterSlashings(ctx, tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, len(tt.want), len(got))
+			for i := range tt.want {
+				require.Equal(t, tt.want[i].Version(), got[i].Version())
+				require.DeepSSZEqual(t, tt.want[i], got[i])
+			}
+		})
+	}
+}
+
+func TestStore_ProposerSlashings_SaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	slashing := func(slot primitives.Slot, proposer primitives.ValidatorIndex) *ethpb.ProposerSlashing {
+		return &ethpb.ProposerSlashing{
+			Header_1: createProposalWrapper(t, slot, proposer, []byte{1}).SignedBeaconBlockHeader,
+			Header_2: createProposalWrapper(t, slot, proposer, []byte{2}).SignedBeaconBlockHeader,
+		}
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	slashings := []*ethpb.ProposerSlashing{
+		slashing(1, 3),
+		slashing(slotsPerEpoch, 4),
+		slashing(2*slotsPerEpoch+1, 3),
+	}
+	require.NoError(t, beaconDB.SaveProposerSlashings(ctx, slashings))
+
+	tests := []struct {
+		name   string
+		filter *slashertypes.SlashingsFilter
+		want   []*ethpb.ProposerSlashing
+	}{
+		{
+			name:   "all",
+			filter: &slashertypes.SlashingsFilter{EndEpoch: math.MaxUint64},
+			want:   slashings,
+		},
+		{
+			name:   "epoch range",
+			filter: &slashertypes.SlashingsFilter{StartEpoch: 1, EndEpoch: 1},
+			want:   slashings[1:2],
+		},
+		{
+			name:   "validator index",
+			filter: &slashertypes.SlashingsFilter{EndEpoch: math.MaxUint64, ValidatorIndices: []primitives.ValidatorIndex{3}},
+			want:   []*ethpb.ProposerSlashing{slashings[0], slashings[2]},
+		},
+		{
+			name:   "no match",
+			filter: &slashertypes.SlashingsFilter{EndEpoch: math.MaxUint64, ValidatorIndices: []primitives.ValidatorIndex{5}},
+			want:   []*ethpb.ProposerSlashing{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := beaconDB.ProposerSlashings(ctx, tt.filter)
+			require.NoError(t, err)
+			require.DeepSSZEqual(t, tt.want, got)
+		})
+	}
+}
@@ -125,7 +125,8 @@ type EngineCaller interface {
 
 var ErrEmptyBlockHash = errors.New("Block hash is empty 0x0000...")
 
-// NewPayload calls the engine_newPayloadVX method via JSON-RPC.
+// NewPayload calls the engine_newPayloadVX method via JSON-RPC. The payload is sent to every execution endpoint,
+// and their verdicts are reconciled according to the failover policy.
 func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionData, versionedHashes []common.Hash, parentBlockRoot *common.Hash) ([]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.NewPayload")
 	defer span.End()
@@ -137,15 +138,43 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 	d := time.Now().Add(time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue) * time.Second)
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
-	result := &pb.PayloadStatus{}
 
+	verdict, _, err := s.callEngines(ctx, func(ctx context.Context, client RPCClient) (*pb.PayloadStatus, *pb.PayloadIDBytes, error) {
+		status, err := newPayload(ctx, client, payload, versionedHashes, parentBlockRoot)
+		return status, nil, err
+	})
+	if errors.Is(err, errNoMajority) {
+		// Without a majority, the payload is imported optimistically until more endpoints are synced.
+		log.WithError(err).Debug("Execution endpoints did not agree on the payload")
+		return nil, ErrAcceptedSyncingPayloadStatus
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := verdict.status
+	switch result.Status {
+	case pb.PayloadStatus_INVALID_BLOCK_HASH:
+		return nil, ErrInvalidBlockHashPayloadStatus
+	case pb.PayloadStatus_ACCEPTED, pb.PayloadStatus_SYNCING:
+		return nil, ErrAcceptedSyncingPayloadStatus
+	case pb.PayloadStatus_INVALID:
+		return result.LatestValidHash, ErrInvalidPayloadStatus
+	case pb.PayloadStatus_VALID:
+		return result.LatestValidHash, nil
+	default:
+		return nil, ErrUnknownPayloadStatus
+	}
+}
+
+func newPayload(ctx context.Context, client RPCClient, payload interfaces.ExecutionData, versionedHashes []common.Hash, parentBlockRoot *common.Hash) (*pb.PayloadStatus, error) {
+	result := &pb.PayloadStatus{}
 	switch payload.Proto().(type) {
 	case *pb.ExecutionPayload:
 		payloadPb, ok := payload.Proto().(*pb.ExecutionPayload)
 		if !ok {
 			return nil, errors.New("execution data must be a Bellatrix or Capella execution payload")
 		}
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethod, payloadPb)
+		err := client.CallContext(ctx, result, NewPayloadMethod, payloadPb)
 		if err != nil {
 			return nil, handleRPCError(err)
 		}
@@ -154,7 +183,7 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 		if !ok {
 			return nil, errors.New("execution data must be a Capella execution payload")
 		}
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV2, payloadPb)
+		err := client.CallContext(ctx, result, NewPayloadMethodV2, payloadPb)
 		if err != nil {
 			return nil, handleRPCError(err)
 		}
@@ -163,7 +192,7 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 		if !ok {
 			return nil, errors.New("execution data must be a Deneb execution payload")
 		}
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV3, payloadPb, versionedHashes, parentBlockRoot)
+		err := client.CallContext(ctx, result, NewPayloadMethodV3, payloadPb, versionedHashes, parentBlockRoot)
 		if err != nil {
 			return nil, handleRPCError(err)
 		}
@@ -172,7 +201,7 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 		if !ok {
 			return nil, errors.New("execution data must be a Deneb execution payload")
 		}
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV4, payloadPb, versionedHashes, parentBlockRoot)
+		err := client.CallContext(ctx, result, NewPayloadMethodV4, payloadPb, versionedHashes, parentBlockRoot)
 		if err != nil {
 			return nil, handleRPCError(err)
 		}
@@ -182,21 +211,12 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 	if result.ValidationError != "" {
 		log.WithError(errors.New(result.ValidationError)).Error("Got a validation error in newPayload")
 	}
-	switch result.Status {
-	case pb.PayloadStatus_INVALID_BLOCK_HASH:
-		return nil, ErrInvalidBlockHashPayloadStatus
-	case pb.PayloadStatus_ACCEPTED, pb.PayloadStatus_SYNCING:
-		return nil, ErrAcceptedSyncingPayloadStatus
-	case pb.PayloadStatus_INVALID:
-		return result.LatestValidHash, ErrInvalidPayloadStatus
-	case pb.PayloadStatus_VALID:
-		return result.LatestValidHash, nil
-	default:
-		return nil, ErrUnknownPayloadStatus
-	}
+	return result, nil
 }
 
-// ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC.
+// ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC. The update is sent to every execution
+// endpoint, and their verdicts are reconciled according to the failover policy. When a payload is requested, the
+// returned payload ID is the one of the healthiest endpoint building it.
 func (s *Service) ForkchoiceUpdated(
 	ctx context.Context, state *pb.ForkchoiceState, attrs payloadattribute.Attributer,
 ) (*pb.PayloadIDBytes, []byte, error) {
@@ -210,60 +230,80 @@ func (s *Service) ForkchoiceUpdated(
 	d := time.Now().Add(time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue) * time.Second)
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
-	result := &ForkchoiceUpdatedResponse{}
 
 	if attrs == nil {
 		return nil, nil, errors.New("nil payload attributer")
 	}
+	verdict, verdicts, err := s.callEngines(ctx, func(ctx context.Context, client RPCClient) (*pb.PayloadStatus, *pb.PayloadIDBytes, error) {
+		result, err := forkchoiceUpdated(ctx, client, state, attrs)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Status, result.PayloadId, nil
+	})
+	if errors.Is(err, errNoMajority) {
+		log.WithError(err).Debug("Execution endpoints did not agree on the forkchoice update")
+		return nil, nil, ErrAcceptedSyncingPayloadStatus
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	resp := verdict.status
+	switch resp.Status {
+	case pb.PayloadStatus_SYNCING:
+		return nil, nil, ErrAcceptedSyncingPayloadStatus
+	case pb.PayloadStatus_INVALID:
+		return nil, resp.LatestValidHash, ErrInvalidPayloadStatus
+	case pb.PayloadStatus_VALID:
+		return s.payloadIdOf(verdicts, verdict), resp.LatestValidHash, nil
+	default:
+		return nil, nil, ErrUnknownPayloadStatus
+	}
+}
+
+func forkchoiceUpdated(
+	ctx context.Context, client RPCClient, state *pb.ForkchoiceState, attrs payloadattribute.Attributer,
+) (*ForkchoiceUpdatedResponse, error) {
+	result := &ForkchoiceUpdatedResponse{}
 	switch attrs.Version() {
 	case version.Bellatrix:
 		a, err := attrs.PbV1()
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethod, state, a)
+		err = client.CallContext(ctx, result, ForkchoiceUpdatedMethod, state, a)
 		if err != nil {
-			return nil, nil, handleRPCError(err)
+			return nil, handleRPCError(err)
 		}
 	case version.Capella:
 		a, err := attrs.PbV2()
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethodV2, state, a)
+		err = client.CallContext(ctx, result, ForkchoiceUpdatedMethodV2, state, a)
 		if err != nil {
-			return nil, nil, handleRPCError(err)
+			return nil, handleRPCError(err)
 		}
 	case version.Deneb, version.Electra:
 		a, err := attrs.PbV3()
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethodV3, state, a)
+		err = client.CallContext(ctx, result, ForkchoiceUpdatedMethodV3, state, a)
 		if err != nil {
-			return nil, nil, handleRPCError(err)
+			return nil, handleRPCError(err)
 		}
 	default:
-		return nil, nil, fmt.Errorf("unknown payload attribute version: %v", attrs.Version())
+		return nil, fmt.Errorf("unknown payload attribute version: %v", attrs.Version())
 	}
 
 	if result.Status == nil {
-		return nil, nil, ErrNilResponse
+		return nil, ErrNilResponse
 	}
 	if result.ValidationError != "" {
 		log.WithError(errors.New(result.ValidationError)).Error("Got a validation error in forkChoiceUpdated")
 	}
-	resp := result.Status
-	switch resp.Status {
-	case pb.PayloadStatus_SYNCING:
-		return nil, nil, ErrAcceptedSyncingPayloadStatus
-	case pb.PayloadStatus_INVALID:
-		return nil, resp.LatestValidHash, ErrInvalidPayloadStatus
-	case pb.PayloadStatus_VALID:
-		return result.PayloadId, resp.LatestValidHash, nil
-	default:
-		return nil, nil, ErrUnknownPayloadStatus
-	}
+	return result, nil
 }
 
 func getPayloadMethodAndMessage(slot primitives.Slot) (string, proto.Message) {
@@ -281,7 +321,8 @@ func getPayloadMethodAndMessage(slot primitives.Slot) (string, proto.Message) {
 }
 
 // GetPayload calls the engine_getPayloadVX method via JSON-RPC.
-// It returns the execution data as well as the blobs bundle.
+// It returns the execution data as well as the blobs bundle. The payload is fetched from the healthiest
+// execution endpoint building it, then from the other ones if that fails.
 func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primitives.Slot) (*blocks.GetPayloadResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.GetPayload")
 	defer span.End()
@@ -293,8 +334,33 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primit
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
 
+	builders := s.payloadBuilds.get(payloadId)
+	if len(builders) == 0 {
+		return getPayload(ctx, s.rpcClient, payloadId, slot)
+	}
+	sortByHealth(builders)
+	var err error
+	for _, b := range builders {
+		client := s.clientOf(b.engine)
+		if client == nil {
+			continue
+		}
+		var res *blocks.GetPayloadResponse
+		res, err = getPayload(ctx, client, *b.payloadId, slot)
+		if err == nil {
+			return res, nil
+		}
+		log.WithError(err).WithField("endpoint", b.engine.url()).Warn("Could not get payload from execution endpoint")
+	}
+	if err == nil {
+		err = errEndpointNotConnected
+	}
+	return nil, err
+}
+
+func getPayload(ctx context.Context, client RPCClient, payloadId [8]byte, slot primitives.Slot) (*blocks.GetPayloadResponse, error) {
 	method, result := getPayloadMethodAndMessage(slot)
-	err := s.rpcClient.CallContext(ctx, result, method, pb.PayloadIDBytes(payloadId))
+	err := client.CallContext(ctx, result, method, pb.PayloadIDBytes(payloadId))
 	if err != nil {
 		return nil, handleRPCError(err)
 	}
@@ -0,0 +1,467 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/sirupsen/logrus"
+)
+
+// SyncingMethod request string for JSON-RPC, used to check the health of the execution endpoints.
+const SyncingMethod = "eth_syncing"
+
+// maxPayloadBuilds is the number of payload builds for which the payload IDs of every endpoint are kept.
+const maxPayloadBuilds = 16
+
+var (
+	errEndpointNotConnected = errors.New("execution endpoint is not connected")
+	// errNoMajority is returned when the majority policy cannot consider a payload valid or invalid, until more
+	// endpoints are synced.
+	errNoMajority = errors.New("no majority of execution endpoints agree on the payload")
+)
+
+// FailoverPolicy defines how the verdicts of several execution endpoints on a payload, or on a fork choice update,
+// are reconciled into a single one.
+type FailoverPolicy string
+
+const (
+	// PrimaryWithFallbackPolicy follows the verdict of the primary endpoint, or of the first fallback endpoint in
+	// order when the previous ones do not return a VALID or INVALID verdict.
+	PrimaryWithFallbackPolicy FailoverPolicy = "primary-with-fallback"
+	// FirstValidPolicy considers a payload valid as soon as one endpoint finds it valid.
+	FirstValidPolicy FailoverPolicy = "first-valid"
+	// MajorityPolicy requires more than half of the endpoints to agree on a VALID or INVALID verdict. The payload is
+	// otherwise considered as syncing, so that it is imported optimistically.
+	MajorityPolicy FailoverPolicy = "majority"
+)
+
+// ParseFailoverPolicy returns the failover policy with the given name.
+func ParseFailoverPolicy(policy string) (FailoverPolicy, error) {
+	switch p := FailoverPolicy(policy); p {
+	case PrimaryWithFallbackPolicy, FirstValidPolicy, MajorityPolicy:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown execution failover policy %q, expected one of %s, %s or %s",
+			policy, PrimaryWithFallbackPolicy, FirstValidPolicy, MajorityPolicy)
+	}
+}
+
+// engineEndpoint tracks the health of an execution endpoint, and the connection to it for fallback endpoints.
+// The connection to the primary endpoint is the rpc client of the service, which may be redialed.
+type engineEndpoint struct {
+	endpoint   network.Endpoint
+	primary    bool
+	lock       sync.RWMutex
+	client     RPCClient
+	health     types.EndpointHealth
+	lastErr    error
+	lastUpdate time.Time
+}
+
+func (e *engineEndpoint) url() string {
+	return logs.MaskCredentialsLogging(e.endpoint.Url)
+}
+
+func (e *engineEndpoint) currentHealth() types.EndpointHealth {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return e.health
+}
+
+func (e *engineEndpoint) update(health types.EndpointHealth, err error) {
+	e.lock.Lock()
+	e.health = health
+	e.lastErr = err
+	e.lastUpdate = prysmTime.Now()
+	e.lock.Unlock()
+	if e.endpoint.Url != "" {
+		engineEndpointHealth.WithLabelValues(e.url()).Set(float64(health))
+	}
+}
+
+// observe updates the health of the endpoint from its answer to an engine API call.
+func (e *engineEndpoint) observe(v *engineVerdict) {
+	switch {
+	case v.err != nil:
+		e.update(types.EndpointOffline, v.err)
+	case v.status.Status == pb.PayloadStatus_SYNCING || v.status.Status == pb.PayloadStatus_ACCEPTED:
+		e.update(types.EndpointSyncing, nil)
+	default:
+		e.update(types.EndpointSynced, nil)
+	}
+}
+
+// engines returns the primary execution endpoint, followed by the fallback endpoints.
+func (s *Service) engines() []*engineEndpoint {
+	primary := s.primaryEngine
+	if primary == nil {
+		primary = &engineEndpoint{primary: true}
+	}
+	return append([]*engineEndpoint{primary}, s.fallbackEngines...)
+}
+
+func (s *Service) clientOf(e *engineEndpoint) RPCClient {
+	if e.primary {
+		return s.rpcClient
+	}
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return e.client
+}
+
+// ExecutionEndpointStatuses returns the health of the primary and fallback execution endpoints.
+func (s *Service) ExecutionEndpointStatuses() []*types.EndpointStatus {
+	engines := s.engines()
+	statuses := make([]*types.EndpointStatus, len(engines))
+	for i, e := range engines {
+		e.lock.RLock()
+		statuses[i] = &types.EndpointStatus{
+			Endpoint:   e.url(),
+			Primary:    e.primary,
+			Health:     e.health,
+			LastError:  e.lastErr,
+			LastUpdate: e.lastUpdate,
+		}
+		e.lock.RUnlock()
+	}
+	return statuses
+}
+
+// connectFallbackEngines dials the fallback endpoints which are not connected yet.
+func (s *Service) connectFallbackEngines(ctx context.Context) {
+	for _, e := range s.fallbackEngines {
+		if s.clientOf(e) != nil {
+			continue
+		}
+		client, err := s.newRPCClientWithAuth(ctx, e.endpoint)
+		if err != nil {
+			e.update(types.EndpointOffline, errors.Wrap(err, "could not dial execution node"))
+			continue
+		}
+		if err := ensureCorrectExecutionChain(ctx, ethclient.NewClient(client)); err != nil {
+			client.Close()
+			e.update(types.EndpointOffline, err)
+			continue
+		}
+		e.lock.Lock()
+		e.client = client
+		e.lock.Unlock()
+		log.WithField("endpoint", e.url()).Info("Connected to fallback execution endpoint")
+	}
+}
+
+func (s *Service) closeFallbackEngines() {
+	for _, e := range s.fallbackEngines {
+		e.lock.Lock()
+		if e.client != nil {
+			e.client.Close()
+			e.client = nil
+		}
+		e.lock.Unlock()
+	}
+}
+
+// monitorEngineEndpoints checks the sync status of every execution endpoint once per slot, and redials the
+// fallback endpoints which could not be reached.
+func (s *Service) monitorEngineEndpoints() {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.connectFallbackEngines(s.ctx)
+			for _, e := range s.engines() {
+				client := s.clientOf(e)
+				if client == nil {
+					continue
+				}
+				health, err := checkEndpointHealth(s.ctx, client)
+				e.update(health, err)
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func checkEndpointHealth(ctx context.Context, client RPCClient) (types.EndpointHealth, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultEngineTimeout)
+	defer cancel()
+	var syncing interface{}
+	if err := client.CallContext(ctx, &syncing, SyncingMethod); err != nil {
+		return types.EndpointOffline, handleRPCError(err)
+	}
+	// eth_syncing returns false once the node is synced, and its sync progress otherwise.
+	if done, ok := syncing.(bool); ok && !done {
+		return types.EndpointSynced, nil
+	}
+	return types.EndpointSyncing, nil
+}
+
+// engineVerdict is the answer of an execution endpoint to an engine API call.
+type engineVerdict struct {
+	engine    *engineEndpoint
+	status    *pb.PayloadStatus
+	payloadId *pb.PayloadIDBytes
+	err       error
+}
+
+type engineCall func(ctx context.Context, client RPCClient) (*pb.PayloadStatus, *pb.PayloadIDBytes, error)
+
+// callEngines makes the engine API call on every execution endpoint concurrently, and returns the verdict picked by
+// the failover policy as soon as the policy can decide. The calls still running are left to finish, so that every
+// endpoint keeps receiving the payloads and fork choice updates, on a context detached from the one of the caller
+// with the same deadline. The verdicts received until then are returned in the order of the endpoints, the primary
+// first.
+func (s *Service) callEngines(ctx context.Context, call engineCall) (*engineVerdict, []*engineVerdict, error) {
+	engines := s.engines()
+	if len(engines) == 1 {
+		v := &engineVerdict{engine: engines[0], err: errEndpointNotConnected}
+		if client := s.clientOf(engines[0]); client != nil {
+			v.status, v.payloadId, v.err = call(ctx, client)
+		}
+		v.engine.observe(v)
+		return v, []*engineVerdict{v}, v.err
+	}
+
+	callCtx, cancel := detachedEngineContext(ctx)
+	var wg sync.WaitGroup
+	type result struct {
+		index   int
+		verdict *engineVerdict
+	}
+	results := make(chan result, len(engines))
+	verdicts := make([]*engineVerdict, len(engines))
+	for i, e := range engines {
+		client := s.clientOf(e)
+		if client == nil {
+			verdicts[i] = &engineVerdict{engine: e, err: errEndpointNotConnected}
+			e.observe(verdicts[i])
+			continue
+		}
+		wg.Add(1)
+		go func(i int, e *engineEndpoint) {
+			defer wg.Done()
+			v := &engineVerdict{engine: e}
+			v.status, v.payloadId, v.err = call(callCtx, client)
+			e.observe(v)
+			results <- result{index: i, verdict: v}
+		}(i, e)
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+	}()
+	for {
+		verdict, decided, err := decide(s.cfg.failoverPolicy, verdicts)
+		if decided {
+			received := make([]*engineVerdict, 0, len(verdicts))
+			for _, v := range verdicts {
+				if v != nil {
+					received = append(received, v)
+				}
+			}
+			return verdict, received, err
+		}
+		select {
+		case r := <-results:
+			verdicts[r.index] = r.verdict
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+}
+
+// detachedEngineContext returns a context which is not canceled with the one of the caller, but expires with it.
+// Engine API calls are otherwise bounded by the execution engine timeout.
+func detachedEngineContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	detached := context.WithoutCancel(ctx)
+	if d, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(detached, d)
+	}
+	return context.WithTimeout(detached, time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue)*time.Second)
+}
+
+func isValidVerdict(v *engineVerdict) bool {
+	return v.status.Status == pb.PayloadStatus_VALID
+}
+
+func isInvalidVerdict(v *engineVerdict) bool {
+	return v.status.Status == pb.PayloadStatus_INVALID || v.status.Status == pb.PayloadStatus_INVALID_BLOCK_HASH
+}
+
+func isDecisiveVerdict(v *engineVerdict) bool {
+	return isValidVerdict(v) || isInvalidVerdict(v)
+}
+
+func firstVerdict(verdicts []*engineVerdict, match func(*engineVerdict) bool) *engineVerdict {
+	for _, v := range verdicts {
+		if match(v) {
+			return v
+		}
+	}
+	return nil
+}
+
+// firstVerdictInOrder returns the first answered verdict matching, once the verdicts of all the endpoints before it
+// are received. Pending verdicts are nil.
+func firstVerdictInOrder(verdicts []*engineVerdict, match func(*engineVerdict) bool) *engineVerdict {
+	for _, v := range verdicts {
+		if v == nil {
+			return nil
+		}
+		if v.err == nil && match(v) {
+			return v
+		}
+	}
+	return nil
+}
+
+func countVerdicts(verdicts []*engineVerdict, match func(*engineVerdict) bool) int {
+	n := 0
+	for _, v := range verdicts {
+		if match(v) {
+			n++
+		}
+	}
+	return n
+}
+
+// decide picks the verdict answering the engine API call according to the failover policy, from the verdicts
+// received so far, the pending ones being nil. It returns false while the pick depends on pending verdicts. An
+// error is returned when no endpoint answered, or when the endpoints cannot reach the majority the policy requires.
+func decide(policy FailoverPolicy, verdicts []*engineVerdict) (*engineVerdict, bool, error) {
+	pending := 0
+	answered := make([]*engineVerdict, 0, len(verdicts))
+	for _, v := range verdicts {
+		switch {
+		case v == nil:
+			pending++
+		case v.err == nil:
+			answered = append(answered, v)
+		}
+	}
+	if pending == 0 && len(answered) == 0 {
+		return nil, true, verdicts[0].err
+	}
+
+	var picked *engineVerdict
+	switch policy {
+	case FirstValidPolicy:
+		picked = firstVerdictInOrder(verdicts, isValidVerdict)
+		if picked == nil && pending == 0 {
+			picked = firstVerdict(answered, isInvalidVerdict)
+		}
+	case MajorityPolicy:
+		valid, invalid := countVerdicts(answered, isValidVerdict), countVerdicts(answered, isInvalidVerdict)
+		switch {
+		case 2*valid > len(verdicts):
+			picked = firstVerdict(answered, isValidVerdict)
+		case 2*invalid > len(verdicts):
+			picked = firstVerdict(answered, isInvalidVerdict)
+		case 2*(valid+pending) <= len(verdicts) && 2*(invalid+pending) <= len(verdicts):
+			logDisagreement(policy, answered)
+			return nil, true, errors.Wrapf(errNoMajority, "%d valid and %d invalid verdicts out of %d endpoints",
+				valid, invalid, len(verdicts))
+		}
+	default:
+		picked = firstVerdictInOrder(verdicts, isDecisiveVerdict)
+	}
+	if picked == nil {
+		if pending > 0 {
+			return nil, false, nil
+		}
+		picked = answered[0]
+	}
+	logDisagreement(policy, answered)
+	return picked, true, nil
+}
+
+// logDisagreement reports the execution endpoints disagreeing on the validity of a payload.
+func logDisagreement(policy FailoverPolicy, answered []*engineVerdict) {
+	valid, invalid := countVerdicts(answered, isValidVerdict), countVerdicts(answered, isInvalidVerdict)
+	if valid == 0 || invalid == 0 {
+		return
+	}
+	engineVerdictDisagreements.Inc()
+	log.WithFields(logrus.Fields{
+		"policy":   policy,
+		"valid":    valid,
+		"invalid":  invalid,
+		"answered": len(answered),
+	}).Warn("Execution endpoints disagree on the validity of a payload")
+}
+
+// sortByHealth orders the verdicts from the healthiest endpoint to the least healthy one, keeping the order of the
+// endpoints otherwise.
+func sortByHealth(verdicts []*engineVerdict) {
+	sort.SliceStable(verdicts, func(i, j int) bool {
+		return verdicts[i].engine.currentHealth() > verdicts[j].engine.currentHealth()
+	})
+}
+
+// payloadBuilds keeps, for the payload IDs returned by ForkchoiceUpdated, the payload ID of every execution
+// endpoint building a payload with the same attributes. The payload can then be fetched from any of them.
+type payloadBuilds struct {
+	lock   sync.Mutex
+	builds map[pb.PayloadIDBytes][]*engineVerdict
+	ids    []pb.PayloadIDBytes
+}
+
+func newPayloadBuilds() *payloadBuilds {
+	return &payloadBuilds{builds: make(map[pb.PayloadIDBytes][]*engineVerdict)}
+}
+
+func (b *payloadBuilds) add(id pb.PayloadIDBytes, builders []*engineVerdict) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if _, ok := b.builds[id]; !ok {
+		b.ids = append(b.ids, id)
+	}
+	b.builds[id] = builders
+	if len(b.ids) > maxPayloadBuilds {
+		delete(b.builds, b.ids[0])
+		b.ids = b.ids[1:]
+	}
+}
+
+func (b *payloadBuilds) get(id pb.PayloadIDBytes) []*engineVerdict {
+	if b == nil {
+		return nil
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	builders := b.builds[id]
+	return append(make([]*engineVerdict, 0, len(builders)), builders...)
+}
+
+// payloadIdOf returns the payload ID of the healthiest endpoint which started building a payload, and remembers
+// the payload IDs of the other endpoints in case the payload cannot be fetched from it.
+func (s *Service) payloadIdOf(verdicts []*engineVerdict, chosen *engineVerdict) *pb.PayloadIDBytes {
+	if len(verdicts) == 1 {
+		return chosen.payloadId
+	}
+	builders := make([]*engineVerdict, 0, len(verdicts))
+	for _, v := range verdicts {
+		if v.err == nil && v.payloadId != nil && isValidVerdict(v) {
+			builders = append(builders, v)
+		}
+	}
+	if len(builders) == 0 {
+		return chosen.payloadId
+	}
+	sortByHealth(builders)
+	s.payloadBuilds.add(*builders[0].payloadId, builders)
+	return builders[0].payloadId
+}
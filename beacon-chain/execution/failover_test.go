@@ -0,0 +1,230 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	payloadattribute "github.com/prysmaticlabs/prysm/v5/consensus-types/payload-attribute"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeEngineClient answers every engine API call with the same payload status.
+type fakeEngineClient struct {
+	lock         sync.Mutex
+	status       *pb.PayloadStatus
+	payloadId    *pb.PayloadIDBytes
+	err          error
+	calls        []string
+	gotPayloadId pb.PayloadIDBytes
+}
+
+func (*fakeEngineClient) Close() {}
+
+// blockingEngineClient answers an engine API call once it is released.
+type blockingEngineClient struct {
+	fakeEngineClient
+	release  chan struct{}
+	answered chan struct{}
+}
+
+func (c *blockingEngineClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	defer close(c.answered)
+	select {
+	case <-c.release:
+		return c.fakeEngineClient.CallContext(ctx, result, method, args...)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (*fakeEngineClient) BatchCall([]gethRPC.BatchElem) error {
+	return nil
+}
+
+func (c *fakeEngineClient) CallContext(_ context.Context, result interface{}, method string, args ...interface{}) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.calls = append(c.calls, method)
+	if c.err != nil {
+		return c.err
+	}
+	switch r := result.(type) {
+	case *pb.PayloadStatus:
+		proto.Merge(r, c.status)
+	case *ForkchoiceUpdatedResponse:
+		r.Status = c.status
+		r.PayloadId = c.payloadId
+	case *pb.ExecutionPayload:
+		c.gotPayloadId = args[0].(pb.PayloadIDBytes)
+	}
+	return nil
+}
+
+func failoverService(policy FailoverPolicy, primary RPCClient, fallbacks ...RPCClient) *Service {
+	s := &Service{
+		cfg:           &config{failoverPolicy: policy},
+		rpcClient:     primary,
+		primaryEngine: &engineEndpoint{primary: true},
+		payloadBuilds: newPayloadBuilds(),
+	}
+	for _, f := range fallbacks {
+		s.fallbackEngines = append(s.fallbackEngines, &engineEndpoint{client: f})
+	}
+	return s
+}
+
+func verdict(status pb.PayloadStatus_Status) *engineVerdict {
+	return &engineVerdict{status: &pb.PayloadStatus{Status: status}}
+}
+
+func TestParseFailoverPolicy(t *testing.T) {
+	p, err := ParseFailoverPolicy("majority")
+	require.NoError(t, err)
+	assert.Equal(t, MajorityPolicy, p)
+	_, err = ParseFailoverPolicy("random")
+	require.ErrorContains(t, "unknown execution failover policy", err)
+}
+
+func TestDecide(t *testing.T) {
+	offline := &engineVerdict{err: errors.New("connection refused")}
+	valid, invalid, syncing := verdict(pb.PayloadStatus_VALID), verdict(pb.PayloadStatus_INVALID), verdict(pb.PayloadStatus_SYNCING)
+	tests := []struct {
+		name      string
+		policy    FailoverPolicy
+		verdicts  []*engineVerdict
+		want      pb.PayloadStatus_Status
+		wantErr   string
+		undecided bool
+	}{
+		{name: "primary is followed", policy: PrimaryWithFallbackPolicy, verdicts: []*engineVerdict{invalid, valid}, want: pb.PayloadStatus_INVALID},
+		{name: "fallback when primary is syncing", policy: PrimaryWithFallbackPolicy, verdicts: []*engineVerdict{syncing, valid}, want: pb.PayloadStatus_VALID},
+		{name: "fallback when primary is offline", policy: PrimaryWithFallbackPolicy, verdicts: []*engineVerdict{offline, invalid}, want: pb.PayloadStatus_INVALID},
+		{name: "syncing when no endpoint is synced", policy: PrimaryWithFallbackPolicy, verdicts: []*engineVerdict{offline, syncing}, want: pb.PayloadStatus_SYNCING},
+		{name: "error when no endpoint answers", policy: PrimaryWithFallbackPolicy, verdicts: []*engineVerdict{offline, offline}, wantErr: "connection refused"},
+		{name: "primary decides without fallbacks", policy: PrimaryWithFallbackPolicy, verdicts: []*engineVerdict{valid, nil}, want: pb.PayloadStatus_VALID},
+		{name: "fallback waits for primary", policy: PrimaryWithFallbackPolicy, verdicts: []*engineVerdict{nil, valid}, undecided: true},
+		{name: "first valid wins", policy: FirstValidPolicy, verdicts: []*engineVerdict{invalid, syncing, valid}, want: pb.PayloadStatus_VALID},
+		{name: "first valid falls back to invalid", policy: FirstValidPolicy, verdicts: []*engineVerdict{syncing, invalid}, want: pb.PayloadStatus_INVALID},
+		{name: "first valid decides early", policy: FirstValidPolicy, verdicts: []*engineVerdict{syncing, valid, nil}, want: pb.PayloadStatus_VALID},
+		{name: "invalid waits for a valid verdict", policy: FirstValidPolicy, verdicts: []*engineVerdict{invalid, nil}, undecided: true},
+		{name: "majority valid", policy: MajorityPolicy, verdicts: []*engineVerdict{valid, offline, valid}, want: pb.PayloadStatus_VALID},
+		{name: "majority invalid", policy: MajorityPolicy, verdicts: []*engineVerdict{valid, invalid, invalid}, want: pb.PayloadStatus_INVALID},
+		{name: "majority decides early", policy: MajorityPolicy, verdicts: []*engineVerdict{valid, nil, valid}, want: pb.PayloadStatus_VALID},
+		{name: "majority waits", policy: MajorityPolicy, verdicts: []*engineVerdict{valid, nil, invalid}, undecided: true},
+		{name: "no majority", policy: MajorityPolicy, verdicts: []*engineVerdict{valid, syncing, offline}, wantErr: errNoMajority.Error()},
+		{name: "no majority possible", policy: MajorityPolicy, verdicts: []*engineVerdict{valid, invalid, nil, syncing}, wantErr: errNoMajority.Error()},
+		{name: "split", policy: MajorityPolicy, verdicts: []*engineVerdict{valid, invalid}, wantErr: errNoMajority.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, decided, err := decide(tt.policy, tt.verdicts)
+			require.Equal(t, !tt.undecided, decided)
+			if tt.undecided {
+				return
+			}
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, v.status.Status)
+		})
+	}
+}
+
+func TestService_NewPayload_Failover(t *testing.T) {
+	payload, err := blocks.WrappedExecutionPayload(&pb.ExecutionPayload{})
+	require.NoError(t, err)
+	lvh := make([]byte, 32)
+	lvh[0] = 1
+
+	primary := &fakeEngineClient{err: errors.New("connection refused")}
+	fallback := &fakeEngineClient{status: &pb.PayloadStatus{Status: pb.PayloadStatus_VALID, LatestValidHash: lvh}}
+	s := failoverService(PrimaryWithFallbackPolicy, primary, fallback)
+	got, err := s.NewPayload(context.Background(), payload, nil, nil)
+	require.NoError(t, err)
+	assert.DeepEqual(t, lvh, got)
+	assert.DeepEqual(t, []string{NewPayloadMethod}, primary.calls)
+	assert.DeepEqual(t, []string{NewPayloadMethod}, fallback.calls)
+
+	statuses := s.ExecutionEndpointStatuses()
+	require.Equal(t, 2, len(statuses))
+	assert.Equal(t, true, statuses[0].Primary)
+	assert.Equal(t, types.EndpointOffline, statuses[0].Health)
+	require.ErrorContains(t, "connection refused", statuses[0].LastError)
+	assert.Equal(t, types.EndpointSynced, statuses[1].Health)
+
+	// Without a majority of synced endpoints, the payload is imported optimistically.
+	s = failoverService(MajorityPolicy, primary, fallback)
+	_, err = s.NewPayload(context.Background(), payload, nil, nil)
+	require.ErrorIs(t, err, ErrAcceptedSyncingPayloadStatus)
+}
+
+func TestService_NewPayload_ReturnsOnceDecided(t *testing.T) {
+	payload, err := blocks.WrappedExecutionPayload(&pb.ExecutionPayload{})
+	require.NoError(t, err)
+	primary := &fakeEngineClient{status: &pb.PayloadStatus{Status: pb.PayloadStatus_VALID}}
+	fallback := &blockingEngineClient{
+		fakeEngineClient: fakeEngineClient{status: &pb.PayloadStatus{Status: pb.PayloadStatus_VALID}},
+		release:          make(chan struct{}),
+		answered:         make(chan struct{}),
+	}
+	s := failoverService(PrimaryWithFallbackPolicy, primary, fallback)
+
+	// The verdict of the primary endpoint is followed without waiting for the fallback endpoint.
+	_, err = s.NewPayload(context.Background(), payload, nil, nil)
+	require.NoError(t, err)
+	statuses := s.ExecutionEndpointStatuses()
+	require.Equal(t, 2, len(statuses))
+	assert.Equal(t, types.EndpointSynced, statuses[0].Health)
+	assert.Equal(t, true, statuses[1].LastUpdate.IsZero())
+
+	// The call to the fallback endpoint is not canceled, and still delivers the payload.
+	close(fallback.release)
+	<-fallback.answered
+	time.Sleep(50 * time.Millisecond)
+	assert.DeepEqual(t, []string{NewPayloadMethod}, fallback.calls)
+	assert.Equal(t, types.EndpointSynced, s.ExecutionEndpointStatuses()[1].Health)
+}
+
+func TestService_ForkchoiceUpdated_PayloadFromHealthiestEndpoint(t *testing.T) {
+	primaryId, fallbackId := pb.PayloadIDBytes{1}, pb.PayloadIDBytes{2}
+	primary := &fakeEngineClient{status: &pb.PayloadStatus{Status: pb.PayloadStatus_SYNCING}, payloadId: &primaryId}
+	fallback := &fakeEngineClient{status: &pb.PayloadStatus{Status: pb.PayloadStatus_VALID}, payloadId: &fallbackId}
+	s := failoverService(PrimaryWithFallbackPolicy, primary, fallback)
+
+	attrs, err := payloadattribute.New(&pb.PayloadAttributes{})
+	require.NoError(t, err)
+	id, _, err := s.ForkchoiceUpdated(context.Background(), &pb.ForkchoiceState{}, attrs)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &fallbackId, id)
+
+	// The payload is fetched from the endpoint which returned the payload ID.
+	_, err = s.GetPayload(context.Background(), *id, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{ForkchoiceUpdatedMethod}, primary.calls)
+	assert.DeepEqual(t, []string{ForkchoiceUpdatedMethod, GetPayloadMethod}, fallback.calls)
+	assert.Equal(t, fallbackId, fallback.gotPayloadId)
+
+	// When that endpoint fails, the payload is fetched from the next endpoint building it, with its own payload ID.
+	// The majority policy waits for both endpoints, so that both build the payload.
+	s.cfg.failoverPolicy = MajorityPolicy
+	primary.status = &pb.PayloadStatus{Status: pb.PayloadStatus_VALID}
+	id, _, err = s.ForkchoiceUpdated(context.Background(), &pb.ForkchoiceState{}, attrs)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &primaryId, id)
+	primary.err = errors.New("connection refused")
+	fallback.gotPayloadId = pb.PayloadIDBytes{}
+	_, err = s.GetPayload(context.Background(), *id, 0)
+	require.NoError(t, err)
+	assert.Equal(t, fallbackId, fallback.gotPayloadId)
+}
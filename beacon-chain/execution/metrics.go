@@ -71,4 +71,12 @@ var (
 		Name: "execution_payload_bodies_count",
 		Help: "The number of requested payload bodies is too large",
 	})
+	engineEndpointHealth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "execution_endpoint_health",
+		Help: "The health of each execution endpoint: 0 when offline, 1 when syncing and 2 when synced",
+	}, []string{"endpoint"})
+	engineVerdictDisagreements = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_endpoint_disagreements_total",
+		Help: "The number of engine API calls for which some execution endpoints returned VALID and others INVALID",
+	})
 )
@@ -37,6 +37,22 @@ func WithHttpEndpointAndJWTSecret(endpointString string, secret []byte) Option {
 	}
 }
 
+// WithFallbackEndpoints adds execution endpoints which receive the engine API calls along with the primary one.
+func WithFallbackEndpoints(endpoints []network.Endpoint) Option {
+	return func(s *Service) error {
+		s.cfg.fallbackEndpoints = endpoints
+		return nil
+	}
+}
+
+// WithFailoverPolicy to reconcile the verdicts of the execution endpoints on payloads and fork choice updates.
+func WithFailoverPolicy(policy FailoverPolicy) Option {
+	return func(s *Service) error {
+		s.cfg.failoverPolicy = policy
+		return nil
+	}
+}
+
 // WithHeaders adds headers to the execution node JSON-RPC requests.
 func WithHeaders(headers []string) Option {
 	return func(s *Service) error {
@@ -79,6 +79,7 @@ type ChainInfoFetcher interface {
 	ExecutionClientConnected() bool
 	ExecutionClientEndpoint() string
 	ExecutionClientConnectionErr() error
+	ExecutionEndpointStatuses() []*types.EndpointStatus
 }
 
 // POWBlockFetcher defines a struct that can retrieve mainchain blocks.
@@ -128,6 +129,8 @@ type config struct {
 	headers                 []string
 	finalizedStateAtStartup state.BeaconState
 	jwtId                   string
+	fallbackEndpoints       []network.Endpoint
+	failoverPolicy          FailoverPolicy
 }
 
 // Service fetches important information about the canonical
@@ -155,6 +158,9 @@ type Service struct {
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	runError                error
 	preGenesisState         state.BeaconState
+	primaryEngine           *engineEndpoint
+	fallbackEngines         []*engineEndpoint
+	payloadBuilds           *payloadBuilds
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
@@ -176,6 +182,7 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		cfg: &config{
 			beaconNodeStatsUpdater: &NopBeaconNodeStatsUpdater{},
 			eth1HeaderReqLimit:     defaultEth1HeaderReqLimit,
+			failoverPolicy:         PrimaryWithFallbackPolicy,
 		},
 		latestEth1Data: &ethpb.LatestETH1Data{
 			BlockHeight:        0,
@@ -192,6 +199,8 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		lastReceivedMerkleIndex: -1,
 		preGenesisState:         genState,
 		eth1HeadTicker:          time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerETH1Block) * time.Second),
+		primaryEngine:           &engineEndpoint{primary: true},
+		payloadBuilds:           newPayloadBuilds(),
 	}
 
 	for _, opt := range opts {
@@ -199,6 +208,10 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 			return nil, err
 		}
 	}
+	s.primaryEngine.endpoint = s.cfg.currHttpEndpoint
+	for _, e := range s.cfg.fallbackEndpoints {
+		s.fallbackEngines = append(s.fallbackEngines, &engineEndpoint{endpoint: e})
+	}
 
 	eth1Data, err := s.validPowchainData(ctx)
 	if err != nil {
@@ -215,6 +228,14 @@ func (s *Service) Start() {
 	if err := s.setupExecutionClientConnections(s.ctx, s.cfg.currHttpEndpoint); err != nil {
 		log.WithError(err).Error("Could not connect to execution endpoint")
 	}
+	if len(s.fallbackEngines) > 0 {
+		s.connectFallbackEngines(s.ctx)
+		log.WithFields(logrus.Fields{
+			"fallbackEndpoints": len(s.fallbackEngines),
+			"policy":            s.cfg.failoverPolicy,
+		}).Info("Sending engine API calls to fallback execution endpoints")
+	}
+	go s.monitorEngineEndpoints()
 	// If the chain has not started already and we don't have access to eth1 nodes, we will not be
 	// able to generate the genesis state.
 	if !s.chainStartData.Chainstarted && s.cfg.currHttpEndpoint.Url == "" {
@@ -245,6 +266,7 @@ func (s *Service) Stop() error {
 	if s.rpcClient != nil {
 		s.rpcClient.Close()
 	}
+	s.closeFallbackEngines()
 	return nil
 }
 
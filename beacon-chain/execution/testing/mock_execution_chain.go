@@ -37,6 +37,7 @@ type Chain struct {
 	CurrError         error
 	Endpoints         []string
 	Errors            []error
+	EndpointStatuses  []*types.EndpointStatus
 }
 
 // GenesisTime represents a static past date - JAN 01 2000.
@@ -131,6 +132,10 @@ func (m *Chain) ExecutionClientConnectionErr() error {
 	return m.CurrError
 }
 
+func (m *Chain) ExecutionEndpointStatuses() []*types.EndpointStatus {
+	return m.EndpointStatuses
+}
+
 func (m *Chain) ETH1Endpoints() []string {
 	return m.Endpoints
 }
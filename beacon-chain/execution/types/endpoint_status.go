@@ -0,0 +1,35 @@
+package types
+
+import "time"
+
+// EndpointHealth of an execution endpoint, as last observed.
+type EndpointHealth int
+
+const (
+	// EndpointOffline endpoints could not be reached.
+	EndpointOffline EndpointHealth = iota
+	// EndpointSyncing endpoints are reachable, but are not synced to the head of the chain.
+	EndpointSyncing
+	// EndpointSynced endpoints are able to validate payloads.
+	EndpointSynced
+)
+
+func (h EndpointHealth) String() string {
+	switch h {
+	case EndpointSyncing:
+		return "syncing"
+	case EndpointSynced:
+		return "synced"
+	default:
+		return "offline"
+	}
+}
+
+// EndpointStatus is a snapshot of the health of an execution endpoint.
+type EndpointStatus struct {
+	Endpoint   string
+	Primary    bool
+	Health     EndpointHealth
+	LastError  error
+	LastUpdate time.Time
+}
@@ -0,0 +1,109 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	forkchoice2 "github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// Explain returns the breakdown of the weight of the block with the given root and whether it is viable for head
+// with the current checkpoints of the store. The weights are the ones of the last head computation.
+func (f *ForkChoice) Explain(ctx context.Context, root [32]byte) (*forkchoice2.Explanation, error) {
+	_, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.Explain")
+	defer span.End()
+
+	n, ok := f.store.nodeByRoot[root]
+	if !ok || n == nil {
+		return nil, errors.Wrapf(ErrNilNode, "could not explain root %#x", root)
+	}
+	return f.explain(n), nil
+}
+
+func (f *ForkChoice) explain(n *Node) *forkchoice2.Explanation {
+	s := f.store
+	var parentRoot [32]byte
+	if n.parent != nil {
+		parentRoot = n.parent.root
+	}
+	e := &forkchoice2.Explanation{
+		BlockRoot:                n.root[:],
+		ParentRoot:               parentRoot[:],
+		Slot:                     n.slot,
+		Weight:                   n.weight,
+		Balance:                  n.balance,
+		Children:                 make([]*forkchoice2.ChildWeight, 0, len(n.children)),
+		CommitteeWeight:          s.committeeWeight,
+		JustifiedEpoch:           n.justifiedEpoch,
+		UnrealizedJustifiedEpoch: n.unrealizedJustifiedEpoch,
+		FinalizedEpoch:           n.finalizedEpoch,
+		UnrealizedFinalizedEpoch: n.unrealizedFinalizedEpoch,
+		JustifiedCheckpoint:      &ethpb.Checkpoint{Epoch: s.justifiedCheckpoint.Epoch, Root: s.justifiedCheckpoint.Root[:]},
+		FinalizedCheckpoint:      &ethpb.Checkpoint{Epoch: s.finalizedCheckpoint.Epoch, Root: s.finalizedCheckpoint.Root[:]},
+		Canonical:                f.IsCanonical(n.root),
+		Head:                     n == s.headNode,
+		ExecutionOptimistic:      n.optimistic,
+		Timestamp:                n.timestamp,
+	}
+	for _, child := range n.children {
+		root := child.root
+		e.Children = append(e.Children, &forkchoice2.ChildWeight{BlockRoot: root[:], Weight: child.weight})
+		e.ChildrenWeight += child.weight
+	}
+	if n.bestDescendant != nil {
+		e.BestDescendant = n.bestDescendant.root[:]
+	}
+
+	// Validators which never voted have zero hash votes, no vote is counted for a zero hash root.
+	zHash := params.BeaconConfig().ZeroHash
+	for index := 0; n.root != zHash && index < len(f.votes); index++ {
+		vote := f.votes[index]
+		if s.slashedIndices[primitives.ValidatorIndex(index)] {
+			if vote.currentRoot == n.root || vote.nextRoot == n.root {
+				e.SlashedVotes++
+			}
+			continue
+		}
+		if vote.currentRoot == n.root {
+			if index < len(f.balances) {
+				e.VoteBalance += f.balances[index]
+			}
+			e.Votes++
+		} else if vote.nextRoot == n.root {
+			if index < len(f.justifiedBalances) {
+				e.PendingVoteBalance += f.justifiedBalances[index]
+			}
+			e.PendingVotes++
+		}
+	}
+
+	// The proposer boost included in the weights is the one applied at the last head computation.
+	if boosted, ok := s.nodeByRoot[s.previousProposerBoostRoot]; ok && s.previousProposerBoostRoot != zHash {
+		if boosted == n {
+			e.ProposerBoost = s.previousProposerBoostScore
+		} else {
+			for a := boosted.parent; a != nil && a.slot >= n.slot; a = a.parent {
+				if a == n {
+					e.DescendantProposerBoost = s.previousProposerBoostScore
+					break
+				}
+			}
+		}
+	}
+
+	currentEpoch := slots.EpochsSinceGenesis(time.Unix(int64(s.genesisTime), 0))
+	e.ViableForHead = n.viableForHead(s.justifiedCheckpoint.Epoch, currentEpoch)
+	e.LeadsToViableHead = n.leadsToViableHead(s.justifiedCheckpoint.Epoch, currentEpoch)
+	// A block inserted before the start of its slot is not considered early.
+	early, err := n.arrivedEarly(s.genesisTime)
+	e.ArrivedEarly = err == nil && early
+	e.WeakHead = n.weight*100 <= s.committeeWeight*params.BeaconConfig().ReorgWeightThreshold
+	e.StrongParent = n.weight*100 >= s.committeeWeight*params.BeaconConfig().ReorgParentWeightThreshold
+	return e
+}
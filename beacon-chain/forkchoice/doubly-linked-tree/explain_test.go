@@ -0,0 +1,78 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// explainTestForkChoice returns fork choice with the chain 1 <- 2 and the fork 1 <- 3, where the block 3 is boosted.
+func explainTestForkChoice(t *testing.T) *ForkChoice {
+	f := setup(0, 0)
+	ctx := context.Background()
+	st, root, err := prepareForkchoiceState(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{'a'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 2, indexToHash(2), indexToHash(1), [32]byte{'b'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 3, indexToHash(3), indexToHash(1), [32]byte{'c'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+
+	f.justifiedBalances = []uint64{10, 20, 30, 40}
+	f.store.committeeWeight = 25
+	f.store.proposerBoostRoot = indexToHash(3)
+	f.store.slashedIndices[3] = true
+	f.ProcessAttestation(ctx, []uint64{0, 2, 3}, indexToHash(2), 1)
+	f.ProcessAttestation(ctx, []uint64{1}, indexToHash(3), 1)
+	head, err := f.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(2), head)
+	return f
+}
+
+func TestForkChoice_Explain(t *testing.T) {
+	ctx := context.Background()
+	f := explainTestForkChoice(t)
+	boost := f.store.committeeWeight * params.BeaconConfig().ProposerScoreBoost / 100
+	// A pending vote moving from the fork to the canonical block.
+	f.ProcessAttestation(ctx, []uint64{1}, indexToHash(2), 2)
+
+	e, err := f.Explain(ctx, indexToHash(2))
+	require.NoError(t, err)
+	assert.DeepEqual(t, indexToHash(1), [32]byte(e.ParentRoot))
+	assert.Equal(t, uint64(40), e.VoteBalance)
+	assert.Equal(t, uint64(2), e.Votes)
+	assert.Equal(t, uint64(20), e.PendingVoteBalance)
+	assert.Equal(t, uint64(1), e.PendingVotes)
+	assert.Equal(t, uint64(1), e.SlashedVotes)
+	assert.Equal(t, uint64(0), e.ProposerBoost)
+	assert.Equal(t, e.VoteBalance, e.Weight)
+	assert.Equal(t, true, e.Head)
+	assert.Equal(t, true, e.Canonical)
+	assert.Equal(t, true, e.ViableForHead)
+
+	e, err = f.Explain(ctx, indexToHash(3))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), e.VoteBalance)
+	assert.Equal(t, boost, e.ProposerBoost)
+	assert.Equal(t, e.VoteBalance+e.ProposerBoost, e.Weight)
+	assert.Equal(t, false, e.Head)
+	assert.Equal(t, false, e.Canonical)
+
+	e, err = f.Explain(ctx, indexToHash(1))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(e.Children))
+	assert.Equal(t, uint64(40)+uint64(20)+boost, e.ChildrenWeight)
+	assert.Equal(t, e.ChildrenWeight, e.Weight)
+	assert.Equal(t, boost, e.DescendantProposerBoost)
+	assert.DeepEqual(t, indexToHash(2), [32]byte(e.BestDescendant))
+	assert.Equal(t, true, e.LeadsToViableHead)
+
+	_, err = f.Explain(ctx, indexToHash(4))
+	require.ErrorIs(t, err, ErrNilNode)
+}
@@ -57,25 +57,34 @@ func (f *ForkChoice) Head(
 
 	calledHeadCount.Inc()
 
+	if err := f.updateWeights(ctx); err != nil {
+		return [32]byte{}, err
+	}
+	return f.store.head(ctx)
+}
+
+// updateWeights accounts the latest votes and the proposer boost in the weights of the nodes and updates their best
+// descendants.
+func (f *ForkChoice) updateWeights(ctx context.Context) error {
 	if err := f.updateBalances(); err != nil {
-		return [32]byte{}, errors.Wrap(err, "could not update balances")
+		return errors.Wrap(err, "could not update balances")
 	}
 
 	if err := f.applyProposerBoostScore(); err != nil {
-		return [32]byte{}, errors.Wrap(err, "could not apply proposer boost score")
+		return errors.Wrap(err, "could not apply proposer boost score")
 	}
 
 	if err := f.store.treeRootNode.applyWeightChanges(ctx); err != nil {
-		return [32]byte{}, errors.Wrap(err, "could not apply weight changes")
+		return errors.Wrap(err, "could not apply weight changes")
 	}
 
 	jc := f.JustifiedCheckpoint()
 	fc := f.FinalizedCheckpoint()
 	currentEpoch := slots.EpochsSinceGenesis(time.Unix(int64(f.store.genesisTime), 0))
 	if err := f.store.treeRootNode.updateBestDescendant(ctx, jc.Epoch, fc.Epoch, currentEpoch); err != nil {
-		return [32]byte{}, errors.Wrap(err, "could not update best descendant")
+		return errors.Wrap(err, "could not update best descendant")
 	}
-	return f.store.head(ctx)
+	return nil
 }
 
 // ProcessAttestation processes attestation for vote accounting, it iterates around validator indices
@@ -84,6 +93,12 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 	_, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.ProcessAttestation")
 	defer span.End()
 
+	f.processVotes(validatorIndices, blockRoot, targetEpoch)
+	processedAttestationCount.Inc()
+}
+
+// processVotes updates the latest votes of the validators.
+func (f *ForkChoice) processVotes(validatorIndices []uint64, blockRoot [32]byte, targetEpoch primitives.Epoch) {
 	for _, index := range validatorIndices {
 		// Validator indices will grow the vote cache.
 		for index >= uint64(len(f.votes)) {
@@ -100,8 +115,6 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 			f.votes[index].nextRoot = blockRoot
 		}
 	}
-
-	processedAttestationCount.Inc()
 }
 
 // InsertNode processes a new block by inserting it to the fork choice store.
@@ -0,0 +1,204 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	forkchoice2 "github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// Simulator returns a copy of fork choice to run a simulation against once the lock of fork choice is released. The
+// store is left untouched by the simulation, and neither are the fork choice metrics.
+func (f *ForkChoice) Simulator() (forkchoice.Simulator, error) {
+	if f.store.treeRootNode == nil {
+		return nil, errors.Wrap(ErrNilNode, "could not simulate an empty store")
+	}
+	return &simulator{f: f.clone()}, nil
+}
+
+// simulator is a copy of fork choice which a single simulation is applied to.
+type simulator struct {
+	f *ForkChoice
+}
+
+// Simulate applies the hypothetical blocks and attestations of the simulation to the copy of fork choice and returns
+// the resulting head.
+func (s *simulator) Simulate(ctx context.Context, sim *forkchoice2.Simulation) (*forkchoice2.SimulationResult, error) {
+	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.Simulate")
+	defer span.End()
+
+	if sim == nil {
+		return nil, errors.New("nil simulation")
+	}
+	c := s.f
+	if c == nil {
+		return nil, errors.New("simulator already used")
+	}
+	s.f = nil
+	// Votes are only simulated for known validators, as processing a vote grows the vote cache up to its index.
+	numValidators := uint64(max(len(c.votes), len(c.justifiedBalances)))
+	for _, a := range sim.Attestations {
+		for _, index := range a.ValidatorIndices {
+			if index >= numValidators {
+				return nil, fmt.Errorf("validator index %d is not below the number of validators %d", index, numValidators)
+			}
+		}
+	}
+	result := &forkchoice2.SimulationResult{}
+	if c.store.headNode != nil {
+		result.PreviousHeadRoot = c.store.headNode.root[:]
+	}
+	for _, b := range sim.Blocks {
+		if err := c.store.insertSimulated(b); err != nil {
+			return nil, err
+		}
+	}
+	for _, a := range sim.Attestations {
+		c.processVotes(a.ValidatorIndices, a.BlockRoot, a.TargetEpoch)
+	}
+	if err := c.updateWeights(ctx); err != nil {
+		return nil, err
+	}
+	head, err := c.store.viableHead()
+	if err != nil {
+		return nil, err
+	}
+	c.store.headNode = head
+	result.Head = c.explain(head)
+	return result, nil
+}
+
+// insertSimulated inserts a hypothetical block into the store. Unlike insert, it does not depend on the wall clock,
+// and the block only receives the proposer boost when asked to.
+func (s *Store) insertSimulated(b *forkchoice2.SimulatedBlock) error {
+	if b == nil {
+		return errors.New("nil simulated block")
+	}
+	if _, ok := s.nodeByRoot[b.BlockRoot]; ok {
+		return fmt.Errorf("block %#x is already in fork choice", b.BlockRoot)
+	}
+	parent, ok := s.nodeByRoot[b.ParentRoot]
+	if !ok || parent == nil {
+		return errors.Wrapf(errInvalidParentRoot, "%#x", b.ParentRoot)
+	}
+	if b.Slot <= parent.slot {
+		return fmt.Errorf("block slot %d is not after parent slot %d", b.Slot, parent.slot)
+	}
+	justifiedEpoch, finalizedEpoch := parent.justifiedEpoch, parent.finalizedEpoch
+	if b.JustifiedEpoch != nil {
+		justifiedEpoch = *b.JustifiedEpoch
+	}
+	if b.FinalizedEpoch != nil {
+		finalizedEpoch = *b.FinalizedEpoch
+	}
+	n := &Node{
+		slot:                     b.Slot,
+		root:                     b.BlockRoot,
+		parent:                   parent,
+		justifiedEpoch:           justifiedEpoch,
+		unrealizedJustifiedEpoch: justifiedEpoch,
+		finalizedEpoch:           finalizedEpoch,
+		unrealizedFinalizedEpoch: finalizedEpoch,
+		optimistic:               true,
+		timestamp:                s.genesisTime + uint64(b.Slot)*params.BeaconConfig().SecondsPerSlot,
+	}
+	if b.Slot%params.BeaconConfig().SlotsPerEpoch == 0 {
+		n.target = n
+	} else if slots.ToEpoch(b.Slot) == slots.ToEpoch(parent.slot) {
+		n.target = parent.target
+	} else {
+		n.target = parent
+	}
+	s.nodeByRoot[b.BlockRoot] = n
+	parent.children = append(parent.children, n)
+	if b.ProposerBoost {
+		s.proposerBoostRoot = b.BlockRoot
+	}
+	return nil
+}
+
+// clone returns a deep copy of fork choice, sharing no mutable state with it.
+func (f *ForkChoice) clone() *ForkChoice {
+	s := f.store
+	nodes := make(map[*Node]*Node, len(s.nodeByRoot))
+	if s.treeRootNode != nil {
+		s.treeRootNode.cloneTree(nil, nodes)
+	}
+	cp := func(n *Node) *Node {
+		if n == nil {
+			return nil
+		}
+		return nodes[n]
+	}
+	for n, c := range nodes {
+		c.target = cp(n.target)
+		c.bestDescendant = cp(n.bestDescendant)
+	}
+	checkpoint := func(c *forkchoicetypes.Checkpoint) *forkchoicetypes.Checkpoint {
+		cc := *c
+		return &cc
+	}
+	c := &Store{
+		justifiedCheckpoint:           checkpoint(s.justifiedCheckpoint),
+		unrealizedJustifiedCheckpoint: checkpoint(s.unrealizedJustifiedCheckpoint),
+		unrealizedFinalizedCheckpoint: checkpoint(s.unrealizedFinalizedCheckpoint),
+		prevJustifiedCheckpoint:       checkpoint(s.prevJustifiedCheckpoint),
+		finalizedCheckpoint:           checkpoint(s.finalizedCheckpoint),
+		proposerBoostRoot:             s.proposerBoostRoot,
+		previousProposerBoostRoot:     s.previousProposerBoostRoot,
+		previousProposerBoostScore:    s.previousProposerBoostScore,
+		committeeWeight:               s.committeeWeight,
+		treeRootNode:                  cp(s.treeRootNode),
+		headNode:                      cp(s.headNode),
+		nodeByRoot:                    make(map[[fieldparams.RootLength]byte]*Node, len(s.nodeByRoot)),
+		nodeByPayload:                 make(map[[fieldparams.RootLength]byte]*Node, len(s.nodeByPayload)),
+		slashedIndices:                make(map[primitives.ValidatorIndex]bool, len(s.slashedIndices)),
+		originRoot:                    s.originRoot,
+		genesisTime:                   s.genesisTime,
+		highestReceivedNode:           cp(s.highestReceivedNode),
+		receivedBlocksLastEpoch:       s.receivedBlocksLastEpoch,
+		allTipsAreInvalid:             s.allTipsAreInvalid,
+	}
+	for root, n := range s.nodeByRoot {
+		if cn := cp(n); cn != nil {
+			c.nodeByRoot[root] = cn
+		}
+	}
+	for hash, n := range s.nodeByPayload {
+		if cn := cp(n); cn != nil {
+			c.nodeByPayload[hash] = cn
+		}
+	}
+	for index, slashed := range s.slashedIndices {
+		c.slashedIndices[index] = slashed
+	}
+	return &ForkChoice{
+		store:               c,
+		votes:               append([]Vote(nil), f.votes...),
+		balances:            append([]uint64(nil), f.balances...),
+		justifiedBalances:   append([]uint64(nil), f.justifiedBalances...),
+		numActiveValidators: f.numActiveValidators,
+		balancesByRoot:      f.balancesByRoot,
+	}
+}
+
+// cloneTree copies this node and its descendants under the given parent, recording every copy in nodes. The target
+// and best descendant of the copies still point to the original nodes.
+func (n *Node) cloneTree(parent *Node, nodes map[*Node]*Node) *Node {
+	c := *n
+	c.parent = parent
+	c.children = make([]*Node, 0, len(n.children))
+	nodes[n] = &c
+	for _, child := range n.children {
+		c.children = append(c.children, child.cloneTree(&c, nodes))
+	}
+	return &c
+}
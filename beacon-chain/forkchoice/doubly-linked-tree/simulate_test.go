@@ -0,0 +1,109 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"testing"
+
+	forkchoice2 "github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func simulate(t *testing.T, f *ForkChoice, ctx context.Context, sim *forkchoice2.Simulation) (*forkchoice2.SimulationResult, error) {
+	simulator, err := f.Simulator()
+	require.NoError(t, err)
+	return simulator.Simulate(ctx, sim)
+}
+
+func TestForkChoice_Simulate(t *testing.T) {
+	ctx := context.Background()
+	f := explainTestForkChoice(t)
+	votes := append([]Vote(nil), f.votes...)
+	weight, err := f.Weight(indexToHash(2))
+	require.NoError(t, err)
+
+	t.Run("attestations", func(t *testing.T) {
+		res, err := simulate(t, f, ctx, &forkchoice2.Simulation{
+			Attestations: []*forkchoice2.SimulatedAttestation{{ValidatorIndices: []uint64{0}, BlockRoot: indexToHash(3), TargetEpoch: 2}},
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, indexToHash(2), [32]byte(res.PreviousHeadRoot))
+		assert.DeepEqual(t, indexToHash(3), [32]byte(res.Head.BlockRoot))
+		assert.Equal(t, uint64(30), res.Head.VoteBalance)
+	})
+	t.Run("boosted block", func(t *testing.T) {
+		res, err := simulate(t, f, ctx, &forkchoice2.Simulation{
+			Blocks: []*forkchoice2.SimulatedBlock{{Slot: 4, BlockRoot: indexToHash(4), ParentRoot: indexToHash(2), ProposerBoost: true}},
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, indexToHash(4), [32]byte(res.Head.BlockRoot))
+		assert.Equal(t, true, res.Head.ProposerBoost > 0)
+		assert.Equal(t, true, res.Head.ArrivedEarly)
+	})
+	t.Run("invalid blocks", func(t *testing.T) {
+		_, err := simulate(t, f, ctx, &forkchoice2.Simulation{
+			Blocks: []*forkchoice2.SimulatedBlock{{Slot: 4, BlockRoot: indexToHash(4), ParentRoot: indexToHash(5)}},
+		})
+		require.ErrorIs(t, err, errInvalidParentRoot)
+		_, err = simulate(t, f, ctx, &forkchoice2.Simulation{
+			Blocks: []*forkchoice2.SimulatedBlock{{Slot: 4, BlockRoot: indexToHash(3), ParentRoot: indexToHash(1)}},
+		})
+		require.ErrorContains(t, "already in fork choice", err)
+		_, err = simulate(t, f, ctx, &forkchoice2.Simulation{
+			Blocks: []*forkchoice2.SimulatedBlock{{Slot: 1, BlockRoot: indexToHash(4), ParentRoot: indexToHash(1)}},
+		})
+		require.ErrorContains(t, "is not after parent slot", err)
+	})
+	t.Run("unknown validator", func(t *testing.T) {
+		_, err := simulate(t, f, ctx, &forkchoice2.Simulation{
+			Attestations: []*forkchoice2.SimulatedAttestation{{ValidatorIndices: []uint64{1 << 40}, BlockRoot: indexToHash(3), TargetEpoch: 2}},
+		})
+		require.ErrorContains(t, "is not below the number of validators", err)
+	})
+	t.Run("single use", func(t *testing.T) {
+		simulator, err := f.Simulator()
+		require.NoError(t, err)
+		_, err = simulator.Simulate(ctx, &forkchoice2.Simulation{})
+		require.NoError(t, err)
+		_, err = simulator.Simulate(ctx, &forkchoice2.Simulation{})
+		require.ErrorContains(t, "simulator already used", err)
+	})
+
+	// The live store is left untouched.
+	assert.Equal(t, false, f.HasNode(indexToHash(4)))
+	assert.Equal(t, indexToHash(2), f.CachedHeadRoot())
+	assert.Equal(t, indexToHash(3), f.store.proposerBoostRoot)
+	assert.DeepEqual(t, votes, f.votes)
+	w, err := f.Weight(indexToHash(2))
+	require.NoError(t, err)
+	assert.Equal(t, weight, w)
+	head, err := f.Head(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), head)
+}
+
+func TestForkChoice_clone(t *testing.T) {
+	f := explainTestForkChoice(t)
+	c := f.clone()
+	require.Equal(t, len(f.store.nodeByRoot), len(c.store.nodeByRoot))
+	for root, n := range f.store.nodeByRoot {
+		cn := c.store.nodeByRoot[root]
+		require.NotNil(t, cn)
+		assert.Equal(t, false, n == cn)
+		assert.Equal(t, n.weight, cn.weight)
+		assert.Equal(t, len(n.children), len(cn.children))
+		if n.parent != nil {
+			assert.Equal(t, c.store.nodeByRoot[n.parent.root], cn.parent)
+		}
+		if n.target != nil {
+			assert.Equal(t, c.store.nodeByRoot[n.target.root], cn.target)
+		}
+	}
+	for hash, n := range c.store.nodeByPayload {
+		assert.Equal(t, c.store.nodeByRoot[n.root], n, "payload %#x", hash)
+	}
+	assert.Equal(t, c.store.nodeByRoot[indexToHash(2)], c.store.headNode)
+	assert.Equal(t, c.store.nodeByRoot[f.store.treeRootNode.root], c.store.treeRootNode)
+	c.votes[0].nextRoot = indexToHash(3)
+	assert.Equal(t, indexToHash(2), f.votes[0].nextRoot)
+}
@@ -0,0 +1,238 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// snapshotVersion is the version of fork choice snapshots. Snapshots of other versions are rejected.
+const snapshotVersion = 1
+
+var errInvalidSnapshot = errors.New("invalid forkchoice snapshot")
+
+// Snapshot is a copy of the store, the latest votes and the balances of fork choice, which can be encoded without
+// holding the fork choice lock.
+type Snapshot struct {
+	pb *ethpb.ForkChoiceSnapshot
+}
+
+// Snapshot copies the store, the latest votes and the balances of fork choice, so that they can be encoded with
+// Marshal once the fork choice lock is released, and restored after a restart with RestoreSnapshot.
+// The caller is responsible for holding the fork choice read lock.
+func (f *ForkChoice) Snapshot() (forkchoice.Snapshot, error) {
+	s := f.store
+	if s.treeRootNode == nil {
+		return nil, errors.Wrap(ErrNilNode, "could not snapshot an empty store")
+	}
+	pb := &ethpb.ForkChoiceSnapshot{
+		Version:                       snapshotVersion,
+		GenesisTime:                   s.genesisTime,
+		JustifiedCheckpoint:           checkpointProto(s.justifiedCheckpoint),
+		UnrealizedJustifiedCheckpoint: checkpointProto(s.unrealizedJustifiedCheckpoint),
+		UnrealizedFinalizedCheckpoint: checkpointProto(s.unrealizedFinalizedCheckpoint),
+		PrevJustifiedCheckpoint:       checkpointProto(s.prevJustifiedCheckpoint),
+		FinalizedCheckpoint:           checkpointProto(s.finalizedCheckpoint),
+		ProposerBoostRoot:             rootBytes(s.proposerBoostRoot),
+		PreviousProposerBoostRoot:     rootBytes(s.previousProposerBoostRoot),
+		PreviousProposerBoostScore:    s.previousProposerBoostScore,
+		CommitteeWeight:               s.committeeWeight,
+		OriginRoot:                    rootBytes(s.originRoot),
+		HeadRoot:                      rootBytes(nodeRoot(s.headNode)),
+		HighestReceivedRoot:           rootBytes(nodeRoot(s.highestReceivedNode)),
+		ReceivedBlocksLastEpoch:       make([]uint64, len(s.receivedBlocksLastEpoch)),
+		AllTipsAreInvalid:             s.allTipsAreInvalid,
+		NumActiveValidators:           f.numActiveValidators,
+		SlashedIndices:                make([]uint64, 0, len(s.slashedIndices)),
+		Votes:                         make([]*ethpb.ForkChoiceSnapshotVote, len(f.votes)),
+		Balances:                      append([]uint64{}, f.balances...),
+		JustifiedBalances:             append([]uint64{}, f.justifiedBalances...),
+	}
+	for i, slot := range s.receivedBlocksLastEpoch {
+		pb.ReceivedBlocksLastEpoch[i] = uint64(slot)
+	}
+	// Parents are saved before their children, starting from the tree root.
+	nodes := make([]*Node, 0, len(s.nodeByRoot))
+	nodes = s.treeRootNode.appendTree(nodes)
+	pb.Nodes = make([]*ethpb.ForkChoiceSnapshotNode, len(nodes))
+	for i, n := range nodes {
+		pb.Nodes[i] = &ethpb.ForkChoiceSnapshotNode{
+			Slot:                     n.slot,
+			Root:                     rootBytes(n.root),
+			ParentRoot:               rootBytes(nodeRoot(n.parent)),
+			PayloadHash:              rootBytes(n.payloadHash),
+			TargetRoot:               rootBytes(nodeRoot(n.target)),
+			JustifiedEpoch:           n.justifiedEpoch,
+			UnrealizedJustifiedEpoch: n.unrealizedJustifiedEpoch,
+			FinalizedEpoch:           n.finalizedEpoch,
+			UnrealizedFinalizedEpoch: n.unrealizedFinalizedEpoch,
+			Balance:                  n.balance,
+			Weight:                   n.weight,
+			Optimistic:               n.optimistic,
+			Timestamp:                n.timestamp,
+		}
+	}
+	for index := range s.slashedIndices {
+		pb.SlashedIndices = append(pb.SlashedIndices, uint64(index))
+	}
+	for i, v := range f.votes {
+		pb.Votes[i] = &ethpb.ForkChoiceSnapshotVote{
+			CurrentRoot: rootBytes(v.currentRoot),
+			NextRoot:    rootBytes(v.nextRoot),
+			NextEpoch:   v.nextEpoch,
+		}
+	}
+	return &Snapshot{pb: pb}, nil
+}
+
+// Marshal SSZ encodes the snapshot.
+func (snap *Snapshot) Marshal() ([]byte, error) {
+	return snap.pb.MarshalSSZ()
+}
+
+// RestoreSnapshot replaces the store, the latest votes and the balances of fork choice with the ones of a snapshot
+// taken with Snapshot and encoded with Marshal. Fork choice is left untouched if the snapshot is invalid.
+func (f *ForkChoice) RestoreSnapshot(ctx context.Context, snapshot []byte) error {
+	pb := &ethpb.ForkChoiceSnapshot{}
+	if err := pb.UnmarshalSSZ(snapshot); err != nil {
+		return errors.Wrap(errInvalidSnapshot, err.Error())
+	}
+	if pb.Version != snapshotVersion {
+		return errors.Wrapf(errInvalidSnapshot, "unsupported version %d", pb.Version)
+	}
+	s := &Store{
+		genesisTime:                   pb.GenesisTime,
+		justifiedCheckpoint:           checkpointFromProto(pb.JustifiedCheckpoint),
+		unrealizedJustifiedCheckpoint: checkpointFromProto(pb.UnrealizedJustifiedCheckpoint),
+		unrealizedFinalizedCheckpoint: checkpointFromProto(pb.UnrealizedFinalizedCheckpoint),
+		prevJustifiedCheckpoint:       checkpointFromProto(pb.PrevJustifiedCheckpoint),
+		finalizedCheckpoint:           checkpointFromProto(pb.FinalizedCheckpoint),
+		proposerBoostRoot:             bytesutil.ToBytes32(pb.ProposerBoostRoot),
+		previousProposerBoostRoot:     bytesutil.ToBytes32(pb.PreviousProposerBoostRoot),
+		previousProposerBoostScore:    pb.PreviousProposerBoostScore,
+		committeeWeight:               pb.CommitteeWeight,
+		originRoot:                    bytesutil.ToBytes32(pb.OriginRoot),
+		allTipsAreInvalid:             pb.AllTipsAreInvalid,
+		nodeByRoot:                    make(map[[fieldparams.RootLength]byte]*Node, len(pb.Nodes)),
+		nodeByPayload:                 make(map[[fieldparams.RootLength]byte]*Node, len(pb.Nodes)),
+		slashedIndices:                make(map[primitives.ValidatorIndex]bool, len(pb.SlashedIndices)),
+	}
+	if len(pb.ReceivedBlocksLastEpoch) != len(s.receivedBlocksLastEpoch) {
+		return errors.Wrapf(errInvalidSnapshot, "snapshot tracks %d slots per epoch, want %d", len(pb.ReceivedBlocksLastEpoch), len(s.receivedBlocksLastEpoch))
+	}
+	for i, slot := range pb.ReceivedBlocksLastEpoch {
+		s.receivedBlocksLastEpoch[i] = primitives.Slot(slot)
+	}
+	if len(pb.Nodes) == 0 {
+		return errors.Wrap(errInvalidSnapshot, "snapshot has no nodes")
+	}
+	for i, pn := range pb.Nodes {
+		n := &Node{
+			slot:                     pn.Slot,
+			root:                     bytesutil.ToBytes32(pn.Root),
+			payloadHash:              bytesutil.ToBytes32(pn.PayloadHash),
+			justifiedEpoch:           pn.JustifiedEpoch,
+			unrealizedJustifiedEpoch: pn.UnrealizedJustifiedEpoch,
+			finalizedEpoch:           pn.FinalizedEpoch,
+			unrealizedFinalizedEpoch: pn.UnrealizedFinalizedEpoch,
+			balance:                  pn.Balance,
+			weight:                   pn.Weight,
+			optimistic:               pn.Optimistic,
+			timestamp:                pn.Timestamp,
+		}
+		if _, ok := s.nodeByRoot[n.root]; ok {
+			return errors.Wrapf(errInvalidSnapshot, "duplicate node %#x", n.root)
+		}
+		if i == 0 {
+			s.treeRootNode = n
+		} else {
+			parentRoot := bytesutil.ToBytes32(pn.ParentRoot)
+			parent, ok := s.nodeByRoot[parentRoot]
+			if !ok {
+				return errors.Wrapf(errInvalidSnapshot, "unknown parent %#x of node %#x", parentRoot, n.root)
+			}
+			n.parent = parent
+			parent.children = append(parent.children, n)
+		}
+		switch targetRoot := bytesutil.ToBytes32(pn.TargetRoot); {
+		case targetRoot == n.root:
+			n.target = n
+		case targetRoot != [32]byte{}:
+			target, ok := s.nodeByRoot[targetRoot]
+			if !ok {
+				return errors.Wrapf(errInvalidSnapshot, "unknown target %#x of node %#x", targetRoot, n.root)
+			}
+			n.target = target
+		}
+		s.nodeByRoot[n.root] = n
+		s.nodeByPayload[n.payloadHash] = n
+	}
+	for _, index := range pb.SlashedIndices {
+		s.slashedIndices[primitives.ValidatorIndex(index)] = true
+	}
+	votes := make([]Vote, len(pb.Votes))
+	for i, v := range pb.Votes {
+		votes[i] = Vote{
+			currentRoot: bytesutil.ToBytes32(v.CurrentRoot),
+			nextRoot:    bytesutil.ToBytes32(v.NextRoot),
+			nextEpoch:   v.NextEpoch,
+		}
+	}
+
+	s.headNode = s.nodeByRoot[bytesutil.ToBytes32(pb.HeadRoot)]
+	if s.headNode == nil {
+		s.headNode = s.treeRootNode
+	}
+	s.highestReceivedNode = s.nodeByRoot[bytesutil.ToBytes32(pb.HighestReceivedRoot)]
+	if s.highestReceivedNode == nil {
+		s.highestReceivedNode = s.headNode
+	}
+	currentEpoch := slots.EpochsSinceGenesis(time.Unix(int64(s.genesisTime), 0))
+	if err := s.treeRootNode.updateBestDescendant(ctx, s.justifiedCheckpoint.Epoch, s.finalizedCheckpoint.Epoch, currentEpoch); err != nil {
+		return errors.Wrap(err, "could not update best descendant")
+	}
+
+	f.store = s
+	f.votes = votes
+	f.balances = pb.Balances
+	f.justifiedBalances = pb.JustifiedBalances
+	f.numActiveValidators = pb.NumActiveValidators
+	nodeCount.Set(float64(len(s.nodeByRoot)))
+	return nil
+}
+
+// appendTree appends this node and its descendants to nodes, parents before their children.
+func (n *Node) appendTree(nodes []*Node) []*Node {
+	nodes = append(nodes, n)
+	for _, child := range n.children {
+		nodes = child.appendTree(nodes)
+	}
+	return nodes
+}
+
+func nodeRoot(n *Node) [32]byte {
+	if n == nil {
+		return [32]byte{}
+	}
+	return n.root
+}
+
+func rootBytes(r [32]byte) []byte {
+	return r[:]
+}
+
+func checkpointProto(cp *forkchoicetypes.Checkpoint) *ethpb.Checkpoint {
+	return &ethpb.Checkpoint{Epoch: cp.Epoch, Root: rootBytes(cp.Root)}
+}
+
+func checkpointFromProto(cp *ethpb.Checkpoint) *forkchoicetypes.Checkpoint {
+	return &forkchoicetypes.Checkpoint{Epoch: cp.Epoch, Root: bytesutil.ToBytes32(cp.Root)}
+}
@@ -0,0 +1,115 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestForkChoice_SnapshotRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	f := explainTestForkChoice(t)
+	f.store.unrealizedJustifiedCheckpoint.Epoch = 1
+	f.store.receivedBlocksLastEpoch[3] = 3
+	f.numActiveValidators = 4
+	require.NoError(t, f.SetOptimisticToValid(ctx, indexToHash(2)))
+	snapshot, err := f.Snapshot()
+	require.NoError(t, err)
+	votes := append([]Vote{}, f.votes...)
+	// The snapshot is a copy, which is not affected by the updates of fork choice before it is encoded.
+	f.ProcessAttestation(ctx, []uint64{7}, indexToHash(3), 3)
+	enc, err := snapshot.Marshal()
+	require.NoError(t, err)
+	f.votes = votes
+
+	restored := New()
+	require.NoError(t, restored.RestoreSnapshot(ctx, enc))
+	want, err := f.ForkChoiceDump(ctx)
+	require.NoError(t, err)
+	got, err := restored.ForkChoiceDump(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+	assert.DeepEqual(t, f.votes, restored.votes)
+	assert.DeepEqual(t, f.balances, restored.balances)
+	assert.DeepEqual(t, f.justifiedBalances, restored.justifiedBalances)
+	assert.DeepEqual(t, f.store.slashedIndices, restored.store.slashedIndices)
+	assert.Equal(t, f.numActiveValidators, restored.numActiveValidators)
+	assert.Equal(t, f.store.committeeWeight, restored.store.committeeWeight)
+	assert.Equal(t, f.store.receivedBlocksLastEpoch, restored.store.receivedBlocksLastEpoch)
+	assert.Equal(t, f.store.genesisTime, restored.store.genesisTime)
+	assert.Equal(t, f.CachedHeadRoot(), restored.CachedHeadRoot())
+	assert.Equal(t, f.HighestReceivedBlockSlot(), restored.HighestReceivedBlockSlot())
+	assert.Equal(t, true, restored.IsCanonical(indexToHash(1)))
+	optimistic, err := restored.IsOptimistic(indexToHash(3))
+	require.NoError(t, err)
+	assert.Equal(t, true, optimistic)
+	for root, n := range f.store.nodeByRoot {
+		r := restored.store.nodeByRoot[root]
+		require.NotNil(t, r)
+		assert.Equal(t, nodeRoot(n.target), nodeRoot(r.target))
+		assert.Equal(t, nodeRoot(n.bestDescendant), nodeRoot(r.bestDescendant))
+		assert.Equal(t, r, restored.store.nodeByPayload[n.payloadHash])
+	}
+
+	// The restored store keeps working.
+	restored.ProcessAttestation(ctx, []uint64{0, 2}, indexToHash(3), 2)
+	head, err := restored.Head(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), head)
+}
+
+func TestForkChoice_RestoreSnapshot_Invalid(t *testing.T) {
+	ctx := context.Background()
+	f := explainTestForkChoice(t)
+	s, err := f.Snapshot()
+	require.NoError(t, err)
+	snapshot, err := s.Marshal()
+	require.NoError(t, err)
+	// withSnapshot encodes the snapshot after applying the change to a copy of it.
+	withSnapshot := func(change func(*ethpb.ForkChoiceSnapshot)) []byte {
+		pb := proto.Clone(s.(*Snapshot).pb).(*ethpb.ForkChoiceSnapshot)
+		change(pb)
+		enc, err := pb.MarshalSSZ()
+		require.NoError(t, err)
+		return enc
+	}
+
+	tests := []struct {
+		name     string
+		snapshot []byte
+		err      string
+	}{
+		{name: "empty", snapshot: nil, err: "invalid forkchoice snapshot"},
+		{name: "truncated", snapshot: snapshot[:len(snapshot)-1], err: "invalid forkchoice snapshot"},
+		{name: "version", snapshot: withSnapshot(func(pb *ethpb.ForkChoiceSnapshot) { pb.Version++ }), err: "unsupported version"},
+		{name: "slots per epoch", snapshot: withSnapshot(func(pb *ethpb.ForkChoiceSnapshot) {
+			pb.ReceivedBlocksLastEpoch = pb.ReceivedBlocksLastEpoch[1:]
+		}), err: "slots per epoch"},
+		{name: "no nodes", snapshot: withSnapshot(func(pb *ethpb.ForkChoiceSnapshot) { pb.Nodes = nil }), err: "snapshot has no nodes"},
+		{name: "duplicate node", snapshot: withSnapshot(func(pb *ethpb.ForkChoiceSnapshot) {
+			pb.Nodes = append(pb.Nodes, pb.Nodes[len(pb.Nodes)-1])
+		}), err: "duplicate node"},
+		{name: "unknown parent", snapshot: withSnapshot(func(pb *ethpb.ForkChoiceSnapshot) {
+			pb.Nodes = append(pb.Nodes[:1], pb.Nodes[2:]...)
+		}), err: "unknown parent"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restored := explainTestForkChoice(t)
+			restored.ProcessAttestation(ctx, []uint64{5}, indexToHash(3), 3)
+			votes := append([]Vote{}, restored.votes...)
+			err := restored.RestoreSnapshot(ctx, tt.snapshot)
+			require.ErrorIs(t, err, errInvalidSnapshot)
+			assert.ErrorContains(t, tt.err, err)
+			// Fork choice is left untouched.
+			assert.DeepEqual(t, votes, restored.votes)
+		})
+	}
+
+	_, err = New().Snapshot()
+	require.ErrorIs(t, err, ErrNilNode)
+}
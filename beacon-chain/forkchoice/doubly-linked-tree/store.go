@@ -23,6 +23,23 @@ func (s *Store) head(ctx context.Context) ([32]byte, error) {
 		return [32]byte{}, err
 	}
 
+	bestDescendant, err := s.viableHead()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	// Update metrics.
+	if bestDescendant != s.headNode {
+		headChangesCount.Inc()
+		headSlotNumber.Set(float64(bestDescendant.slot))
+		s.headNode = bestDescendant
+	}
+
+	return bestDescendant.root, nil
+}
+
+// viableHead returns the best descendant of the justified node, or the justified node itself, if it is viable for head.
+func (s *Store) viableHead() (*Node, error) {
 	// JustifiedRoot has to be known
 	justifiedNode, ok := s.nodeByRoot[s.justifiedCheckpoint.Root]
 	if !ok || justifiedNode == nil {
@@ -32,7 +49,7 @@ func (s *Store) head(ctx context.Context) ([32]byte, error) {
 		if s.justifiedCheckpoint.Epoch == params.BeaconConfig().GenesisEpoch {
 			justifiedNode = s.treeRootNode
 		} else {
-			return [32]byte{}, errors.WithMessage(errUnknownJustifiedRoot, fmt.Sprintf("%#x", s.justifiedCheckpoint.Root))
+			return nil, errors.WithMessage(errUnknownJustifiedRoot, fmt.Sprintf("%#x", s.justifiedCheckpoint.Root))
 		}
 	}
 
@@ -45,19 +62,11 @@ func (s *Store) head(ctx context.Context) ([32]byte, error) {
 	currentEpoch := slots.EpochsSinceGenesis(time.Unix(int64(s.genesisTime), 0))
 	if !bestDescendant.viableForHead(s.justifiedCheckpoint.Epoch, currentEpoch) {
 		s.allTipsAreInvalid = true
-		return [32]byte{}, fmt.Errorf("head at slot %d with weight %d is not eligible, finalizedEpoch, justified Epoch %d, %d != %d, %d",
+		return nil, fmt.Errorf("head at slot %d with weight %d is not eligible, finalizedEpoch, justified Epoch %d, %d != %d, %d",
 			bestDescendant.slot, bestDescendant.weight/10e9, bestDescendant.finalizedEpoch, bestDescendant.justifiedEpoch, s.finalizedCheckpoint.Epoch, s.justifiedCheckpoint.Epoch)
 	}
 	s.allTipsAreInvalid = false
-
-	// Update metrics.
-	if bestDescendant != s.headNode {
-		headChangesCount.Inc()
-		headSlotNumber.Set(float64(bestDescendant.slot))
-		s.headNode = bestDescendant
-	}
-
-	return bestDescendant.root, nil
+	return bestDescendant, nil
 }
 
 // insert registers a new block node to the fork choice store's node list.
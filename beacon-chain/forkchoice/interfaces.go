@@ -50,12 +50,26 @@ type AttestationProcessor interface {
 	ProcessAttestation(context.Context, []uint64, [32]byte, primitives.Epoch)
 }
 
+// Snapshot is a copy of fork choice taken under its lock, which is encoded once the lock is released.
+type Snapshot interface {
+	Marshal() ([]byte, error)
+}
+
+// Simulator is a copy of fork choice taken under its lock, which a simulation is run against once the lock is
+// released.
+type Simulator interface {
+	Simulate(context.Context, *forkchoice2.Simulation) (*forkchoice2.SimulationResult, error)
+}
+
 // Getter returns fork choice related information.
 type Getter interface {
 	FastGetter
 	AncestorRoot(ctx context.Context, root [32]byte, slot primitives.Slot) ([32]byte, error)
 	CommonAncestor(ctx context.Context, root1 [32]byte, root2 [32]byte) ([32]byte, primitives.Slot, error)
 	ForkChoiceDump(context.Context) (*forkchoice2.Dump, error)
+	Explain(context.Context, [32]byte) (*forkchoice2.Explanation, error)
+	Simulator() (Simulator, error)
+	Snapshot() (Snapshot, error)
 	Tips() ([][32]byte, []primitives.Slot)
 }
 
@@ -93,4 +107,5 @@ type Setter interface {
 	NewSlot(context.Context, primitives.Slot) error
 	SetBalancesByRooter(BalancesByRooter)
 	InsertSlashedIndex(context.Context, primitives.ValidatorIndex)
+	RestoreSnapshot(context.Context, []byte) error
 }
@@ -0,0 +1,86 @@
+package monitor
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// HistoryLength is the number of epochs of performance history kept in memory for every tracked validator.
+const HistoryLength = primitives.Epoch(2048)
+
+// ErrNotTracked is returned when the history of a validator that is not tracked by the monitor is requested.
+var ErrNotTracked = errors.New("validator is not tracked by the validator monitor")
+
+// PerformanceHistoryFetcher provides the performance history of the validators tracked by the validator monitor.
+type PerformanceHistoryFetcher interface {
+	PerformanceHistory(idx primitives.ValidatorIndex, from, to primitives.Epoch) ([]EpochPerformance, error)
+}
+
+// EpochPerformance is the performance of a tracked validator during one epoch, as observed by the monitor.
+// Attestation fields refer to the attestation for the epoch, the other fields to the blocks of the epoch.
+type EpochPerformance struct {
+	Epoch                 primitives.Epoch
+	Balance               uint64
+	BalanceChange         int64
+	AttestationIncluded   bool
+	InclusionDistance     primitives.Slot
+	CorrectSource         bool
+	CorrectTarget         bool
+	CorrectHead           bool
+	SyncCommitteeExpected uint64
+	SyncCommitteeIncluded uint64
+	ProposedBlocks        uint64
+}
+
+// PerformanceHistory returns the per-epoch performance of a tracked validator from epoch `from` to epoch `to`, inclusive.
+// Epochs during which the monitor did not observe anything for the validator are omitted.
+func (s *Service) PerformanceHistory(idx primitives.ValidatorIndex, from, to primitives.Epoch) ([]EpochPerformance, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if !s.trackedIndex(idx) {
+		return nil, ErrNotTracked
+	}
+	h := s.history[idx]
+	start := sort.Search(len(h), func(i int) bool { return h[i].Epoch >= from })
+	end := sort.Search(len(h), func(i int) bool { return h[i].Epoch > to })
+	if start >= end {
+		return []EpochPerformance{}, nil
+	}
+	return append([]EpochPerformance{}, h[start:end]...), nil
+}
+
+// updateHistory applies the update to the history entry of the validator for the given epoch, creating it if needed,
+// and drops the entries that fell out of the history window. It assumes the caller holds the service Lock.
+func (s *Service) updateHistory(idx primitives.ValidatorIndex, epoch primitives.Epoch, update func(p *EpochPerformance)) {
+	if s.history == nil {
+		s.history = make(map[primitives.ValidatorIndex][]EpochPerformance)
+	}
+	h := s.history[idx]
+	if len(h) > 0 && h[len(h)-1].Epoch >= HistoryLength && epoch <= h[len(h)-1].Epoch-HistoryLength {
+		return
+	}
+	i := sort.Search(len(h), func(i int) bool { return h[i].Epoch >= epoch })
+	if i == len(h) || h[i].Epoch != epoch {
+		h = append(h, EpochPerformance{})
+		copy(h[i+1:], h[i:])
+		h[i] = EpochPerformance{Epoch: epoch}
+	}
+	update(&h[i])
+
+	if newest := h[len(h)-1].Epoch; newest >= HistoryLength {
+		cut := sort.Search(len(h), func(i int) bool { return h[i].Epoch > newest-HistoryLength })
+		h = h[cut:]
+	}
+	s.history[idx] = h
+}
+
+// updateHistoryBalance records the latest balance of the validator observed during the epoch.
+// It assumes the caller holds the service Lock.
+func (s *Service) updateHistoryBalance(idx primitives.ValidatorIndex, epoch primitives.Epoch, balance uint64, change int64) {
+	s.updateHistory(idx, epoch, func(p *EpochPerformance) {
+		p.Balance = balance
+		p.BalanceChange += change
+	})
+}
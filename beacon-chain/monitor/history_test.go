@@ -0,0 +1,106 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestUpdateHistory_KeepsEpochsSorted(t *testing.T) {
+	s := &Service{}
+	for _, e := range []primitives.Epoch{5, 2, 8, 2, 5} {
+		s.updateHistory(1, e, func(p *EpochPerformance) { p.ProposedBlocks++ })
+	}
+	h := s.history[1]
+	require.Equal(t, 3, len(h))
+	require.Equal(t, primitives.Epoch(2), h[0].Epoch)
+	require.Equal(t, uint64(2), h[0].ProposedBlocks)
+	require.Equal(t, primitives.Epoch(5), h[1].Epoch)
+	require.Equal(t, uint64(2), h[1].ProposedBlocks)
+	require.Equal(t, primitives.Epoch(8), h[2].Epoch)
+	require.Equal(t, uint64(1), h[2].ProposedBlocks)
+}
+
+func TestUpdateHistory_DropsOldEpochs(t *testing.T) {
+	s := &Service{}
+	s.updateHistoryBalance(1, 0, 32, 0)
+	s.updateHistoryBalance(1, 1, 33, 1)
+	s.updateHistoryBalance(1, HistoryLength, 34, 1)
+	h := s.history[1]
+	require.Equal(t, 2, len(h))
+	require.Equal(t, primitives.Epoch(1), h[0].Epoch)
+	require.Equal(t, HistoryLength, h[1].Epoch)
+
+	// Epochs that already fell out of the window are not recorded again.
+	s.updateHistoryBalance(1, 0, 32, 0)
+	require.Equal(t, 2, len(s.history[1]))
+	require.Equal(t, primitives.Epoch(1), s.history[1][0].Epoch)
+}
+
+func TestPerformanceHistory(t *testing.T) {
+	s := setupService(t)
+	for e := primitives.Epoch(1); e <= 5; e++ {
+		s.updateHistoryBalance(1, e, 32000000000+uint64(e), 1)
+	}
+
+	h, err := s.PerformanceHistory(1, 2, 4)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(h))
+	require.Equal(t, primitives.Epoch(2), h[0].Epoch)
+	require.Equal(t, uint64(32000000004), h[2].Balance)
+
+	h, err = s.PerformanceHistory(1, 6, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(h))
+
+	// A tracked validator without any history.
+	h, err = s.PerformanceHistory(2, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(h))
+
+	_, err = s.PerformanceHistory(3, 0, 10)
+	require.ErrorIs(t, err, ErrNotTracked)
+}
+
+func TestProcessIncludedAttestation_UpdatesHistory(t *testing.T) {
+	s := setupService(t)
+	state, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, state.SetSlot(2))
+	require.NoError(t, state.SetCurrentParticipationBits(bytes.Repeat([]byte{0xff}, 13)))
+
+	att := &ethpb.Attestation{
+		Data: &ethpb.AttestationData{
+			Slot:            1,
+			CommitteeIndex:  0,
+			BeaconBlockRoot: bytesutil.PadTo([]byte("hello-world"), 32),
+			Source: &ethpb.Checkpoint{
+				Epoch: 0,
+				Root:  bytesutil.PadTo([]byte("hello-world"), 32),
+			},
+			Target: &ethpb.Checkpoint{
+				Epoch: 1,
+				Root:  bytesutil.PadTo([]byte("hello-world"), 32),
+			},
+		},
+		AggregationBits: bitfield.Bitlist{0b11, 0b1},
+	}
+	s.processIncludedAttestation(context.Background(), state, att)
+
+	h, err := s.PerformanceHistory(12, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(h))
+	require.Equal(t, true, h[0].AttestationIncluded)
+	require.Equal(t, primitives.Slot(1), h[0].InclusionDistance)
+	require.Equal(t, true, h[0].CorrectSource)
+	require.Equal(t, true, h[0].CorrectTarget)
+	require.Equal(t, true, h[0].CorrectHead)
+	require.Equal(t, uint64(32000000000), h[0].Balance)
+	require.Equal(t, int64(100000000), h[0].BalanceChange)
+}
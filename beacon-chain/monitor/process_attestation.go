@@ -93,7 +93,7 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 			inclusionSlotGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(latestPerf.inclusionSlot))
 			aggregatedPerf.totalDistance += uint64(latestPerf.inclusionSlot - latestPerf.attestedSlot)
 
-			if state.Version() == version.Altair {
+			if state.Version() >= version.Altair {
 				targetIdx := params.BeaconConfig().TimelyTargetFlagIndex
 				sourceIdx := params.BeaconConfig().TimelySourceFlagIndex
 				headIdx := params.BeaconConfig().TimelyHeadFlagIndex
@@ -155,6 +155,14 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 
 			s.latestPerformance[primitives.ValidatorIndex(idx)] = latestPerf
 			s.aggregatedPerformance[primitives.ValidatorIndex(idx)] = aggregatedPerf
+			s.updateHistoryBalance(primitives.ValidatorIndex(idx), slots.ToEpoch(state.Slot()), balance, balanceChg)
+			s.updateHistory(primitives.ValidatorIndex(idx), slots.ToEpoch(latestPerf.attestedSlot), func(p *EpochPerformance) {
+				p.AttestationIncluded = true
+				p.InclusionDistance = latestPerf.inclusionSlot - latestPerf.attestedSlot
+				p.CorrectSource = latestPerf.timelySource
+				p.CorrectTarget = latestPerf.timelyTarget
+				p.CorrectHead = latestPerf.timelyHead
+			})
 			log.WithFields(logFields).Info("Attestation included")
 		}
 	}
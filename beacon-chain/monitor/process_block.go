@@ -87,6 +87,10 @@ func (s *Service) processProposedBlock(state state.BeaconState, root [32]byte, b
 		aggPerf := s.aggregatedPerformance[blk.ProposerIndex()]
 		aggPerf.totalProposedCount++
 		s.aggregatedPerformance[blk.ProposerIndex()] = aggPerf
+		s.updateHistoryBalance(blk.ProposerIndex(), slots.ToEpoch(blk.Slot()), balance, balanceChg)
+		s.updateHistory(blk.ProposerIndex(), slots.ToEpoch(blk.Slot()), func(p *EpochPerformance) {
+			p.ProposedBlocks++
+		})
 
 		parentRoot := blk.ParentRoot()
 		log.WithFields(logrus.Fields{
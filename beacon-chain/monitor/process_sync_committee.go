@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
 )
 
@@ -64,6 +65,11 @@ func (s *Service) processSyncAggregate(state state.BeaconState, blk interfaces.R
 			aggPerf := s.aggregatedPerformance[validatorIdx]
 			aggPerf.totalSyncCommitteeContributions += uint64(contrib)
 			s.aggregatedPerformance[validatorIdx] = aggPerf
+			s.updateHistoryBalance(validatorIdx, slots.ToEpoch(blk.Slot()), balance, balanceChg)
+			s.updateHistory(validatorIdx, slots.ToEpoch(blk.Slot()), func(p *EpochPerformance) {
+				p.SyncCommitteeExpected += uint64(len(committeeIndices))
+				p.SyncCommitteeIncluded += uint64(contrib)
+			})
 
 			syncCommitteeContributionCounter.WithLabelValues(
 				fmt.Sprintf("%d", validatorIdx)).Add(float64(contrib))
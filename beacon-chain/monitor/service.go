@@ -70,12 +70,13 @@ type Service struct {
 	isLogging bool
 
 	// Locks access to TrackedValidators, latestPerformance, aggregatedPerformance,
-	// trackedSyncedCommitteeIndices and lastSyncedEpoch
+	// history, trackedSyncedCommitteeIndices and lastSyncedEpoch
 	sync.RWMutex
 
 	TrackedValidators           map[primitives.ValidatorIndex]bool
 	latestPerformance           map[primitives.ValidatorIndex]ValidatorLatestPerformance
 	aggregatedPerformance       map[primitives.ValidatorIndex]ValidatorAggregatedPerformance
+	history                     map[primitives.ValidatorIndex][]EpochPerformance
 	trackedSyncCommitteeIndices map[primitives.ValidatorIndex][]primitives.CommitteeIndex
 	lastSyncedEpoch             primitives.Epoch
 }
@@ -90,6 +91,7 @@ func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []p
 		TrackedValidators:           make(map[primitives.ValidatorIndex]bool, len(tracked)),
 		latestPerformance:           make(map[primitives.ValidatorIndex]ValidatorLatestPerformance),
 		aggregatedPerformance:       make(map[primitives.ValidatorIndex]ValidatorAggregatedPerformance),
+		history:                     make(map[primitives.ValidatorIndex][]EpochPerformance),
 		trackedSyncCommitteeIndices: make(map[primitives.ValidatorIndex][]primitives.CommitteeIndex),
 		isLogging:                   false,
 	}
@@ -29,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/v5/beacon-chain/deterministic-genesis"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
@@ -58,11 +59,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	backfillflags "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/backfill/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/slice"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/prometheus"
 	"github.com/prysmaticlabs/prysm/v5/runtime"
 	"github.com/prysmaticlabs/prysm/v5/runtime/debug"
@@ -103,6 +106,7 @@ type BeaconNode struct {
 	depositCache            cache.DepositCache
 	trackedValidatorsCache  *cache.TrackedValidatorsCache
 	payloadIDCache          *cache.PayloadIDCache
+	proposalAuditCache      *cache.ProposalAuditCache
 	stateFeed               *event.Feed
 	blockFeed               *event.Feed
 	opFeed                  *event.Feed
@@ -291,6 +295,12 @@ func startBaseServices(cliCtx *cli.Context, beacon *BeaconNode, depositAddress s
 	}
 	beacon.BlobStorage.WarmCache()
 
+	auditCache, err := cache.LoadProposalAuditCache(ctx, beacon.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load proposal audits")
+	}
+	beacon.proposalAuditCache = auditCache
+
 	log.Debugln("Starting Slashing DB")
 	if err := beacon.startSlasherDB(cliCtx); err != nil {
 		return nil, errors.Wrap(err, "could not start slashing DB")
@@ -354,6 +364,16 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register sync service")
 	}
 
+	log.Debugln("Registering History Pruner Service")
+	if err := beacon.registerPrunerService(cliCtx, bfs); err != nil {
+		return errors.Wrap(err, "could not register history pruner service")
+	}
+
+	log.Debugln("Registering Backup Service")
+	if err := beacon.registerBackupService(cliCtx); err != nil {
+		return errors.Wrap(err, "could not register backup service")
+	}
+
 	log.Debugln("Registering Slasher Service")
 	if err := beacon.registerSlasherService(); err != nil {
 		return errors.Wrap(err, "could not register slasher service")
@@ -364,6 +384,11 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register builder service")
 	}
 
+	log.Debugln("Registering Validator Monitoring Service")
+	if err := beacon.registerValidatorMonitorService(beacon.initialSyncComplete); err != nil {
+		return errors.Wrap(err, "could not register validator monitoring service")
+	}
+
 	log.Debugln("Registering RPC Service")
 	router := newRouter(cliCtx)
 	if err := beacon.registerRPCService(router); err != nil {
@@ -375,11 +400,6 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register GRPC gateway service")
 	}
 
-	log.Debugln("Registering Validator Monitoring Service")
-	if err := beacon.registerValidatorMonitorService(beacon.initialSyncComplete); err != nil {
-		return errors.Wrap(err, "could not register validator monitoring service")
-	}
-
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		log.Debugln("Registering Prometheus Service")
 		if err := beacon.registerPrometheusService(cliCtx); err != nil {
@@ -651,6 +671,10 @@ func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
 
 func (b *BeaconNode) startStateGen(ctx context.Context, bfs coverage.AvailableBlocker, fc forkchoice.ForkChoicer) error {
 	opts := []stategen.Option{stategen.WithAvailableBlocker(bfs)}
+	archive := b.cliCtx.Bool(flags.HistoricalStateArchive.Name)
+	if archive {
+		opts = append(opts, stategen.WithStateArchive())
+	}
 	sg := stategen.New(b.db, fc, opts...)
 
 	cp, err := b.db.FinalizedCheckpoint(ctx)
@@ -679,6 +703,13 @@ func (b *BeaconNode) startStateGen(ctx context.Context, bfs coverage.AvailableBl
 	}
 
 	b.stateGen = sg
+	if archive {
+		go func() {
+			if err := sg.MigrateToArchive(b.ctx); err != nil {
+				log.WithError(err).Error("Could not migrate finalized history to the state archive")
+			}
+		}()
+	}
 	return nil
 }
 
@@ -689,28 +720,31 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	}
 
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
-		NoDiscovery:          cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:          slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		Discv5BootStrapAddrs: p2p.ParseBootStrapAddrs(bootstrapNodeAddrs),
-		RelayNodeAddr:        cliCtx.String(cmd.RelayNode.Name),
-		DataDir:              dataDir,
-		LocalIP:              cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:          cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:              cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:           cliCtx.String(cmd.P2PPrivKey.Name),
-		StaticPeerID:         cliCtx.Bool(cmd.P2PStaticID.Name),
-		MetaDataDir:          cliCtx.String(cmd.P2PMetadata.Name),
-		QUICPort:             cliCtx.Uint(cmd.P2PQUICPort.Name),
-		TCPPort:              cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:              cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:             cliCtx.Uint(cmd.P2PMaxPeers.Name),
-		QueueSize:            cliCtx.Uint(cmd.PubsubQueueSize.Name),
-		AllowListCIDR:        cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:         slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:           cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		StateNotifier:        b,
-		DB:                   b.db,
-		ClockWaiter:          b.clockWaiter,
+		NoDiscovery:             cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:             slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		Discv5BootStrapAddrs:    p2p.ParseBootStrapAddrs(bootstrapNodeAddrs),
+		RelayNodeAddr:           cliCtx.String(cmd.RelayNode.Name),
+		DataDir:                 dataDir,
+		LocalIP:                 cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:             cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:                 cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:              cliCtx.String(cmd.P2PPrivKey.Name),
+		StaticPeerID:            cliCtx.Bool(cmd.P2PStaticID.Name),
+		MetaDataDir:             cliCtx.String(cmd.P2PMetadata.Name),
+		QUICPort:                cliCtx.Uint(cmd.P2PQUICPort.Name),
+		TCPPort:                 cliCtx.Uint(cmd.P2PTCPPort.Name),
+		UDPPort:                 cliCtx.Uint(cmd.P2PUDPPort.Name),
+		MaxPeers:                cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		QueueSize:               cliCtx.Uint(cmd.PubsubQueueSize.Name),
+		AllowListCIDR:           cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:            slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		EnableUPnP:              cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		StateNotifier:           b,
+		DB:                      b.db,
+		ClockWaiter:             b.clockWaiter,
+		GossipRecordDir:         cliCtx.String(flags.GossipRecordDir.Name),
+		GossipRecordMaxFileSize: int64(cliCtx.Int(flags.GossipRecordMaxFileSizeMB.Name)) * 1024 * 1024,
+		GossipRecordMaxFiles:    cliCtx.Int(flags.GossipRecordMaxFiles.Name),
 	})
 	if err != nil {
 		return err
@@ -866,6 +900,59 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}, bFil
 	return b.services.RegisterService(rs)
 }
 
+func (b *BeaconNode) registerPrunerService(cliCtx *cli.Context, bfs *backfill.Store) error {
+	retention := cliCtx.Uint64(flags.HistoryRetentionEpochs.Name)
+	if retention == 0 {
+		return nil
+	}
+	if cliCtx.IsSet(backfillflags.BackfillOldestSlot.Name) {
+		return fmt.Errorf("--%s cannot be used with --%s", flags.HistoryRetentionEpochs.Name, backfillflags.BackfillOldestSlot.Name)
+	}
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	svc, err := pruner.New(b.ctx, &pruner.Config{
+		Database:            b.db,
+		Tracker:             bfs,
+		HeadFetcher:         chainService,
+		ClockWaiter:         b.clockWaiter,
+		InitialSyncComplete: b.initialSyncComplete,
+		RetentionEpochs:     primitives.Epoch(retention),
+	})
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(svc)
+}
+
+// registerBackupService registers the service writing the scheduled database backups, which also writes the
+// backups requested from the webhook.
+func (b *BeaconNode) registerBackupService(cliCtx *cli.Context) error {
+	interval := cliCtx.Duration(cmd.BackupIntervalFlag.Name)
+	if interval == 0 && !cliCtx.IsSet(cmd.EnableBackupWebhookFlag.Name) {
+		return nil
+	}
+	outputDir := cliCtx.String(cmd.BackupWebhookOutputDir.Name)
+	if outputDir == "" {
+		outputDir = filepath.Join(b.db.DatabasePath(), "backups")
+	}
+	svc, err := backup.NewService(b.ctx, &backup.Config{
+		Exporter:  b.db,
+		Database:  "beacondb",
+		OutputDir: outputDir,
+		Interval:  interval,
+		Retention: cliCtx.Int(cmd.BackupRetentionFlag.Name),
+		Compress:  cliCtx.Bool(cmd.BackupCompressFlag.Name),
+		FullEvery: cliCtx.Int(cmd.BackupFullEveryFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerInitialSyncService(complete chan struct{}) error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -937,10 +1024,20 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 	}
 
 	var slasherService *slasher.Service
+	var slasherDB db.SlasherDatabase
+	var slashingChecker slasher.SlashingChecker
 	if features.Get().EnableSlasher {
 		if err := b.services.FetchService(&slasherService); err != nil {
 			return err
 		}
+		slasherDB = b.slasherDB
+		slashingChecker = slasherService
+	}
+
+	var validatorMonitor monitor.PerformanceHistoryFetcher
+	var monitorService *monitor.Service
+	if err := b.services.FetchService(&monitorService); err == nil {
+		validatorMonitor = monitorService
 	}
 
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
@@ -1019,12 +1116,17 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		BlobStorage:                   b.BlobStorage,
 		TrackedValidatorsCache:        b.trackedValidatorsCache,
 		PayloadIDCache:                b.payloadIDCache,
+		ProposalAuditCache:            b.proposalAuditCache,
+		BuilderRevealMissCooldown:     primitives.Slot(b.cliCtx.Uint64(flags.BuilderRevealMissCooldownSlots.Name)),
+		SlasherDB:                     slasherDB,
+		SlashingChecker:               slashingChecker,
+		ValidatorMonitor:              validatorMonitor,
 	})
 
 	return b.services.RegisterService(rpcService)
 }
 
-func (b *BeaconNode) registerPrometheusService(_ *cli.Context) error {
+func (b *BeaconNode) registerPrometheusService(cliCtx *cli.Context) error {
 	var additionalHandlers []prometheus.Handler
 	var p *p2p.Service
 	if err := b.services.FetchService(&p); err != nil {
@@ -1032,6 +1134,20 @@ func (b *BeaconNode) registerPrometheusService(_ *cli.Context) error {
 	}
 	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/p2p", Handler: p.InfoHandler})
 
+	if cliCtx.IsSet(cmd.EnableBackupWebhookFlag.Name) {
+		var backupService *backup.Service
+		if err := b.services.FetchService(&backupService); err != nil {
+			return err
+		}
+		additionalHandlers = append(
+			additionalHandlers,
+			prometheus.Handler{
+				Path:    "/db/backup",
+				Handler: backup.Handler(backupService, cliCtx.String(cmd.BackupWebhookOutputDir.Name)),
+			},
+		)
+	}
+
 	var c *blockchain.Service
 	if err := b.services.FetchService(&c); err != nil {
 		panic(err)
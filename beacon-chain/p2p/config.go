@@ -34,6 +34,10 @@ type Config struct {
 	StateNotifier        statefeed.Notifier
 	DB                   db.ReadOnlyDatabase
 	ClockWaiter          startup.ClockWaiter
+	// GossipRecordDir enables the recording of received gossip messages into the directory.
+	GossipRecordDir         string
+	GossipRecordMaxFileSize int64
+	GossipRecordMaxFiles    int
 }
 
 // validateConfig validates whether the values provided are accurate and will set
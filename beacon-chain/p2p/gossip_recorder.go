@@ -0,0 +1,172 @@
+package p2p
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/recorder"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// gossipRecordQueueSize is the number of records waiting to be written, beyond which records are dropped
+	// so that recording never slows down gossip processing.
+	gossipRecordQueueSize = 4096
+	// gossipRecordFlushInterval is how often the buffered records are written to disk.
+	gossipRecordFlushInterval = time.Second
+	// gossipArrivalTTL is how long the arrival time of a message is kept while waiting for its validation result.
+	gossipArrivalTTL = time.Minute
+)
+
+// gossipRecorder writes every gossip message received from a peer, with the result of its validation, to a
+// rotating on-disk recording. It is fed by the gossip tracer.
+type gossipRecorder struct {
+	writer       *recorder.Writer
+	records      chan *recorder.Record
+	arrivals     map[*pubsubpb.Message]time.Time
+	arrivalsLock sync.Mutex
+	cancel       context.CancelFunc
+	done         chan struct{}
+}
+
+// newGossipRecorder starts recording gossip messages into the configured directory, until stop is called.
+func newGossipRecorder(ctx context.Context, cfg *Config) (*gossipRecorder, error) {
+	w, err := recorder.NewWriter(cfg.GossipRecordDir, cfg.GossipRecordMaxFileSize, cfg.GossipRecordMaxFiles)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create gossip recorder")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	r := &gossipRecorder{
+		writer:   w,
+		records:  make(chan *recorder.Record, gossipRecordQueueSize),
+		arrivals: make(map[*pubsubpb.Message]time.Time),
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	go r.run(ctx)
+	log.WithFields(logrus.Fields{
+		"dir":         cfg.GossipRecordDir,
+		"maxFileSize": cfg.GossipRecordMaxFileSize,
+		"maxFiles":    cfg.GossipRecordMaxFiles,
+	}).Info("Recording received gossip messages")
+	return r, nil
+}
+
+// received notes the arrival time of the messages of an incoming rpc, before they are validated.
+func (r *gossipRecorder) received(rpc *pubsub.RPC) {
+	if r == nil || len(rpc.Publish) == 0 {
+		return
+	}
+	now := time.Now()
+	r.arrivalsLock.Lock()
+	defer r.arrivalsLock.Unlock()
+	for _, msg := range rpc.Publish {
+		r.arrivals[msg] = now
+	}
+}
+
+// record queues a received message with the result of its validation for writing.
+func (r *gossipRecorder) record(msg *pubsub.Message, result, reason string) {
+	if r == nil || msg.Local || msg.Topic == nil {
+		return
+	}
+	r.arrivalsLock.Lock()
+	arrival, ok := r.arrivals[msg.Message]
+	delete(r.arrivals, msg.Message)
+	r.arrivalsLock.Unlock()
+	if !ok {
+		arrival = time.Now()
+	}
+	rec := &recorder.Record{
+		Topic:       *msg.Topic,
+		Peer:        msg.ReceivedFrom.String(),
+		ArrivalTime: arrival,
+		Data:        msg.Data,
+		Result:      result,
+		Reason:      reason,
+	}
+	select {
+	case r.records <- rec:
+	default:
+		gossipRecordDropped.Inc()
+	}
+}
+
+// reject records a message which pubsub rejected, with the reason given by pubsub.
+func (r *gossipRecorder) reject(msg *pubsub.Message, reason string) {
+	if r == nil {
+		return
+	}
+	switch reason {
+	case pubsub.RejectValidationFailed:
+		r.record(msg, recorder.ResultReject, "")
+	case pubsub.RejectValidationIgnored:
+		r.record(msg, recorder.ResultIgnore, "")
+	default:
+		r.record(msg, recorder.ResultDropped, reason)
+	}
+}
+
+// run writes the queued records until the recorder is stopped.
+func (r *gossipRecorder) run(ctx context.Context) {
+	defer close(r.done)
+	ticker := time.NewTicker(gossipRecordFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case rec := <-r.records:
+			r.write(rec)
+		case <-ticker.C:
+			if err := r.writer.Flush(); err != nil {
+				log.WithError(err).Error("Could not flush gossip recording")
+			}
+			r.pruneArrivals()
+		case <-ctx.Done():
+			for {
+				select {
+				case rec := <-r.records:
+					r.write(rec)
+				default:
+					if err := r.writer.Close(); err != nil {
+						log.WithError(err).Error("Could not close gossip recording")
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+func (r *gossipRecorder) write(rec *recorder.Record) {
+	if err := r.writer.Write(rec); err != nil {
+		log.WithError(err).Error("Could not record gossip message")
+		gossipRecordDropped.Inc()
+		return
+	}
+	gossipRecordedMessages.Inc()
+}
+
+// pruneArrivals forgets the arrival time of messages which never got a validation result, such as messages on
+// topics we are not subscribed to.
+func (r *gossipRecorder) pruneArrivals() {
+	r.arrivalsLock.Lock()
+	defer r.arrivalsLock.Unlock()
+	for msg, arrival := range r.arrivals {
+		if time.Since(arrival) > gossipArrivalTTL {
+			delete(r.arrivals, msg)
+		}
+	}
+}
+
+// stop writes the remaining records and closes the recording.
+func (r *gossipRecorder) stop() {
+	if r == nil {
+		return
+	}
+	r.cancel()
+	<-r.done
+}
@@ -0,0 +1,88 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/recorder"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestGossipRecorder_RecordsTracedMessages(t *testing.T) {
+	dir := t.TempDir()
+	r, err := newGossipRecorder(context.Background(), &Config{
+		GossipRecordDir:         dir,
+		GossipRecordMaxFileSize: 1 << 20,
+		GossipRecordMaxFiles:    1,
+	})
+	require.NoError(t, err)
+	tracer := gossipTracer{recorder: r}
+
+	topic := "/eth2/01020304/beacon_block/ssz_snappy"
+	newMsg := func(data byte, local bool) *pubsub.Message {
+		return &pubsub.Message{
+			Message:      &pubsubpb.Message{Data: []byte{data}, Topic: &topic},
+			ReceivedFrom: peer.ID("peer"),
+			Local:        local,
+		}
+	}
+	accepted, rejected, ignored, dropped, duplicate, local := newMsg(1, false), newMsg(2, false), newMsg(3, false), newMsg(4, false), newMsg(5, false), newMsg(6, true)
+	rpc := &pubsub.RPC{}
+	rpc.Publish = []*pubsubpb.Message{accepted.Message, rejected.Message}
+	before := time.Now()
+	tracer.RecvRPC(rpc)
+
+	tracer.ValidateMessage(accepted)
+	tracer.DeliverMessage(accepted)
+	tracer.RejectMessage(rejected, pubsub.RejectValidationFailed)
+	tracer.RejectMessage(ignored, pubsub.RejectValidationIgnored)
+	tracer.RejectMessage(dropped, pubsub.RejectValidationQueueFull)
+	tracer.DuplicateMessage(duplicate)
+	tracer.DeliverMessage(local)
+	r.stop()
+
+	var got []*recorder.Record
+	require.NoError(t, recorder.Read(dir, func(rec *recorder.Record) error {
+		got = append(got, rec)
+		return nil
+	}))
+	require.Equal(t, 5, len(got))
+	want := []struct {
+		data   byte
+		result string
+		reason string
+	}{
+		{1, recorder.ResultAccept, ""},
+		{2, recorder.ResultReject, ""},
+		{3, recorder.ResultIgnore, ""},
+		{4, recorder.ResultDropped, pubsub.RejectValidationQueueFull},
+		{5, recorder.ResultDuplicate, ""},
+	}
+	for i, w := range want {
+		assert.DeepEqual(t, []byte{w.data}, got[i].Data)
+		assert.Equal(t, w.result, got[i].Result)
+		assert.Equal(t, w.reason, got[i].Reason)
+		assert.Equal(t, topic, got[i].Topic)
+		assert.Equal(t, peer.ID("peer").String(), got[i].Peer)
+	}
+	// The arrival time of the messages of the rpc is the time they were received, before validation.
+	assert.Equal(t, true, !got[0].ArrivalTime.Before(before) && got[0].ArrivalTime.Equal(got[1].ArrivalTime))
+	assert.Equal(t, 0, len(r.arrivals))
+}
+
+func TestGossipTracer_NilRecorder(t *testing.T) {
+	topic := "/eth2/01020304/beacon_block/ssz_snappy"
+	msg := &pubsub.Message{Message: &pubsubpb.Message{Topic: &topic}}
+	tracer := gossipTracer{}
+	tracer.RecvRPC(&pubsub.RPC{})
+	tracer.DeliverMessage(msg)
+	tracer.RejectMessage(msg, pubsub.RejectValidationFailed)
+	tracer.DuplicateMessage(msg)
+	var r *gossipRecorder
+	r.stop()
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"google.golang.org/protobuf/proto"
 )
@@ -22,6 +23,8 @@ var gossipTopicMappings = map[string]proto.Message{
 	SyncCommitteeSubnetTopicFormat:            &ethpb.SyncCommitteeMessage{},
 	BlsToExecutionChangeSubnetTopicFormat:     &ethpb.SignedBLSToExecutionChange{},
 	BlobSubnetTopicFormat:                     &ethpb.BlobSidecar{},
+	LightClientFinalityUpdateTopicFormat:      &ethpbv2.LightClientFinalityUpdate{},
+	LightClientOptimisticUpdateTopicFormat:    &ethpbv2.LightClientOptimisticUpdate{},
 }
 
 // GossipTopicMappings is a function to return the assigned data type
@@ -44,6 +47,22 @@ func GossipTopicMappings(topic string, epoch primitives.Epoch) proto.Message {
 			return &ethpb.SignedBeaconBlockAltair{}
 		}
 	}
+	if topic == LightClientFinalityUpdateTopicFormat {
+		if epoch >= params.BeaconConfig().DenebForkEpoch {
+			return &ethpbv2.LightClientFinalityUpdateDeneb{}
+		}
+		if epoch >= params.BeaconConfig().CapellaForkEpoch {
+			return &ethpbv2.LightClientFinalityUpdateCapella{}
+		}
+	}
+	if topic == LightClientOptimisticUpdateTopicFormat {
+		if epoch >= params.BeaconConfig().DenebForkEpoch {
+			return &ethpbv2.LightClientOptimisticUpdateDeneb{}
+		}
+		if epoch >= params.BeaconConfig().CapellaForkEpoch {
+			return &ethpbv2.LightClientOptimisticUpdateCapella{}
+		}
+	}
 	return gossipTopicMappings[topic]
 }
 
@@ -75,4 +94,9 @@ func init() {
 	GossipTypeMapping[reflect.TypeOf(&ethpb.SignedBeaconBlockDeneb{})] = BlockSubnetTopicFormat
 	// Specially handle Electra objects.
 	GossipTypeMapping[reflect.TypeOf(&ethpb.SignedBeaconBlockElectra{})] = BlockSubnetTopicFormat
+	// Specially handle the light client updates of Capella and Deneb.
+	GossipTypeMapping[reflect.TypeOf(&ethpbv2.LightClientFinalityUpdateCapella{})] = LightClientFinalityUpdateTopicFormat
+	GossipTypeMapping[reflect.TypeOf(&ethpbv2.LightClientFinalityUpdateDeneb{})] = LightClientFinalityUpdateTopicFormat
+	GossipTypeMapping[reflect.TypeOf(&ethpbv2.LightClientOptimisticUpdateCapella{})] = LightClientOptimisticUpdateTopicFormat
+	GossipTypeMapping[reflect.TypeOf(&ethpbv2.LightClientOptimisticUpdateDeneb{})] = LightClientOptimisticUpdateTopicFormat
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 )
@@ -72,4 +73,15 @@ func TestGossipTopicMappings_CorrectBlockType(t *testing.T) {
 	pMessage = GossipTopicMappings(BlockSubnetTopicFormat, ElectraForkEpoch)
 	_, ok = pMessage.(*ethpb.SignedBeaconBlockElectra)
 	assert.Equal(t, true, ok)
+
+	// Light client updates
+	pMessage = GossipTopicMappings(LightClientFinalityUpdateTopicFormat, altairForkEpoch)
+	_, ok = pMessage.(*ethpbv2.LightClientFinalityUpdate)
+	assert.Equal(t, true, ok)
+	pMessage = GossipTopicMappings(LightClientFinalityUpdateTopicFormat, CapellaForkEpoch)
+	_, ok = pMessage.(*ethpbv2.LightClientFinalityUpdateCapella)
+	assert.Equal(t, true, ok)
+	pMessage = GossipTopicMappings(LightClientOptimisticUpdateTopicFormat, DenebForkEpoch)
+	_, ok = pMessage.(*ethpbv2.LightClientOptimisticUpdateDeneb)
+	assert.Equal(t, true, ok)
 }
@@ -161,6 +161,14 @@ var (
 		Help: "The number of publish messages sent via rpc for a particular topic",
 	},
 		[]string{"topic"})
+	gossipRecordedMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_gossip_recorded_messages_total",
+		Help: "The number of received gossip messages written to the gossip recording",
+	})
+	gossipRecordDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_gossip_record_dropped_total",
+		Help: "The number of received gossip messages which could not be written to the gossip recording",
+	})
 )
 
 func (s *Service) updateMetrics() {
@@ -6,6 +6,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/recorder"
 )
 
 var _ = pubsub.RawTracer(gossipTracer{})
@@ -20,9 +21,11 @@ const (
 )
 
 // This tracer is used to implement metrics collection for messages received
-// and broadcasted through gossipsub.
+// and broadcasted through gossipsub. When gossip recording is enabled, it also
+// feeds the received messages to the recorder.
 type gossipTracer struct {
-	host host.Host
+	host     host.Host
+	recorder *gossipRecorder
 }
 
 // AddPeer .
@@ -63,16 +66,19 @@ func (g gossipTracer) ValidateMessage(msg *pubsub.Message) {
 // DeliverMessage .
 func (g gossipTracer) DeliverMessage(msg *pubsub.Message) {
 	pubsubMessageDeliver.WithLabelValues(*msg.Topic).Inc()
+	g.recorder.record(msg, recorder.ResultAccept, "")
 }
 
 // RejectMessage .
 func (g gossipTracer) RejectMessage(msg *pubsub.Message, reason string) {
 	pubsubMessageReject.WithLabelValues(*msg.Topic, reason).Inc()
+	g.recorder.reject(msg, reason)
 }
 
 // DuplicateMessage .
 func (g gossipTracer) DuplicateMessage(msg *pubsub.Message) {
 	pubsubMessageDuplicate.WithLabelValues(*msg.Topic).Inc()
+	g.recorder.record(msg, recorder.ResultDuplicate, "")
 }
 
 // UndeliverableMessage .
@@ -88,6 +94,7 @@ func (g gossipTracer) ThrottlePeer(p peer.ID) {
 
 // RecvRPC .
 func (g gossipTracer) RecvRPC(rpc *pubsub.RPC) {
+	g.recorder.received(rpc)
 	g.setMetricFromRPC(recv, pubsubRPCSubRecv, pubsubRPCPubRecv, pubsubRPCRecv, rpc)
 }
 
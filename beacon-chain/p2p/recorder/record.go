@@ -0,0 +1,30 @@
+// Package recorder writes received gossip messages to a rotating on-disk log, and reads them back, so that a
+// sequence of gossip messages can be replayed to reproduce a validation issue.
+package recorder
+
+import "time"
+
+// The validation results of a recorded gossip message.
+const (
+	// ResultAccept is the result of messages which passed validation and were delivered.
+	ResultAccept = "accept"
+	// ResultReject is the result of messages which failed validation.
+	ResultReject = "reject"
+	// ResultIgnore is the result of messages which were ignored by validation.
+	ResultIgnore = "ignore"
+	// ResultDuplicate is the result of messages which were already seen, and were not validated again.
+	ResultDuplicate = "duplicate"
+	// ResultDropped is the result of messages which were dropped before validation, the reason tells why.
+	ResultDropped = "dropped"
+)
+
+// Record is a gossip message as received from a peer, with the result of its validation.
+type Record struct {
+	Topic       string    `json:"topic"`
+	Peer        string    `json:"peer"`
+	ArrivalTime time.Time `json:"arrival_time"`
+	// Data is the message as received on the wire, snappy compressed.
+	Data   []byte `json:"data"`
+	Result string `json:"result"`
+	Reason string `json:"reason,omitempty"`
+}
@@ -0,0 +1,201 @@
+package recorder
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+const (
+	filePrefix = "gossip-"
+	fileSuffix = ".jsonl"
+	// fileTimeFormat sorts the recording files in chronological order.
+	fileTimeFormat = "20060102-150405.000000000"
+)
+
+// Writer appends records to a recording directory, one JSON record per line. A new file is started when the
+// current one reaches the maximum size, and the oldest files are deleted to keep at most the maximum number
+// of files.
+type Writer struct {
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+	lock        sync.Mutex
+	file        *os.File
+	buf         *bufio.Writer
+	size        int64
+}
+
+// NewWriter creates a writer which records into the given directory, creating it if needed.
+func NewWriter(dir string, maxFileSize int64, maxFiles int) (*Writer, error) {
+	if maxFileSize <= 0 {
+		return nil, errors.New("maximum file size must be positive")
+	}
+	if maxFiles <= 0 {
+		return nil, errors.New("maximum number of files must be positive")
+	}
+	if err := file.MkdirAll(dir); err != nil {
+		return nil, errors.Wrapf(err, "could not create recording directory %s", dir)
+	}
+	return &Writer{dir: dir, maxFileSize: maxFileSize, maxFiles: maxFiles}, nil
+}
+
+// Write appends a record to the current recording file, rotating the files if needed.
+func (w *Writer) Write(r *Record) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return errors.Wrap(err, "could not encode record")
+	}
+	line = append(line, '\n')
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.file == nil || w.size+int64(len(line)) > w.maxFileSize {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := w.buf.Write(line)
+	w.size += int64(n)
+	if err != nil {
+		return errors.Wrap(err, "could not write record")
+	}
+	return nil
+}
+
+// Flush writes the buffered records to the current recording file.
+func (w *Writer) Flush() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.buf == nil {
+		return nil
+	}
+	return w.buf.Flush()
+}
+
+// Close flushes and closes the current recording file.
+func (w *Writer) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.closeFile()
+}
+
+// rotate closes the current recording file, starts a new one, and deletes the oldest files. The lock must be held.
+func (w *Writer) rotate() error {
+	if err := w.closeFile(); err != nil {
+		return err
+	}
+	name := filepath.Join(w.dir, filePrefix+time.Now().UTC().Format(fileTimeFormat)+fileSuffix)
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600) // #nosec G304 -- The directory is set by the operator.
+	if err != nil {
+		return errors.Wrapf(err, "could not create recording file %s", name)
+	}
+	w.file = f
+	w.buf = bufio.NewWriter(f)
+	w.size = 0
+
+	files, err := Files(w.dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > w.maxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return errors.Wrapf(err, "could not delete recording file %s", files[0])
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// closeFile flushes and closes the current recording file, if any. The lock must be held.
+func (w *Writer) closeFile() error {
+	if w.file == nil {
+		return nil
+	}
+	err := w.buf.Flush()
+	if closeErr := w.file.Close(); err == nil {
+		err = closeErr
+	}
+	w.file, w.buf = nil, nil
+	if err != nil {
+		return errors.Wrap(err, "could not close recording file")
+	}
+	return nil
+}
+
+// Files returns the recording files in the given directory, oldest first. When the path is a file, it is the
+// only recording file.
+func Files(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read recording path %s", path)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read recording directory %s", path)
+	}
+	files := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasPrefix(e.Name(), filePrefix) || !strings.HasSuffix(e.Name(), fileSuffix) {
+			continue
+		}
+		files = append(files, filepath.Join(path, e.Name()))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// Read calls f with every record of the recording at the given path, a recording file or directory, in the
+// order they were recorded.
+func Read(path string, f func(*Record) error) error {
+	files, err := Files(path)
+	if err != nil {
+		return err
+	}
+	for _, name := range files {
+		if err := readFile(name, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readFile(name string, f func(*Record) error) error {
+	fd, err := os.Open(name) // #nosec G304 -- The recording path is set by the operator.
+	if err != nil {
+		return errors.Wrapf(err, "could not open recording file %s", name)
+	}
+	defer func() {
+		if err := fd.Close(); err != nil {
+			log.WithError(err).WithField("file", name).Error("Could not close recording file")
+		}
+	}()
+	dec := json.NewDecoder(bufio.NewReader(fd))
+	for i := 1; dec.More(); i++ {
+		r := &Record{}
+		if err := dec.Decode(r); err != nil {
+			return errors.Wrapf(err, "could not decode record %d of %s", i, name)
+		}
+		if err := f(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String returns a short description of the record, for logging.
+func (r *Record) String() string {
+	return fmt.Sprintf("%s from %s at %s (%s)", r.Topic, r.Peer, r.ArrivalTime.Format(time.RFC3339Nano), r.Result)
+}
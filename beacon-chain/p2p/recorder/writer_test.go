@@ -0,0 +1,100 @@
+package recorder
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func testRecord(i int) *Record {
+	return &Record{
+		Topic:       "/eth2/01020304/beacon_block/ssz_snappy",
+		Peer:        fmt.Sprintf("peer-%d", i),
+		ArrivalTime: time.Unix(int64(1700000000+i), 0).UTC(),
+		Data:        []byte{byte(i), 0xff, 0x00},
+		Result:      ResultAccept,
+	}
+}
+
+func TestNewWriter_InvalidLimits(t *testing.T) {
+	_, err := NewWriter(t.TempDir(), 0, 1)
+	assert.ErrorContains(t, "maximum file size must be positive", err)
+	_, err = NewWriter(t.TempDir(), 1, 0)
+	assert.ErrorContains(t, "maximum number of files must be positive", err)
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(dir, 1<<20, 4)
+	require.NoError(t, err)
+	want := []*Record{testRecord(1), testRecord(2), testRecord(3)}
+	want[2].Result = ResultDropped
+	want[2].Reason = "validation queue full"
+	for _, r := range want {
+		require.NoError(t, w.Write(r))
+	}
+	require.NoError(t, w.Close())
+
+	var got []*Record
+	require.NoError(t, Read(dir, func(r *Record) error {
+		got = append(got, r)
+		return nil
+	}))
+	assert.DeepEqual(t, want, got)
+}
+
+func TestWriter_Rotates(t *testing.T) {
+	dir := t.TempDir()
+	// Every file holds a single record.
+	w, err := NewWriter(dir, 100, 2)
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, w.Write(testRecord(i)))
+	}
+	require.NoError(t, w.Close())
+
+	files, err := Files(dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(files))
+	var peers []string
+	require.NoError(t, Read(dir, func(r *Record) error {
+		peers = append(peers, r.Peer)
+		return nil
+	}))
+	assert.DeepEqual(t, []string{"peer-3", "peer-4"}, peers)
+
+	// A single recording file can be read too.
+	peers = nil
+	require.NoError(t, Read(files[1], func(r *Record) error {
+		peers = append(peers, r.Peer)
+		return nil
+	}))
+	assert.DeepEqual(t, []string{"peer-4"}, peers)
+}
+
+func TestRead_StopsOnError(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(dir, 1<<20, 1)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(testRecord(1)))
+	require.NoError(t, w.Write(testRecord(2)))
+	require.NoError(t, w.Close())
+
+	n := 0
+	err = Read(dir, func(r *Record) error {
+		n++
+		return errors.New("stop")
+	})
+	assert.ErrorContains(t, "stop", err)
+	assert.Equal(t, 1, n)
+}
+
+func TestRead_MissingPath(t *testing.T) {
+	err := Read(filepath.Join(t.TempDir(), "missing"), func(*Record) error { return nil })
+	assert.ErrorContains(t, "could not read recording path", err)
+}
@@ -78,6 +78,7 @@ type Service struct {
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
 	activeValidatorCount  uint64
+	recorder              *gossipRecorder
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -135,6 +136,13 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 
 	s.host = h
 
+	if cfg.GossipRecordDir != "" {
+		s.recorder, err = newGossipRecorder(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Gossipsub registration is done before we add in any new peers
 	// due to libp2p's gossipsub implementation not taking into
 	// account previously added peers when creating the gossipsub
@@ -282,6 +290,7 @@ func (s *Service) Stop() error {
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
+	s.recorder.stop()
 	return nil
 }
 
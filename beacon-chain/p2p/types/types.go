@@ -207,6 +207,70 @@ func (s BlobSidecarsByRootReq) Len() int {
 	return len(s)
 }
 
+// LightClientBootstrapReq specifies the block root of a light client bootstrap request.
+type LightClientBootstrapReq [rootLength]byte
+
+// MarshalSSZTo marshals the light client bootstrap request with the provided byte slice.
+func (r *LightClientBootstrapReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, r[:]...), nil
+}
+
+// MarshalSSZ marshals the light client bootstrap request into the serialized object.
+func (r *LightClientBootstrapReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, rootLength))
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (r *LightClientBootstrapReq) SizeSSZ() int {
+	return rootLength
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// light client bootstrap request object.
+func (r *LightClientBootstrapReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != rootLength {
+		return ssz.ErrIncorrectByteSize
+	}
+	copy(r[:], buf)
+	return nil
+}
+
+// LightClientUpdatesByRangeReq specifies the sync committee periods of a light client updates by range request.
+type LightClientUpdatesByRangeReq struct {
+	StartPeriod uint64
+	Count       uint64
+}
+
+// lightClientUpdatesByRangeReqSize is the size of the two uint64 fields of the request.
+const lightClientUpdatesByRangeReqSize = 16
+
+// MarshalSSZTo marshals the light client updates by range request with the provided byte slice.
+func (r *LightClientUpdatesByRangeReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = ssz.MarshalUint64(dst, r.StartPeriod)
+	return ssz.MarshalUint64(dst, r.Count), nil
+}
+
+// MarshalSSZ marshals the light client updates by range request into the serialized object.
+func (r *LightClientUpdatesByRangeReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, lightClientUpdatesByRangeReqSize))
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (r *LightClientUpdatesByRangeReq) SizeSSZ() int {
+	return lightClientUpdatesByRangeReqSize
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// light client updates by range request object.
+func (r *LightClientUpdatesByRangeReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != lightClientUpdatesByRangeReqSize {
+		return ssz.ErrIncorrectByteSize
+	}
+	r.StartPeriod = ssz.UnmarshallUint64(buf[0:8])
+	r.Count = ssz.UnmarshallUint64(buf[8:16])
+	return nil
+}
+
 func init() {
 	sizer := &eth.BlobIdentifier{}
 	blobIdSize = sizer.SizeSSZ()
@@ -112,6 +112,8 @@ func TestErrorResponse_Limit(t *testing.T) {
 func TestRoundTripSerialization(t *testing.T) {
 	roundTripTestBlocksByRootReq(t)
 	roundTripTestErrorMessage(t)
+	roundTripTestLightClientBootstrapReq(t)
+	roundTripTestLightClientUpdatesByRangeReq(t)
 }
 
 func roundTripTestBlocksByRootReq(t *testing.T) {
@@ -142,6 +144,32 @@ func roundTripTestErrorMessage(t *testing.T) {
 	assert.DeepEqual(t, []byte(newVal), errMsg)
 }
 
+func roundTripTestLightClientBootstrapReq(t *testing.T) {
+	req := LightClientBootstrapReq{'r', 'o', 'o', 't'}
+
+	marshalledObj, err := req.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, rootLength, len(marshalledObj))
+	newVal := LightClientBootstrapReq{}
+
+	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
+	assert.Equal(t, req, newVal)
+	require.ErrorIs(t, newVal.UnmarshalSSZ(marshalledObj[1:]), ssz.ErrIncorrectByteSize)
+}
+
+func roundTripTestLightClientUpdatesByRangeReq(t *testing.T) {
+	req := &LightClientUpdatesByRangeReq{StartPeriod: 290, Count: 128}
+
+	marshalledObj, err := req.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, "22010000000000008000000000000000", hex.EncodeToString(marshalledObj))
+	newVal := &LightClientUpdatesByRangeReq{}
+
+	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
+	assert.DeepEqual(t, req, newVal)
+	require.ErrorIs(t, newVal.UnmarshalSSZ(marshalledObj[:8]), ssz.ErrIncorrectByteSize)
+}
+
 func TestSSZBytes_HashTreeRoot(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -20,11 +20,13 @@ import (
 	beaconState "github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
@@ -153,6 +155,11 @@ func (s *Service) ComputeValidatorPerformance(
 		return validatorIndices[i] < validatorIndices[j]
 	})
 
+	inclusions, err := s.attestationInclusionSlots(ctx, headState, filtered)
+	if err != nil {
+		return nil, &RpcError{Err: errors.Wrap(err, "could not get attestation inclusion slots"), Reason: Internal}
+	}
+
 	currentEpoch := coreTime.CurrentEpoch(headState)
 	responseCap = len(validatorIndices)
 	pubKeys := make([][]byte, 0, responseCap)
@@ -163,6 +170,7 @@ func (s *Service) ComputeValidatorPerformance(
 	correctlyVotedTarget := make([]bool, 0, responseCap)
 	correctlyVotedHead := make([]bool, 0, responseCap)
 	inactivityScores := make([]uint64, 0, responseCap)
+	inclusionSlots := make([]primitives.Slot, 0, responseCap)
 	// Append performance summaries.
 	// Also track missing validators using public keys.
 	for _, idx := range validatorIndices {
@@ -189,6 +197,11 @@ func (s *Service) ComputeValidatorPerformance(
 		afterTransitionBalances = append(afterTransitionBalances, summary.AfterEpochTransitionBalance)
 		correctlyVotedTarget = append(correctlyVotedTarget, summary.IsPrevEpochTargetAttester)
 		correctlyVotedHead = append(correctlyVotedHead, summary.IsPrevEpochHeadAttester)
+		if slot, ok := inclusions[idx]; ok {
+			inclusionSlots = append(inclusionSlots, slot)
+		} else {
+			inclusionSlots = append(inclusionSlots, params.BeaconConfig().FarFutureSlot)
+		}
 
 		if headState.Version() == version.Phase0 {
 			correctlyVotedSource = append(correctlyVotedSource, summary.IsPrevEpochAttester)
@@ -200,6 +213,7 @@ func (s *Service) ComputeValidatorPerformance(
 
 	return &ethpb.ValidatorPerformanceResponse{
 		PublicKeys:                    pubKeys,
+		InclusionSlots:                inclusionSlots, // Far future slot when the attestation was not included.
 		CorrectlyVotedSource:          correctlyVotedSource,
 		CorrectlyVotedTarget:          correctlyVotedTarget, // In altair, when this is true then the attestation was definitely included.
 		CorrectlyVotedHead:            correctlyVotedHead,
@@ -211,6 +225,56 @@ func (s *Service) ComputeValidatorPerformance(
 	}, nil
 }
 
+// attestationInclusionSlots returns the slot of the earliest canonical block including an attestation of the previous
+// epoch for each of the validators, scanning the chain back from the head block.
+func (s *Service) attestationInclusionSlots(
+	ctx context.Context,
+	st beaconState.ReadOnlyBeaconState,
+	validators map[primitives.ValidatorIndex]bool,
+) (map[primitives.ValidatorIndex]primitives.Slot, error) {
+	prevEpoch := coreTime.PrevEpoch(st)
+	startSlot, err := slots.EpochStart(prevEpoch)
+	if err != nil {
+		return nil, err
+	}
+	inclusions := make(map[primitives.ValidatorIndex]primitives.Slot)
+	blk, err := s.HeadFetcher.HeadBlock(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head block")
+	}
+	for blocks.BeaconBlockIsNil(blk) == nil && blk.Block().Slot() >= startSlot {
+		for _, att := range blk.Block().Body().Attestations() {
+			if att.GetData().Target.Epoch != prevEpoch {
+				continue
+			}
+			committees, err := helpers.AttestationCommittees(ctx, st, att)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get attestation committees")
+			}
+			indices, err := attestation.AttestingIndices(att, committees...)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get attesting indices")
+			}
+			for _, i := range indices {
+				idx := primitives.ValidatorIndex(i)
+				if validators[idx] {
+					// Blocks are walked from the head, so the earliest inclusion is the last one seen.
+					inclusions[idx] = blk.Block().Slot()
+				}
+			}
+		}
+		if blk.Block().Slot() == startSlot {
+			break
+		}
+		parentRoot := blk.Block().ParentRoot()
+		blk, err = s.BeaconDB.Block(ctx, parentRoot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get block %#x", parentRoot)
+		}
+	}
+	return inclusions, nil
+}
+
 // SubmitSignedContributionAndProof is called by a sync committee aggregator
 // to submit signed contribution and proof object.
 func (s *Service) SubmitSignedContributionAndProof(
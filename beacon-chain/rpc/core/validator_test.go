@@ -1,18 +1,74 @@
 package core
 
 import (
+	"context"
 	"encoding/binary"
 	"testing"
 	"time"
 
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
 )
 
+func TestAttestationInclusionSlots(t *testing.T) {
+	helpers.ClearCache()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 64)
+	require.NoError(t, st.SetSlot(2*slotsPerEpoch+2))
+	attSlot := slotsPerEpoch + 1
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, attSlot, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 1)
+	aggBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggBits.SetBitAt(0, true)
+	att := util.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: aggBits,
+		Data: &ethpb.AttestationData{
+			Slot:   attSlot,
+			Target: &ethpb.Checkpoint{Epoch: 1},
+		},
+	})
+
+	// The attestation is included in a block of the previous epoch, and again in the head block.
+	parent := util.NewBeaconBlockAltair()
+	parent.Block.Slot = attSlot + 1
+	parent.Block.Body.Attestations = []*ethpb.Attestation{att}
+	parentRoot, err := parent.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, parent)
+	head := util.NewBeaconBlockAltair()
+	head.Block.Slot = 2*slotsPerEpoch + 1
+	head.Block.ParentRoot = parentRoot[:]
+	head.Block.Body.Attestations = []*ethpb.Attestation{att}
+	headBlock, err := blocks.NewSignedBeaconBlock(head)
+	require.NoError(t, err)
+
+	s := &Service{
+		BeaconDB:    beaconDB,
+		HeadFetcher: &mock.ChainService{Block: headBlock},
+	}
+	inclusions, err := s.attestationInclusionSlots(ctx, st, map[primitives.ValidatorIndex]bool{committee[0]: true, committee[1]: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(inclusions))
+	assert.Equal(t, attSlot+1, inclusions[committee[0]])
+}
+
 func TestRegisterSyncSubnetProto(t *testing.T) {
 	k := pubKey(3)
 	committee := make([][]byte, 0)
@@ -22,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
 	beaconprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/beacon"
 	nodeprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/node"
+	slasherprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/slasher"
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
 	validatorprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/validator"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
@@ -71,8 +72,9 @@ func (s *Service) endpoints(
 	endpoints = append(endpoints, s.prysmBeaconEndpoints(ch, stater)...)
 	endpoints = append(endpoints, s.prysmNodeEndpoints()...)
 	endpoints = append(endpoints, s.prysmValidatorEndpoints(coreService, stater)...)
+	endpoints = append(endpoints, s.prysmSlasherEndpoints()...)
 	if enableDebug {
-		endpoints = append(endpoints, s.debugEndpoints(stater)...)
+		endpoints = append(endpoints, s.debugEndpoints(stater, validatorServer)...)
 	}
 	return endpoints
 }
@@ -528,6 +530,33 @@ func (s *Service) beaconEndpoints(
 			handler: server.GetRandao,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/eth/v1/beacon/states/{state_id}/pending_deposits",
+			name:     namespace + ".GetPendingDeposits",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType, api.OctetStreamMediaType}),
+			},
+			handler: server.GetPendingDeposits,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/eth/v1/beacon/states/{state_id}/pending_partial_withdrawals",
+			name:     namespace + ".GetPendingPartialWithdrawals",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType, api.OctetStreamMediaType}),
+			},
+			handler: server.GetPendingPartialWithdrawals,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/eth/v1/beacon/states/{state_id}/pending_consolidations",
+			name:     namespace + ".GetPendingConsolidations",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType, api.OctetStreamMediaType}),
+			},
+			handler: server.GetPendingConsolidations,
+			methods: []string{http.MethodGet},
+		},
 		{
 			template: "/eth/v1/beacon/blocks",
 			name:     namespace + ".PublishBlock",
@@ -815,6 +844,7 @@ func (s *Service) lightClientEndpoints(blocker lookup.Blocker, stater lookup.Sta
 		Blocker:     blocker,
 		Stater:      stater,
 		HeadFetcher: s.cfg.HeadFetcher,
+		BeaconDB:    s.cfg.BeaconDB,
 	}
 
 	const namespace = "lightclient"
@@ -858,7 +888,7 @@ func (s *Service) lightClientEndpoints(blocker lookup.Blocker, stater lookup.Sta
 	}
 }
 
-func (s *Service) debugEndpoints(stater lookup.Stater) []endpoint {
+func (s *Service) debugEndpoints(stater lookup.Stater, validatorServer *validatorv1alpha1.Server) []endpoint {
 	server := &debug.Server{
 		BeaconDB:              s.cfg.BeaconDB,
 		HeadFetcher:           s.cfg.HeadFetcher,
@@ -868,6 +898,7 @@ func (s *Service) debugEndpoints(stater lookup.Stater) []endpoint {
 		ForkchoiceFetcher:     s.cfg.ForkchoiceFetcher,
 		FinalizationFetcher:   s.cfg.FinalizationFetcher,
 		ChainInfoFetcher:      s.cfg.ChainInfoFetcher,
+		AttestationPacker:     validatorServer,
 	}
 
 	const namespace = "debug"
@@ -899,6 +930,34 @@ func (s *Service) debugEndpoints(stater lookup.Stater) []endpoint {
 			handler: server.GetForkChoice,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/debug/fork_choice/explain",
+			name:     namespace + ".GetForkChoiceExplanation",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetForkChoiceExplanation,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/debug/fork_choice/simulate",
+			name:     namespace + ".SimulateForkChoice",
+			middleware: []mux.MiddlewareFunc{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.SimulateForkChoice,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/debug/attestation_packing",
+			name:     namespace + ".GetAttestationPacking",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetAttestationPacking,
+			methods: []string{http.MethodGet},
+		},
 	}
 }
 
@@ -968,6 +1027,15 @@ func (s *Service) prysmBeaconEndpoints(ch *stategen.CanonicalHistory, stater loo
 			handler: server.GetValidatorCount,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/beacon/states/{state_id}/churn",
+			name:     namespace + ".GetChurn",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetChurn,
+			methods: []string{http.MethodGet},
+		},
 	}
 }
 
@@ -1047,7 +1115,9 @@ func (s *Service) prysmNodeEndpoints() []endpoint {
 
 func (s *Service) prysmValidatorEndpoints(coreService *core.Service, stater lookup.Stater) []endpoint {
 	server := &validatorprysm.Server{
-		CoreService: coreService,
+		CoreService:      coreService,
+		ValidatorMonitor: s.cfg.ValidatorMonitor,
+		ProposalAudits:   s.cfg.ProposalAuditCache,
 	}
 
 	const namespace = "prysm.validator"
@@ -1072,5 +1142,99 @@ func (s *Service) prysmValidatorEndpoints(coreService *core.Service, stater look
 			handler: server.GetValidatorPerformance,
 			methods: []string{http.MethodPost},
 		},
+		{
+			template: "/prysm/v1/validators/monitor/{index}",
+			name:     namespace + ".GetValidatorMonitorHistory",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType, api.CsvMediaType}),
+			},
+			handler: server.GetValidatorMonitorHistory,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validator/proposals/{slot}",
+			name:     namespace + ".GetProposalAudit",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetProposalAudit,
+			methods: []string{http.MethodGet},
+		},
+	}
+}
+
+func (s *Service) prysmSlasherEndpoints() []endpoint {
+	server := &slasherprysm.Server{
+		SlasherDB:       s.cfg.SlasherDB,
+		SlashingChecker: s.cfg.SlashingChecker,
+	}
+
+	const namespace = "prysm.slasher"
+	return []endpoint{
+		{
+			template: "/prysm/v1/slasher/attester_slashings",
+			name:     namespace + ".ListAttesterSlashings",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ListAttesterSlashings,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/slasher/proposer_slashings",
+			name:     namespace + ".ListProposerSlashings",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ListProposerSlashings,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/slasher/attestations/slashable",
+			name:     namespace + ".IsSlashableAttestation",
+			middleware: []mux.MiddlewareFunc{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.IsSlashableAttestation,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/slasher/blocks/slashable",
+			name:     namespace + ".IsSlashableBlock",
+			middleware: []mux.MiddlewareFunc{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.IsSlashableBlock,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/slasher/validators/{validator_index}/attestations/{target_epoch}",
+			name:     namespace + ".GetAttestationRecord",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetAttestationRecord,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/slasher/validators/{validator_index}/proposals/{slot}",
+			name:     namespace + ".GetProposalRecord",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetProposalRecord,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/slasher/validators/{validator_index}/spans/{epoch}",
+			name:     namespace + ".GetValidatorSpans",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetValidatorSpans,
+			methods: []string{http.MethodGet},
+		},
 	}
 }
@@ -8,6 +8,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
@@ -19,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpbalpha "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"go.opencensus.io/trace"
 )
@@ -335,3 +338,163 @@ func (s *Server) stateForSyncCommittee(ctx context.Context, w http.ResponseWrite
 	}
 	return st, true
 }
+
+// GetPendingDeposits returns the deposits waiting to be applied to validator balances in the
+// state identified by state_id. Only available for Electra states.
+func (s *Server) GetPendingDeposits(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetPendingDeposits")
+	defer span.End()
+
+	stateId, st, ok := s.electraStateFromRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	deposits, err := st.PendingBalanceDeposits()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending deposits: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(api.VersionHeader, version.String(st.Version()))
+	if httputil.RespondWithSsz(r) {
+		sszData, err := marshalSszList(deposits)
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal pending deposits into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, sszData, "pending_deposits.ssz")
+		return
+	}
+	isOptimistic, isFinalized, ok := s.stateStatus(ctx, w, stateId, st)
+	if !ok {
+		return
+	}
+	httputil.WriteJson(w, &structs.GetPendingDepositsResponse{
+		Version:             version.String(st.Version()),
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           isFinalized,
+		Data:                structs.PendingBalanceDepositsFromConsensus(deposits),
+	})
+}
+
+// GetPendingPartialWithdrawals returns the partial withdrawals waiting to be processed in the
+// state identified by state_id. Only available for Electra states.
+func (s *Server) GetPendingPartialWithdrawals(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetPendingPartialWithdrawals")
+	defer span.End()
+
+	stateId, st, ok := s.electraStateFromRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	withdrawals, err := st.PendingPartialWithdrawals()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending partial withdrawals: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(api.VersionHeader, version.String(st.Version()))
+	if httputil.RespondWithSsz(r) {
+		sszData, err := marshalSszList(withdrawals)
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal pending partial withdrawals into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, sszData, "pending_partial_withdrawals.ssz")
+		return
+	}
+	isOptimistic, isFinalized, ok := s.stateStatus(ctx, w, stateId, st)
+	if !ok {
+		return
+	}
+	httputil.WriteJson(w, &structs.GetPendingPartialWithdrawalsResponse{
+		Version:             version.String(st.Version()),
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           isFinalized,
+		Data:                structs.PendingPartialWithdrawalsFromConsensus(withdrawals),
+	})
+}
+
+// GetPendingConsolidations returns the consolidations waiting to be processed in the state
+// identified by state_id. Only available for Electra states.
+func (s *Server) GetPendingConsolidations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetPendingConsolidations")
+	defer span.End()
+
+	stateId, st, ok := s.electraStateFromRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	consolidations, err := st.PendingConsolidations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending consolidations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(api.VersionHeader, version.String(st.Version()))
+	if httputil.RespondWithSsz(r) {
+		sszData, err := marshalSszList(consolidations)
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal pending consolidations into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, sszData, "pending_consolidations.ssz")
+		return
+	}
+	isOptimistic, isFinalized, ok := s.stateStatus(ctx, w, stateId, st)
+	if !ok {
+		return
+	}
+	httputil.WriteJson(w, &structs.GetPendingConsolidationsResponse{
+		Version:             version.String(st.Version()),
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           isFinalized,
+		Data:                structs.PendingConsolidationsFromConsensus(consolidations),
+	})
+}
+
+// electraStateFromRequest fetches the state identified by the state_id route parameter,
+// rejecting states from before the Electra fork.
+func (s *Server) electraStateFromRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) (string, state.BeaconState, bool) {
+	stateId := mux.Vars(r)["state_id"]
+	if stateId == "" {
+		httputil.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return "", nil, false
+	}
+	st, err := s.Stater.State(ctx, []byte(stateId))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return "", nil, false
+	}
+	if st.Version() < version.Electra {
+		httputil.HandleError(w, "Endpoint is only available for Electra states, got "+version.String(st.Version()), http.StatusBadRequest)
+		return "", nil, false
+	}
+	return stateId, st, true
+}
+
+// stateStatus returns whether the state is optimistic and whether it is finalized.
+func (s *Server) stateStatus(ctx context.Context, w http.ResponseWriter, stateId string, st state.BeaconState) (bool, bool, bool) {
+	isOptimistic, err := helpers.IsOptimistic(ctx, []byte(stateId), s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
+	if err != nil {
+		httputil.HandleError(w, "Could not check optimistic status: "+err.Error(), http.StatusInternalServerError)
+		return false, false, false
+	}
+	blockRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not calculate root of latest block header: "+err.Error(), http.StatusInternalServerError)
+		return false, false, false
+	}
+	return isOptimistic, s.FinalizationFetcher.IsFinalized(ctx, blockRoot), true
+}
+
+// marshalSszList serializes a list of fixed size SSZ objects, which is the concatenation of the
+// serialized objects.
+func marshalSszList[T interface{ MarshalSSZ() ([]byte, error) }](items []T) ([]byte, error) {
+	var result []byte
+	for i, item := range items {
+		b, err := item.MarshalSSZ()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not marshal item %d", i)
+		}
+		result = append(result, b...)
+	}
+	return result, nil
+}
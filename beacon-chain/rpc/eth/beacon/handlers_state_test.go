@@ -13,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
@@ -648,3 +649,185 @@ func TestGetSyncCommittees_Future(t *testing.T) {
 		}
 	}
 }
+
+func TestGetPendingDeposits(t *testing.T) {
+	deposits := []*ethpbalpha.PendingBalanceDeposit{
+		{Index: 1, Amount: 100},
+		{Index: 2, Amount: 200},
+	}
+	st, err := util.NewBeaconStateElectra(func(state *ethpbalpha.BeaconStateElectra) error {
+		state.PendingBalanceDeposits = deposits
+		return nil
+	})
+	require.NoError(t, err)
+
+	chainService := &chainMock.ChainService{}
+	s := &Server{
+		Stater:                &testutil.MockStater{BeaconState: st},
+		HeadFetcher:           chainService,
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		BeaconDB:              dbTest.SetupDB(t),
+	}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_deposits", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "electra", writer.Header().Get(api.VersionHeader))
+		resp := &structs.GetPendingDepositsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "electra", resp.Version)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "1", resp.Data[0].Index)
+		assert.Equal(t, "100", resp.Data[0].Amount)
+		assert.Equal(t, "2", resp.Data[1].Index)
+		assert.Equal(t, "200", resp.Data[1].Amount)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_deposits", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var want []byte
+		for _, d := range deposits {
+			b, err := d.MarshalSSZ()
+			require.NoError(t, err)
+			want = append(want, b...)
+		}
+		assert.DeepEqual(t, want, writer.Body.Bytes())
+	})
+	t.Run("pre-electra state", func(t *testing.T) {
+		denebSt, err := util.NewBeaconStateDeneb()
+		require.NoError(t, err)
+		s := &Server{Stater: &testutil.MockStater{BeaconState: denebSt}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_deposits", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "only available for Electra states", e.Message)
+	})
+}
+
+func TestGetPendingPartialWithdrawals(t *testing.T) {
+	withdrawals := []*ethpbalpha.PendingPartialWithdrawal{
+		{Index: 1, Amount: 100, WithdrawableEpoch: 10},
+		{Index: 2, Amount: 200, WithdrawableEpoch: 20},
+	}
+	st, err := util.NewBeaconStateElectra(func(state *ethpbalpha.BeaconStateElectra) error {
+		state.PendingPartialWithdrawals = withdrawals
+		return nil
+	})
+	require.NoError(t, err)
+
+	chainService := &chainMock.ChainService{}
+	s := &Server{
+		Stater:                &testutil.MockStater{BeaconState: st},
+		HeadFetcher:           chainService,
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		BeaconDB:              dbTest.SetupDB(t),
+	}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_partial_withdrawals", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingPartialWithdrawals(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPendingPartialWithdrawalsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "1", resp.Data[0].Index)
+		assert.Equal(t, "100", resp.Data[0].Amount)
+		assert.Equal(t, "10", resp.Data[0].WithdrawableEpoch)
+		assert.Equal(t, "20", resp.Data[1].WithdrawableEpoch)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_partial_withdrawals", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingPartialWithdrawals(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var want []byte
+		for _, w := range withdrawals {
+			b, err := w.MarshalSSZ()
+			require.NoError(t, err)
+			want = append(want, b...)
+		}
+		assert.DeepEqual(t, want, writer.Body.Bytes())
+	})
+}
+
+func TestGetPendingConsolidations(t *testing.T) {
+	consolidations := []*ethpbalpha.PendingConsolidation{
+		{SourceIndex: 1, TargetIndex: 2},
+		{SourceIndex: 3, TargetIndex: 4},
+	}
+	st, err := util.NewBeaconStateElectra(func(state *ethpbalpha.BeaconStateElectra) error {
+		state.PendingConsolidations = consolidations
+		return nil
+	})
+	require.NoError(t, err)
+
+	chainService := &chainMock.ChainService{}
+	s := &Server{
+		Stater:                &testutil.MockStater{BeaconState: st},
+		HeadFetcher:           chainService,
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		BeaconDB:              dbTest.SetupDB(t),
+	}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_consolidations", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingConsolidations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPendingConsolidationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "1", resp.Data[0].SourceIndex)
+		assert.Equal(t, "2", resp.Data[0].TargetIndex)
+		assert.Equal(t, "3", resp.Data[1].SourceIndex)
+		assert.Equal(t, "4", resp.Data[1].TargetIndex)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_consolidations", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingConsolidations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var want []byte
+		for _, c := range consolidations {
+			b, err := c.MarshalSSZ()
+			require.NoError(t, err)
+			want = append(want, b...)
+		}
+		assert.DeepEqual(t, want, writer.Body.Bytes())
+	})
+}
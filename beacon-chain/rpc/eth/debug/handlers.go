@@ -4,20 +4,33 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"go.opencensus.io/trace"
 )
 
-const errMsgStateFromConsensus = "Could not convert consensus state to response"
+const (
+	errMsgStateFromConsensus = "Could not convert consensus state to response"
+	// maxSimulationRequestBytes bounds the size of a fork choice simulation request.
+	maxSimulationRequestBytes = 1 << 20
+)
 
 // GetBeaconStateV2 returns the full beacon state for a given state ID.
 func (s *Server) GetBeaconStateV2(w http.ResponseWriter, r *http.Request) {
@@ -196,3 +209,218 @@ func (s *Server) GetForkChoice(w http.ResponseWriter, r *http.Request) {
 	}
 	httputil.WriteJson(w, resp)
 }
+
+// GetForkChoiceExplanation returns the breakdown of the fork choice weight of a block and whether it is viable for
+// head. It defaults to the current head when no block root is given.
+func (s *Server) GetForkChoiceExplanation(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetForkChoiceExplanation")
+	defer span.End()
+
+	_, rawRoot, ok := shared.HexFromQuery(w, r, "block_root", fieldparams.RootLength, false)
+	if !ok {
+		return
+	}
+	root := s.ForkchoiceFetcher.CachedHeadRoot()
+	if rawRoot != nil {
+		root = bytesutil.ToBytes32(rawRoot)
+	}
+	e, err := s.ForkchoiceFetcher.ForkChoiceExplain(ctx, root)
+	if err != nil {
+		httputil.HandleError(w, "Could not explain block: "+err.Error(), http.StatusNotFound)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetForkChoiceExplanationResponse{Data: forkChoiceExplanation(e)})
+}
+
+// SimulateForkChoice applies hypothetical blocks and attestations to a copy of the fork choice store and returns the
+// resulting head. The fork choice store of the node is left untouched.
+func (s *Server) SimulateForkChoice(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.SimulateForkChoice")
+	defer span.End()
+
+	var req structs.SimulateForkChoiceRequest
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxSimulationRequestBytes)).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	sim, err := simulationFromRequest(&req)
+	if err != nil {
+		httputil.HandleError(w, "Could not decode simulation: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	result, err := s.ForkchoiceFetcher.ForkChoiceSimulate(ctx, sim)
+	if err != nil {
+		httputil.HandleError(w, "Could not simulate fork choice: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	httputil.WriteJson(w, &structs.SimulateForkChoiceResponse{Data: &structs.ForkChoiceSimulation{
+		PreviousHeadRoot: hexutil.Encode(result.PreviousHeadRoot),
+		Head:             forkChoiceExplanation(result.Head),
+	}})
+}
+
+func simulationFromRequest(req *structs.SimulateForkChoiceRequest) (*forkchoice.Simulation, error) {
+	sim := &forkchoice.Simulation{
+		Blocks:       make([]*forkchoice.SimulatedBlock, len(req.Blocks)),
+		Attestations: make([]*forkchoice.SimulatedAttestation, len(req.Attestations)),
+	}
+	for i, b := range req.Blocks {
+		if b == nil {
+			return nil, fmt.Errorf("block %d is empty", i)
+		}
+		slot, err := strconv.ParseUint(b.Slot, 10, 64)
+		if err != nil {
+			return nil, server.NewDecodeError(err, fmt.Sprintf("Blocks[%d].Slot", i))
+		}
+		root, err := bytesutil.DecodeHexWithLength(b.BlockRoot, fieldparams.RootLength)
+		if err != nil {
+			return nil, server.NewDecodeError(err, fmt.Sprintf("Blocks[%d].BlockRoot", i))
+		}
+		parentRoot, err := bytesutil.DecodeHexWithLength(b.ParentRoot, fieldparams.RootLength)
+		if err != nil {
+			return nil, server.NewDecodeError(err, fmt.Sprintf("Blocks[%d].ParentRoot", i))
+		}
+		sim.Blocks[i] = &forkchoice.SimulatedBlock{
+			Slot:          primitives.Slot(slot),
+			BlockRoot:     bytesutil.ToBytes32(root),
+			ParentRoot:    bytesutil.ToBytes32(parentRoot),
+			ProposerBoost: b.ProposerBoost,
+		}
+		if b.JustifiedEpoch != "" {
+			epoch, err := strconv.ParseUint(b.JustifiedEpoch, 10, 64)
+			if err != nil {
+				return nil, server.NewDecodeError(err, fmt.Sprintf("Blocks[%d].JustifiedEpoch", i))
+			}
+			e := primitives.Epoch(epoch)
+			sim.Blocks[i].JustifiedEpoch = &e
+		}
+		if b.FinalizedEpoch != "" {
+			epoch, err := strconv.ParseUint(b.FinalizedEpoch, 10, 64)
+			if err != nil {
+				return nil, server.NewDecodeError(err, fmt.Sprintf("Blocks[%d].FinalizedEpoch", i))
+			}
+			e := primitives.Epoch(epoch)
+			sim.Blocks[i].FinalizedEpoch = &e
+		}
+	}
+	for i, a := range req.Attestations {
+		if a == nil {
+			return nil, fmt.Errorf("attestation %d is empty", i)
+		}
+		indices := make([]uint64, len(a.ValidatorIndices))
+		for j, index := range a.ValidatorIndices {
+			v, err := strconv.ParseUint(index, 10, 64)
+			if err != nil {
+				return nil, server.NewDecodeError(err, fmt.Sprintf("Attestations[%d].ValidatorIndices[%d]", i, j))
+			}
+			indices[j] = v
+		}
+		root, err := bytesutil.DecodeHexWithLength(a.BlockRoot, fieldparams.RootLength)
+		if err != nil {
+			return nil, server.NewDecodeError(err, fmt.Sprintf("Attestations[%d].BlockRoot", i))
+		}
+		epoch, err := strconv.ParseUint(a.TargetEpoch, 10, 64)
+		if err != nil {
+			return nil, server.NewDecodeError(err, fmt.Sprintf("Attestations[%d].TargetEpoch", i))
+		}
+		sim.Attestations[i] = &forkchoice.SimulatedAttestation{
+			ValidatorIndices: indices,
+			BlockRoot:        bytesutil.ToBytes32(root),
+			TargetEpoch:      primitives.Epoch(epoch),
+		}
+	}
+	return sim, nil
+}
+
+func forkChoiceExplanation(e *forkchoice.Explanation) *structs.ForkChoiceExplanation {
+	children := make([]*structs.ForkChoiceChildWeight, len(e.Children))
+	for i, c := range e.Children {
+		children[i] = &structs.ForkChoiceChildWeight{
+			BlockRoot: hexutil.Encode(c.BlockRoot),
+			Weight:    fmt.Sprintf("%d", c.Weight),
+		}
+	}
+	resp := &structs.ForkChoiceExplanation{
+		Slot:                     fmt.Sprintf("%d", e.Slot),
+		BlockRoot:                hexutil.Encode(e.BlockRoot),
+		ParentRoot:               hexutil.Encode(e.ParentRoot),
+		Weight:                   fmt.Sprintf("%d", e.Weight),
+		Balance:                  fmt.Sprintf("%d", e.Balance),
+		VoteBalance:              fmt.Sprintf("%d", e.VoteBalance),
+		Votes:                    fmt.Sprintf("%d", e.Votes),
+		PendingVoteBalance:       fmt.Sprintf("%d", e.PendingVoteBalance),
+		PendingVotes:             fmt.Sprintf("%d", e.PendingVotes),
+		SlashedVotes:             fmt.Sprintf("%d", e.SlashedVotes),
+		ChildrenWeight:           fmt.Sprintf("%d", e.ChildrenWeight),
+		Children:                 children,
+		ProposerBoost:            fmt.Sprintf("%d", e.ProposerBoost),
+		DescendantProposerBoost:  fmt.Sprintf("%d", e.DescendantProposerBoost),
+		CommitteeWeight:          fmt.Sprintf("%d", e.CommitteeWeight),
+		JustifiedEpoch:           fmt.Sprintf("%d", e.JustifiedEpoch),
+		UnrealizedJustifiedEpoch: fmt.Sprintf("%d", e.UnrealizedJustifiedEpoch),
+		FinalizedEpoch:           fmt.Sprintf("%d", e.FinalizedEpoch),
+		UnrealizedFinalizedEpoch: fmt.Sprintf("%d", e.UnrealizedFinalizedEpoch),
+		JustifiedCheckpoint:      structs.CheckpointFromConsensus(e.JustifiedCheckpoint),
+		FinalizedCheckpoint:      structs.CheckpointFromConsensus(e.FinalizedCheckpoint),
+		ViableForHead:            e.ViableForHead,
+		LeadsToViableHead:        e.LeadsToViableHead,
+		Canonical:                e.Canonical,
+		Head:                     e.Head,
+		ExecutionOptimistic:      e.ExecutionOptimistic,
+		TimeStamp:                fmt.Sprintf("%d", e.Timestamp),
+		ArrivedEarly:             e.ArrivedEarly,
+		WeakHead:                 e.WeakHead,
+		StrongParent:             e.StrongParent,
+	}
+	if e.BestDescendant != nil {
+		resp.BestDescendant = hexutil.Encode(e.BestDescendant)
+	}
+	return resp
+}
+
+// GetAttestationPacking packs the attestations of the pool as for a block proposed at the next slot, and compares the
+// proposer reward of the attestations packed by their participation flags with the attestations packed by the number
+// of their new aggregation bits only.
+func (s *Server) GetAttestationPacking(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetAttestationPacking")
+	defer span.End()
+
+	if s.AttestationPacker == nil {
+		httputil.HandleError(w, "Attestation packing is not available", http.StatusServiceUnavailable)
+		return
+	}
+	report, err := s.AttestationPacker.AttestationPackingReport(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not pack attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetAttestationPackingResponse{Data: &structs.AttestationPacking{
+		Slot:   fmt.Sprintf("%d", report.Slot),
+		Packed: packedAttestationsFromReport(report.Packed),
+		Naive:  packedAttestationsFromReport(report.Naive),
+	}})
+}
+
+func packedAttestationsFromReport(packed *validator.PackedAttestations) *structs.PackedAttestations {
+	atts := make([]*structs.PackedAttestation, len(packed.Attestations))
+	for i, a := range packed.Attestations {
+		atts[i] = &structs.PackedAttestation{
+			Data:            structs.AttDataFromConsensus(a.Attestation.GetData()),
+			AggregationBits: hexutil.Encode(a.Attestation.GetAggregationBits()),
+			Attesters:       fmt.Sprintf("%d", a.Attesters),
+			Reward:          fmt.Sprintf("%d", a.Reward),
+		}
+		if a.Attestation.Version() >= version.Electra {
+			atts[i].CommitteeBits = hexutil.Encode(a.Attestation.CommitteeBitsVal().Bytes())
+		}
+	}
+	return &structs.PackedAttestations{
+		Reward:       fmt.Sprintf("%d", packed.Reward),
+		Attestations: atts,
+	}
+}
@@ -6,18 +6,22 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	blockchainmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -459,3 +463,199 @@ func TestGetForkChoice(t *testing.T) {
 	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 	require.Equal(t, "2", resp.FinalizedCheckpoint.Epoch)
 }
+
+func forkChoiceTestStore(t *testing.T) *doublylinkedtree.ForkChoice {
+	ctx := context.Background()
+	store := doublylinkedtree.New()
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, store.InsertNode(ctx, st, [32]byte{'a'}))
+	require.NoError(t, st.SetSlot(1))
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{Slot: 1, ParentRoot: bytesutil.PadTo([]byte{'a'}, 32)}))
+	require.NoError(t, store.InsertNode(ctx, st, [32]byte{'b'}))
+	head, err := store.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{'b'}, head)
+	return store
+}
+
+func TestGetForkChoiceExplanation(t *testing.T) {
+	s := &Server{ForkchoiceFetcher: &blockchainmock.ChainService{ForkChoiceStore: forkChoiceTestStore(t)}}
+	a := hexutil.Encode(bytesutil.PadTo([]byte{'a'}, 32))
+	b := hexutil.Encode(bytesutil.PadTo([]byte{'b'}, 32))
+
+	t.Run("head", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/fork_choice/explain", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceExplanation(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetForkChoiceExplanationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, b, resp.Data.BlockRoot)
+		assert.Equal(t, a, resp.Data.ParentRoot)
+		assert.Equal(t, "1", resp.Data.Slot)
+		assert.Equal(t, true, resp.Data.Head)
+		assert.Equal(t, true, resp.Data.ViableForHead)
+		assert.Equal(t, "", resp.Data.BestDescendant)
+	})
+	t.Run("block root", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/fork_choice/explain?block_root="+a, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceExplanation(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetForkChoiceExplanationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, a, resp.Data.BlockRoot)
+		assert.Equal(t, false, resp.Data.Head)
+		assert.Equal(t, true, resp.Data.Canonical)
+		assert.Equal(t, b, resp.Data.BestDescendant)
+		require.Equal(t, 1, len(resp.Data.Children))
+		assert.Equal(t, b, resp.Data.Children[0].BlockRoot)
+	})
+	t.Run("unknown block root", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/fork_choice/explain?block_root="+hexutil.Encode(make([]byte, 32)), nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceExplanation(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("invalid block root", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/fork_choice/explain?block_root=0x01", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceExplanation(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestSimulateForkChoice(t *testing.T) {
+	store := forkChoiceTestStore(t)
+	// Only the votes of known validators are simulated.
+	store.ProcessAttestation(context.Background(), []uint64{0, 1}, [32]byte{'b'}, 0)
+	s := &Server{ForkchoiceFetcher: &blockchainmock.ChainService{ForkChoiceStore: store}}
+	b := hexutil.Encode(bytesutil.PadTo([]byte{'b'}, 32))
+	c := hexutil.Encode(bytesutil.PadTo([]byte{'c'}, 32))
+
+	t.Run("ok", func(t *testing.T) {
+		body := `{"blocks":[{"slot":"2","block_root":"` + c + `","parent_root":"` + b + `","proposer_boost":true}],` +
+			`"attestations":[{"validator_indices":["0","1"],"block_root":"` + c + `","target_epoch":"0"}]}`
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/fork_choice/simulate", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SimulateForkChoice(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.SimulateForkChoiceResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, b, resp.Data.PreviousHeadRoot)
+		assert.Equal(t, c, resp.Data.Head.BlockRoot)
+		assert.Equal(t, "2", resp.Data.Head.Slot)
+		assert.Equal(t, false, store.HasNode([32]byte{'c'}))
+	})
+	t.Run("no body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/fork_choice/simulate", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SimulateForkChoice(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "No data submitted", writer.Body.String())
+	})
+	t.Run("invalid slot", func(t *testing.T) {
+		body := `{"blocks":[{"slot":"foo","block_root":"` + c + `","parent_root":"` + b + `"}]}`
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/fork_choice/simulate", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SimulateForkChoice(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "Blocks[0].Slot", writer.Body.String())
+	})
+	t.Run("unknown parent", func(t *testing.T) {
+		body := `{"blocks":[{"slot":"2","block_root":"` + c + `","parent_root":"` + c + `"}]}`
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/fork_choice/simulate", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SimulateForkChoice(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "invalid parent root", writer.Body.String())
+	})
+	t.Run("unknown validator", func(t *testing.T) {
+		body := `{"attestations":[{"validator_indices":["1099511627776"],"block_root":"` + b + `","target_epoch":"0"}]}`
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/fork_choice/simulate", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SimulateForkChoice(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "is not below the number of validators", writer.Body.String())
+	})
+	t.Run("body too large", func(t *testing.T) {
+		body := `{"blocks":[],"attestations":[{"validator_indices":["` + strings.Repeat("0", maxSimulationRequestBytes) + `"]}]}`
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/debug/fork_choice/simulate", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SimulateForkChoice(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "request body too large", writer.Body.String())
+	})
+}
+
+type mockAttestationPacker struct {
+	report *validator.AttestationPackingReport
+}
+
+func (m *mockAttestationPacker) AttestationPackingReport(context.Context) (*validator.AttestationPackingReport, error) {
+	return m.report, nil
+}
+
+func TestGetAttestationPacking(t *testing.T) {
+	att := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b1101}})
+	attElectra := util.HydrateAttestationElectra(&ethpb.AttestationElectra{AggregationBits: bitfield.Bitlist{0b1101}, CommitteeBits: bitfield.NewBitvector64()})
+	attElectra.CommitteeBits.SetBitAt(1, true)
+	s := &Server{AttestationPacker: &mockAttestationPacker{report: &validator.AttestationPackingReport{
+		Slot: 5,
+		Packed: &validator.PackedAttestations{
+			Attestations: []*validator.PackedAttestation{{Attestation: attElectra, Attesters: 2, Reward: 7}},
+			Reward:       7,
+		},
+		Naive: &validator.PackedAttestations{
+			Attestations: []*validator.PackedAttestation{{Attestation: att, Attesters: 3, Reward: 5}},
+			Reward:       5,
+		},
+	}}}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/attestation_packing", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetAttestationPacking(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetAttestationPackingResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "5", resp.Data.Slot)
+	assert.Equal(t, "7", resp.Data.Packed.Reward)
+	require.Equal(t, 1, len(resp.Data.Packed.Attestations))
+	assert.Equal(t, "2", resp.Data.Packed.Attestations[0].Attesters)
+	assert.Equal(t, "0x0200000000000000", resp.Data.Packed.Attestations[0].CommitteeBits)
+	assert.Equal(t, "5", resp.Data.Naive.Reward)
+	require.Equal(t, 1, len(resp.Data.Naive.Attestations))
+	assert.Equal(t, "4", resp.Data.Naive.Attestations[0].Data.Slot)
+	assert.Equal(t, "0x0d", resp.Data.Naive.Attestations[0].AggregationBits)
+	assert.Equal(t, "", resp.Data.Naive.Attestations[0].CommitteeBits)
+
+	t.Run("not available", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		(&Server{}).GetAttestationPacking(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
@@ -4,9 +4,12 @@
 package debug
 
 import (
+	"context"
+
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
 )
 
 // Server defines a server implementation of the gRPC Beacon Chain service,
@@ -20,4 +23,10 @@ type Server struct {
 	ForkchoiceFetcher     blockchain.ForkchoiceFetcher
 	FinalizationFetcher   blockchain.FinalizationFetcher
 	ChainInfoFetcher      blockchain.ChainInfoFetcher
+	AttestationPacker     AttestationPackingReporter
+}
+
+// AttestationPackingReporter packs the attestations of the pool as for the next block proposed by the node.
+type AttestationPackingReporter interface {
+	AttestationPackingReport(ctx context.Context) (*validator.AttestationPackingReport, error)
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	v2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
 
@@ -118,9 +119,27 @@ func (s *Server) GetLightClientUpdatesByRange(w http.ResponseWriter, req *http.R
 		endPeriod = maxSlot / slotsPerPeriod
 	}
 
+	// Updates saved while importing blocks are served from the database
+	var savedUpdates map[uint64]*v2.LightClientUpdate
+	if s.BeaconDB != nil && startPeriod <= endPeriod {
+		savedUpdates, err = s.BeaconDB.LightClientUpdates(ctx, startPeriod, endPeriod)
+		if err != nil {
+			httputil.HandleError(w, "could not get light client updates: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
 	// Populate updates
 	var updates []*structs.LightClientUpdateWithVersion
 	for period := startPeriod; period <= endPeriod; period++ {
+		if update, ok := savedUpdates[period]; ok {
+			updates = append(updates, &structs.LightClientUpdateWithVersion{
+				Version: version.String(versionAtSlot(update.AttestedHeader.Slot)),
+				Data:    newLightClientUpdateToJSON(update),
+			})
+			continue
+		}
+
 		// Get the last known state of the period,
 		//    1. We wish the block has a parent in the same period if possible
 		//	  2. We wish the block has a state in the same period
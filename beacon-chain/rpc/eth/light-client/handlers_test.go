@@ -15,6 +15,7 @@ import (
 
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -22,6 +23,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	v2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
@@ -1008,3 +1011,75 @@ func TestLightClientHandler_GetLightClientEventBlock_NeedFetchParent(t *testing.
 	require.Equal(t, true, syncAggregate.SyncCommitteeBits.Count() >= minSignaturesRequired)
 	require.Equal(t, slot-1, eventBlock.Block().Slot())
 }
+
+func TestLightClientHandler_GetLightClientUpdatesByRange_FromDB(t *testing.T) {
+	helpers.ClearCache()
+	ctx := context.Background()
+	config := params.BeaconConfig()
+	slotsPerPeriod := primitives.Slot(config.EpochsPerSyncCommitteePeriod) * config.SlotsPerEpoch
+	startPeriod := uint64(config.AltairForkEpoch/config.EpochsPerSyncCommitteePeriod) + 1
+
+	beaconDB := dbtest.SetupDB(t)
+	for _, period := range []uint64{startPeriod, startPeriod + 1} {
+		attestedSlot := primitives.Slot(period)*slotsPerPeriod + 1
+		update := &v2.LightClientUpdate{
+			AttestedHeader: &v1.BeaconBlockHeader{
+				Slot:       attestedSlot,
+				ParentRoot: make([]byte, 32),
+				StateRoot:  make([]byte, 32),
+				BodyRoot:   bytesutil.PadTo([]byte{byte(period)}, 32),
+			},
+			NextSyncCommittee: &v2.SyncCommittee{
+				Pubkeys:         make([][]byte, config.SyncCommitteeSize),
+				AggregatePubkey: make([]byte, 48),
+			},
+			NextSyncCommitteeBranch: make([][]byte, 5),
+			FinalizedHeader: &v1.BeaconBlockHeader{
+				ParentRoot: make([]byte, 32),
+				StateRoot:  make([]byte, 32),
+				BodyRoot:   make([]byte, 32),
+			},
+			FinalityBranch: make([][]byte, 6),
+			SyncAggregate: &v1.SyncAggregate{
+				SyncCommitteeBits:      make([]byte, 64),
+				SyncCommitteeSignature: make([]byte, 96),
+			},
+			SignatureSlot: attestedSlot + 1,
+		}
+		for i := range update.NextSyncCommittee.Pubkeys {
+			update.NextSyncCommittee.Pubkeys[i] = make([]byte, 48)
+		}
+		for i := range update.NextSyncCommitteeBranch {
+			update.NextSyncCommitteeBranch[i] = make([]byte, 32)
+		}
+		for i := range update.FinalityBranch {
+			update.FinalityBranch[i] = make([]byte, 32)
+		}
+		require.NoError(t, beaconDB.SaveLightClientUpdate(ctx, period, update))
+	}
+
+	headState, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(primitives.Slot(startPeriod+2)*slotsPerPeriod))
+	s := &Server{
+		Stater:      &testutil.MockStater{},
+		Blocker:     &testutil.MockBlocker{},
+		HeadFetcher: &mock.ChainService{State: headState},
+		BeaconDB:    beaconDB,
+	}
+	url := fmt.Sprintf("http://foo.com/?count=2&start_period=%d", startPeriod)
+	request := httptest.NewRequest("GET", url, nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetLightClientUpdatesByRange(writer, request)
+
+	require.Equal(t, http.StatusOK, writer.Code)
+	var resp []structs.LightClientUpdateWithVersion
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+	require.Equal(t, 2, len(resp))
+	for i, update := range resp {
+		require.Equal(t, "altair", update.Version)
+		require.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{byte(startPeriod + uint64(i))}, 32)), update.Data.AttestedHeader.BodyRoot)
+	}
+}
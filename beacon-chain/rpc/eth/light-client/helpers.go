@@ -2,19 +2,19 @@ package lightclient
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
-	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	v2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/v5/proto/migration"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
@@ -36,55 +36,16 @@ import (
 //	    current_sync_committee_branch=compute_merkle_proof_for_state(state, CURRENT_SYNC_COMMITTEE_INDEX)
 //	)
 func createLightClientBootstrap(ctx context.Context, state state.BeaconState) (*structs.LightClientBootstrap, error) {
-	// assert compute_epoch_at_slot(state.slot) >= ALTAIR_FORK_EPOCH
-	if slots.ToEpoch(state.Slot()) < params.BeaconConfig().AltairForkEpoch {
-		return nil, fmt.Errorf("light client bootstrap is not supported before Altair, invalid slot %d", state.Slot())
-	}
-
-	// assert state.slot == state.latest_block_header.slot
-	latestBlockHeader := state.LatestBlockHeader()
-	if state.Slot() != latestBlockHeader.Slot {
-		return nil, fmt.Errorf("state slot %d not equal to latest block header slot %d", state.Slot(), latestBlockHeader.Slot)
-	}
-
-	// Prepare data
-	currentSyncCommittee, err := state.CurrentSyncCommittee()
-	if err != nil {
-		return nil, fmt.Errorf("could not get current sync committee: %s", err.Error())
-	}
-
-	committee := structs.SyncCommitteeFromConsensus(currentSyncCommittee)
-
-	currentSyncCommitteeProof, err := state.CurrentSyncCommitteeProof(ctx)
+	bootstrap, err := blockchain.NewLightClientBootstrapFromBeaconState(ctx, state)
 	if err != nil {
-		return nil, fmt.Errorf("could not get current sync committee proof: %s", err.Error())
-	}
-
-	branch := make([]string, fieldparams.NextSyncCommitteeBranchDepth)
-	for i, proof := range currentSyncCommitteeProof {
-		branch[i] = hexutil.Encode(proof)
-	}
-
-	header := structs.BeaconBlockHeaderFromConsensus(latestBlockHeader)
-	if header == nil {
-		return nil, fmt.Errorf("could not get beacon block header")
-	}
-
-	// Above shared util function won't calculate state root, so we need to do it manually
-	stateRoot, err := state.HashTreeRoot(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("could not get state root: %s", err.Error())
-	}
-	header.StateRoot = hexutil.Encode(stateRoot[:])
-
-	// Return result
-	result := &structs.LightClientBootstrap{
-		Header:                     header,
-		CurrentSyncCommittee:       committee,
-		CurrentSyncCommitteeBranch: branch,
+		return nil, err
 	}
 
-	return result, nil
+	return &structs.LightClientBootstrap{
+		Header:                     structs.BeaconBlockHeaderFromConsensus(migration.V1HeaderToV1Alpha1(bootstrap.Header)),
+		CurrentSyncCommittee:       structs.SyncCommitteeFromConsensus(migration.V2SyncCommitteeToV1Alpha1(bootstrap.CurrentSyncCommittee)),
+		CurrentSyncCommitteeBranch: branchToJSON(bootstrap.CurrentSyncCommitteeBranch),
+	}, nil
 }
 
 // createLightClientUpdate - implements https://github.
@@ -151,55 +112,11 @@ func createLightClientUpdate(
 	block interfaces.ReadOnlySignedBeaconBlock,
 	attestedState state.BeaconState,
 	finalizedBlock interfaces.ReadOnlySignedBeaconBlock) (*structs.LightClientUpdate, error) {
-	result, err := blockchain.NewLightClientFinalityUpdateFromBeaconState(ctx, state, block, attestedState, finalizedBlock)
+	result, err := blockchain.NewLightClientUpdateFromBeaconState(ctx, state, block, attestedState, finalizedBlock)
 	if err != nil {
 		return nil, err
 	}
 
-	// Generate next sync committee and proof
-	var nextSyncCommittee *v2.SyncCommittee
-	var nextSyncCommitteeBranch [][]byte
-
-	// update_signature_period = compute_sync_committee_period(compute_epoch_at_slot(block.message.slot))
-	updateSignaturePeriod := slots.ToEpoch(block.Block().Slot())
-
-	// update_attested_period = compute_sync_committee_period(compute_epoch_at_slot(attested_header.slot))
-	updateAttestedPeriod := slots.ToEpoch(result.AttestedHeader.Slot)
-
-	if updateAttestedPeriod == updateSignaturePeriod {
-		tempNextSyncCommittee, err := attestedState.NextSyncCommittee()
-		if err != nil {
-			return nil, fmt.Errorf("could not get next sync committee: %s", err.Error())
-		}
-
-		nextSyncCommittee = &v2.SyncCommittee{
-			Pubkeys:         tempNextSyncCommittee.Pubkeys,
-			AggregatePubkey: tempNextSyncCommittee.AggregatePubkey,
-		}
-
-		nextSyncCommitteeBranch, err = attestedState.NextSyncCommitteeProof(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("could not get next sync committee proof: %s", err.Error())
-		}
-	} else {
-		syncCommitteeSize := params.BeaconConfig().SyncCommitteeSize
-		pubKeys := make([][]byte, syncCommitteeSize)
-		for i := uint64(0); i < syncCommitteeSize; i++ {
-			pubKeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
-		}
-		nextSyncCommittee = &v2.SyncCommittee{
-			Pubkeys:         pubKeys,
-			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
-		}
-
-		nextSyncCommitteeBranch = make([][]byte, fieldparams.NextSyncCommitteeBranchDepth)
-		for i := 0; i < fieldparams.NextSyncCommitteeBranchDepth; i++ {
-			nextSyncCommitteeBranch[i] = make([]byte, fieldparams.RootLength)
-		}
-	}
-
-	result.NextSyncCommittee = nextSyncCommittee
-	result.NextSyncCommitteeBranch = nextSyncCommitteeBranch
 	return newLightClientUpdateToJSON(result), nil
 }
 
@@ -230,6 +147,26 @@ func newLightClientOptimisticUpdateFromBeaconState(
 	return newLightClientUpdateToJSON(result), nil
 }
 
+// versionAtSlot returns the fork version of the beacon state at the slot.
+func versionAtSlot(slot primitives.Slot) int {
+	epoch := slots.ToEpoch(slot)
+	config := params.BeaconConfig()
+	switch {
+	case epoch >= config.ElectraForkEpoch:
+		return version.Electra
+	case epoch >= config.DenebForkEpoch:
+		return version.Deneb
+	case epoch >= config.CapellaForkEpoch:
+		return version.Capella
+	case epoch >= config.BellatrixForkEpoch:
+		return version.Bellatrix
+	case epoch >= config.AltairForkEpoch:
+		return version.Altair
+	default:
+		return version.Phase0
+	}
+}
+
 func NewLightClientBootstrapFromJSON(bootstrapJSON *structs.LightClientBootstrap) (*v2.LightClientBootstrap, error) {
 	bootstrap := &v2.LightClientBootstrap{}
 
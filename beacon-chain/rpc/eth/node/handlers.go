@@ -1,12 +1,14 @@
 package node
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"runtime"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
@@ -119,21 +121,53 @@ func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	detail := false
+	if rawDetail := r.URL.Query().Get("detail"); rawDetail != "" {
+		var err error
+		detail, err = strconv.ParseBool(rawDetail)
+		if err != nil {
+			httputil.HandleError(w, "detail is not a valid boolean: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
 	optimistic, err := s.OptimisticModeFetcher.IsOptimistic(ctx)
 	if err != nil {
 		httputil.HandleError(w, "Could not check optimistic status: "+err.Error(), http.StatusInternalServerError)
 	}
+	code := http.StatusServiceUnavailable
 	if s.SyncChecker.Synced() && !optimistic {
-		return
-	}
-	if s.SyncChecker.Syncing() || optimistic {
+		code = http.StatusOK
+	} else if s.SyncChecker.Syncing() || optimistic {
 		if rawSyncingStatus != "" {
-			w.WriteHeader(intSyncingStatus)
+			code = intSyncingStatus
 		} else {
-			w.WriteHeader(http.StatusPartialContent)
+			code = http.StatusPartialContent
 		}
+	}
+	if !detail {
+		w.WriteHeader(code)
 		return
 	}
 
-	w.WriteHeader(http.StatusServiceUnavailable)
+	// The detail reports the health of every execution endpoint, along with the status code.
+	statuses := s.ExecutionChainInfoFetcher.ExecutionEndpointStatuses()
+	endpoints := make([]*structs.ExecutionEndpointStatus, len(statuses))
+	for i, st := range statuses {
+		endpoints[i] = &structs.ExecutionEndpointStatus{
+			Endpoint:   st.Endpoint,
+			Primary:    st.Primary,
+			Status:     st.Health.String(),
+			LastUpdate: strconv.FormatInt(st.LastUpdate.Unix(), 10),
+		}
+		if st.LastError != nil {
+			endpoints[i].LastError = st.LastError.Error()
+		}
+	}
+	w.Header().Set("Content-Type", api.JsonMediaType)
+	w.WriteHeader(code)
+	resp := &structs.GetHealthDetailResponse{Data: &structs.HealthDetail{ExecutionEndpoints: endpoints}}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("Could not write health detail")
+	}
 }
@@ -3,11 +3,13 @@ package node
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
@@ -16,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
@@ -129,6 +132,44 @@ func TestGetHealth(t *testing.T) {
 	assert.Equal(t, http.StatusPartialContent, writer.Code)
 }
 
+func TestGetHealth_Detail(t *testing.T) {
+	checker := &syncmock.Sync{IsSynced: true}
+	s := &Server{
+		SyncChecker:           checker,
+		OptimisticModeFetcher: &mock.ChainService{Optimistic: true},
+		ExecutionChainInfoFetcher: &testutil.MockExecutionChainInfoFetcher{
+			EndpointStatuses: []*types.EndpointStatus{
+				{Endpoint: "http://primary:8551", Primary: true, Health: types.EndpointSyncing, LastUpdate: time.Unix(100, 0)},
+				{Endpoint: "http://fallback:8551", Health: types.EndpointOffline, LastError: errors.New("connection refused")},
+			},
+		},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/node/health?detail=true", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetHealth(writer, request)
+	assert.Equal(t, http.StatusPartialContent, writer.Code)
+	resp := &structs.GetHealthDetailResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Data.ExecutionEndpoints))
+	primary := resp.Data.ExecutionEndpoints[0]
+	assert.Equal(t, "http://primary:8551", primary.Endpoint)
+	assert.Equal(t, true, primary.Primary)
+	assert.Equal(t, "syncing", primary.Status)
+	assert.Equal(t, "100", primary.LastUpdate)
+	fallback := resp.Data.ExecutionEndpoints[1]
+	assert.Equal(t, false, fallback.Primary)
+	assert.Equal(t, "offline", fallback.Status)
+	assert.Equal(t, "connection refused", fallback.LastError)
+
+	request = httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/node/health?detail=maybe", nil)
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetHealth(writer, request)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+}
+
 func TestGetIdentity(t *testing.T) {
 	p2pAddr, err := ma.NewMultiaddr("/ip4/7.7.7.7/udp/30303")
 	require.NoError(t, err)
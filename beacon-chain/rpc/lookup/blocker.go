@@ -222,8 +222,9 @@ func (p *BeaconDbBlocker) Blobs(ctx context.Context, id string, indices []uint64
 	if err != nil {
 		return nil, &core.RpcError{Err: errors.Wrap(err, "failed to retrieve block from db"), Reason: core.Internal}
 	}
-	// if block is not in the retention window  return 200 w/ empty list
-	if !p.BlobStorage.WithinRetentionPeriod(slots.ToEpoch(b.Block().Slot()), slots.ToEpoch(p.GenesisTimeFetcher.CurrentSlot())) {
+	// if block is not in the retention window  return 200 w/ empty list, unless the node keeps blobs in archive mode.
+	archived := !p.BlobStorage.WithinRetentionPeriod(slots.ToEpoch(b.Block().Slot()), slots.ToEpoch(p.GenesisTimeFetcher.CurrentSlot()))
+	if archived && !p.BlobStorage.ArchiveMode() {
 		return make([]*blocks.VerifiedROBlob, 0), nil
 	}
 	commitments, err := b.Block().Body().BlobKzgCommitments()
@@ -234,7 +235,7 @@ func (p *BeaconDbBlocker) Blobs(ctx context.Context, id string, indices []uint64
 	if len(commitments) == 0 {
 		return make([]*blocks.VerifiedROBlob, 0), nil
 	}
-	if len(indices) == 0 {
+	if len(indices) == 0 || archived {
 		m, err := p.BlobStorage.Indices(bytesutil.ToBytes32(root))
 		if err != nil {
 			log.WithFields(log.Fields{
@@ -242,10 +243,21 @@ func (p *BeaconDbBlocker) Blobs(ctx context.Context, id string, indices []uint64
 			}).Error(errors.Wrapf(err, "could not retrieve blob indices for root %#x", root))
 			return nil, &core.RpcError{Err: fmt.Errorf("could not retrieve blob indices for root %#x", root), Reason: core.Internal}
 		}
-		for k, v := range m {
-			if v {
-				indices = append(indices, uint64(k))
+		if len(indices) == 0 {
+			for k, v := range m {
+				if v {
+					indices = append(indices, uint64(k))
+				}
+			}
+		} else {
+			// Blobs outside the retention period are only served if they were kept in the archive.
+			present := make([]uint64, 0, len(indices))
+			for _, index := range indices {
+				if index < uint64(len(m)) && m[index] {
+					present = append(present, index)
+				}
 			}
+			indices = present
 		}
 	}
 	// returns empty slice if there are no indices
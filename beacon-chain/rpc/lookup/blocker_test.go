@@ -300,4 +300,38 @@ func TestGetBlob(t *testing.T) {
 		assert.Equal(t, rpcErr == nil, true)
 		require.Equal(t, 0, len(verifiedBlobs))
 	})
+	t.Run("outside retention period returns an empty array", func(t *testing.T) {
+		blocker := &BeaconDbBlocker{
+			GenesisTimeFetcher: &testutil.MockGenesisTimeFetcher{
+				Genesis: time.Now().Add(-time.Hour * 24 * 30),
+			},
+			BeaconDB:    db,
+			BlobStorage: bs,
+		}
+		verifiedBlobs, rpcErr := blocker.Blobs(ctx, "123", nil)
+		assert.Equal(t, rpcErr == nil, true)
+		require.Equal(t, 0, len(verifiedBlobs))
+	})
+	t.Run("archive mode outside retention period", func(t *testing.T) {
+		archive := filesystem.NewEphemeralBlobStorage(t, filesystem.WithArchiveMode(true))
+		for i := range testSidecars[:2] {
+			require.NoError(t, archive.Save(testSidecars[i]))
+		}
+		blocker := &BeaconDbBlocker{
+			GenesisTimeFetcher: &testutil.MockGenesisTimeFetcher{
+				Genesis: time.Now().Add(-time.Hour * 24 * 30),
+			},
+			BeaconDB:    db,
+			BlobStorage: archive,
+		}
+		verifiedBlobs, rpcErr := blocker.Blobs(ctx, "123", nil)
+		assert.Equal(t, rpcErr == nil, true)
+		require.Equal(t, 2, len(verifiedBlobs))
+		assert.DeepEqual(t, blobs[1].Blob, verifiedBlobs[1].Blob)
+		// Indices missing from the archive are skipped rather than failing the request.
+		verifiedBlobs, rpcErr = blocker.Blobs(ctx, "123", []uint64{1, 3})
+		assert.Equal(t, rpcErr == nil, true)
+		require.Equal(t, 1, len(verifiedBlobs))
+		assert.Equal(t, uint64(1), verifiedBlobs[0].Index)
+	})
 }
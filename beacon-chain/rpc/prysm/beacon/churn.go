@@ -0,0 +1,121 @@
+package beacon
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	rpchelpers "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// GetChurn is a HTTP handler that serves the GET /prysm/v1/beacon/states/{state_id}/churn endpoint.
+// It returns the Electra churn limits derived from the total active balance of the state, along with
+// the churn already consumed and the lengths of the pending deposit, partial withdrawal and
+// consolidation queues. All balances are in Gwei.
+func (s *Server) GetChurn(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetChurn")
+	defer span.End()
+
+	stateID := mux.Vars(r)["state_id"]
+	if stateID == "" {
+		httputil.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	st, err := s.Stater.State(ctx, []byte(stateID))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return
+	}
+	if st.Version() < version.Electra {
+		httputil.HandleError(w, "Endpoint is only available for Electra states, got "+version.String(st.Version()), http.StatusBadRequest)
+		return
+	}
+	churn, err := churnFromState(st)
+	if err != nil {
+		httputil.HandleError(w, "Could not get churn: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	isOptimistic, err := rpchelpers.IsOptimistic(ctx, []byte(stateID), s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
+	if err != nil {
+		httputil.HandleError(w, "Could not check optimistic status: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	blockRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not calculate root of latest block header: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httputil.WriteJson(w, &structs.GetChurnResponse{
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           s.FinalizationFetcher.IsFinalized(ctx, blockRoot),
+		Data:                churn,
+	})
+}
+
+func churnFromState(st state.ReadOnlyBeaconState) (*structs.Churn, error) {
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get total active balance")
+	}
+	depositBalanceToConsume, err := st.DepositBalanceToConsume()
+	if err != nil {
+		return nil, err
+	}
+	exitBalanceToConsume, err := st.ExitBalanceToConsume()
+	if err != nil {
+		return nil, err
+	}
+	earliestExitEpoch, err := st.EarliestExitEpoch()
+	if err != nil {
+		return nil, err
+	}
+	consolidationBalanceToConsume, err := st.ConsolidationBalanceToConsume()
+	if err != nil {
+		return nil, err
+	}
+	earliestConsolidationEpoch, err := st.EarliestConsolidationEpoch()
+	if err != nil {
+		return nil, err
+	}
+	deposits, err := st.PendingBalanceDeposits()
+	if err != nil {
+		return nil, err
+	}
+	numWithdrawals, err := st.NumPendingPartialWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	numConsolidations, err := st.NumPendingConsolidations()
+	if err != nil {
+		return nil, err
+	}
+
+	gwei := primitives.Gwei(activeBalance)
+	return &structs.Churn{
+		Epoch:                          fmt.Sprintf("%d", slots.ToEpoch(st.Slot())),
+		TotalActiveBalance:             fmt.Sprintf("%d", activeBalance),
+		BalanceChurnLimit:              fmt.Sprintf("%d", helpers.BalanceChurnLimit(gwei)),
+		ActivationExitChurnLimit:       fmt.Sprintf("%d", helpers.ActivationExitChurnLimit(gwei)),
+		ConsolidationChurnLimit:        fmt.Sprintf("%d", helpers.ConsolidationChurnLimit(gwei)),
+		DepositBalanceToConsume:        fmt.Sprintf("%d", depositBalanceToConsume),
+		ExitBalanceToConsume:           fmt.Sprintf("%d", exitBalanceToConsume),
+		EarliestExitEpoch:              fmt.Sprintf("%d", earliestExitEpoch),
+		ConsolidationBalanceToConsume:  fmt.Sprintf("%d", consolidationBalanceToConsume),
+		EarliestConsolidationEpoch:     fmt.Sprintf("%d", earliestConsolidationEpoch),
+		PendingDepositsCount:           fmt.Sprintf("%d", len(deposits)),
+		PendingPartialWithdrawalsCount: fmt.Sprintf("%d", numWithdrawals),
+		PendingConsolidationsCount:     fmt.Sprintf("%d", numConsolidations),
+	}, nil
+}
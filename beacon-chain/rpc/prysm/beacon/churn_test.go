@@ -0,0 +1,80 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestGetChurn(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateElectra(t, 64)
+	require.NoError(t, st.SetConsolidationBalanceToConsume(20))
+	require.NoError(t, st.SetEarliestConsolidationEpoch(6))
+	require.NoError(t, st.SetPendingBalanceDeposits([]*eth.PendingBalanceDeposit{{Index: 1, Amount: 1}, {Index: 2, Amount: 2}}))
+	require.NoError(t, st.AppendPendingConsolidation(&eth.PendingConsolidation{SourceIndex: 1, TargetIndex: 2}))
+
+	chainService := &chainMock.ChainService{}
+	server := &Server{
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		Stater:                &testutil.MockStater{BeaconState: st},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/states/{state_id}/churn", nil)
+	request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	server.GetChurn(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetChurnResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	require.NoError(t, err)
+	gwei := primitives.Gwei(activeBalance)
+	assert.Equal(t, fmt.Sprintf("%d", activeBalance), resp.Data.TotalActiveBalance)
+	assert.Equal(t, fmt.Sprintf("%d", helpers.BalanceChurnLimit(gwei)), resp.Data.BalanceChurnLimit)
+	assert.Equal(t, fmt.Sprintf("%d", helpers.ActivationExitChurnLimit(gwei)), resp.Data.ActivationExitChurnLimit)
+	assert.Equal(t, fmt.Sprintf("%d", helpers.ConsolidationChurnLimit(gwei)), resp.Data.ConsolidationChurnLimit)
+	exitBalanceToConsume, err := st.ExitBalanceToConsume()
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%d", exitBalanceToConsume), resp.Data.ExitBalanceToConsume)
+	assert.Equal(t, "20", resp.Data.ConsolidationBalanceToConsume)
+	assert.Equal(t, "6", resp.Data.EarliestConsolidationEpoch)
+	assert.Equal(t, "2", resp.Data.PendingDepositsCount)
+	assert.Equal(t, "0", resp.Data.PendingPartialWithdrawalsCount)
+	assert.Equal(t, "1", resp.Data.PendingConsolidationsCount)
+}
+
+func TestGetChurn_PreElectra(t *testing.T) {
+	st, err := util.NewBeaconStateDeneb()
+	require.NoError(t, err)
+	server := &Server{Stater: &testutil.MockStater{BeaconState: st}}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/states/{state_id}/churn", nil)
+	request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	server.GetChurn(writer, request)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &httputil.DefaultJsonError{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "only available for Electra states", e.Message)
+}
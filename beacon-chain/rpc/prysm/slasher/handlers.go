@@ -0,0 +1,303 @@
+package slasher
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	slasherservice "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// ListAttesterSlashings retrieves the attester slashings detected by the slasher, optionally
+// filtered by epoch range and slashed validator indices.
+func (s *Server) ListAttesterSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.ListAttesterSlashings")
+	defer span.End()
+
+	if !s.slasherEnabled(w) {
+		return
+	}
+	filter, ok := slashingsFilterFromQuery(w, r)
+	if !ok {
+		return
+	}
+	slashings, err := s.SlasherDB.AttesterSlashings(ctx, filter)
+	if err != nil {
+		httputil.HandleError(w, "Could not get attester slashings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetSlasherAttesterSlashingsResponse{Data: attesterSlashingsFromConsensus(slashings)})
+}
+
+// ListProposerSlashings retrieves the proposer slashings detected by the slasher, optionally
+// filtered by epoch range and slashed validator indices.
+func (s *Server) ListProposerSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.ListProposerSlashings")
+	defer span.End()
+
+	if !s.slasherEnabled(w) {
+		return
+	}
+	filter, ok := slashingsFilterFromQuery(w, r)
+	if !ok {
+		return
+	}
+	// Proposer slashings are looked up by slot, so the start epoch must be convertible to a slot.
+	if filter.StartEpoch > slots.MaxSafeEpoch() {
+		httputil.HandleError(w, fmt.Sprintf("start_epoch must not be greater than %d", slots.MaxSafeEpoch()), http.StatusBadRequest)
+		return
+	}
+	slashings, err := s.SlasherDB.ProposerSlashings(ctx, filter)
+	if err != nil {
+		httputil.HandleError(w, "Could not get proposer slashings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetSlasherProposerSlashingsResponse{Data: structs.ProposerSlashingsFromConsensus(slashings)})
+}
+
+// IsSlashableAttestation checks an indexed attestation against the slasher history and returns
+// the attester slashings it would cause. The attestation is not submitted to the slasher.
+func (s *Server) IsSlashableAttestation(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.IsSlashableAttestation")
+	defer span.End()
+
+	if !s.slasherEnabled(w) {
+		return
+	}
+	var req structs.IndexedAttestation
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	att, err := req.ToConsensus()
+	if err != nil {
+		httputil.HandleError(w, "Could not convert request attestation to consensus attestation: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	slashings, err := s.SlashingChecker.IsSlashableAttestation(ctx, att)
+	if errors.Is(err, slasherservice.ErrInvalidAttestation) {
+		httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		httputil.HandleError(w, "Could not check attestation: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.CheckSlashableAttestationResponse{Data: attesterSlashingsFromConsensus(slashings)})
+}
+
+// IsSlashableBlock checks a signed block header against the slasher history and returns the
+// proposer slashing it would cause. The header is not submitted to the slasher.
+func (s *Server) IsSlashableBlock(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.IsSlashableBlock")
+	defer span.End()
+
+	if !s.slasherEnabled(w) {
+		return
+	}
+	var req structs.SignedBeaconBlockHeader
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	header, err := req.ToConsensus()
+	if err != nil {
+		httputil.HandleError(w, "Could not convert request header to consensus header: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	slashing, err := s.SlashingChecker.IsSlashableBlock(ctx, header)
+	if errors.Is(err, slasherservice.ErrInvalidBlockHeader) {
+		httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		httputil.HandleError(w, "Could not check block header: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &structs.CheckSlashableBlockResponse{Data: make([]*structs.ProposerSlashing, 0, 1)}
+	if slashing != nil {
+		resp.Data = append(resp.Data, structs.ProposerSlashingFromConsensus(slashing))
+	}
+	httputil.WriteJson(w, resp)
+}
+
+// GetAttestationRecord retrieves the attestation recorded by the slasher for a validator at a target epoch.
+func (s *Server) GetAttestationRecord(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.GetAttestationRecord")
+	defer span.End()
+
+	if !s.slasherEnabled(w) {
+		return
+	}
+	_, validatorIndex, ok := shared.UintFromRoute(w, r, "validator_index")
+	if !ok {
+		return
+	}
+	_, targetEpoch, ok := shared.UintFromRoute(w, r, "target_epoch")
+	if !ok {
+		return
+	}
+	record, err := s.SlasherDB.AttestationRecordForValidator(
+		ctx, primitives.ValidatorIndex(validatorIndex), primitives.Epoch(targetEpoch),
+	)
+	if err != nil {
+		httputil.HandleError(w, "Could not get attestation record: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if record == nil {
+		httputil.HandleError(w, "Attestation record not found", http.StatusNotFound)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetSlasherAttestationRecordResponse{
+		Data: &structs.SlasherAttestationRecord{
+			DataRoot:    hexutil.Encode(record.DataRoot[:]),
+			Attestation: structs.IndexedAttestationFromConsensus(record.IndexedAttestation),
+		},
+	})
+}
+
+// GetProposalRecord retrieves the block proposal recorded by the slasher for a validator at a slot.
+func (s *Server) GetProposalRecord(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.GetProposalRecord")
+	defer span.End()
+
+	if !s.slasherEnabled(w) {
+		return
+	}
+	_, validatorIndex, ok := shared.UintFromRoute(w, r, "validator_index")
+	if !ok {
+		return
+	}
+	_, slot, ok := shared.UintFromRoute(w, r, "slot")
+	if !ok {
+		return
+	}
+	record, err := s.SlasherDB.BlockProposalForValidator(
+		ctx, primitives.ValidatorIndex(validatorIndex), primitives.Slot(slot),
+	)
+	if err != nil {
+		httputil.HandleError(w, "Could not get proposal record: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if record == nil {
+		httputil.HandleError(w, "Proposal record not found", http.StatusNotFound)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetSlasherProposalRecordResponse{
+		Data: &structs.SlasherProposalRecord{
+			HeaderRoot:   hexutil.Encode(record.HeaderRoot[:]),
+			SignedHeader: structs.SignedBeaconBlockHeaderFromConsensus(record.SignedBeaconBlockHeader),
+		},
+	})
+}
+
+// GetValidatorSpans retrieves the min and max spans the slasher holds for a validator at an epoch.
+func (s *Server) GetValidatorSpans(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.GetValidatorSpans")
+	defer span.End()
+
+	if !s.slasherEnabled(w) {
+		return
+	}
+	_, validatorIndex, ok := shared.UintFromRoute(w, r, "validator_index")
+	if !ok {
+		return
+	}
+	_, epoch, ok := shared.UintFromRoute(w, r, "epoch")
+	if !ok {
+		return
+	}
+	spans, err := s.SlashingChecker.ValidatorSpans(ctx, primitives.ValidatorIndex(validatorIndex), primitives.Epoch(epoch))
+	if errors.Is(err, slasherservice.ErrEpochOutsideHistory) {
+		httputil.HandleError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httputil.HandleError(w, "Could not get validator spans: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := &structs.SlasherValidatorSpans{
+		ValidatorIndex: fmt.Sprintf("%d", validatorIndex),
+		Epoch:          fmt.Sprintf("%d", epoch),
+		MinSpan:        fmt.Sprintf("%d", spans.MinSpan),
+		MaxSpan:        fmt.Sprintf("%d", spans.MaxSpan),
+	}
+	if spans.MinSpanSet {
+		data.MinTarget = fmt.Sprintf("%d", epoch+uint64(spans.MinSpan))
+	}
+	if spans.MaxSpanSet {
+		data.MaxTarget = fmt.Sprintf("%d", epoch+uint64(spans.MaxSpan))
+	}
+	httputil.WriteJson(w, &structs.GetSlasherValidatorSpansResponse{Data: data})
+}
+
+func (s *Server) slasherEnabled(w http.ResponseWriter) bool {
+	if s.SlasherDB == nil || s.SlashingChecker == nil {
+		httputil.HandleError(w, "Slasher is not enabled", http.StatusServiceUnavailable)
+		return false
+	}
+	return true
+}
+
+func slashingsFilterFromQuery(w http.ResponseWriter, r *http.Request) (*slashertypes.SlashingsFilter, bool) {
+	filter := &slashertypes.SlashingsFilter{EndEpoch: math.MaxUint64}
+	rawStart, start, ok := shared.UintFromQuery(w, r, "start_epoch", false)
+	if !ok {
+		return nil, false
+	}
+	if rawStart != "" {
+		filter.StartEpoch = primitives.Epoch(start)
+	}
+	rawEnd, end, ok := shared.UintFromQuery(w, r, "end_epoch", false)
+	if !ok {
+		return nil, false
+	}
+	if rawEnd != "" {
+		filter.EndEpoch = primitives.Epoch(end)
+	}
+	if filter.StartEpoch > filter.EndEpoch {
+		httputil.HandleError(w, "start_epoch must not be greater than end_epoch", http.StatusBadRequest)
+		return nil, false
+	}
+	for _, raw := range r.URL.Query()["validator_index"] {
+		idx, ok := shared.ValidateUint(w, "validator_index", raw)
+		if !ok {
+			return nil, false
+		}
+		filter.ValidatorIndices = append(filter.ValidatorIndices, primitives.ValidatorIndex(idx))
+	}
+	return filter, true
+}
+
+func attesterSlashingsFromConsensus(slashings []eth.AttSlashing) []*structs.AttesterSlashing {
+	result := make([]*structs.AttesterSlashing, len(slashings))
+	for i, slashing := range slashings {
+		result[i] = &structs.AttesterSlashing{
+			Attestation1: structs.IndexedAttestationFromConsensus(slashing.FirstAttestation()),
+			Attestation2: structs.IndexedAttestationFromConsensus(slashing.SecondAttestation()),
+		}
+	}
+	return result
+}
@@ -0,0 +1,359 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	slasherservice "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+type mockSlashingChecker struct {
+	attesterSlashings []eth.AttSlashing
+	proposerSlashing  *eth.ProposerSlashing
+	spans             *slasherservice.ValidatorSpans
+	err               error
+}
+
+func (m *mockSlashingChecker) IsSlashableBlock(_ context.Context, _ *eth.SignedBeaconBlockHeader) (*eth.ProposerSlashing, error) {
+	return m.proposerSlashing, m.err
+}
+
+func (m *mockSlashingChecker) IsSlashableAttestation(_ context.Context, _ eth.IndexedAtt) ([]eth.AttSlashing, error) {
+	return m.attesterSlashings, m.err
+}
+
+func (m *mockSlashingChecker) ValidatorSpans(_ context.Context, _ primitives.ValidatorIndex, _ primitives.Epoch) (*slasherservice.ValidatorSpans, error) {
+	return m.spans, m.err
+}
+
+func attesterSlashing(target primitives.Epoch, indices []uint64) *eth.AttesterSlashing {
+	att1 := util.HydrateIndexedAttestation(&eth.IndexedAttestation{
+		AttestingIndices: indices,
+		Data:             &eth.AttestationData{Target: &eth.Checkpoint{Epoch: target}},
+	})
+	att2 := util.HydrateIndexedAttestation(&eth.IndexedAttestation{
+		AttestingIndices: indices,
+		Data:             &eth.AttestationData{Target: &eth.Checkpoint{Epoch: target}, BeaconBlockRoot: bytes.Repeat([]byte{1}, 32)},
+	})
+	return &eth.AttesterSlashing{Attestation_1: att1, Attestation_2: att2}
+}
+
+func proposerSlashing(slot primitives.Slot, proposer primitives.ValidatorIndex) *eth.ProposerSlashing {
+	return &eth.ProposerSlashing{
+		Header_1: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{
+			Header: &eth.BeaconBlockHeader{Slot: slot, ProposerIndex: proposer},
+		}),
+		Header_2: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{
+			Header: &eth.BeaconBlockHeader{Slot: slot, ProposerIndex: proposer, BodyRoot: bytes.Repeat([]byte{1}, 32)},
+		}),
+	}
+}
+
+func TestListAttesterSlashings(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	require.NoError(t, slasherDB.SaveAttesterSlashings(ctx, []eth.AttSlashing{
+		attesterSlashing(1, []uint64{1, 2}),
+		attesterSlashing(5, []uint64{3}),
+	}))
+	s := &Server{SlasherDB: slasherDB, SlashingChecker: &mockSlashingChecker{}}
+
+	t.Run("all", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/attester_slashings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListAttesterSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetSlasherAttesterSlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.DeepEqual(t, structs.AttesterSlashingFromConsensus(attesterSlashing(1, []uint64{1, 2})), resp.Data[0])
+	})
+	t.Run("filtered", func(t *testing.T) {
+		request := httptest.NewRequest(
+			http.MethodGet,
+			"http://example.com/prysm/v1/slasher/attester_slashings?start_epoch=2&end_epoch=10&validator_index=3",
+			nil,
+		)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListAttesterSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetSlasherAttesterSlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "5", resp.Data[0].Attestation1.Data.Target.Epoch)
+	})
+	t.Run("invalid range", func(t *testing.T) {
+		request := httptest.NewRequest(
+			http.MethodGet,
+			"http://example.com/prysm/v1/slasher/attester_slashings?start_epoch=3&end_epoch=2",
+			nil,
+		)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListAttesterSlashings(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "start_epoch must not be greater than end_epoch", e.Message)
+	})
+	t.Run("slasher disabled", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/attester_slashings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		(&Server{}).ListAttesterSlashings(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
+
+func TestListProposerSlashings(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	require.NoError(t, slasherDB.SaveProposerSlashings(ctx, []*eth.ProposerSlashing{
+		proposerSlashing(1, 1),
+		proposerSlashing(2, 2),
+	}))
+	s := &Server{SlasherDB: slasherDB, SlashingChecker: &mockSlashingChecker{}}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(
+			http.MethodGet,
+			"http://example.com/prysm/v1/slasher/proposer_slashings?validator_index=2",
+			nil,
+		)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListProposerSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetSlasherProposerSlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, structs.ProposerSlashingFromConsensus(proposerSlashing(2, 2)), resp.Data[0])
+	})
+	t.Run("start epoch overflows", func(t *testing.T) {
+		request := httptest.NewRequest(
+			http.MethodGet,
+			fmt.Sprintf("http://example.com/prysm/v1/slasher/proposer_slashings?start_epoch=%d", uint64(slots.MaxSafeEpoch())+1),
+			nil,
+		)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListProposerSlashings(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "start_epoch must not be greater than", e.Message)
+	})
+}
+
+func TestIsSlashableAttestation(t *testing.T) {
+	slashing := attesterSlashing(1, []uint64{1})
+	s := &Server{
+		SlasherDB:       dbtest.SetupSlasherDB(t),
+		SlashingChecker: &mockSlashingChecker{attesterSlashings: []eth.AttSlashing{slashing}},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		body, err := json.Marshal(structs.IndexedAttestationFromConsensus(slashing.Attestation_2))
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.CheckSlashableAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, structs.AttesterSlashingFromConsensus(slashing), resp.Data[0])
+	})
+	t.Run("no body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("invalid attestation", func(t *testing.T) {
+		s := &Server{
+			SlasherDB:       dbtest.SetupSlasherDB(t),
+			SlashingChecker: &mockSlashingChecker{err: slasherservice.ErrInvalidAttestation},
+		}
+		body, err := json.Marshal(structs.IndexedAttestationFromConsensus(slashing.Attestation_2))
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestIsSlashableBlock(t *testing.T) {
+	slashing := proposerSlashing(1, 1)
+	body, err := json.Marshal(structs.SignedBeaconBlockHeaderFromConsensus(slashing.Header_2))
+	require.NoError(t, err)
+
+	t.Run("slashable", func(t *testing.T) {
+		s := &Server{
+			SlasherDB:       dbtest.SetupSlasherDB(t),
+			SlashingChecker: &mockSlashingChecker{proposerSlashing: slashing},
+		}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/blocks/slashable", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.IsSlashableBlock(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.CheckSlashableBlockResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, structs.ProposerSlashingFromConsensus(slashing), resp.Data[0])
+	})
+	t.Run("not slashable", func(t *testing.T) {
+		s := &Server{
+			SlasherDB:       dbtest.SetupSlasherDB(t),
+			SlashingChecker: &mockSlashingChecker{},
+		}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/blocks/slashable", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.IsSlashableBlock(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.CheckSlashableBlockResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 0, len(resp.Data))
+	})
+}
+
+func TestGetAttestationRecord(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	att := attesterSlashing(3, []uint64{7}).Attestation_1
+	dataRoot, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{
+		{IndexedAttestation: att, DataRoot: dataRoot},
+	}))
+	s := &Server{SlasherDB: slasherDB, SlashingChecker: &mockSlashingChecker{}}
+
+	t.Run("found", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/validators/7/attestations/3", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "7", "target_epoch": "3"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAttestationRecord(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetSlasherAttestationRecordResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.DeepEqual(t, structs.IndexedAttestationFromConsensus(att), resp.Data.Attestation)
+	})
+	t.Run("not found", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/validators/7/attestations/4", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "7", "target_epoch": "4"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAttestationRecord(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
+
+func TestGetProposalRecord(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	header := proposerSlashing(9, 4).Header_1
+	headerRoot, err := header.Header.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, slasherDB.SaveBlockProposals(ctx, []*slashertypes.SignedBlockHeaderWrapper{
+		{SignedBeaconBlockHeader: header, HeaderRoot: headerRoot},
+	}))
+	s := &Server{SlasherDB: slasherDB, SlashingChecker: &mockSlashingChecker{}}
+
+	t.Run("found", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/validators/4/proposals/9", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "4", "slot": "9"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetProposalRecord(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetSlasherProposalRecordResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.DeepEqual(t, structs.SignedBeaconBlockHeaderFromConsensus(header), resp.Data.SignedHeader)
+	})
+	t.Run("not found", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/validators/5/proposals/9", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "5", "slot": "9"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetProposalRecord(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
+
+func TestGetValidatorSpans(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := &Server{
+			SlasherDB:       dbtest.SetupSlasherDB(t),
+			SlashingChecker: &mockSlashingChecker{spans: &slasherservice.ValidatorSpans{MinSpan: 2, MinSpanSet: true}},
+		}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/validators/1/spans/10", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "1", "epoch": "10"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorSpans(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetSlasherValidatorSpansResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.DeepEqual(t, &structs.SlasherValidatorSpans{
+			ValidatorIndex: "1",
+			Epoch:          "10",
+			MinSpan:        "2",
+			MaxSpan:        "0",
+			MinTarget:      "12",
+		}, resp.Data)
+	})
+	t.Run("outside history", func(t *testing.T) {
+		s := &Server{
+			SlasherDB:       dbtest.SetupSlasherDB(t),
+			SlashingChecker: &mockSlashingChecker{err: slasherservice.ErrEpochOutsideHistory},
+		}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/validators/1/spans/10", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "1", "epoch": "10"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorSpans(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
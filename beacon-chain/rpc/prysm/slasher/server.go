@@ -0,0 +1,12 @@
+package slasher
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	slasherservice "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+)
+
+// Server exposes the slasher history over HTTP. Both fields are nil when the slasher is not enabled.
+type Server struct {
+	SlasherDB       db.SlasherDatabase
+	SlashingChecker slasherservice.SlashingChecker
+}
@@ -1874,6 +1874,7 @@ func TestGetValidatorPerformance_OK(t *testing.T) {
 		BalancesBeforeEpochTransition: []uint64{101, 102},
 		BalancesAfterEpochTransition:  []uint64{0, 0},
 		MissingValidators:             [][]byte{publicKey1[:]},
+		InclusionSlots:                []primitives.Slot{params.BeaconConfig().FarFutureSlot, params.BeaconConfig().FarFutureSlot},
 	}
 
 	res, err := bs.GetValidatorPerformance(ctx, &ethpb.ValidatorPerformanceRequest{
@@ -1945,6 +1946,7 @@ func TestGetValidatorPerformance_Indices(t *testing.T) {
 		BalancesBeforeEpochTransition: []uint64{extraBal, extraBal + params.BeaconConfig().GweiPerEth},
 		BalancesAfterEpochTransition:  []uint64{vp[1].AfterEpochTransitionBalance, vp[2].AfterEpochTransitionBalance},
 		MissingValidators:             [][]byte{publicKey1[:]},
+		InclusionSlots:                []primitives.Slot{params.BeaconConfig().FarFutureSlot, params.BeaconConfig().FarFutureSlot},
 	}
 
 	res, err := bs.GetValidatorPerformance(ctx, &ethpb.ValidatorPerformanceRequest{
@@ -2017,6 +2019,7 @@ func TestGetValidatorPerformance_IndicesPubkeys(t *testing.T) {
 		BalancesBeforeEpochTransition: []uint64{extraBal, extraBal + params.BeaconConfig().GweiPerEth},
 		BalancesAfterEpochTransition:  []uint64{vp[1].AfterEpochTransitionBalance, vp[2].AfterEpochTransitionBalance},
 		MissingValidators:             [][]byte{publicKey1[:]},
+		InclusionSlots:                []primitives.Slot{params.BeaconConfig().FarFutureSlot, params.BeaconConfig().FarFutureSlot},
 	}
 	// Index 2 and publicKey3 points to the same validator.
 	// Should not return duplicates.
@@ -2087,6 +2090,7 @@ func TestGetValidatorPerformanceAltair_OK(t *testing.T) {
 		BalancesBeforeEpochTransition: []uint64{101, 102},
 		BalancesAfterEpochTransition:  []uint64{0, 0},
 		MissingValidators:             [][]byte{publicKey1[:]},
+		InclusionSlots:                []primitives.Slot{params.BeaconConfig().FarFutureSlot, params.BeaconConfig().FarFutureSlot},
 		InactivityScores:              []uint64{0, 0},
 	}
 
@@ -2157,6 +2161,7 @@ func TestGetValidatorPerformanceBellatrix_OK(t *testing.T) {
 		BalancesBeforeEpochTransition: []uint64{101, 102},
 		BalancesAfterEpochTransition:  []uint64{0, 0},
 		MissingValidators:             [][]byte{publicKey1[:]},
+		InclusionSlots:                []primitives.Slot{params.BeaconConfig().FarFutureSlot, params.BeaconConfig().FarFutureSlot},
 		InactivityScores:              []uint64{0, 0},
 	}
 
@@ -2227,6 +2232,7 @@ func TestGetValidatorPerformanceCapella_OK(t *testing.T) {
 		BalancesBeforeEpochTransition: []uint64{101, 102},
 		BalancesAfterEpochTransition:  []uint64{0, 0},
 		MissingValidators:             [][]byte{publicKey1[:]},
+		InclusionSlots:                []primitives.Slot{params.BeaconConfig().FarFutureSlot, params.BeaconConfig().FarFutureSlot},
 		InactivityScores:              []uint64{0, 0},
 	}
 
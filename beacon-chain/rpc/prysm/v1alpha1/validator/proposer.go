@@ -228,25 +228,62 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 	winningBid := primitives.ZeroWei()
 	var bundle *enginev1.BlobsBundle
 	if sBlk.Version() >= version.Bellatrix {
+		slot, proposerIndex := sBlk.Block().Slot(), sBlk.Block().ProposerIndex()
+		audit := vs.newProposalAudit(slot, proposerIndex, builderBoostFactor)
+
+		// The builder bid is requested while the local payload is built, so that a slow builder does not delay
+		// the local payload which is the fallback.
+		builderCtx, cancelBuilder := context.WithCancel(ctx)
+		defer cancelBuilder()
+		builderResults := make(chan builderResult, 1)
+		if !skipMevBoost {
+			go func() {
+				start := time.Now()
+				bid, err := vs.getBuilderPayloadAndBlobs(builderCtx, slot, proposerIndex)
+				builderResults <- builderResult{bid: bid, err: err, duration: time.Since(start)}
+			}()
+		}
+
+		localStart := time.Now()
 		local, err := vs.getLocalPayload(ctx, sBlk.Block(), head)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not get local payload: %v", err)
 		}
+		auditLocalPayload(audit, local, time.Since(localStart))
 
-		// There's no reason to try to get a builder bid if local override is true.
+		// There's no reason to wait for a builder bid if local override is true.
 		var builderBid builderapi.Bid
-		if !(local.OverrideBuilder || skipMevBoost) {
-			builderBid, err = vs.getBuilderPayloadAndBlobs(ctx, sBlk.Block().Slot(), sBlk.Block().ProposerIndex())
-			if err != nil {
+		if local.OverrideBuilder || skipMevBoost {
+			cancelBuilder()
+			if audit != nil {
+				audit.BuilderSkipReason = vs.builderSkipReason(skipMevBoost, local)
+			}
+		} else {
+			res := <-builderResults
+			builderBid = res.bid
+			switch {
+			case res.err != nil:
 				builderGetPayloadMissCount.Inc()
-				log.WithError(err).Error("Could not get builder payload")
+				log.WithError(res.err).Error("Could not get builder payload")
+				vs.auditBuilderBids(audit, res)
+			case res.bid == nil:
+				if audit != nil {
+					audit.BuilderSkipReason = vs.builderSkipReason(skipMevBoost, local)
+				}
+			default:
+				vs.auditBuilderBids(audit, res)
 			}
 		}
 
-		winningBid, bundle, err = setExecutionData(ctx, sBlk, local, builderBid, builderBoostFactor)
+		winningBid, bundle, err = setExecutionData(ctx, sBlk, local, builderBid, builderBoostFactor, audit)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not set execution data: %v", err)
 		}
+		if audit != nil {
+			if err := vs.ProposalAuditCache.Add(ctx, audit); err != nil {
+				log.WithError(err).Error("Could not save proposal audit")
+			}
+		}
 	}
 
 	wg.Wait()
@@ -330,12 +367,17 @@ func (vs *Server) handleBlindedBlock(ctx context.Context, block interfaces.Signe
 
 	payload, bundle, err := vs.BlockBuilder.SubmitBlindedBlock(ctx, block)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "submit blinded block failed")
+		err = errors.Wrap(err, "submit blinded block failed")
+		vs.recordReveal(ctx, block.Block().Slot(), err)
+		return nil, nil, err
 	}
 
 	if err := copiedBlock.Unblind(payload); err != nil {
-		return nil, nil, errors.Wrap(err, "unblind failed")
+		err = errors.Wrap(err, "unblind failed")
+		vs.recordReveal(ctx, block.Block().Slot(), err)
+		return nil, nil, err
 	}
+	vs.recordReveal(ctx, block.Block().Slot(), nil)
 
 	sidecars, err := unblindBlobsSidecars(copiedBlock, bundle)
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation/aggregation"
 	attaggregation "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation/aggregation/attestations"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"go.opencensus.io/trace"
 )
 
@@ -23,8 +24,49 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.packAttestations")
 	defer span.End()
 
+	atts, err := vs.attestationsForInclusion(ctx, latestState, true /* pruneInvalid */)
+	if err != nil {
+		return nil, err
+	}
+	sorted, err := atts.sortForInclusion(ctx, latestState)
+	if err != nil {
+		return nil, err
+	}
+	return sorted.limitToMaxAttestations(latestState.Version()), nil
+}
+
+// sortForInclusion orders attestations by profitability and, since Altair, by the proposer reward of the
+// participation flags they set. When the reward cannot be computed, the attestations keep the profitability order.
+func (a proposerAtts) sortForInclusion(ctx context.Context, st state.BeaconState) (proposerAtts, error) {
+	sorted, err := a.sortByProfitability()
+	if err != nil {
+		return nil, err
+	}
+	if st.Version() < version.Altair {
+		return sorted, nil
+	}
+	byReward, err := sorted.sortByReward(ctx, st)
+	if err != nil {
+		log.WithError(err).Warn("Could not sort attestations by reward, falling back to profitability order")
+		return sorted, nil
+	}
+	return byReward, nil
+}
+
+// attestationsForInclusion returns the aggregated and unaggregated attestations of the pool which are valid for
+// inclusion in a block on top of the state, aggregated as much as possible. When pruneInvalid is set, the attestations
+// of the pool which are not valid are deleted from it.
+func (vs *Server) attestationsForInclusion(ctx context.Context, latestState state.BeaconState, pruneInvalid bool) (proposerAtts, error) {
+	validate := vs.validateAndDeleteAttsInPool
+	if !pruneInvalid {
+		validate = func(ctx context.Context, st state.BeaconState, atts []ethpb.Att) ([]ethpb.Att, error) {
+			valid, _ := proposerAtts(atts).filter(ctx, st)
+			return valid, nil
+		}
+	}
+
 	atts := vs.AttPool.AggregatedAttestations()
-	atts, err := vs.validateAndDeleteAttsInPool(ctx, latestState, atts)
+	atts, err := validate(ctx, latestState, atts)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not filter attestations")
 	}
@@ -33,7 +75,7 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get unaggregated attestations")
 	}
-	uAtts, err = vs.validateAndDeleteAttsInPool(ctx, latestState, uAtts)
+	uAtts, err = validate(ctx, latestState, uAtts)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not filter attestations")
 	}
@@ -63,16 +105,7 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 		}
 		attsForInclusion = append(attsForInclusion, as...)
 	}
-	deduped, err := attsForInclusion.dedup()
-	if err != nil {
-		return nil, err
-	}
-	sorted, err := deduped.sortByProfitability()
-	if err != nil {
-		return nil, err
-	}
-	atts = sorted.limitToMaxAttestations()
-	return atts, nil
+	return attsForInclusion.dedup()
 }
 
 // filter separates attestation list into two groups: valid and invalid attestations.
@@ -167,10 +200,14 @@ func (a proposerAtts) sortByProfitabilityUsingMaxCover() (proposerAtts, error) {
 	return sortedAtts, nil
 }
 
-// limitToMaxAttestations limits attestations to maximum attestations per block.
-func (a proposerAtts) limitToMaxAttestations() proposerAtts {
-	if uint64(len(a)) > params.BeaconConfig().MaxAttestations {
-		return a[:params.BeaconConfig().MaxAttestations]
+// limitToMaxAttestations limits attestations to maximum attestations per block of the given version.
+func (a proposerAtts) limitToMaxAttestations(v int) proposerAtts {
+	maxAtts := params.BeaconConfig().MaxAttestations
+	if v >= version.Electra {
+		maxAtts = params.BeaconConfig().MaxAttestationsElectra
+	}
+	if uint64(len(a)) > maxAtts {
+		return a[:maxAtts]
 	}
 	return a
 }
@@ -0,0 +1,360 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	coretime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"go.opencensus.io/trace"
+)
+
+// PackedAttestation is an attestation packed into a block, with the proposer reward it adds on top of the
+// attestations packed before it.
+type PackedAttestation struct {
+	Attestation ethpb.Att
+	Attesters   int
+	Reward      uint64
+}
+
+// PackedAttestations are the attestations packed into a block, in the order they are included, with their total
+// proposer reward.
+type PackedAttestations struct {
+	Attestations []*PackedAttestation
+	Reward       uint64
+}
+
+// AttestationPackingReport compares the attestations packed into a block at a slot by their proposer reward with the
+// attestations packed by the number of new aggregation bits only.
+type AttestationPackingReport struct {
+	Slot   primitives.Slot
+	Packed *PackedAttestations
+	Naive  *PackedAttestations
+}
+
+// attestationRewards computes the proposer reward of attestations against the epoch participation flags of a beacon
+// state. The flags set by the attestations credited with credit are not rewarded again.
+type attestationRewards struct {
+	st                    state.BeaconState
+	currentEpoch          primitives.Epoch
+	currentParticipation  []byte
+	previousParticipation []byte
+	totalBalance          uint64
+	baseRewards           map[uint64]uint64
+}
+
+// rewardCandidate is an attestation with the attesting indices and the participation flags it can set.
+type rewardCandidate struct {
+	att       ethpb.Att
+	indices   []uint64
+	flags     map[uint8]bool
+	current   bool
+	numerator uint64
+}
+
+func newAttestationRewards(st state.BeaconState) (*attestationRewards, error) {
+	if st.Version() < version.Altair {
+		return nil, fmt.Errorf("participation flags are not supported by %s states", version.String(st.Version()))
+	}
+	current, err := st.CurrentEpochParticipation()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current epoch participation")
+	}
+	previous, err := st.PreviousEpochParticipation()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get previous epoch participation")
+	}
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get total active balance")
+	}
+	return &attestationRewards{
+		st:                    st,
+		currentEpoch:          coretime.CurrentEpoch(st),
+		currentParticipation:  current,
+		previousParticipation: previous,
+		totalBalance:          totalBalance,
+		baseRewards:           make(map[uint64]uint64),
+	}, nil
+}
+
+// candidate returns the validators attesting in the attestation, across all the committees of its committee bits
+// after Electra, and the participation flags the attestation sets for them when included at the slot of the state.
+func (r *attestationRewards) candidate(ctx context.Context, att ethpb.Att) (*rewardCandidate, error) {
+	data := att.GetData()
+	delay, err := r.st.Slot().SafeSubSlot(data.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("attestation slot %d is after state slot %d", data.Slot, r.st.Slot())
+	}
+	flags, err := altair.AttestationParticipationFlagIndices(r.st, data, delay)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get participation flags")
+	}
+	committees, err := helpers.AttestationCommittees(ctx, r.st, att)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation committees")
+	}
+	indices, err := attestation.AttestingIndices(att, committees...)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attesting indices")
+	}
+	return &rewardCandidate{
+		att:     att,
+		indices: indices,
+		flags:   flags,
+		current: data.Target.Epoch == r.currentEpoch,
+	}, nil
+}
+
+// numerator returns the proposer reward numerator of the participation flags the candidate sets and which are not
+// set yet, as in altair.EpochParticipation.
+func (r *attestationRewards) numerator(c *rewardCandidate) (uint64, error) {
+	participation := r.participation(c)
+	var numerator uint64
+	for _, index := range c.indices {
+		if index >= uint64(len(participation)) {
+			return 0, fmt.Errorf("index %d exceeds participation length %d", index, len(participation))
+		}
+		var weights uint64
+		for _, f := range participationFlagWeights() {
+			if !c.flags[f.index] {
+				continue
+			}
+			has, err := altair.HasValidatorFlag(participation[index], f.index)
+			if err != nil {
+				return 0, err
+			}
+			if !has {
+				weights += f.weight
+			}
+		}
+		if weights == 0 {
+			continue
+		}
+		br, err := r.baseReward(index)
+		if err != nil {
+			return 0, err
+		}
+		numerator += br * weights
+	}
+	return numerator, nil
+}
+
+// credit sets the participation flags of the candidate, so that they are not rewarded again.
+func (r *attestationRewards) credit(c *rewardCandidate) error {
+	participation := r.participation(c)
+	for _, index := range c.indices {
+		if index >= uint64(len(participation)) {
+			return fmt.Errorf("index %d exceeds participation length %d", index, len(participation))
+		}
+		for _, f := range participationFlagWeights() {
+			if !c.flags[f.index] {
+				continue
+			}
+			flag, err := altair.AddValidatorFlag(participation[index], f.index)
+			if err != nil {
+				return err
+			}
+			participation[index] = flag
+		}
+	}
+	return nil
+}
+
+func (r *attestationRewards) participation(c *rewardCandidate) []byte {
+	if c.current {
+		return r.currentParticipation
+	}
+	return r.previousParticipation
+}
+
+func (r *attestationRewards) baseReward(index uint64) (uint64, error) {
+	if br, ok := r.baseRewards[index]; ok {
+		return br, nil
+	}
+	br, err := altair.BaseRewardWithTotalBalance(r.st, primitives.ValidatorIndex(index), r.totalBalance)
+	if err != nil {
+		return 0, err
+	}
+	r.baseRewards[index] = br
+	return br, nil
+}
+
+type participationFlagWeight struct {
+	index  uint8
+	weight uint64
+}
+
+func participationFlagWeights() []participationFlagWeight {
+	cfg := params.BeaconConfig()
+	return []participationFlagWeight{
+		{index: cfg.TimelySourceFlagIndex, weight: cfg.TimelySourceWeight},
+		{index: cfg.TimelyTargetFlagIndex, weight: cfg.TimelyTargetWeight},
+		{index: cfg.TimelyHeadFlagIndex, weight: cfg.TimelyHeadWeight},
+	}
+}
+
+// proposerReward converts a proposer reward numerator to Gwei, as in altair.RewardProposer.
+func proposerReward(numerator uint64) uint64 {
+	cfg := params.BeaconConfig()
+	return numerator / ((cfg.WeightDenominator - cfg.ProposerWeight) * cfg.WeightDenominator / cfg.ProposerWeight)
+}
+
+// sortByReward orders attestations by the proposer reward they add on top of the attestations ordered before them:
+// the timely source, target and head flags they set for the first time in the epoch participation of the state,
+// weighted by the base reward of the attesting validators. The state must be at the slot of the block the
+// attestations are included in, so that the inclusion delay of every attestation is accounted for.
+//
+// The attestations are selected greedily. As the reward of an attestation can only decrease when other attestations
+// are selected, the reward of the best attestation is only recomputed when it is about to be selected. Attestations
+// which add no reward keep their original order and are appended at the end.
+func (a proposerAtts) sortByReward(ctx context.Context, st state.BeaconState) (proposerAtts, error) {
+	if len(a) < 2 {
+		return a, nil
+	}
+	r, err := newAttestationRewards(st)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]*rewardCandidate, 0, len(a))
+	for _, att := range a {
+		c, err := r.candidate(ctx, att)
+		if err != nil {
+			return nil, err
+		}
+		c.numerator, err = r.numerator(c)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, c)
+	}
+
+	sorted := make(proposerAtts, 0, len(a))
+	for len(candidates) > 0 {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		best := 0
+		for i, c := range candidates {
+			if c.numerator > candidates[best].numerator {
+				best = i
+			}
+		}
+		c := candidates[best]
+		if c.numerator == 0 {
+			break
+		}
+		numerator, err := r.numerator(c)
+		if err != nil {
+			return nil, err
+		}
+		if numerator < c.numerator {
+			c.numerator = numerator
+			continue
+		}
+		if err := r.credit(c); err != nil {
+			return nil, err
+		}
+		sorted = append(sorted, c.att)
+		candidates = append(candidates[:best], candidates[best+1:]...)
+	}
+	for _, c := range candidates {
+		sorted = append(sorted, c.att)
+	}
+	return sorted, nil
+}
+
+// packedRewards returns the proposer reward of every attestation included in a block at the slot of the state, in
+// the order of the block.
+func packedRewards(ctx context.Context, st state.BeaconState, atts []ethpb.Att) (*PackedAttestations, error) {
+	packed := &PackedAttestations{Attestations: make([]*PackedAttestation, 0, len(atts))}
+	if len(atts) == 0 {
+		return packed, nil
+	}
+	r, err := newAttestationRewards(st)
+	if err != nil {
+		return nil, err
+	}
+	for _, att := range atts {
+		c, err := r.candidate(ctx, att)
+		if err != nil {
+			return nil, err
+		}
+		numerator, err := r.numerator(c)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.credit(c); err != nil {
+			return nil, err
+		}
+		// The proposer reward is rounded down for every attestation of the block.
+		reward := proposerReward(numerator)
+		packed.Attestations = append(packed.Attestations, &PackedAttestation{
+			Attestation: att,
+			Attesters:   len(c.indices),
+			Reward:      reward,
+		})
+		packed.Reward += reward
+	}
+	return packed, nil
+}
+
+// AttestationPackingReport packs the attestations of the pool for a block at the next slot, on top of the current
+// head, both by their proposer reward and by the number of their new aggregation bits, and returns the proposer
+// reward of both sets. The attestation pool is left untouched.
+func (vs *Server) AttestationPackingReport(ctx context.Context) (*AttestationPackingReport, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.AttestationPackingReport")
+	defer span.End()
+
+	slot := vs.TimeFetcher.CurrentSlot() + 1
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head root")
+	}
+	st, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	if st.Slot() < slot {
+		st, err = transition.ProcessSlotsUsingNextSlotCache(ctx, st, headRoot, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+		}
+	}
+	if st.Version() < version.Altair {
+		return nil, fmt.Errorf("attestation rewards are not supported by %s states", version.String(st.Version()))
+	}
+
+	candidates, err := vs.attestationsForInclusion(ctx, st, false /* pruneInvalid */)
+	if err != nil {
+		return nil, err
+	}
+	naive, err := candidates.sortByProfitability()
+	if err != nil {
+		return nil, err
+	}
+	packed, err := naive.sortByReward(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &AttestationPackingReport{Slot: slot}
+	report.Packed, err = packedRewards(ctx, st, packed.limitToMaxAttestations(st.Version()))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute the rewards of the packed attestations")
+	}
+	report.Naive, err = packedRewards(ctx, st, naive.limitToMaxAttestations(st.Version()))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute the rewards of the naively packed attestations")
+	}
+	return report, nil
+}
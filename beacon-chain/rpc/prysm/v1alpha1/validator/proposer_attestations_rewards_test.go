@@ -0,0 +1,181 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// rewardTestState returns an Altair state at the slot where the validators at the given positions of the committee of
+// slot 1 already have all their participation flags set.
+func rewardTestState(t *testing.T, slot primitives.Slot, participating ...int) state.BeaconState {
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, st.SetSlot(slot))
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), st, 1, 0)
+	require.NoError(t, err)
+	participation, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	for _, p := range participating {
+		participation[committee[p]] = 0b111
+	}
+	require.NoError(t, st.SetCurrentParticipationBits(participation))
+	return st
+}
+
+// rewardTestAtt returns an attestation of the validators at the given positions of the committee of the slot, voting
+// for the head of the state.
+func rewardTestAtt(t *testing.T, st state.BeaconState, slot primitives.Slot, positions ...int) *ethpb.Attestation {
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), st, slot, 0)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	for _, p := range positions {
+		bits.SetBitAt(uint64(p), true)
+	}
+	headRoot, err := helpers.BlockRootAtSlot(st, slot)
+	require.NoError(t, err)
+	targetRoot, err := helpers.BlockRoot(st, 0)
+	require.NoError(t, err)
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Slot:            slot,
+			BeaconBlockRoot: headRoot,
+			Source:          st.CurrentJustifiedCheckpoint(),
+			Target:          &ethpb.Checkpoint{Epoch: 0, Root: targetRoot},
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func TestProposer_ProposerAtts_sortByReward(t *testing.T) {
+	st := rewardTestState(t, 2, 0, 1, 2, 3, 4)
+	// The first attestation has more bits, but only one of its validators has not participated yet.
+	many := rewardTestAtt(t, st, 1, 0, 1, 2, 3, 4, 5)
+	few := rewardTestAtt(t, st, 1, 5, 6, 7)
+	naive, err := proposerAtts{few, many}.sortByProfitability()
+	require.NoError(t, err)
+	require.DeepEqual(t, proposerAtts{many, few}, naive)
+
+	sorted, err := naive.sortByReward(context.Background(), st)
+	require.NoError(t, err)
+	// Once the second attestation is included, the first one adds nothing.
+	require.DeepEqual(t, proposerAtts{few, many}, sorted)
+
+	t.Run("single attestation", func(t *testing.T) {
+		sorted, err := proposerAtts{many}.sortByReward(context.Background(), st)
+		require.NoError(t, err)
+		require.DeepEqual(t, proposerAtts{many}, sorted)
+	})
+	t.Run("phase0 state", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisState(t, 256)
+		_, err := naive.sortByReward(context.Background(), st)
+		require.ErrorContains(t, "participation flags are not supported by phase0 states", err)
+	})
+}
+
+func TestProposer_PackedRewards_MatchStateTransition(t *testing.T) {
+	ctx := context.Background()
+	st := rewardTestState(t, 6, 0, 1)
+	atts := []ethpb.Att{
+		rewardTestAtt(t, st, 5, 0, 1, 2),
+		// Too late for the timely head flag.
+		rewardTestAtt(t, st, 1, 0, 1, 2, 3),
+		rewardTestAtt(t, st, 1, 3, 4, 5, 6, 7),
+		rewardTestAtt(t, st, 5, 2, 3),
+	}
+	packed, err := packedRewards(ctx, st, atts)
+	require.NoError(t, err)
+	require.Equal(t, len(atts), len(packed.Attestations))
+	assert.Equal(t, 3, packed.Attestations[0].Attesters)
+
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	require.NoError(t, err)
+	proposer, err := helpers.BeaconProposerIndex(ctx, st)
+	require.NoError(t, err)
+	post := st.Copy()
+	var sum uint64
+	for i, att := range atts {
+		before, err := post.BalanceAtIndex(proposer)
+		require.NoError(t, err)
+		post, err = altair.ProcessAttestationNoVerifySignature(ctx, post, att, totalBalance)
+		require.NoError(t, err)
+		after, err := post.BalanceAtIndex(proposer)
+		require.NoError(t, err)
+		assert.Equal(t, after-before, packed.Attestations[i].Reward, "attestation %d", i)
+		sum += packed.Attestations[i].Reward
+	}
+	assert.NotEqual(t, uint64(0), packed.Reward)
+	assert.Equal(t, sum, packed.Reward)
+}
+
+func TestProposer_ProposerAtts_limitToMaxAttestations(t *testing.T) {
+	atts := make(proposerAtts, params.BeaconConfig().MaxAttestations+1)
+	assert.Equal(t, params.BeaconConfig().MaxAttestations, uint64(len(atts.limitToMaxAttestations(version.Deneb))))
+	assert.Equal(t, params.BeaconConfig().MaxAttestationsElectra, uint64(len(atts.limitToMaxAttestations(version.Electra))))
+	assert.Equal(t, 1, len(atts[:1].limitToMaxAttestations(version.Electra)))
+}
+
+func TestServer_AttestationPackingReport(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxAttestations = 1
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+
+	head := rewardTestState(t, 5, 0, 1, 2, 3, 4)
+	// The attestations are included in a block at the next slot.
+	next, err := transition.ProcessSlots(ctx, head.Copy(), 6)
+	require.NoError(t, err)
+	many := rewardTestAtt(t, next, 1, 0, 1, 2, 3, 4, 5)
+	few := rewardTestAtt(t, next, 1, 5, 6, 7)
+	pool := attestations.NewPool()
+	require.NoError(t, pool.SaveAggregatedAttestations([]ethpb.Att{many, few}))
+
+	slot := primitives.Slot(5)
+	chain := &mock.ChainService{State: head, Root: make([]byte, 32), Slot: &slot}
+	vs := &Server{HeadFetcher: chain, TimeFetcher: chain, AttPool: pool}
+	report, err := vs.AttestationPackingReport(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(6), report.Slot)
+	require.Equal(t, 1, len(report.Packed.Attestations))
+	require.Equal(t, 1, len(report.Naive.Attestations))
+	assert.DeepEqual(t, few, report.Packed.Attestations[0].Attestation)
+	assert.DeepEqual(t, many, report.Naive.Attestations[0].Attestation)
+	assert.Equal(t, true, report.Packed.Reward > 2*report.Naive.Reward)
+	// The pool is left untouched.
+	assert.Equal(t, 2, len(pool.AggregatedAttestations()))
+}
+
+func TestProposer_ProposerAtts_sortForInclusion_FallsBackToProfitability(t *testing.T) {
+	hook := logTest.NewGlobal()
+	st := rewardTestState(t, 2, 0, 1, 2, 3, 4)
+	many := rewardTestAtt(t, st, 1, 0, 1, 2, 3, 4, 5)
+	few := rewardTestAtt(t, st, 1, 5, 6, 7)
+
+	sorted, err := proposerAtts{few, many}.sortForInclusion(context.Background(), st)
+	require.NoError(t, err)
+	require.DeepEqual(t, proposerAtts{few, many}, sorted)
+
+	// A canceled context makes the reward sort fail.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sorted, err = proposerAtts{few, many}.sortForInclusion(ctx, st)
+	require.NoError(t, err)
+	require.DeepEqual(t, proposerAtts{many, few}, sorted)
+	require.LogsContain(t, hook, "Could not sort attestations by reward")
+}
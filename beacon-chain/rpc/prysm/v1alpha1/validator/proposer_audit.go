@@ -0,0 +1,134 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+// builderPayloadSource is the source of a builder bid which cannot be matched with the bid of a relay.
+const builderPayloadSource = "builder"
+
+// builderResult is the outcome of a bid request to the builder, racing with the local payload request.
+type builderResult struct {
+	bid      builder.Bid
+	err      error
+	duration time.Duration
+}
+
+// newProposalAudit starts the audit of a block proposal, or returns nil when proposals are not audited.
+func (vs *Server) newProposalAudit(slot primitives.Slot, idx primitives.ValidatorIndex, builderBoostFactor primitives.Gwei) *cache.ProposalAudit {
+	if vs.ProposalAuditCache == nil {
+		return nil
+	}
+	return &cache.ProposalAudit{
+		Slot:                 slot,
+		ProposerIndex:        idx,
+		BuilderBoostFactor:   builderBoostFactor,
+		LocalBlockValueBoost: params.BeaconConfig().LocalBlockValueBoost,
+	}
+}
+
+// builderSkipReason returns why no bid was requested from the builder.
+func (vs *Server) builderSkipReason(skipMevBoost bool, local *blocks.GetPayloadResponse) string {
+	switch {
+	case skipMevBoost:
+		return "builder skipped by the validator"
+	case local.OverrideBuilder:
+		return "local execution client requested to override the builder"
+	case vs.BlockBuilder == nil || !vs.BlockBuilder.Configured():
+		return "builder not configured"
+	default:
+		return "builder circuit breaker activated or validator not registered"
+	}
+}
+
+// auditLocalPayload adds the local payload as a candidate of the audited proposal.
+func auditLocalPayload(audit *cache.ProposalAudit, local *blocks.GetPayloadResponse, duration time.Duration) {
+	if audit == nil || local == nil {
+		return
+	}
+	c := &cache.ProposalCandidate{
+		Source:   cache.LocalPayloadSource,
+		Duration: duration,
+	}
+	if local.Bid != nil {
+		c.Value = new(big.Int).Set(local.Bid)
+	}
+	if local.ExecutionData != nil && !local.ExecutionData.IsNil() {
+		c.BlockHash = bytesutil.SafeCopyBytes(local.ExecutionData.BlockHash())
+	}
+	audit.Candidates = append(audit.Candidates, c)
+}
+
+// auditBuilderBids adds the bids of the relays as candidates of the audited proposal. When the builder does
+// not report the bids of its relays, the returned bid is added as a single candidate.
+func (vs *Server) auditBuilderBids(audit *cache.ProposalAudit, res builderResult) {
+	if audit == nil {
+		return
+	}
+	relayBids := vs.BlockBuilder.RelayBids(audit.Slot)
+	if len(relayBids) > 0 {
+		audit.Candidates = append(audit.Candidates, relayBids...)
+		return
+	}
+	c := &cache.ProposalCandidate{
+		Source:   builderPayloadSource,
+		Duration: res.duration,
+	}
+	switch {
+	case res.err != nil:
+		c.Reason = res.err.Error()
+	case res.bid == nil || res.bid.IsNil():
+		c.Reason = "builder returned no bid"
+	default:
+		c.Value = new(big.Int).Set(res.bid.Value())
+		if header, err := res.bid.Header(); err == nil && !header.IsNil() {
+			c.BlockHash = bytesutil.SafeCopyBytes(header.BlockHash())
+		}
+	}
+	audit.Candidates = append(audit.Candidates, c)
+}
+
+// builderCandidateSource returns the source of the audited candidate which offered the given builder payload.
+func builderCandidateSource(audit *cache.ProposalAudit, payload interfaces.ExecutionData) string {
+	if audit == nil || payload == nil || payload.IsNil() {
+		return builderPayloadSource
+	}
+	for _, c := range audit.Candidates {
+		if c.Source != cache.LocalPayloadSource && c.Reason == "" && bytes.Equal(c.BlockHash, payload.BlockHash()) {
+			return c.Source
+		}
+	}
+	return builderPayloadSource
+}
+
+// selectBuilderPayload records that the builder payload was selected for the audited proposal, whose blinded
+// block now waits for the builder to reveal the payload.
+func selectBuilderPayload(audit *cache.ProposalAudit, payload interfaces.ExecutionData, reason string) {
+	if audit == nil {
+		return
+	}
+	audit.Select(builderCandidateSource(audit, payload), reason, "lower value than the builder bid")
+	audit.Blinded = true
+	audit.Reveal = cache.RevealPending
+}
+
+// recordReveal records whether the builder revealed the payload of the blinded block proposed at the given slot.
+func (vs *Server) recordReveal(ctx context.Context, slot primitives.Slot, revealErr error) {
+	if vs.ProposalAuditCache == nil {
+		return
+	}
+	if err := vs.ProposalAuditCache.RecordReveal(ctx, slot, revealErr); err != nil {
+		log.WithError(err).Error("Could not record builder payload reveal")
+	}
+}
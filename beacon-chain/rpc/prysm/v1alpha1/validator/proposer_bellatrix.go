@@ -12,6 +12,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -53,7 +54,8 @@ var emptyTransactionsRoot = [32]byte{127, 254, 36, 30, 166, 1, 135, 253, 176, 24
 const blockBuilderTimeout = 1 * time.Second
 
 // Sets the execution data for the block. Execution data can come from local EL client or remote builder depends on validator registration and circuit breaker conditions.
-func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, local *blocks.GetPayloadResponse, bid builder.Bid, builderBoostFactor primitives.Gwei) (primitives.Wei, *enginev1.BlobsBundle, error) {
+// Why each candidate payload was selected or rejected is recorded in the audit, which may be nil.
+func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, local *blocks.GetPayloadResponse, bid builder.Bid, builderBoostFactor primitives.Gwei, audit *cache.ProposalAudit) (primitives.Wei, *enginev1.BlobsBundle, error) {
 	_, span := trace.StartSpan(ctx, "ProposerServer.setExecutionData")
 	defer span.End()
 
@@ -68,6 +70,7 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 
 	// Use local payload if builder payload is nil.
 	if bid == nil {
+		audit.Select(cache.LocalPayloadSource, "no builder bid", "")
 		return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 	}
 
@@ -75,6 +78,7 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 	builderPayload, err := bid.Header()
 	if err != nil {
 		log.WithError(err).Warn("Proposer: failed to retrieve header from BuilderBid")
+		audit.Select(cache.LocalPayloadSource, "builder bid has no header", "could not retrieve header from builder bid: "+err.Error())
 		return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 	}
 	if bid.Version() >= version.Deneb {
@@ -90,6 +94,7 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 		if err != nil {
 			tracing.AnnotateError(span, err)
 			log.WithError(err).Warn("Proposer: failed to match withdrawals root")
+			audit.Select(cache.LocalPayloadSource, "builder bid withdrawals could not be checked", "could not match withdrawals root: "+err.Error())
 			return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 		}
 
@@ -115,11 +120,18 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 		if higherValueBuilder && withdrawalsMatched { // Builder value is higher and withdrawals match.
 			if err := setBuilderExecution(blk, builderPayload, builderKzgCommitments); err != nil {
 				log.WithError(err).Warn("Proposer: failed to set builder payload")
+				audit.Select(cache.LocalPayloadSource, "builder payload could not be used", "could not set builder payload: "+err.Error())
 				return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 			} else {
+				selectBuilderPayload(audit, builderPayload, "higher value than the local payload including boosts")
 				return bid.Value(), nil, nil
 			}
 		}
+		if !withdrawalsMatched {
+			audit.Select(cache.LocalPayloadSource, "builder bid withdrawals do not match", "withdrawals do not match the local payload")
+		} else {
+			audit.Select(cache.LocalPayloadSource, "higher value than the builder bid including boosts", "lower value than the local payload including boosts")
+		}
 		if !higherValueBuilder {
 			log.WithFields(logrus.Fields{
 				"localGweiValue":       localValueGwei,
@@ -139,8 +151,10 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 	default: // Bellatrix case.
 		if err := setBuilderExecution(blk, builderPayload, builderKzgCommitments); err != nil {
 			log.WithError(err).Warn("Proposer: failed to set builder payload")
+			audit.Select(cache.LocalPayloadSource, "builder payload could not be used", "could not set builder payload: "+err.Error())
 			return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 		} else {
+			selectBuilderPayload(audit, builderPayload, "builder payload is always preferred before Capella")
 			return bid.Value(), nil, nil
 		}
 	}
@@ -29,6 +29,7 @@ import (
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
@@ -104,7 +105,7 @@ func TestServer_setExecutionData(t *testing.T) {
 		builderBid, err := vs.getBuilderPayloadAndBlobs(ctx, b.Slot(), b.ProposerIndex())
 		require.NoError(t, err)
 		require.IsNil(t, builderBid)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -173,12 +174,20 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		audit := &cache.ProposalAudit{}
+		auditLocalPayload(audit, res, 0)
+		vs.auditBuilderBids(audit, builderResult{bid: builderBid})
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, audit)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
 		require.Equal(t, uint64(1), e.BlockNumber()) // Local block because incorrect withdrawals
+		require.Equal(t, 2, len(audit.Candidates))
+		assert.Equal(t, true, audit.Candidates[0].Selected)
+		assert.Equal(t, "builder bid withdrawals do not match", audit.Candidates[0].Reason)
+		assert.Equal(t, "withdrawals do not match the local payload", audit.Candidates[1].Reason)
+		assert.Equal(t, false, audit.Blinded)
 	})
 	t.Run("Builder configured. Builder Block has higher value. Correct withdrawals.", func(t *testing.T) {
 		blk, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockCapella())
@@ -245,12 +254,26 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		audit := &cache.ProposalAudit{}
+		auditLocalPayload(audit, res, 0)
+		vs.BlockBuilder.(*builderTest.MockBuilderService).Candidates = []*cache.ProposalCandidate{
+			{Source: "http://relay", BlockHash: make([]byte, fieldparams.RootLength), Value: big.NewInt(1e18)},
+			{Source: "http://other-relay", Reason: "timeout"},
+		}
+		vs.auditBuilderBids(audit, builderResult{bid: builderBid})
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, audit)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
 		require.Equal(t, uint64(2), e.BlockNumber()) // Builder block
+		require.Equal(t, 3, len(audit.Candidates))
+		assert.Equal(t, "lower value than the builder bid", audit.Candidates[0].Reason)
+		assert.Equal(t, true, audit.Candidates[1].Selected)
+		assert.Equal(t, "higher value than the local payload including boosts", audit.Candidates[1].Reason)
+		assert.Equal(t, "timeout", audit.Candidates[2].Reason)
+		assert.Equal(t, true, audit.Blinded)
+		assert.Equal(t, cache.RevealPending, audit.Reveal)
 	})
 	t.Run("Max builder boost factor should return builder", func(t *testing.T) {
 		blk, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockCapella())
@@ -316,7 +339,7 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, math.MaxUint64)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, math.MaxUint64, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -387,7 +410,7 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, 0)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, 0, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -413,7 +436,7 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -446,7 +469,7 @@ func TestServer_setExecutionData(t *testing.T) {
 		_, err = builderBid.Header()
 		require.NoError(t, err)
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -472,7 +495,7 @@ func TestServer_setExecutionData(t *testing.T) {
 		builderBid, err := vs.getBuilderPayloadAndBlobs(ctx, b.Slot(), b.ProposerIndex())
 		require.ErrorIs(t, consensus_types.ErrNilObjectWrapped, err) // Builder returns fault. Use local block
 		require.IsNil(t, builderBid)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, nil, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, nil, defaultBuilderBoostFactor, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -596,7 +619,7 @@ func TestServer_setExecutionData(t *testing.T) {
 
 		res, err := vs.getLocalPayload(ctx, blk.Block(), denebTransitionState)
 		require.NoError(t, err)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 
@@ -56,6 +56,20 @@ func (vs *Server) circuitBreakBuilder(s primitives.Slot) (bool, error) {
 		return true, errors.New("no fork choicer configured")
 	}
 
+	// Circuit breaker is active for `RevealMissCooldown` slots after a builder did not reveal the payload of a
+	// blinded block.
+	if vs.ProposalAuditCache != nil {
+		cooldown := vs.RevealMissCooldown
+		if missSlot, missed := vs.ProposalAuditCache.LastRevealMiss(); missed && cooldown > 0 && s < missSlot+cooldown {
+			log.WithFields(logrus.Fields{
+				"currentSlot":    s,
+				"revealMissSlot": missSlot,
+				"cooldownSlots":  cooldown,
+			}).Warn("Circuit breaker activated due to a builder not revealing a blinded block payload")
+			return true, nil
+		}
+	}
+
 	// Circuit breaker is active if the missing consecutive slots greater than `MaxBuilderConsecutiveMissedSlots`.
 	highestReceivedSlot := vs.ForkchoiceFetcher.HighestReceivedBlockSlot()
 	maxConsecutiveSkipSlotsAllowed := params.BeaconConfig().MaxBuilderConsecutiveMissedSlots
@@ -2,12 +2,14 @@ package validator
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	blockchainTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	testing2 "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -69,6 +71,20 @@ func TestServer_circuitBreakBuilder(t *testing.T) {
 	b, err = s.circuitBreakBuilder(params.BeaconConfig().SlotsPerEpoch + 1)
 	require.NoError(t, err)
 	require.Equal(t, false, b)
+
+	// A builder which did not reveal the payload of a blinded block is not used during the cooldown.
+	s.RevealMissCooldown = 2
+	s.ProposalAuditCache = cache.NewProposalAuditCache()
+	require.NoError(t, s.ProposalAuditCache.RecordReveal(ctx, params.BeaconConfig().SlotsPerEpoch, errors.New("timeout")))
+	b, err = s.circuitBreakBuilder(params.BeaconConfig().SlotsPerEpoch + 1)
+	require.NoError(t, err)
+	require.Equal(t, true, b)
+	require.LogsContain(t, hook, "Circuit breaker activated due to a builder not revealing a blinded block payload")
+	s.ProposalAuditCache = cache.NewProposalAuditCache()
+	require.NoError(t, s.ProposalAuditCache.RecordReveal(ctx, params.BeaconConfig().SlotsPerEpoch-1, errors.New("timeout")))
+	b, err = s.circuitBreakBuilder(params.BeaconConfig().SlotsPerEpoch + 1)
+	require.NoError(t, err)
+	require.Equal(t, false, b)
 }
 
 func TestServer_validatorRegistered(t *testing.T) {
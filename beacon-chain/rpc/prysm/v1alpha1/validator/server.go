@@ -28,6 +28,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/forks"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -46,6 +47,8 @@ type Server struct {
 	Ctx                    context.Context
 	PayloadIDCache         *cache.PayloadIDCache
 	TrackedValidatorsCache *cache.TrackedValidatorsCache
+	ProposalAuditCache     *cache.ProposalAuditCache
+	RevealMissCooldown     primitives.Slot
 	HeadFetcher            blockchain.HeadFetcher
 	ForkFetcher            blockchain.ForkFetcher
 	ForkchoiceFetcher      blockchain.ForkchoiceFetcher
@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"go.opencensus.io/trace"
+)
+
+// GetProposalAudit returns how the execution payload of the block proposed by this node at a slot was chosen:
+// every candidate payload with its value, the time it took to get it and why it was selected or rejected, and
+// whether the builder revealed the payload of the blinded block.
+func (s *Server) GetProposalAudit(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetProposalAudit")
+	defer span.End()
+
+	if s.ProposalAudits == nil {
+		httputil.HandleError(w, "Proposal audits are not available", http.StatusServiceUnavailable)
+		return
+	}
+	_, slot, ok := shared.UintFromRoute(w, r, "slot")
+	if !ok {
+		return
+	}
+	audit, err := s.ProposalAudits.Audit(ctx, primitives.Slot(slot))
+	if err != nil {
+		httputil.HandleError(w, "Could not get proposal audit: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if audit == nil {
+		httputil.HandleError(w, fmt.Sprintf("No block was proposed by this node at slot %d", slot), http.StatusNotFound)
+		return
+	}
+
+	candidates := make([]*structs.ProposalCandidate, len(audit.Candidates))
+	for i, c := range audit.Candidates {
+		candidates[i] = &structs.ProposalCandidate{
+			Source:     c.Source,
+			DurationMs: strconv.FormatInt(c.Duration.Milliseconds(), 10),
+			Selected:   c.Selected,
+			Reason:     c.Reason,
+		}
+		if len(c.BlockHash) > 0 {
+			candidates[i].BlockHash = hexutil.Encode(c.BlockHash)
+		}
+		if c.Value != nil {
+			candidates[i].Value = c.Value.String()
+		}
+	}
+	httputil.WriteJson(w, &structs.GetProposalAuditResponse{Data: &structs.ProposalAudit{
+		Slot:                 strconv.FormatUint(uint64(audit.Slot), 10),
+		ProposerIndex:        strconv.FormatUint(uint64(audit.ProposerIndex), 10),
+		BuilderBoostFactor:   strconv.FormatUint(uint64(audit.BuilderBoostFactor), 10),
+		LocalBlockValueBoost: strconv.FormatUint(audit.LocalBlockValueBoost, 10),
+		BuilderSkipReason:    audit.BuilderSkipReason,
+		Candidates:           candidates,
+		Blinded:              audit.Blinded,
+		Reveal:               audit.Reveal.String(),
+		RevealError:          audit.RevealError,
+	}})
+}
@@ -0,0 +1,79 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestServer_GetProposalAudit(t *testing.T) {
+	ctx := context.Background()
+	audits := cache.NewProposalAuditCache()
+	require.NoError(t, audits.Add(ctx, &cache.ProposalAudit{
+		Slot:               5,
+		ProposerIndex:      3,
+		BuilderBoostFactor: 100,
+		Candidates: []*cache.ProposalCandidate{
+			{Source: cache.LocalPayloadSource, BlockHash: []byte{1}, Value: big.NewInt(10), Duration: 20 * time.Millisecond, Reason: "lower value than the builder bid"},
+			{Source: "http://relay", BlockHash: []byte{2}, Value: big.NewInt(30), Duration: 300 * time.Millisecond, Selected: true, Reason: "higher value than the local payload including boosts"},
+			{Source: "http://other-relay", Reason: "timeout"},
+		},
+		Blinded: true,
+		Reveal:  cache.RevealPending,
+	}))
+	require.NoError(t, audits.RecordReveal(ctx, 5, errors.New("builder did not respond")))
+	s := &Server{ProposalAudits: audits}
+
+	get := func(s *Server, slot string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validator/proposals/"+slot, nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": slot})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetProposalAudit(writer, request)
+		return writer
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		writer := get(s, "5")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetProposalAuditResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "5", resp.Data.Slot)
+		assert.Equal(t, "3", resp.Data.ProposerIndex)
+		assert.Equal(t, "100", resp.Data.BuilderBoostFactor)
+		assert.Equal(t, true, resp.Data.Blinded)
+		assert.Equal(t, "missed", resp.Data.Reveal)
+		assert.Equal(t, "builder did not respond", resp.Data.RevealError)
+		require.Equal(t, 3, len(resp.Data.Candidates))
+		assert.DeepEqual(t, &structs.ProposalCandidate{
+			Source:     "http://relay",
+			BlockHash:  "0x02",
+			Value:      "30",
+			DurationMs: "300",
+			Selected:   true,
+			Reason:     "higher value than the local payload including boosts",
+		}, resp.Data.Candidates[1])
+		assert.Equal(t, "", resp.Data.Candidates[2].Value)
+	})
+	t.Run("not found", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, get(s, "6").Code)
+	})
+	t.Run("invalid slot", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, get(s, "foo").Code)
+	})
+	t.Run("not available", func(t *testing.T) {
+		assert.Equal(t, http.StatusServiceUnavailable, get(&Server{}, "5").Code)
+	})
+}
@@ -1,9 +1,13 @@
 package validator
 
 import (
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 )
 
 type Server struct {
-	CoreService *core.Service
+	CoreService      *core.Service
+	ValidatorMonitor monitor.PerformanceHistoryFetcher
+	ProposalAudits   *cache.ProposalAuditCache
 }
@@ -0,0 +1,128 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"go.opencensus.io/trace"
+)
+
+var validatorMonitorCsvHeader = []string{
+	"epoch",
+	"balance",
+	"balance_change",
+	"attestation_included",
+	"inclusion_distance",
+	"correct_source",
+	"correct_target",
+	"correct_head",
+	"sync_committee_expected",
+	"sync_committee_included",
+	"proposed_blocks",
+}
+
+// GetValidatorMonitorHistory returns the per-epoch performance history that the validator monitor kept for
+// a tracked validator, optionally limited to an epoch range. The history is returned as CSV when requested
+// with the `text/csv` Accept header.
+func (s *Server) GetValidatorMonitorHistory(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.GetValidatorMonitorHistory")
+	defer span.End()
+
+	if s.ValidatorMonitor == nil {
+		httputil.HandleError(w, "Validator monitor is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	_, idx, ok := shared.UintFromRoute(w, r, "index")
+	if !ok {
+		return
+	}
+	_, from, ok := shared.UintFromQuery(w, r, "from_epoch", false)
+	if !ok {
+		return
+	}
+	rawTo, to, ok := shared.UintFromQuery(w, r, "to_epoch", false)
+	if !ok {
+		return
+	}
+	if rawTo == "" {
+		to = math.MaxUint64
+	}
+	if from > to {
+		httputil.HandleError(w, "from_epoch must not be greater than to_epoch", http.StatusBadRequest)
+		return
+	}
+
+	history, err := s.ValidatorMonitor.PerformanceHistory(primitives.ValidatorIndex(idx), primitives.Epoch(from), primitives.Epoch(to))
+	if errors.Is(err, monitor.ErrNotTracked) {
+		httputil.HandleError(w, fmt.Sprintf("Validator %d is not tracked by the validator monitor", idx), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httputil.HandleError(w, "Could not get validator monitor history: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data := make([]*structs.ValidatorMonitorEpoch, len(history))
+	for i, p := range history {
+		data[i] = &structs.ValidatorMonitorEpoch{
+			Epoch:                 strconv.FormatUint(uint64(p.Epoch), 10),
+			Balance:               strconv.FormatUint(p.Balance, 10),
+			BalanceChange:         strconv.FormatInt(p.BalanceChange, 10),
+			AttestationIncluded:   p.AttestationIncluded,
+			InclusionDistance:     strconv.FormatUint(uint64(p.InclusionDistance), 10),
+			CorrectSource:         p.CorrectSource,
+			CorrectTarget:         p.CorrectTarget,
+			CorrectHead:           p.CorrectHead,
+			SyncCommitteeExpected: strconv.FormatUint(p.SyncCommitteeExpected, 10),
+			SyncCommitteeIncluded: strconv.FormatUint(p.SyncCommitteeIncluded, 10),
+			ProposedBlocks:        strconv.FormatUint(p.ProposedBlocks, 10),
+		}
+	}
+	if strings.Contains(r.Header.Get("Accept"), api.CsvMediaType) {
+		writeValidatorMonitorCsv(w, idx, data)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetValidatorMonitorHistoryResponse{Data: data})
+}
+
+func writeValidatorMonitorCsv(w http.ResponseWriter, idx uint64, data []*structs.ValidatorMonitorEpoch) {
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	records := make([][]string, 0, len(data)+1)
+	records = append(records, validatorMonitorCsvHeader)
+	for _, d := range data {
+		records = append(records, []string{
+			d.Epoch,
+			d.Balance,
+			d.BalanceChange,
+			strconv.FormatBool(d.AttestationIncluded),
+			d.InclusionDistance,
+			strconv.FormatBool(d.CorrectSource),
+			strconv.FormatBool(d.CorrectTarget),
+			strconv.FormatBool(d.CorrectHead),
+			d.SyncCommitteeExpected,
+			d.SyncCommitteeIncluded,
+			d.ProposedBlocks,
+		})
+	}
+	if err := cw.WriteAll(records); err != nil {
+		httputil.HandleError(w, "Could not encode validator monitor history: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", api.CsvMediaType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=validator_%d_history.csv", idx))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
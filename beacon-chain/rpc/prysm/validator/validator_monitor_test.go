@@ -0,0 +1,114 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockPerformanceHistory struct {
+	history map[primitives.ValidatorIndex][]monitor.EpochPerformance
+}
+
+func (m *mockPerformanceHistory) PerformanceHistory(idx primitives.ValidatorIndex, from, to primitives.Epoch) ([]monitor.EpochPerformance, error) {
+	h, ok := m.history[idx]
+	if !ok {
+		return nil, monitor.ErrNotTracked
+	}
+	res := make([]monitor.EpochPerformance, 0)
+	for _, p := range h {
+		if p.Epoch >= from && p.Epoch <= to {
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
+
+func TestServer_GetValidatorMonitorHistory(t *testing.T) {
+	s := &Server{
+		ValidatorMonitor: &mockPerformanceHistory{
+			history: map[primitives.ValidatorIndex][]monitor.EpochPerformance{
+				1: {
+					{Epoch: 3, Balance: 32000000000, BalanceChange: -10, AttestationIncluded: true, InclusionDistance: 1, CorrectSource: true, CorrectTarget: true},
+					{Epoch: 4, Balance: 32000000020, BalanceChange: 20, SyncCommitteeExpected: 32, SyncCommitteeIncluded: 31, ProposedBlocks: 1},
+				},
+			},
+		},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor/1?from_epoch=4", nil)
+		request = mux.SetURLVars(request, map[string]string{"index": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorMonitorHistory(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetValidatorMonitorHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, &structs.ValidatorMonitorEpoch{
+			Epoch:                 "4",
+			Balance:               "32000000020",
+			BalanceChange:         "20",
+			InclusionDistance:     "0",
+			SyncCommitteeExpected: "32",
+			SyncCommitteeIncluded: "31",
+			ProposedBlocks:        "1",
+		}, resp.Data[0])
+	})
+	t.Run("csv", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor/1?to_epoch=3", nil)
+		request = mux.SetURLVars(request, map[string]string{"index": "1"})
+		request.Header.Set("Accept", api.CsvMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorMonitorHistory(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.CsvMediaType, writer.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=validator_1_history.csv", writer.Header().Get("Content-Disposition"))
+		want := "epoch,balance,balance_change,attestation_included,inclusion_distance,correct_source,correct_target,correct_head,sync_committee_expected,sync_committee_included,proposed_blocks\n" +
+			"3,32000000000,-10,true,1,true,true,false,0,0,0\n"
+		assert.Equal(t, want, writer.Body.String())
+	})
+	t.Run("not tracked", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor/2", nil)
+		request = mux.SetURLVars(request, map[string]string{"index": "2"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorMonitorHistory(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+		assert.StringContains(t, "Validator 2 is not tracked by the validator monitor", writer.Body.String())
+	})
+	t.Run("invalid range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor/1?from_epoch=5&to_epoch=4", nil)
+		request = mux.SetURLVars(request, map[string]string{"index": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorMonitorHistory(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "from_epoch must not be greater than to_epoch", writer.Body.String())
+	})
+	t.Run("monitor disabled", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor/1", nil)
+		request = mux.SetURLVars(request, map[string]string{"index": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		(&Server{}).GetValidatorMonitorHistory(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
@@ -44,6 +44,10 @@ func (s *Server) GetValidatorPerformance(w http.ResponseWriter, r *http.Request)
 		BalancesAfterEpochTransition:  computed.BalancesAfterEpochTransition,
 		MissingValidators:             computed.MissingValidators,
 		InactivityScores:              computed.InactivityScores, // Only populated in Altair
+		InclusionSlots:                make([]uint64, len(computed.InclusionSlots)),
+	}
+	for i, slot := range computed.InclusionSlots {
+		response.InclusionSlots[i] = uint64(slot)
 	}
 	httputil.WriteJson(w, response)
 }
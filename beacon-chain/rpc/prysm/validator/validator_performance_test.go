@@ -77,6 +77,7 @@ func TestServer_GetValidatorPerformance(t *testing.T) {
 			BalancesBeforeEpochTransition: []uint64{101, 102},
 			BalancesAfterEpochTransition:  []uint64{0, 0},
 			MissingValidators:             [][]byte{publicKeys[0][:]},
+			InclusionSlots:                []uint64{uint64(params.BeaconConfig().FarFutureSlot), uint64(params.BeaconConfig().FarFutureSlot)},
 		}
 
 		request := &structs.GetValidatorPerformanceRequest{
@@ -143,6 +144,7 @@ func TestServer_GetValidatorPerformance(t *testing.T) {
 			BalancesBeforeEpochTransition: []uint64{extraBal, extraBal + params.BeaconConfig().GweiPerEth},
 			BalancesAfterEpochTransition:  []uint64{vp[1].AfterEpochTransitionBalance, vp[2].AfterEpochTransitionBalance},
 			MissingValidators:             [][]byte{publicKeys[0][:]},
+			InclusionSlots:                []uint64{uint64(params.BeaconConfig().FarFutureSlot), uint64(params.BeaconConfig().FarFutureSlot)},
 		}
 		request := &structs.GetValidatorPerformanceRequest{
 			Indices: []primitives.ValidatorIndex{2, 1, 0},
@@ -208,6 +210,7 @@ func TestServer_GetValidatorPerformance(t *testing.T) {
 			BalancesBeforeEpochTransition: []uint64{extraBal, extraBal + params.BeaconConfig().GweiPerEth},
 			BalancesAfterEpochTransition:  []uint64{vp[1].AfterEpochTransitionBalance, vp[2].AfterEpochTransitionBalance},
 			MissingValidators:             [][]byte{publicKeys[0][:]},
+			InclusionSlots:                []uint64{uint64(params.BeaconConfig().FarFutureSlot), uint64(params.BeaconConfig().FarFutureSlot)},
 		}
 		request := &structs.GetValidatorPerformanceRequest{
 			PublicKeys: [][]byte{publicKeys[0][:], publicKeys[2][:]}, Indices: []primitives.ValidatorIndex{1, 2},
@@ -269,6 +272,7 @@ func TestServer_GetValidatorPerformance(t *testing.T) {
 			BalancesBeforeEpochTransition: []uint64{101, 102},
 			BalancesAfterEpochTransition:  []uint64{0, 0},
 			MissingValidators:             [][]byte{publicKeys[0][:]},
+			InclusionSlots:                []uint64{uint64(params.BeaconConfig().FarFutureSlot), uint64(params.BeaconConfig().FarFutureSlot)},
 			InactivityScores:              []uint64{0, 0},
 		}
 		request := &structs.GetValidatorPerformanceRequest{
@@ -331,6 +335,7 @@ func TestServer_GetValidatorPerformance(t *testing.T) {
 			BalancesBeforeEpochTransition: []uint64{101, 102},
 			BalancesAfterEpochTransition:  []uint64{0, 0},
 			MissingValidators:             [][]byte{publicKeys[0][:]},
+			InclusionSlots:                []uint64{uint64(params.BeaconConfig().FarFutureSlot), uint64(params.BeaconConfig().FarFutureSlot)},
 			InactivityScores:              []uint64{0, 0},
 		}
 		request := &structs.GetValidatorPerformanceRequest{
@@ -393,6 +398,7 @@ func TestServer_GetValidatorPerformance(t *testing.T) {
 			BalancesBeforeEpochTransition: []uint64{101, 102},
 			BalancesAfterEpochTransition:  []uint64{0, 0},
 			MissingValidators:             [][]byte{publicKeys[0][:]},
+			InclusionSlots:                []uint64{uint64(params.BeaconConfig().FarFutureSlot), uint64(params.BeaconConfig().FarFutureSlot)},
 			InactivityScores:              []uint64{0, 0},
 		}
 		request := &structs.GetValidatorPerformanceRequest{
@@ -2,12 +2,15 @@ package testutil
 
 import (
 	"math/big"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/types"
 )
 
 // MockExecutionChainInfoFetcher is a fake implementation of the powchain.ChainInfoFetcher
 type MockExecutionChainInfoFetcher struct {
-	CurrEndpoint string
-	CurrError    error
+	CurrEndpoint     string
+	CurrError        error
+	EndpointStatuses []*types.EndpointStatus
 }
 
 func (*MockExecutionChainInfoFetcher) GenesisExecutionChainInfo() (uint64, *big.Int) {
@@ -25,3 +28,7 @@ func (m *MockExecutionChainInfoFetcher) ExecutionClientEndpoint() string {
 func (m *MockExecutionChainInfoFetcher) ExecutionClientConnectionErr() error {
 	return m.CurrError
 }
+
+func (m *MockExecutionChainInfoFetcher) ExecutionEndpointStatuses() []*types.EndpointStatus {
+	return m.EndpointStatuses
+}
@@ -131,11 +131,6 @@ func (s *Service) checkSurroundVotes(
 			maxChunkByChunkIndexByValidatorChunkIndex = make(map[uint64]map[uint64]Chunker, attWrappersByValidatorChunkIndexCount)
 		}
 
-		// Update the latest updated epoch for all validators involved to the current chunk.
-		indexes := s.params.ValidatorIndexesInChunk(validatorChunkIndex)
-		for _, index := range indexes {
-			s.latestEpochUpdatedForValidator[index] = currentEpoch
-		}
 	}
 
 	// Save the updated chunks to disk.
@@ -147,6 +142,18 @@ func (s *Service) checkSurroundVotes(
 		return nil, errors.Wrap(err, "could not save updated max chunks to disk")
 	}
 
+	// Update the latest updated epoch for all validators involved to the current chunk.
+	// This is done once the chunks are on disk, so readers of the spans never see an epoch
+	// which has not been written yet.
+	s.latestEpochUpdatedLock.Lock()
+	for validatorChunkIndex := range attWrappersByValidatorChunkIndex {
+		indexes := s.params.ValidatorIndexesInChunk(validatorChunkIndex)
+		for _, index := range indexes {
+			s.latestEpochUpdatedForValidator[index] = currentEpoch
+		}
+	}
+	s.latestEpochUpdatedLock.Unlock()
+
 	return slashings, nil
 }
 
@@ -8,10 +8,11 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"golang.org/x/exp/maps"
 )
 
-// Verifies attester slashings, logs them, and submits them to the slashing operations pool
-// in the beacon node if they pass validation.
+// Verifies attester slashings, logs them, records them in the slasher database and submits them
+// to the slashing operations pool in the beacon node if they pass validation.
 func (s *Service) processAttesterSlashings(
 	ctx context.Context, slashings map[[fieldparams.RootLength]byte]ethpb.AttSlashing,
 ) (map[[fieldparams.RootLength]byte]ethpb.AttSlashing, error) {
@@ -56,11 +57,16 @@ func (s *Service) processAttesterSlashings(
 		processedSlashings[root] = slashing
 	}
 
+	// Record the verified slashings so they can be queried later on.
+	if err := s.serviceCfg.Database.SaveAttesterSlashings(ctx, maps.Values(processedSlashings)); err != nil {
+		log.WithError(err).Error("Could not save attester slashings")
+	}
+
 	return processedSlashings, nil
 }
 
-// Verifies proposer slashings, logs them, and submits them to the slashing operations pool
-// in the beacon node if they pass validation.
+// Verifies proposer slashings, logs them, records them in the slasher database and submits them
+// to the slashing operations pool in the beacon node if they pass validation.
 func (s *Service) processProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) error {
 	// If no slashings, return early.
 	if len(slashings) == 0 {
@@ -73,6 +79,7 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 		return err
 	}
 
+	verifiedSlashings := make([]*ethpb.ProposerSlashing, 0, len(slashings))
 	for _, slashing := range slashings {
 		// Verify the signature of the first block.
 		if err := s.verifyBlockSignature(ctx, slashing.Header_1); err != nil {
@@ -97,6 +104,13 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, beaconState, slashing); err != nil {
 			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
 		}
+
+		verifiedSlashings = append(verifiedSlashings, slashing)
+	}
+
+	// Record the verified slashings so they can be queried later on.
+	if err := s.serviceCfg.Database.SaveProposerSlashings(ctx, verifiedSlashings); err != nil {
+		log.WithError(err).Error("Could not save proposer slashings")
 	}
 
 	return nil
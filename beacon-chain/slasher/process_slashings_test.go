@@ -2,6 +2,7 @@ package slasher
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
@@ -9,6 +10,7 @@ import (
 	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	slashingsmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings/mock"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -139,6 +141,12 @@ func TestService_processAttesterSlashings(t *testing.T) {
 		_, err = s.processAttesterSlashings(ctx, slashings)
 		require.NoError(tt, err)
 		require.LogsDoNotContain(tt, hook, "Invalid signature")
+
+		// Only the verified slashing is recorded in the database.
+		saved, err := slasherDB.AttesterSlashings(ctx, &slashertypes.SlashingsFilter{EndEpoch: math.MaxUint64})
+		require.NoError(tt, err)
+		require.Equal(tt, 1, len(saved))
+		require.DeepSSZEqual(tt, slashing, saved[0])
 	})
 }
 
@@ -265,5 +273,10 @@ func TestService_processProposerSlashings(t *testing.T) {
 		err = s.processProposerSlashings(ctx, slashings)
 		require.NoError(tt, err)
 		require.LogsDoNotContain(tt, hook, "Invalid signature")
+
+		// Only the verified slashing is recorded in the database.
+		saved, err := slasherDB.ProposerSlashings(ctx, &slashertypes.SlashingsFilter{EndEpoch: math.MaxUint64})
+		require.NoError(tt, err)
+		require.DeepSSZEqual(tt, slashings, saved)
 	})
 }
@@ -0,0 +1,184 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+var (
+	// ErrInvalidAttestation is returned when a candidate attestation is malformed.
+	ErrInvalidAttestation = errors.New("invalid attestation")
+	// ErrInvalidBlockHeader is returned when a candidate block header is malformed.
+	ErrInvalidBlockHeader = errors.New("invalid block header")
+	// ErrEpochOutsideHistory is returned when the requested epoch is not covered by the
+	// spans kept for a validator.
+	ErrEpochOutsideHistory = errors.New("epoch is outside the slasher history")
+)
+
+// SlashingChecker allows checking candidate attestations and block headers against the
+// slasher history without submitting them for slashing detection.
+type SlashingChecker interface {
+	IsSlashableBlock(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error)
+	IsSlashableAttestation(ctx context.Context, att ethpb.IndexedAtt) ([]ethpb.AttSlashing, error)
+	ValidatorSpans(ctx context.Context, validatorIdx primitives.ValidatorIndex, epoch primitives.Epoch) (*ValidatorSpans, error)
+}
+
+// ValidatorSpans are the min and max span distances recorded for a validator at an epoch.
+// The min span is the distance to the lowest target of the attestations with a source
+// greater than the epoch, and the max span is the distance to the highest target of the
+// attestations with a source lower than the epoch.
+type ValidatorSpans struct {
+	MinSpan uint16
+	MaxSpan uint16
+	// MinSpanSet and MaxSpanSet are false when the spans hold their neutral element, i.e.
+	// no recorded attestation constrains them.
+	MinSpanSet bool
+	MaxSpanSet bool
+}
+
+// IsSlashableBlock checks if a block header is slashable with respect to the proposals
+// recorded in the slasher database. The header is not saved.
+func (s *Service) IsSlashableBlock(
+	ctx context.Context, header *ethpb.SignedBeaconBlockHeader,
+) (*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.IsSlashableBlock")
+	defer span.End()
+
+	if !validateBlockHeaderIntegrity(header) {
+		return nil, ErrInvalidBlockHeader
+	}
+	headerRoot, err := header.Header.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block header root")
+	}
+	slashings, err := s.serviceCfg.Database.CheckDoubleBlockProposals(ctx, []*slashertypes.SignedBlockHeaderWrapper{
+		{
+			SignedBeaconBlockHeader: header,
+			HeaderRoot:              headerRoot,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check for double proposals on disk")
+	}
+	if len(slashings) == 0 {
+		return nil, nil
+	}
+	return slashings[0], nil
+}
+
+// IsSlashableAttestation checks if an indexed attestation is a double vote, a surrounding vote
+// or a surrounded vote with respect to the attestations recorded in the slasher database.
+// The attestation is neither saved nor used to update the min/max spans.
+func (s *Service) IsSlashableAttestation(ctx context.Context, att ethpb.IndexedAtt) ([]ethpb.AttSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.IsSlashableAttestation")
+	defer span.End()
+
+	if !validateAttestationIntegrity(att) {
+		return nil, ErrInvalidAttestation
+	}
+	dataRoot, err := att.GetData().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	attWrapper := &slashertypes.IndexedAttestationWrapper{
+		IndexedAttestation: att,
+		DataRoot:           dataRoot,
+	}
+
+	slashingsByRoot, err := s.checkDoubleVotes(ctx, []*slashertypes.IndexedAttestationWrapper{attWrapper})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check slashable double votes")
+	}
+
+	sourceEpoch := att.GetData().Source.Epoch
+	for _, idx := range att.GetAttestingIndices() {
+		validatorIdx := primitives.ValidatorIndex(idx)
+		for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+			chunk, err := s.chunkForValidatorAtEpoch(ctx, kind, validatorIdx, sourceEpoch)
+			if errors.Is(err, ErrEpochOutsideHistory) {
+				// Nothing recorded for this validator can be surrounding or surrounded.
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			slashing, err := chunk.CheckSlashable(ctx, s.serviceCfg.Database, validatorIdx, attWrapper)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not check slashable surround votes for validator %d", validatorIdx)
+			}
+			if slashing == nil {
+				continue
+			}
+			root, err := slashing.HashTreeRoot()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not hash tree root for attester slashing")
+			}
+			slashingsByRoot[root] = slashing
+		}
+	}
+
+	slashings := make([]ethpb.AttSlashing, 0, len(slashingsByRoot))
+	for _, slashing := range slashingsByRoot {
+		slashings = append(slashings, slashing)
+	}
+	return slashings, nil
+}
+
+// ValidatorSpans returns the min and max spans recorded for a validator at an epoch.
+// ErrEpochOutsideHistory is returned if the epoch is not covered by the slasher history of the validator.
+func (s *Service) ValidatorSpans(
+	ctx context.Context, validatorIdx primitives.ValidatorIndex, epoch primitives.Epoch,
+) (*ValidatorSpans, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.ValidatorSpans")
+	defer span.End()
+
+	spans := &ValidatorSpans{}
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		chunk, err := s.chunkForValidatorAtEpoch(ctx, kind, validatorIdx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		distance := chunk.Chunk()[s.params.cellIndex(validatorIdx, epoch)]
+		switch kind {
+		case slashertypes.MinSpan:
+			spans.MinSpan = distance
+			spans.MinSpanSet = distance != chunk.NeutralElement()
+		case slashertypes.MaxSpan:
+			spans.MaxSpan = distance
+			spans.MaxSpanSet = distance != chunk.NeutralElement()
+		}
+	}
+	return spans, nil
+}
+
+// chunkForValidatorAtEpoch retrieves the chunk of the given kind holding the span of a validator
+// at an epoch. Chunks are reused cyclically and only brought up to date when the validator
+// attests, so only epochs within the history length before the latest epoch updated for the
+// validator hold meaningful data.
+func (s *Service) chunkForValidatorAtEpoch(
+	ctx context.Context, kind slashertypes.ChunkKind, validatorIdx primitives.ValidatorIndex, epoch primitives.Epoch,
+) (Chunker, error) {
+	s.latestEpochUpdatedLock.RLock()
+	lastEpoch, ok := s.latestEpochUpdatedForValidator[validatorIdx]
+	s.latestEpochUpdatedLock.RUnlock()
+	if !ok {
+		return nil, errors.Wrapf(ErrEpochOutsideHistory, "no history for validator %d", validatorIdx)
+	}
+	oldestEpoch := primitives.Epoch(0)
+	if lastEpoch >= s.params.historyLength {
+		oldestEpoch = lastEpoch - s.params.historyLength + 1
+	}
+	if epoch > lastEpoch || epoch < oldestEpoch {
+		return nil, errors.Wrapf(
+			ErrEpochOutsideHistory,
+			"epoch %d not in range [%d, %d] for validator %d",
+			epoch, oldestEpoch, lastEpoch, validatorIdx,
+		)
+	}
+	return s.getChunkFromDatabase(ctx, kind, s.params.validatorChunkIndex(validatorIdx), s.params.chunkIndex(epoch))
+}
@@ -0,0 +1,173 @@
+package slasher
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_IsSlashableBlock(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s, err := New(ctx, &ServiceConfig{Database: slasherDB})
+	require.NoError(t, err)
+
+	existing := createProposalWrapper(t, 4, 1, []byte{1})
+	require.NoError(t, slasherDB.SaveBlockProposals(ctx, []*slashertypes.SignedBlockHeaderWrapper{existing}))
+
+	t.Run("invalid header", func(t *testing.T) {
+		_, err := s.IsSlashableBlock(ctx, &ethpb.SignedBeaconBlockHeader{})
+		require.ErrorIs(t, err, ErrInvalidBlockHeader)
+	})
+
+	t.Run("same proposal", func(t *testing.T) {
+		slashing, err := s.IsSlashableBlock(ctx, existing.SignedBeaconBlockHeader)
+		require.NoError(t, err)
+		require.IsNil(t, slashing)
+	})
+
+	t.Run("other slot", func(t *testing.T) {
+		slashing, err := s.IsSlashableBlock(ctx, createProposalWrapper(t, 5, 1, []byte{2}).SignedBeaconBlockHeader)
+		require.NoError(t, err)
+		require.IsNil(t, slashing)
+	})
+
+	t.Run("double proposal", func(t *testing.T) {
+		incoming := createProposalWrapper(t, 4, 1, []byte{2})
+		slashing, err := s.IsSlashableBlock(ctx, incoming.SignedBeaconBlockHeader)
+		require.NoError(t, err)
+		require.NotNil(t, slashing)
+		require.DeepEqual(t, existing.SignedBeaconBlockHeader, slashing.Header_1)
+		require.DeepEqual(t, incoming.SignedBeaconBlockHeader, slashing.Header_2)
+	})
+
+	// Checking a block must not record it.
+	proposal, err := slasherDB.BlockProposalForValidator(ctx, 1, 5)
+	require.NoError(t, err)
+	require.IsNil(t, proposal)
+}
+
+func TestService_IsSlashableAttestation(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s, err := New(ctx, &ServiceConfig{Database: slasherDB})
+	require.NoError(t, err)
+
+	_, err = s.checkSlashableAttestations(ctx, 3, []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapperEmptySig(t, 1, 2, []uint64{0, 1}, []byte{1}),
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		att       ethpb.IndexedAtt
+		slashings int
+		wantErr   error
+	}{
+		{
+			name:    "invalid attestation",
+			att:     createAttestationWrapperEmptySig(t, 3, 2, []uint64{0}, nil).IndexedAttestation,
+			wantErr: ErrInvalidAttestation,
+		},
+		{
+			name: "same attestation",
+			att:  createAttestationWrapperEmptySig(t, 1, 2, []uint64{0}, []byte{1}).IndexedAttestation,
+		},
+		{
+			name:      "double vote",
+			att:       createAttestationWrapperEmptySig(t, 1, 2, []uint64{0, 1}, []byte{2}).IndexedAttestation,
+			slashings: 1,
+		},
+		{
+			name:      "surrounding vote",
+			att:       createAttestationWrapperEmptySig(t, 0, 3, []uint64{1}, nil).IndexedAttestation,
+			slashings: 1,
+		},
+		{
+			name: "not slashable",
+			att:  createAttestationWrapperEmptySig(t, 2, 3, []uint64{0, 1}, nil).IndexedAttestation,
+		},
+		{
+			name: "validator without history",
+			att:  createAttestationWrapperEmptySig(t, 0, 3, []uint64{1000}, nil).IndexedAttestation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slashings, err := s.IsSlashableAttestation(ctx, tt.att)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.slashings, len(slashings))
+		})
+	}
+
+	// Checking an attestation must not record it.
+	record, err := slasherDB.AttestationRecordForValidator(ctx, 0, 3)
+	require.NoError(t, err)
+	require.IsNil(t, record)
+}
+
+func TestService_ValidatorSpans(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s, err := New(ctx, &ServiceConfig{Database: slasherDB})
+	require.NoError(t, err)
+
+	_, err = s.checkSlashableAttestations(ctx, 4, []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapperEmptySig(t, 1, 3, []uint64{0}, nil),
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		validator primitives.ValidatorIndex
+		epoch     primitives.Epoch
+		want      *ValidatorSpans
+		wantErr   error
+	}{
+		{
+			name:      "before source",
+			validator: 0,
+			epoch:     0,
+			want:      &ValidatorSpans{MinSpan: 3, MinSpanSet: true},
+		},
+		{
+			name:      "between source and target",
+			validator: 0,
+			epoch:     2,
+			want:      &ValidatorSpans{MinSpan: math.MaxUint16, MaxSpan: 1, MaxSpanSet: true},
+		},
+		{
+			name:      "future epoch",
+			validator: 0,
+			epoch:     5,
+			wantErr:   ErrEpochOutsideHistory,
+		},
+		{
+			name:      "validator without history",
+			validator: 1000,
+			epoch:     2,
+			wantErr:   ErrEpochOutsideHistory,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spans, err := s.ValidatorSpans(ctx, tt.validator, tt.epoch)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.DeepEqual(t, tt.want, spans)
+		})
+	}
+}
@@ -59,6 +59,7 @@ type Service struct {
 	blocksSlotTicker               *slots.SlotTicker
 	pruningSlotTicker              *slots.SlotTicker
 	latestEpochUpdatedForValidator map[primitives.ValidatorIndex]primitives.Epoch
+	latestEpochUpdatedLock         sync.RWMutex
 	wg                             sync.WaitGroup
 }
 
@@ -110,9 +111,11 @@ func (s *Service) run() {
 		log.Error(err)
 		return
 	}
+	s.latestEpochUpdatedLock.Lock()
 	for _, item := range epochsByValidator {
 		s.latestEpochUpdatedForValidator[item.ValidatorIndex] = item.Epoch
 	}
+	s.latestEpochUpdatedLock.Unlock()
 	log.WithField("elapsed", time.Since(start)).Info(
 		"Finished retrieving last epoch written per validator",
 	)
@@ -63,3 +63,11 @@ type AttestedEpochForValidator struct {
 	ValidatorIndex primitives.ValidatorIndex
 	Epoch          primitives.Epoch
 }
+
+// SlashingsFilter restricts the detected slashings returned from the slasher database.
+// The epoch range is inclusive. An empty list of validator indices matches every validator.
+type SlashingsFilter struct {
+	StartEpoch       primitives.Epoch
+	EndEpoch         primitives.Epoch
+	ValidatorIndices []primitives.ValidatorIndex
+}
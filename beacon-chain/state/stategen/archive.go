@@ -0,0 +1,227 @@
+package stategen
+
+import (
+	"context"
+	"encoding/hex"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// stateArchive tracks the full state that the per-epoch diffs of archive mode are taken against.
+// It is only accessed while holding the migration lock.
+type stateArchive struct {
+	baseRoot [32]byte
+	base     state.ReadOnlyBeaconState
+	// migrated is set once the finalized history synced before archive mode was enabled has been archived.
+	migrated atomic.Bool
+}
+
+// WithStateArchive enables archive mode. In addition to the full states saved on archived points,
+// the state of every finalized epoch boundary is saved as a compact diff against the latest full
+// state, so that any historical state can be regenerated by replaying at most one epoch of blocks.
+func WithStateArchive() Option {
+	return func(sg *State) {
+		sg.archive = &stateArchive{}
+	}
+}
+
+// isArchivedEpochBoundary returns true if the state at the given slot should be kept as a diff
+// when it is migrated to the cold section.
+func (s *State) isArchivedEpochBoundary(slot primitives.Slot) bool {
+	return s.archive != nil && slot%params.BeaconConfig().SlotsPerEpoch == 0
+}
+
+// saveColdState saves the state of a finalized slot. States on archived points are saved in full,
+// the states of other epoch boundaries are saved as a diff against the latest full state.
+func (s *State) saveColdState(ctx context.Context, slot primitives.Slot, root [32]byte, st state.BeaconState) error {
+	if s.archive == nil || slot%s.slotsPerArchivedPoint == 0 {
+		return s.saveArchiveSnapshot(ctx, root, st)
+	}
+	if s.archive.base == nil && s.archive.baseRoot != params.BeaconConfig().ZeroHash {
+		base, err := s.beaconDB.StateOrError(ctx, s.archive.baseRoot)
+		if err != nil {
+			return err
+		}
+		s.archive.base = base
+	}
+	if s.archive.base != nil {
+		err := s.beaconDB.SaveStateDiff(ctx, st, root, s.archive.base, s.archive.baseRoot)
+		if err == nil {
+			log.WithFields(logrus.Fields{
+				"slot": st.Slot(),
+				"root": hex.EncodeToString(bytesutil.Trunc(root[:])),
+			}).Debug("Saved state diff in DB")
+			return nil
+		}
+		// The base is unusable, for example because of a fork transition. Start over from a full state.
+		log.WithError(err).Debug("Could not save state diff, saving full state instead")
+	}
+	return s.saveArchiveSnapshot(ctx, root, st)
+}
+
+func (s *State) saveArchiveSnapshot(ctx context.Context, root [32]byte, st state.BeaconState) error {
+	if err := s.beaconDB.SaveState(ctx, st, root); err != nil {
+		return err
+	}
+	log.WithFields(
+		logrus.Fields{
+			"slot": st.Slot(),
+			"root": hex.EncodeToString(bytesutil.Trunc(root[:])),
+		}).Info("Saved state in DB")
+	if s.archive == nil {
+		return nil
+	}
+	s.setArchiveBase(root, st.Copy())
+	if s.archive.migrated.Load() {
+		return s.beaconDB.SaveStateArchiveProgress(ctx, root)
+	}
+	return nil
+}
+
+// setArchiveBase sets the full state that following diffs are taken against. The state is loaded
+// lazily from the database when it is nil.
+func (s *State) setArchiveBase(root [32]byte, st state.ReadOnlyBeaconState) {
+	s.archive.baseRoot = root
+	s.archive.base = st
+}
+
+// MigrateToArchive archives the finalized history that was synced before archive mode was enabled,
+// by replaying the finalized blocks and saving a snapshot on every archived point and a diff on every
+// other epoch boundary. Progress is recorded in the database, so an interrupted migration resumes
+// from the last snapshot it reached. The migration lock is held while each epoch boundary is archived,
+// so the migration can run in the background alongside MigrateToCold.
+func (s *State) MigrateToArchive(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.MigrateToArchive")
+	defer span.End()
+	if s.archive == nil {
+		return errors.New("state archive is not enabled")
+	}
+
+	cp, err := s.beaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	fSlot, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return err
+	}
+	root, st, err := s.archiveMigrationStart(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not find the state to start the migration from")
+	}
+	log.WithFields(logrus.Fields{
+		"startSlot": st.Slot(),
+		"endSlot":   fSlot,
+	}).Info("Migrating finalized history to the state archive")
+
+	spe := params.BeaconConfig().SlotsPerEpoch
+	baseRoot, base := root, st.Copy()
+	for boundary := st.Slot() - st.Slot()%spe + spe; boundary < fSlot; boundary += spe {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// The lock is taken one epoch at a time, so that finalization keeps migrating new states to the
+		// cold section while the history is archived.
+		s.migrationLock.Lock()
+		st, baseRoot, base, err = s.archiveBoundary(ctx, boundary, st, baseRoot, base)
+		s.migrationLock.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	s.migrationLock.Lock()
+	s.archive.migrated.Store(true)
+	s.migrationLock.Unlock()
+	log.Info("Finished migrating finalized history to the state archive")
+	return nil
+}
+
+// archiveBoundary replays the finalized blocks up to the epoch boundary and archives the resulting state.
+// It returns the replayed state along with the full state that following diffs are taken against.
+// The caller must hold the migration lock.
+func (s *State) archiveBoundary(
+	ctx context.Context,
+	boundary primitives.Slot,
+	st state.BeaconState,
+	baseRoot [32]byte,
+	base state.BeaconState,
+) (state.BeaconState, [32]byte, state.BeaconState, error) {
+	blks, err := s.loadFinalizedBlocks(ctx, st.Slot()+1, boundary)
+	if err != nil {
+		return nil, [32]byte{}, nil, errors.Wrapf(err, "could not load finalized blocks up to slot %d", boundary)
+	}
+	// Skip ahead when there are no new blocks, the state of the boundary has already been saved.
+	if len(blks) == 0 {
+		return st, baseRoot, base, nil
+	}
+	root, err := blks[0].Block().HashTreeRoot()
+	if err != nil {
+		return nil, [32]byte{}, nil, err
+	}
+	st, err = s.replayBlocks(ctx, st, blks, blks[0].Block().Slot())
+	if err != nil {
+		return nil, [32]byte{}, nil, errors.Wrapf(err, "could not replay blocks up to slot %d", boundary)
+	}
+
+	archivedPoint := boundary%s.slotsPerArchivedPoint == 0
+	if s.beaconDB.HasState(ctx, root) {
+		if archivedPoint {
+			return st, root, st.Copy(), nil
+		}
+		return st, baseRoot, base, nil
+	}
+	if !archivedPoint {
+		if err := s.beaconDB.SaveStateDiff(ctx, st, root, base, baseRoot); err == nil {
+			return st, baseRoot, base, nil
+		}
+	}
+	if err := s.beaconDB.SaveState(ctx, st, root); err != nil {
+		return nil, [32]byte{}, nil, err
+	}
+	if archivedPoint {
+		if err := s.beaconDB.SaveStateArchiveProgress(ctx, root); err != nil {
+			return nil, [32]byte{}, nil, err
+		}
+		log.WithField("slot", st.Slot()).Info("Archived finalized history")
+	}
+	return st, root, st.Copy(), nil
+}
+
+// archiveMigrationStart returns the state the migration resumes from, which is either the last
+// snapshot reached by a previous migration, the checkpoint sync origin or genesis.
+func (s *State) archiveMigrationStart(ctx context.Context) ([32]byte, state.BeaconState, error) {
+	root, err := s.beaconDB.StateArchiveProgress(ctx)
+	switch {
+	case err == nil:
+		st, err := s.beaconDB.StateOrError(ctx, root)
+		return root, st, err
+	case !errors.Is(err, db.ErrNotFound):
+		return [32]byte{}, nil, err
+	}
+
+	root, err = s.beaconDB.OriginCheckpointBlockRoot(ctx)
+	switch {
+	case err == nil:
+		st, err := s.beaconDB.StateOrError(ctx, root)
+		return root, st, err
+	case !errors.Is(err, db.ErrNotFoundOriginBlockRoot):
+		return [32]byte{}, nil, err
+	}
+
+	root, err = s.beaconDB.GenesisBlockRoot(ctx)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	st, err := s.beaconDB.StateOrError(ctx, root)
+	return root, st, err
+}
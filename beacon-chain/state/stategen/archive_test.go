@@ -0,0 +1,113 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestMigrateToArchive(t *testing.T) {
+	ctx := context.Background()
+	blockSlots := []primitives.Slot{3, 10, 33, 40, 70, 100, 130, 170}
+	specs := make([]mockHistorySpec, len(blockSlots))
+	for i, slot := range blockSlots {
+		specs[i] = mockHistorySpec{slot: slot, canonicalBlock: true}
+	}
+	hist := newMockHistory(t, specs, 200)
+
+	beaconDB := testDB.SetupDB(t)
+	genesisRoot := hist.slotMap[0]
+	require.NoError(t, beaconDB.SaveBlock(ctx, hist.blocks[genesisRoot]))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, hist.states[genesisRoot], genesisRoot))
+	for _, slot := range blockSlots {
+		root := hist.slotMap[slot]
+		require.NoError(t, beaconDB.SaveBlock(ctx, hist.blocks[root]))
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: slot, Root: root[:]}))
+	}
+	head := hist.slotMap[170]
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 6, Root: head[:]}))
+
+	service := New(beaconDB, doublylinkedtree.New(), WithStateArchive())
+	service.slotsPerArchivedPoint = 64
+	require.NoError(t, service.MigrateToArchive(ctx))
+	require.Equal(t, true, service.archive.migrated.Load())
+
+	// The state of the last block of every epoch before the finalized epoch is stored.
+	for _, slot := range []primitives.Slot{10, 40, 70, 100, 130} {
+		root := hist.slotMap[slot]
+		require.Equal(t, true, beaconDB.HasState(ctx, root))
+		st, err := beaconDB.State(ctx, root)
+		require.NoError(t, err)
+		requireStatesEqual(t, hist.hiddenStates[root], st)
+	}
+	for _, slot := range []primitives.Slot{3, 33, 170} {
+		require.Equal(t, false, beaconDB.HasState(ctx, hist.slotMap[slot]))
+	}
+	// Diffs are indexed by slot along with the full states.
+	for _, slot := range []primitives.Slot{10, 40, 70, 100, 130} {
+		require.Equal(t, hist.slotMap[slot], beaconDB.ArchivedPointRoot(ctx, slot))
+	}
+	progress, err := beaconDB.StateArchiveProgress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, hist.slotMap[100], progress)
+
+	// A second run resumes from the recorded progress.
+	require.NoError(t, service.MigrateToArchive(ctx))
+}
+
+func TestSaveColdState_Archive(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, doublylinkedtree.New(), WithStateArchive())
+	service.slotsPerArchivedPoint = 64
+	service.archive.migrated.Store(true)
+
+	base, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, base.SetSlot(32))
+	baseRoot := [32]byte{'a'}
+	// Without a known base, the state is saved in full.
+	require.NoError(t, service.saveColdState(ctx, 32, baseRoot, base))
+	require.Equal(t, baseRoot, beaconDB.ArchivedPointRoot(ctx, 32))
+	progress, err := beaconDB.StateArchiveProgress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, baseRoot, progress)
+
+	st := base.Copy()
+	require.NoError(t, st.SetSlot(96))
+	require.NoError(t, st.UpdateBalancesAtIndex(0, 1))
+	root := [32]byte{'b'}
+	require.NoError(t, service.saveColdState(ctx, 96, root, st))
+	require.Equal(t, root, beaconDB.ArchivedPointRoot(ctx, 96))
+	// The state is saved as a diff, so the base is unchanged.
+	require.Equal(t, baseRoot, service.archive.baseRoot)
+	got, err := beaconDB.StateOrError(ctx, root)
+	require.NoError(t, err)
+	requireStatesEqual(t, st, got)
+
+	// After a restart, the base is loaded from the database.
+	service.setArchiveBase(baseRoot, nil)
+	require.NoError(t, st.SetSlot(160))
+	root = [32]byte{'c'}
+	require.NoError(t, service.saveColdState(ctx, 160, root, st))
+	require.Equal(t, root, beaconDB.ArchivedPointRoot(ctx, 160))
+	require.Equal(t, baseRoot, service.archive.baseRoot)
+	got, err = beaconDB.StateOrError(ctx, root)
+	require.NoError(t, err)
+	requireStatesEqual(t, st, got)
+}
+
+func requireStatesEqual(t *testing.T, want, got state.BeaconState) {
+	wantRoot, err := want.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	gotRoot, err := got.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, wantRoot, gotRoot)
+}
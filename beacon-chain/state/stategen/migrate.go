@@ -2,12 +2,9 @@ package stategen
 
 import (
 	"context"
-	"encoding/hex"
 	"fmt"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
-	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
-	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -39,12 +36,13 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 
 	// Start at previous finalized slot, stop at current finalized slot (it will be handled in the next migration).
 	// If the slot is on archived point, save the state of that slot to the DB.
+	// In archive mode, the states of all other epoch boundaries are saved too.
 	for slot := oldFSlot; slot < fSlot; slot++ {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
 
-		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 {
+		if (slot%s.slotsPerArchivedPoint == 0 || s.isArchivedEpochBoundary(slot)) && slot != 0 {
 			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
 			if err != nil {
 				return fmt.Errorf("could not get epoch boundary state for slot %d", slot)
@@ -95,17 +93,15 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 					}
 				}
 				s.saveHotStateDB.lock.Unlock()
+				if s.archive != nil && slot%s.slotsPerArchivedPoint == 0 {
+					s.setArchiveBase(aRoot, nil)
+				}
 				continue
 			}
 
-			if err := s.beaconDB.SaveState(ctx, aState, aRoot); err != nil {
+			if err := s.saveColdState(ctx, slot, aRoot, aState); err != nil {
 				return err
 			}
-			log.WithFields(
-				logrus.Fields{
-					"slot": aState.Slot(),
-					"root": hex.EncodeToString(bytesutil.Trunc(aRoot[:])),
-				}).Info("Saved state in DB")
 		}
 	}
 
@@ -56,6 +56,7 @@ type State struct {
 	avb                     coverage.AvailableBlocker
 	migrationLock           *sync.Mutex
 	fc                      forkchoice.ForkChoicer
+	archive                 *stateArchive
 }
 
 // This tracks the config in the event of long non-finality,
@@ -139,11 +140,14 @@ func (s *State) Resume(ctx context.Context, fState state.BeaconState) (state.Bea
 		return nil, errors.New("finalized state is nil")
 	}
 
-	go func() {
-		if err := s.beaconDB.CleanUpDirtyStates(ctx, s.slotsPerArchivedPoint); err != nil {
-			log.WithError(err).Error("Could not clean up dirty states")
-		}
-	}()
+	// In archive mode, any full state may be the base of the diffs saved after it.
+	if s.archive == nil {
+		go func() {
+			if err := s.beaconDB.CleanUpDirtyStates(ctx, s.slotsPerArchivedPoint); err != nil {
+				log.WithError(err).Error("Could not clean up dirty states")
+			}
+		}()
+	}
 
 	s.finalizedInfo = &finalizedInfo{slot: fState.Slot(), root: fRoot, state: fState.Copy()}
 	fEpoch := slots.ToEpoch(fState.Slot())
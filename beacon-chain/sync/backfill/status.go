@@ -113,6 +113,36 @@ func (s *Store) fillBack(ctx context.Context, current primitives.Slot, blocks []
 	return status, s.saveStatus(ctx, status)
 }
 
+// MarkPruned moves the low end of the chain history up to the given block, after the blocks below it were pruned
+// from the database, so that they are no longer reported as available. A node that was synced from genesis starts
+// tracking its history from this block, which is also used as the origin. The status is left untouched if the
+// block is not above the current low end.
+func (s *Store) MarkPruned(ctx context.Context, lowest blocks.ROBlock) error {
+	pr := lowest.Block().ParentRoot()
+	status := &dbval.BackfillStatus{
+		LowSlot:       uint64(lowest.Block().Slot()),
+		LowRoot:       lowest.RootSlice(),
+		LowParentRoot: pr[:],
+		OriginSlot:    uint64(lowest.Block().Slot()),
+		OriginRoot:    lowest.RootSlice(),
+	}
+	if !s.isGenesisSync() {
+		current := s.status()
+		if current.LowSlot >= status.LowSlot {
+			return nil
+		}
+		status.OriginSlot = current.OriginSlot
+		status.OriginRoot = current.OriginRoot
+	}
+	if err := s.saveStatus(ctx, status); err != nil {
+		return err
+	}
+	s.Lock()
+	defer s.Unlock()
+	s.genesisSync = false
+	return nil
+}
+
 // recoverLegacy will check to see if the db is from a legacy checkpoint sync, and either build a new BackfillStatus
 // or label the node as synced from genesis.
 func (s *Store) recoverLegacy(ctx context.Context) error {
@@ -144,6 +144,37 @@ func TestStatusUpdater_FillBack(t *testing.T) {
 	require.Equal(t, true, s.AvailableBlock(95))
 }
 
+func TestStatusUpdater_MarkPruned(t *testing.T) {
+	ctx := context.Background()
+	b, err := setupTestBlock(90)
+	require.NoError(t, err)
+	rob, err := blocks.NewROBlock(b)
+	require.NoError(t, err)
+
+	// A node synced from genesis starts tracking its history from the lowest block left.
+	mdb := &mockBackfillDB{}
+	s := &Store{genesisSync: true, store: mdb}
+	require.Equal(t, true, s.AvailableBlock(50))
+	require.NoError(t, s.MarkPruned(ctx, rob))
+	require.Equal(t, false, s.AvailableBlock(50))
+	require.Equal(t, true, s.AvailableBlock(90))
+	require.Equal(t, true, s.AvailableBlock(0))
+	require.Equal(t, uint64(90), mdb.status.OriginSlot)
+
+	// A checkpoint synced node keeps its origin.
+	mdb = &mockBackfillDB{}
+	s = &Store{bs: &dbval.BackfillStatus{LowSlot: 10, OriginSlot: 100, OriginRoot: []byte{1}}, store: mdb}
+	require.NoError(t, s.MarkPruned(ctx, rob))
+	require.Equal(t, false, s.AvailableBlock(50))
+	require.Equal(t, uint64(90), mdb.status.LowSlot)
+	require.Equal(t, uint64(100), mdb.status.OriginSlot)
+
+	// The low end never moves down.
+	s = &Store{bs: &dbval.BackfillStatus{LowSlot: 95}, store: mdb}
+	require.NoError(t, s.MarkPruned(ctx, rob))
+	require.Equal(t, uint64(95), s.status().LowSlot)
+}
+
 func goodBlockRoot(root [32]byte) func(ctx context.Context) ([32]byte, error) {
 	return func(ctx context.Context) ([32]byte, error) {
 		return root, nil
@@ -10,19 +10,27 @@ import (
 )
 
 // APIInitializer manages initializing the beacon node using checkpoint sync, retrieving the checkpoint state and root
-// from the remote beacon node api.
+// from the remote beacon node apis, once enough of them agree on the finalized checkpoint.
 type APIInitializer struct {
-	c *beacon.Client
+	clients []*beacon.Client
+	quorum  int
 }
 
-// NewAPIInitializer creates an APIInitializer, handling the set up of a beacon node api client
-// using the provided host string.
-func NewAPIInitializer(beaconNodeHost string) (*APIInitializer, error) {
-	c, err := beacon.NewClient(beaconNodeHost)
+// NewAPIInitializer creates an APIInitializer, handling the set up of a beacon node api client for each
+// of the provided host strings. The finalized checkpoint must be agreed on by quorum of the hosts, which must be a
+// majority of them, or by all of them if quorum is 0.
+func NewAPIInitializer(beaconNodeHosts []string, quorum int) (*APIInitializer, error) {
+	clients, err := beacon.NewClients(beaconNodeHosts)
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to parse beacon node url or hostname - %s", beaconNodeHost)
+		return nil, err
 	}
-	return &APIInitializer{c: c}, nil
+	if quorum < 0 || quorum > len(clients) {
+		return nil, errors.Errorf("checkpoint sync quorum must be between 1 and the number of urls (%d), got %d", len(clients), quorum)
+	}
+	if quorum != 0 && !beacon.IsMajorityQuorum(quorum, len(clients)) {
+		return nil, errors.Errorf("checkpoint sync quorum must be a majority of the number of urls (%d), got %d", len(clients), quorum)
+	}
+	return &APIInitializer{clients: clients, quorum: quorum}, nil
 }
 
 // Initialize downloads origin state and block for checkpoint sync and initializes database records to
@@ -37,7 +45,7 @@ func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 			return errors.Wrap(err, "error while checking database for origin root")
 		}
 	}
-	od, err := beacon.DownloadFinalizedData(ctx, dl.c)
+	od, err := beacon.DownloadFinalizedData(ctx, dl.clients, dl.quorum)
 	if err != nil {
 		return errors.Wrap(err, "Error retrieving checkpoint origin state and block")
 	}
@@ -8,6 +8,8 @@ import (
 	"github.com/pkg/errors"
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"google.golang.org/protobuf/proto"
 )
@@ -41,7 +43,16 @@ func (s *Service) decodePubsubMessage(msg *pubsub.Message) (ssz.Unmarshaler, err
 		topic = p2p.GossipTypeMapping[reflect.TypeOf(&ethpb.BlobSidecar{})]
 	}
 
-	base := p2p.GossipTopicMappings(topic, 0)
+	var epoch primitives.Epoch
+	// Light client updates take the form of the fork of their topic.
+	if topic == p2p.LightClientFinalityUpdateTopicFormat || topic == p2p.LightClientOptimisticUpdateTopicFormat {
+		vRoot := s.clock().GenesisValidatorsRoot()
+		_, epoch, err = forks.RetrieveForkDataFromDigest(fDigest, vRoot[:])
+		if err != nil {
+			return nil, errors.Wrapf(ErrNoValidDigest, "could not extract light client update type: %v", err)
+		}
+	}
+	base := p2p.GossipTopicMappings(topic, epoch)
 	if base == nil {
 		return nil, p2p.ErrMessageNotMapped
 	}
@@ -51,7 +62,7 @@ func (s *Service) decodePubsubMessage(msg *pubsub.Message) (ssz.Unmarshaler, err
 	}
 	// Handle different message types across forks.
 	if topic == p2p.BlockSubnetTopicFormat {
-		m, err = extractBlockDataType(fDigest[:], s.cfg.clock)
+		m, err = extractBlockDataType(fDigest[:], s.clock())
 		if err != nil {
 			return nil, err
 		}
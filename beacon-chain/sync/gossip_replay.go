@@ -0,0 +1,163 @@
+package sync
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"google.golang.org/protobuf/proto"
+)
+
+// replayTopic is a gossip topic with the validator and handler of its messages, as registered by
+// registerSubscribers.
+type replayTopic struct {
+	format   string
+	subnets  bool
+	validate wrappedVal
+	handle   subHandler
+}
+
+func (s *Service) replayTopics() []replayTopic {
+	topics := []replayTopic{
+		{format: p2p.BlockSubnetTopicFormat, validate: s.validateBeaconBlockPubSub, handle: s.beaconBlockSubscriber},
+		{format: p2p.AggregateAndProofSubnetTopicFormat, validate: s.validateAggregateAndProof, handle: s.beaconAggregateProofSubscriber},
+		{format: p2p.ExitSubnetTopicFormat, validate: s.validateVoluntaryExit, handle: s.voluntaryExitSubscriber},
+		{format: p2p.ProposerSlashingSubnetTopicFormat, validate: s.validateProposerSlashing, handle: s.proposerSlashingSubscriber},
+		{format: p2p.AttesterSlashingSubnetTopicFormat, validate: s.validateAttesterSlashing, handle: s.attesterSlashingSubscriber},
+		{format: p2p.AttestationSubnetTopicFormat, subnets: true, validate: s.validateCommitteeIndexBeaconAttestation, handle: s.committeeIndexBeaconAttestationSubscriber},
+		{format: p2p.SyncContributionAndProofSubnetTopicFormat, validate: s.validateSyncContributionAndProof, handle: s.syncContributionAndProofSubscriber},
+		{format: p2p.SyncCommitteeSubnetTopicFormat, subnets: true, validate: s.validateSyncCommitteeMessage, handle: s.syncCommitteeMessageSubscriber},
+		{format: p2p.BlsToExecutionChangeSubnetTopicFormat, validate: s.validateBlsToExecutionChange, handle: s.blsToExecutionChangeSubscriber},
+		{format: p2p.BlobSubnetTopicFormat, subnets: true, validate: s.validateBlob, handle: s.blobSubscriber},
+	}
+	if features.Get().EnableLightClient {
+		topics = append(topics,
+			replayTopic{format: p2p.LightClientFinalityUpdateTopicFormat, validate: s.validateLightClientFinalityUpdate, handle: s.lightClientUpdateSubscriber},
+			replayTopic{format: p2p.LightClientOptimisticUpdateTopicFormat, validate: s.validateLightClientOptimisticUpdate, handle: s.lightClientUpdateSubscriber},
+		)
+	}
+	return topics
+}
+
+// matchReplayTopic returns the topic, among the given ones, of a gossip topic as received on the wire.
+func (s *Service) matchReplayTopic(topics []replayTopic, topic string) (replayTopic, bool) {
+	topic, err := s.replaceForkDigest(strings.TrimSuffix(topic, s.cfg.p2p.Encoding().ProtocolSuffix()))
+	if err != nil {
+		return replayTopic{}, false
+	}
+	for _, t := range topics {
+		if !t.subnets {
+			if topic == t.format {
+				return t, true
+			}
+			continue
+		}
+		subnet, ok := strings.CutPrefix(topic, strings.TrimSuffix(t.format, "%d"))
+		if !ok {
+			continue
+		}
+		if _, err := strconv.ParseUint(subnet, 10, 64); err == nil {
+			return t, true
+		}
+	}
+	return replayTopic{}, false
+}
+
+// replayGenesisSetter is implemented by the blockchain service, whose genesis time and the one of its fork choice
+// store are shifted along with the clock of the sync service when replaying gossip.
+type replayGenesisSetter interface {
+	SetGenesisTime(time.Time)
+	SetForkChoiceGenesisTime(uint64)
+}
+
+// PrepareReplay readies a service, which is not started, to replay recorded gossip messages with ReplayGossip.
+// It waits for the clock and the verification initializer the service was configured with. The chain service of
+// the service must be the blockchain service, so that its clock can be shifted.
+func (s *Service) PrepareReplay(ctx context.Context) error {
+	chain, ok := s.cfg.chain.(replayGenesisSetter)
+	if !ok {
+		return errors.Errorf("chain service %T does not support replay", s.cfg.chain)
+	}
+	clock, err := s.clockWaiter.WaitForClock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get clock")
+	}
+	ctxMap, err := ContextByteVersionsForValRoot(clock.GenesisValidatorsRoot())
+	if err != nil {
+		return errors.Wrap(err, "could not initialize context version map")
+	}
+	v, err := s.verifierWaiter.WaitForInitializer(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get verification initializer")
+	}
+	s.setClock(clock)
+	s.replayClock = clock
+	s.replayChain = chain
+	s.ctxMap = ctxMap
+	s.newBlobVerifier = newBlobVerifierFromInitializer(v)
+	s.markForChainStart()
+	go s.verifierRoutine()
+	return nil
+}
+
+// ReplayGossip runs a gossip message received at the given time through the validator of its topic, and through
+// the handler of the topic when the message is accepted, as it happens when the message is received from the
+// network. The clocks of the service, of the blockchain service and of fork choice are shifted so that the message
+// is validated and handled at its arrival time, as proposer boost and block timeliness depend on it.
+func (s *Service) ReplayGossip(ctx context.Context, topic string, from peer.ID, data []byte, arrival time.Time) (pubsub.ValidationResult, error) {
+	if s.replayClock == nil {
+		return pubsub.ValidationIgnore, errors.New("service is not prepared for replay")
+	}
+	t, ok := s.matchReplayTopic(s.replayTopics(), topic)
+	if !ok {
+		return pubsub.ValidationIgnore, errors.Errorf("no validator for topic %s", topic)
+	}
+	genesis := s.replayClock.GenesisTime().Add(prysmTime.Since(arrival))
+	s.setClock(startup.NewClock(genesis, s.replayClock.GenesisValidatorsRoot()))
+	s.replayChain.SetGenesisTime(genesis)
+	s.replayChain.SetForkChoiceGenesisTime(uint64(genesis.Unix()))
+
+	retDigest, err := p2p.ExtractGossipDigest(topic)
+	if err != nil {
+		return pubsub.ValidationIgnore, errors.Wrapf(err, "invalid topic %s", topic)
+	}
+	currDigest, err := s.currentForkDigest()
+	if err != nil {
+		return pubsub.ValidationIgnore, errors.Wrap(err, "could not retrieve fork data")
+	}
+	if currDigest != retDigest {
+		return pubsub.ValidationIgnore, errors.Errorf("message from outdated fork digest %#x", retDigest)
+	}
+
+	msg := &pubsub.Message{
+		Message:      &pubsubpb.Message{Data: data, Topic: &topic},
+		ReceivedFrom: from,
+	}
+	vCtx, cancel := context.WithTimeout(ctx, pubsubMessageTimeout)
+	res, err := t.validate(vCtx, from, msg)
+	if res == pubsub.ValidationReject && vCtx.Err() != nil {
+		res = pubsub.ValidationIgnore
+	}
+	cancel()
+	if res != pubsub.ValidationAccept {
+		return res, err
+	}
+	if msg.ValidatorData == nil {
+		return res, errors.New("accepted message has no validator data")
+	}
+	hCtx, cancel := context.WithTimeout(ctx, pubsubMessageTimeout)
+	defer cancel()
+	if err := t.handle(hCtx, msg.ValidatorData.(proto.Message)); err != nil {
+		return res, errors.Wrap(err, "could not handle message")
+	}
+	return res, nil
+}
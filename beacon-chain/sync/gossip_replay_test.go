@@ -0,0 +1,83 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestMatchReplayTopic(t *testing.T) {
+	s := &Service{cfg: &config{p2p: p2ptest.NewTestP2P(t)}}
+	topics := s.replayTopics()
+	tests := []struct {
+		topic  string
+		format string
+		ok     bool
+	}{
+		{topic: "/eth2/6a95a1a9/beacon_block/ssz_snappy", format: "/eth2/%x/beacon_block", ok: true},
+		{topic: "/eth2/6a95a1a9/beacon_attestation_12/ssz_snappy", format: "/eth2/%x/beacon_attestation_%d", ok: true},
+		{topic: "/eth2/6a95a1a9/sync_committee_3/ssz_snappy", format: "/eth2/%x/sync_committee_%d", ok: true},
+		{topic: "/eth2/6a95a1a9/sync_committee_contribution_and_proof/ssz_snappy", format: "/eth2/%x/sync_committee_contribution_and_proof", ok: true},
+		{topic: "/eth2/6a95a1a9/blob_sidecar_5/ssz_snappy", format: "/eth2/%x/blob_sidecar_%d", ok: true},
+		{topic: "/eth2/6a95a1a9/beacon_attestation_x/ssz_snappy"},
+		{topic: "/eth2/6a95a1a9/unknown/ssz_snappy"},
+		{topic: "not a topic"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.topic, func(t *testing.T) {
+			got, ok := s.matchReplayTopic(topics, tt.topic)
+			require.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.format, got.format)
+		})
+	}
+}
+
+func TestReplayGossip_NotPrepared(t *testing.T) {
+	s := &Service{cfg: &config{p2p: p2ptest.NewTestP2P(t)}}
+	res, err := s.ReplayGossip(context.Background(), "/eth2/6a95a1a9/beacon_block/ssz_snappy", "", nil, time.Now())
+	assert.ErrorContains(t, "not prepared for replay", err)
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+}
+
+type replayGenesisRecorder struct {
+	genesis           time.Time
+	forkChoiceGenesis uint64
+}
+
+func (r *replayGenesisRecorder) SetGenesisTime(t time.Time) {
+	r.genesis = t
+}
+
+func (r *replayGenesisRecorder) SetForkChoiceGenesisTime(t uint64) {
+	r.forkChoiceGenesis = t
+}
+
+func TestReplayGossip_ShiftsClocks(t *testing.T) {
+	genesis := time.Now().Add(-time.Hour).Truncate(time.Second)
+	clock := startup.NewClock(genesis, [32]byte{'a'})
+	chain := &replayGenesisRecorder{}
+	s := &Service{
+		cfg:         &config{p2p: p2ptest.NewTestP2P(t), clock: clock},
+		replayClock: clock,
+		replayChain: chain,
+	}
+	arrival := time.Now().Add(-10 * time.Minute)
+	// The message is ignored as its fork digest is not the one of the clock, once the clocks are shifted.
+	_, err := s.ReplayGossip(context.Background(), "/eth2/00000000/beacon_block/ssz_snappy", "", nil, arrival)
+	require.ErrorContains(t, "outdated fork digest", err)
+
+	shifted := s.clock().GenesisTime()
+	assert.Equal(t, true, shifted.Sub(genesis) >= 10*time.Minute && shifted.Sub(genesis) < 11*time.Minute)
+	assert.Equal(t, shifted, chain.genesis)
+	assert.Equal(t, uint64(shifted.Unix()), chain.forkChoiceGenesis)
+	// The clocks are shifted from the original clock rather than from the previous shift.
+	_, err = s.ReplayGossip(context.Background(), "/eth2/00000000/beacon_block/ssz_snappy", "", nil, time.Now())
+	require.ErrorContains(t, "outdated fork digest", err)
+	assert.Equal(t, true, s.clock().GenesisTime().Sub(genesis) < time.Minute)
+}
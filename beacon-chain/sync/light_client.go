@@ -0,0 +1,247 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientMessage is a light client object that is both gossiped and served over req/resp.
+type lightClientMessage interface {
+	proto.Message
+	ssz.Marshaler
+}
+
+// lightClientUpdates keeps the latest light client updates computed by the node, along with their form for the fork
+// of their attested header. They are served over req/resp, published over gossip and are the only light client
+// updates forwarded from peers.
+type lightClientUpdates struct {
+	sync.RWMutex
+	finality      *ethpbv2.LightClientFinalityUpdate
+	finalityMsg   lightClientMessage
+	optimistic    *ethpbv2.LightClientOptimisticUpdate
+	optimisticMsg lightClientMessage
+	// The highest finalized and attested header slots of the updates forwarded over gossip.
+	forwardedFinalizedSlot primitives.Slot
+	forwardedAttestedSlot  primitives.Slot
+}
+
+func (s *Service) latestLightClientFinalityUpdate() (*ethpbv2.LightClientFinalityUpdate, lightClientMessage) {
+	s.lcUpdates.RLock()
+	defer s.lcUpdates.RUnlock()
+	return s.lcUpdates.finality, s.lcUpdates.finalityMsg
+}
+
+func (s *Service) latestLightClientOptimisticUpdate() (*ethpbv2.LightClientOptimisticUpdate, lightClientMessage) {
+	s.lcUpdates.RLock()
+	defer s.lcUpdates.RUnlock()
+	return s.lcUpdates.optimistic, s.lcUpdates.optimisticMsg
+}
+
+// lightClientUpdatesRoutine keeps the light client updates sent by the blockchain service as the latest
+// ones and publishes them.
+func (s *Service) lightClientUpdatesRoutine() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-stateChannel:
+			switch ev.Type {
+			case statefeed.LightClientFinalityUpdate:
+				data, ok := ev.Data.(*ethpbv2.LightClientFinalityUpdateWithVersion)
+				if !ok || data.Data == nil {
+					continue
+				}
+				msg, err := s.lightClientMessageForFork(data.Data.AttestedHeader.Slot, data.Data)
+				if err != nil {
+					log.WithError(err).Error("Could not create light client finality update")
+					continue
+				}
+				s.lcUpdates.Lock()
+				s.lcUpdates.finality = data.Data
+				s.lcUpdates.finalityMsg = msg
+				s.lcUpdates.Unlock()
+				go s.publishLightClientUpdate(data.Data.SignatureSlot, msg)
+			case statefeed.LightClientOptimisticUpdate:
+				data, ok := ev.Data.(*ethpbv2.LightClientOptimisticUpdateWithVersion)
+				if !ok || data.Data == nil {
+					continue
+				}
+				msg, err := s.lightClientMessageForFork(data.Data.AttestedHeader.Slot, data.Data)
+				if err != nil {
+					log.WithError(err).Error("Could not create light client optimistic update")
+					continue
+				}
+				s.lcUpdates.Lock()
+				s.lcUpdates.optimistic = data.Data
+				s.lcUpdates.optimisticMsg = msg
+				s.lcUpdates.Unlock()
+				go s.publishLightClientUpdate(data.Data.SignatureSlot, msg)
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		}
+	}
+}
+
+// lightClientMessageForFork returns a light client update in the form of the fork of its attested header, see
+// lightClientObjectForFork.
+func (s *Service) lightClientMessageForFork(slot primitives.Slot, update ssz.Marshaler) (lightClientMessage, error) {
+	obj, err := s.lightClientObjectForFork(s.ctx, slot, update)
+	if err != nil {
+		return nil, err
+	}
+	msg, ok := obj.(lightClientMessage)
+	if !ok {
+		return nil, errors.Errorf("light client object %T is not a protobuf message", obj)
+	}
+	return msg, nil
+}
+
+// publishLightClientUpdate broadcasts a light client update once the block of its signature slot was
+// given enough time to propagate, as peers ignore updates received before that.
+func (s *Service) publishLightClientUpdate(signatureSlot primitives.Slot, update proto.Message) {
+	if s.cfg.initialSync.Syncing() {
+		return
+	}
+	select {
+	case <-time.After(prysmTime.Until(lightClientUpdateDueTime(s.clock().GenesisTime(), signatureSlot))):
+	case <-s.ctx.Done():
+		return
+	}
+	if err := s.cfg.p2p.Broadcast(s.ctx, update); err != nil {
+		log.WithError(err).Debug("Could not broadcast light client update")
+	}
+}
+
+// lightClientUpdateDueTime returns the time after which a light client update signed at the slot may be
+// published, which is a third of the way through the slot.
+func lightClientUpdateDueTime(genesisTime time.Time, signatureSlot primitives.Slot) time.Time {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	return slots.StartTime(uint64(genesisTime.Unix()), signatureSlot).Add(slotDuration / time.Duration(params.BeaconConfig().IntervalsPerSlot))
+}
+
+// validateLightClientFinalityUpdate forwards the finality updates that match the one computed by the node.
+// spec: https://github.com/ethereum/consensus-specs/blob/d70dcd9926a4bbe987f1b4e65c3e05bd029fcfb8/specs/altair/light-client/p2p-interface.md#light_client_finality_update
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	switch m.(type) {
+	case *ethpbv2.LightClientFinalityUpdate, *ethpbv2.LightClientFinalityUpdateCapella, *ethpbv2.LightClientFinalityUpdateDeneb:
+	default:
+		return pubsub.ValidationReject, errWrongMessage
+	}
+
+	s.lcUpdates.Lock()
+	defer s.lcUpdates.Unlock()
+	// [IGNORE] The update matches the locally computed one exactly. The checks below then hold for the update as they
+	// do for the local one.
+	update := s.lcUpdates.finality
+	if update == nil || !proto.Equal(m.(proto.Message), s.lcUpdates.finalityMsg) {
+		return pubsub.ValidationIgnore, nil
+	}
+	// [IGNORE] The update is received after the block at signature_slot was given enough time to propagate.
+	if !lightClientUpdateIsDue(s.clock().GenesisTime(), update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	// [IGNORE] The finalized header is newer than that of all previously forwarded finality updates.
+	if update.FinalizedHeader.Slot <= s.lcUpdates.forwardedFinalizedSlot {
+		return pubsub.ValidationIgnore, nil
+	}
+	s.lcUpdates.forwardedFinalizedSlot = update.FinalizedHeader.Slot
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate forwards the optimistic updates that match the one computed by the node.
+// spec: https://github.com/ethereum/consensus-specs/blob/d70dcd9926a4bbe987f1b4e65c3e05bd029fcfb8/specs/altair/light-client/p2p-interface.md#light_client_optimistic_update
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	switch m.(type) {
+	case *ethpbv2.LightClientOptimisticUpdate, *ethpbv2.LightClientOptimisticUpdateCapella, *ethpbv2.LightClientOptimisticUpdateDeneb:
+	default:
+		return pubsub.ValidationReject, errWrongMessage
+	}
+
+	s.lcUpdates.Lock()
+	defer s.lcUpdates.Unlock()
+	// [IGNORE] The update matches the locally computed one exactly. The checks below then hold for the update as they
+	// do for the local one.
+	update := s.lcUpdates.optimistic
+	if update == nil || !proto.Equal(m.(proto.Message), s.lcUpdates.optimisticMsg) {
+		return pubsub.ValidationIgnore, nil
+	}
+	// [IGNORE] The update is received after the block at signature_slot was given enough time to propagate.
+	if !lightClientUpdateIsDue(s.clock().GenesisTime(), update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	// [IGNORE] The attested header is newer than that of all previously forwarded optimistic updates.
+	if update.AttestedHeader.Slot <= s.lcUpdates.forwardedAttestedSlot {
+		return pubsub.ValidationIgnore, nil
+	}
+	s.lcUpdates.forwardedAttestedSlot = update.AttestedHeader.Slot
+	return pubsub.ValidationAccept, nil
+}
+
+// lightClientUpdateIsDue reports whether a light client update signed at the slot may be forwarded,
+// allowing for the clock disparity between peers.
+func lightClientUpdateIsDue(genesisTime time.Time, signatureSlot primitives.Slot) bool {
+	due := lightClientUpdateDueTime(genesisTime, signatureSlot).Add(-params.BeaconConfig().MaximumGossipClockDisparityDuration())
+	return !prysmTime.Now().Before(due)
+}
+
+// lightClientUpdateSubscriber is a no-op, the updates forwarded over gossip are the ones already computed
+// by the node.
+func (*Service) lightClientUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	switch msg.(type) {
+	case *ethpbv2.LightClientFinalityUpdate, *ethpbv2.LightClientFinalityUpdateCapella, *ethpbv2.LightClientFinalityUpdateDeneb,
+		*ethpbv2.LightClientOptimisticUpdate, *ethpbv2.LightClientOptimisticUpdateCapella, *ethpbv2.LightClientOptimisticUpdateDeneb:
+		return nil
+	default:
+		return errors.Errorf("incorrect type of message received, wanted a light client update but got %T", msg)
+	}
+}
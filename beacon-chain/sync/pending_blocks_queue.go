@@ -74,7 +74,7 @@ func (s *Service) processPendingBlocks(ctx context.Context) error {
 	// Iterate through sorted slots.
 	for _, slot := range sortedSlots {
 		// Skip processing if slot is in the future.
-		if slot > s.cfg.clock.CurrentSlot() {
+		if slot > s.clock().CurrentSlot() {
 			continue
 		}
 
@@ -309,7 +309,7 @@ func (s *Service) sendBatchRootRequest(ctx context.Context, roots [][32]byte, ra
 	pid := bestPeers[randGen.Int()%len(bestPeers)]
 	for i := 0; i < numOfTries; i++ {
 		req := p2ptypes.BeaconBlockByRootsReq(roots)
-		currentEpoch := slots.ToEpoch(s.cfg.clock.CurrentSlot())
+		currentEpoch := slots.ToEpoch(s.clock().CurrentSlot())
 		maxReqBlock := params.MaxRequestBlock(currentEpoch)
 		if uint64(len(roots)) > maxReqBlock {
 			req = roots[:maxReqBlock]
@@ -31,7 +31,7 @@ func (s *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 		return errors.New("message is not type *pb.BeaconBlockByRangeRequest")
 	}
 	log.WithField("startSlot", m.StartSlot).WithField("count", m.Count).Debug("Serving block by range request")
-	rp, err := validateRangeRequest(m, s.cfg.clock.CurrentSlot())
+	rp, err := validateRangeRequest(m, s.clock().CurrentSlot())
 	if err != nil {
 		s.writeErrorResponseToStream(responseCodeInvalidRequest, err.Error(), stream)
 		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
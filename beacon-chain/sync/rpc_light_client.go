@@ -0,0 +1,433 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+
+	libp2pcore "github.com/libp2p/go-libp2p/core"
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// errLightClientUpdatesCount is returned for a light client updates by range request without any period.
+var errLightClientUpdatesCount = errors.New("light client updates by range request count must be greater than 0")
+
+// lightClientBootstrapRPCHandler handles the /eth2/beacon_chain/req/light_client_bootstrap/1/ RPC request.
+// spec: https://github.com/ethereum/consensus-specs/blob/d70dcd9926a4bbe987f1b4e65c3e05bd029fcfb8/specs/altair/light-client/p2p-interface.md#getlightclientbootstrap
+func (s *Service) lightClientBootstrapRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, span := trace.StartSpan(ctx, "sync.lightClientBootstrapRPCHandler")
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", p2p.LightClientBootstrapName[1:]) // slice the leading slash off the name var
+
+	req, ok := msg.(*types.LightClientBootstrapReq)
+	if !ok {
+		return errors.New("message is not type LightClientBootstrapReq")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	bootstrap, err := s.lightClientBootstrap(ctx, [32]byte(*req))
+	if errors.Is(err, types.ErrResourceUnavailable) {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return err
+	}
+	if err != nil {
+		log.WithError(err).Debug("Could not create light client bootstrap")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	if err := s.writeLightClientChunk(stream, bootstrap.slot, bootstrap.msg); err != nil {
+		log.WithError(err).Debug("Could not send a chunked response")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientBootstrap is a light client bootstrap in the form of the fork of its header.
+type lightClientBootstrap struct {
+	slot primitives.Slot
+	msg  ssz.Marshaler
+}
+
+// lightClientBootstrap returns the light client bootstrap of a block root. Bootstraps are only served for the
+// epoch boundary blocks of finalized epochs, and kept in a cache as light clients request the same few roots.
+// It returns ErrResourceUnavailable for any other block root.
+func (s *Service) lightClientBootstrap(ctx context.Context, blockRoot [32]byte) (*lightClientBootstrap, error) {
+	if cached, ok := s.lcBootstrapCache.Get(blockRoot); ok {
+		return cached.(*lightClientBootstrap), nil
+	}
+	blk, err := s.cfg.beaconDB.Block(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block")
+	}
+	if blk == nil || blk.IsNil() || !s.isFinalizedEpochBoundary(ctx, blockRoot, blk.Block().Slot()) {
+		return nil, types.ErrResourceUnavailable
+	}
+	st, err := s.cfg.stateGen.StateByRoot(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get state")
+	}
+	bootstrap, err := blockchain.NewLightClientBootstrapFromBeaconState(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := s.lightClientObjectForFork(ctx, bootstrap.Header.Slot, bootstrap)
+	if err != nil {
+		return nil, err
+	}
+	b := &lightClientBootstrap{slot: bootstrap.Header.Slot, msg: msg}
+	s.lcBootstrapCache.Add(blockRoot, b)
+	return b, nil
+}
+
+// isFinalizedEpochBoundary reports whether a block is the epoch boundary block of a finalized epoch: the finalized
+// block at the first slot of its epoch, or the block of the finalized checkpoint when that slot was empty.
+func (s *Service) isFinalizedEpochBoundary(ctx context.Context, blockRoot [32]byte, slot primitives.Slot) bool {
+	if cp := s.cfg.chain.FinalizedCheckpt(); cp != nil && bytes.Equal(cp.Root, blockRoot[:]) {
+		return true
+	}
+	return slots.IsEpochStart(slot) && s.cfg.beaconDB.IsFinalizedBlock(ctx, blockRoot)
+}
+
+// lightClientUpdatesByRangeRPCHandler handles the /eth2/beacon_chain/req/light_client_updates_by_range/1/ RPC request.
+// It responds with the consecutive best updates saved for the requested sync committee periods.
+// spec: https://github.com/ethereum/consensus-specs/blob/d70dcd9926a4bbe987f1b4e65c3e05bd029fcfb8/specs/altair/light-client/p2p-interface.md#lightclientupdatesbyrange
+func (s *Service) lightClientUpdatesByRangeRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, span := trace.StartSpan(ctx, "sync.lightClientUpdatesByRangeRPCHandler")
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", p2p.LightClientUpdatesByRangeName[1:]) // slice the leading slash off the name var
+
+	req, ok := msg.(*types.LightClientUpdatesByRangeReq)
+	if !ok {
+		return errors.New("message is not type LightClientUpdatesByRangeReq")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	if req.Count == 0 {
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, errLightClientUpdatesCount.Error(), stream)
+		return errLightClientUpdatesCount
+	}
+	count := min(req.Count, params.BeaconConfig().MaxRequestLightClientUpdates)
+	endPeriod := req.StartPeriod + count - 1
+	if endPeriod < req.StartPeriod {
+		// The request overflows, serve the periods up to the last one.
+		endPeriod = ^uint64(0)
+	}
+	s.rateLimiter.add(stream, int64(count))
+
+	updates, err := s.cfg.beaconDB.LightClientUpdates(ctx, req.StartPeriod, endPeriod)
+	if err != nil {
+		log.WithError(err).Debug("Could not get light client updates")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	for period := req.StartPeriod; period <= endPeriod; period++ {
+		update, ok := updates[period]
+		if !ok {
+			// The updates of the response must be consecutive.
+			break
+		}
+		msg, err := s.lightClientObjectForFork(ctx, update.AttestedHeader.Slot, update)
+		if err != nil {
+			log.WithError(err).Debug("Could not create light client update")
+			s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		if err := s.writeLightClientChunk(stream, update.AttestedHeader.Slot, msg); err != nil {
+			log.WithError(err).Debug("Could not send a chunked response")
+			s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		if period == endPeriod {
+			break
+		}
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientFinalityUpdateRPCHandler handles the /eth2/beacon_chain/req/light_client_finality_update/1/ RPC request
+// with the latest finality update computed by the node.
+// spec: https://github.com/ethereum/consensus-specs/blob/d70dcd9926a4bbe987f1b4e65c3e05bd029fcfb8/specs/altair/light-client/p2p-interface.md#getlightclientfinalityupdate
+func (s *Service) lightClientFinalityUpdateRPCHandler(ctx context.Context, _ interface{}, stream libp2pcore.Stream) error {
+	_, span := trace.StartSpan(ctx, "sync.lightClientFinalityUpdateRPCHandler")
+	defer span.End()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", p2p.LightClientFinalityUpdateName[1:]) // slice the leading slash off the name var
+
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	update, msg := s.latestLightClientFinalityUpdate()
+	if update == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return types.ErrResourceUnavailable
+	}
+	if err := s.writeLightClientChunk(stream, update.AttestedHeader.Slot, msg); err != nil {
+		log.WithError(err).Debug("Could not send a chunked response")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientOptimisticUpdateRPCHandler handles the /eth2/beacon_chain/req/light_client_optimistic_update/1/ RPC request
+// with the latest optimistic update computed by the node.
+// spec: https://github.com/ethereum/consensus-specs/blob/d70dcd9926a4bbe987f1b4e65c3e05bd029fcfb8/specs/altair/light-client/p2p-interface.md#getlightclientoptimisticupdate
+func (s *Service) lightClientOptimisticUpdateRPCHandler(ctx context.Context, _ interface{}, stream libp2pcore.Stream) error {
+	_, span := trace.StartSpan(ctx, "sync.lightClientOptimisticUpdateRPCHandler")
+	defer span.End()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", p2p.LightClientOptimisticUpdateName[1:]) // slice the leading slash off the name var
+
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	update, msg := s.latestLightClientOptimisticUpdate()
+	if update == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return types.ErrResourceUnavailable
+	}
+	if err := s.writeLightClientChunk(stream, update.AttestedHeader.Slot, msg); err != nil {
+		log.WithError(err).Debug("Could not send a chunked response")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// writeLightClientChunk writes a light client object as a chunked response, with the fork digest of the
+// attested header slot as context bytes. The object must be in the form of that fork, see lightClientObjectForFork.
+// response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
+func (s *Service) writeLightClientChunk(stream libp2pcore.Stream, slot primitives.Slot, msg ssz.Marshaler) error {
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	valRoot := s.clock().GenesisValidatorsRoot()
+	ctxBytes, err := forks.ForkDigestFromEpoch(slots.ToEpoch(slot), valRoot[:])
+	if err != nil {
+		return err
+	}
+	if err := writeContextToStream(ctxBytes[:], stream); err != nil {
+		return err
+	}
+	_, err = s.cfg.p2p.Encoding().EncodeWithMaxLength(stream, msg)
+	return err
+}
+
+// lightClientObjectForFork returns a light client object in the form of the fork of the slot of its attested
+// header, or of its header for a bootstrap. The node keeps light client objects with beacon block headers, which
+// are the light client headers of Altair and Bellatrix. From Capella on, light client headers also carry the
+// execution payload header of their block.
+func (s *Service) lightClientObjectForFork(ctx context.Context, slot primitives.Slot, obj ssz.Marshaler) (ssz.Marshaler, error) {
+	epoch := slots.ToEpoch(slot)
+	cfg := params.BeaconConfig()
+	switch {
+	case epoch < cfg.CapellaForkEpoch:
+		return obj, nil
+	case epoch < cfg.DenebForkEpoch:
+		return s.lightClientObjectCapella(ctx, obj)
+	case epoch < cfg.ElectraForkEpoch:
+		return s.lightClientObjectDeneb(ctx, obj)
+	default:
+		return nil, errors.Errorf("light client objects are not supported at epoch %d", epoch)
+	}
+}
+
+func (s *Service) lightClientObjectCapella(ctx context.Context, obj ssz.Marshaler) (ssz.Marshaler, error) {
+	header := func(h *ethpbv1.BeaconBlockHeader) (*ethpbv2.LightClientHeaderCapella, error) {
+		denebHeader, err := s.lightClientHeader(ctx, h)
+		if err != nil {
+			return nil, err
+		}
+		return blockchain.LightClientHeaderCapellaFromDeneb(denebHeader), nil
+	}
+	switch o := obj.(type) {
+	case *ethpbv2.LightClientBootstrap:
+		h, err := header(o.Header)
+		if err != nil {
+			return nil, err
+		}
+		return &ethpbv2.LightClientBootstrapCapella{
+			Header:                     h,
+			CurrentSyncCommittee:       o.CurrentSyncCommittee,
+			CurrentSyncCommitteeBranch: o.CurrentSyncCommitteeBranch,
+		}, nil
+	case *ethpbv2.LightClientUpdate:
+		attested, err := header(o.AttestedHeader)
+		if err != nil {
+			return nil, err
+		}
+		finalized, err := header(o.FinalizedHeader)
+		if err != nil {
+			return nil, err
+		}
+		return &ethpbv2.LightClientUpdateCapella{
+			AttestedHeader:          attested,
+			NextSyncCommittee:       o.NextSyncCommittee,
+			NextSyncCommitteeBranch: o.NextSyncCommitteeBranch,
+			FinalizedHeader:         finalized,
+			FinalityBranch:          o.FinalityBranch,
+			SyncAggregate:           o.SyncAggregate,
+			SignatureSlot:           o.SignatureSlot,
+		}, nil
+	case *ethpbv2.LightClientFinalityUpdate:
+		attested, err := header(o.AttestedHeader)
+		if err != nil {
+			return nil, err
+		}
+		finalized, err := header(o.FinalizedHeader)
+		if err != nil {
+			return nil, err
+		}
+		return &ethpbv2.LightClientFinalityUpdateCapella{
+			AttestedHeader:  attested,
+			FinalizedHeader: finalized,
+			FinalityBranch:  o.FinalityBranch,
+			SyncAggregate:   o.SyncAggregate,
+			SignatureSlot:   o.SignatureSlot,
+		}, nil
+	case *ethpbv2.LightClientOptimisticUpdate:
+		attested, err := header(o.AttestedHeader)
+		if err != nil {
+			return nil, err
+		}
+		return &ethpbv2.LightClientOptimisticUpdateCapella{
+			AttestedHeader: attested,
+			SyncAggregate:  o.SyncAggregate,
+			SignatureSlot:  o.SignatureSlot,
+		}, nil
+	default:
+		return nil, errors.Errorf("unexpected light client object %T", obj)
+	}
+}
+
+func (s *Service) lightClientObjectDeneb(ctx context.Context, obj ssz.Marshaler) (ssz.Marshaler, error) {
+	header := func(h *ethpbv1.BeaconBlockHeader) (*ethpbv2.LightClientHeaderDeneb, error) {
+		return s.lightClientHeader(ctx, h)
+	}
+	switch o := obj.(type) {
+	case *ethpbv2.LightClientBootstrap:
+		h, err := header(o.Header)
+		if err != nil {
+			return nil, err
+		}
+		return &ethpbv2.LightClientBootstrapDeneb{
+			Header:                     h,
+			CurrentSyncCommittee:       o.CurrentSyncCommittee,
+			CurrentSyncCommitteeBranch: o.CurrentSyncCommitteeBranch,
+		}, nil
+	case *ethpbv2.LightClientUpdate:
+		attested, err := header(o.AttestedHeader)
+		if err != nil {
+			return nil, err
+		}
+		finalized, err := header(o.FinalizedHeader)
+		if err != nil {
+			return nil, err
+		}
+		return &ethpbv2.LightClientUpdateDeneb{
+			AttestedHeader:          attested,
+			NextSyncCommittee:       o.NextSyncCommittee,
+			NextSyncCommitteeBranch: o.NextSyncCommitteeBranch,
+			FinalizedHeader:         finalized,
+			FinalityBranch:          o.FinalityBranch,
+			SyncAggregate:           o.SyncAggregate,
+			SignatureSlot:           o.SignatureSlot,
+		}, nil
+	case *ethpbv2.LightClientFinalityUpdate:
+		attested, err := header(o.AttestedHeader)
+		if err != nil {
+			return nil, err
+		}
+		finalized, err := header(o.FinalizedHeader)
+		if err != nil {
+			return nil, err
+		}
+		return &ethpbv2.LightClientFinalityUpdateDeneb{
+			AttestedHeader:  attested,
+			FinalizedHeader: finalized,
+			FinalityBranch:  o.FinalityBranch,
+			SyncAggregate:   o.SyncAggregate,
+			SignatureSlot:   o.SignatureSlot,
+		}, nil
+	case *ethpbv2.LightClientOptimisticUpdate:
+		attested, err := header(o.AttestedHeader)
+		if err != nil {
+			return nil, err
+		}
+		return &ethpbv2.LightClientOptimisticUpdateDeneb{
+			AttestedHeader: attested,
+			SyncAggregate:  o.SyncAggregate,
+			SignatureSlot:  o.SignatureSlot,
+		}, nil
+	default:
+		return nil, errors.Errorf("unexpected light client object %T", obj)
+	}
+}
+
+// lightClientHeader returns the light client header of a beacon block header in its Deneb form, which holds the
+// light client headers of all forks from Capella on. The headers of saved light client updates are read from the
+// database, as their blocks may be pruned. Other headers are built from their block, and the empty finalized header
+// of an update without finality gets an empty execution payload header.
+func (s *Service) lightClientHeader(ctx context.Context, header *ethpbv1.BeaconBlockHeader) (*ethpbv2.LightClientHeaderDeneb, error) {
+	if bytes.Equal(header.BodyRoot, params.BeaconConfig().ZeroHash[:]) {
+		return blockchain.NewLightClientHeaderDeneb(header, nil)
+	}
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get header root")
+	}
+	saved, err := s.cfg.beaconDB.LightClientHeader(ctx, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get light client header")
+	}
+	if saved != nil {
+		return saved, nil
+	}
+	blk, err := s.cfg.beaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block")
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, errors.Errorf("could not find block %#x of light client header", root)
+	}
+	return blockchain.NewLightClientHeaderDeneb(header, blk)
+}
@@ -0,0 +1,476 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	mockChain "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	db "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func testLightClientHeader(slot primitives.Slot) *ethpbv1.BeaconBlockHeader {
+	return &ethpbv1.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  make([]byte, fieldparams.RootLength),
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}
+}
+
+func testLightClientSyncAggregate() *ethpbv1.SyncAggregate {
+	return &ethpbv1.SyncAggregate{
+		SyncCommitteeBits:      make([]byte, fieldparams.SyncAggregateSyncCommitteeBytesLength),
+		SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+	}
+}
+
+func testLightClientUpdate(signatureSlot primitives.Slot) *ethpbv2.LightClientUpdate {
+	pubkeys := make([][]byte, fieldparams.SyncCommitteeLength)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	nextSyncCommitteeBranch := make([][]byte, fieldparams.NextSyncCommitteeBranchDepth)
+	for i := range nextSyncCommitteeBranch {
+		nextSyncCommitteeBranch[i] = make([]byte, fieldparams.RootLength)
+	}
+	finalityBranch := make([][]byte, 6)
+	for i := range finalityBranch {
+		finalityBranch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return &ethpbv2.LightClientUpdate{
+		AttestedHeader: testLightClientHeader(signatureSlot - 1),
+		NextSyncCommittee: &ethpbv2.SyncCommittee{
+			Pubkeys:         pubkeys,
+			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+		},
+		NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+		FinalizedHeader:         testLightClientHeader(0),
+		FinalityBranch:          finalityBranch,
+		SyncAggregate:           testLightClientSyncAggregate(),
+		SignatureSlot:           signatureSlot,
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler_ServesConsecutiveUpdates(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	d := db.SetupDB(t)
+	ctx := context.Background()
+	slotsPerPeriod := primitives.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	// Periods 1 and 2 are consecutive, period 4 is served only after period 3.
+	for _, period := range []uint64{1, 2, 4} {
+		require.NoError(t, d.SaveLightClientUpdate(ctx, period, testLightClientUpdate(primitives.Slot(period)*slotsPerPeriod+1)))
+	}
+
+	r := &Service{
+		cfg: &config{
+			beaconDB: d,
+			p2p:      p1,
+			clock:    startup.NewClock(time.Now(), [32]byte{}),
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+	pcl := protocol.ID(p2p.RPCLightClientUpdatesByRangeTopicV1 + p1.Encoding().ProtocolSuffix())
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(1, 10, time.Second, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for _, period := range []uint64{1, 2} {
+			expectSuccess(t, stream)
+			_, err := readContextFromStream(stream)
+			require.NoError(t, err)
+			update := new(ethpbv2.LightClientUpdate)
+			require.NoError(t, p1.Encoding().DecodeWithMaxLength(stream, update))
+			assert.Equal(t, primitives.Slot(period)*slotsPerPeriod, update.AttestedHeader.Slot)
+		}
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := &p2ptypes.LightClientUpdatesByRangeReq{StartPeriod: 1, Count: 4}
+	require.NoError(t, r.lightClientUpdatesByRangeRPCHandler(ctx, req, stream))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler_ZeroCount(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	r := &Service{
+		cfg: &config{
+			beaconDB: db.SetupDB(t),
+			p2p:      p1,
+			clock:    startup.NewClock(time.Now(), [32]byte{}),
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+	pcl := protocol.ID(p2p.RPCLightClientUpdatesByRangeTopicV1 + p1.Encoding().ProtocolSuffix())
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeInvalidRequest, errLightClientUpdatesCount.Error(), stream)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := &p2ptypes.LightClientUpdatesByRangeReq{StartPeriod: 1, Count: 0}
+	require.ErrorIs(t, r.lightClientUpdatesByRangeRPCHandler(context.Background(), req, stream), errLightClientUpdatesCount)
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientOptimisticUpdateRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	r := &Service{
+		cfg: &config{
+			p2p:   p1,
+			clock: startup.NewClock(time.Now(), [32]byte{}),
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+	pcl := protocol.ID(p2p.RPCLightClientOptimisticUpdateTopicV1 + p1.Encoding().ProtocolSuffix())
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(1, 10, time.Second, false)
+
+	// No optimistic update was computed yet.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.ErrorIs(t, r.lightClientOptimisticUpdateRPCHandler(context.Background(), nil, stream), p2ptypes.ErrResourceUnavailable)
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	want := &ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: testLightClientHeader(10),
+		SyncAggregate:  testLightClientSyncAggregate(),
+		SignatureSlot:  11,
+	}
+	r.lcUpdates.optimistic = want
+	r.lcUpdates.optimisticMsg = want
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		_, err := readContextFromStream(stream)
+		require.NoError(t, err)
+		got := new(ethpbv2.LightClientOptimisticUpdate)
+		require.NoError(t, p1.Encoding().DecodeWithMaxLength(stream, got))
+		assert.DeepEqual(t, want, got)
+	})
+	stream, err = p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientOptimisticUpdateRPCHandler(context.Background(), nil, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientBootstrapRPCHandler_UnfinalizedRoot(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	d := db.SetupDB(t)
+	ctx := context.Background()
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = params.BeaconConfig().SlotsPerEpoch
+	util.SaveBlock(t, ctx, d, blk)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	r := &Service{
+		cfg: &config{
+			beaconDB: d,
+			p2p:      p1,
+			chain:    &mockChain.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)}},
+			clock:    startup.NewClock(time.Now(), [32]byte{}),
+		},
+		rateLimiter:      newRateLimiter(p1),
+		lcBootstrapCache: lruwrpr.New(10),
+	}
+	pcl := protocol.ID(p2p.RPCLightClientBootstrapTopicV1 + p1.Encoding().ProtocolSuffix())
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(2, 10, time.Second, false)
+
+	// Neither an unknown root nor an epoch boundary block that is not finalized is served.
+	for _, req := range []p2ptypes.LightClientBootstrapReq{{'x'}, p2ptypes.LightClientBootstrapReq(root)} {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+			defer wg.Done()
+			expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+		})
+		stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+		require.NoError(t, err)
+		require.ErrorIs(t, r.lightClientBootstrapRPCHandler(ctx, &req, stream), p2ptypes.ErrResourceUnavailable)
+		if util.WaitTimeout(&wg, 1*time.Second) {
+			t.Fatal("Did not receive stream within 1 sec")
+		}
+	}
+}
+
+func TestLightClientOptimisticUpdateRPCHandler_Capella(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	d := db.SetupDB(t)
+	ctx := context.Background()
+	blk := util.NewBeaconBlockCapella()
+	blk.Block.Slot = 10
+	blk.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte("blockhash"), fieldparams.RootLength)
+	util.SaveBlock(t, ctx, d, blk)
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+
+	r := &Service{
+		ctx: ctx,
+		cfg: &config{
+			beaconDB: d,
+			p2p:      p1,
+			clock:    startup.NewClock(time.Now(), [32]byte{}),
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+	r.lcUpdates.optimistic = &ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: &ethpbv1.BeaconBlockHeader{
+			Slot:       blk.Block.Slot,
+			ParentRoot: blk.Block.ParentRoot,
+			StateRoot:  blk.Block.StateRoot,
+			BodyRoot:   bodyRoot[:],
+		},
+		SyncAggregate: testLightClientSyncAggregate(),
+		SignatureSlot: 11,
+	}
+	r.lcUpdates.optimisticMsg, err = r.lightClientMessageForFork(r.lcUpdates.optimistic.AttestedHeader.Slot, r.lcUpdates.optimistic)
+	require.NoError(t, err)
+	pcl := protocol.ID(p2p.RPCLightClientOptimisticUpdateTopicV1 + p1.Encoding().ProtocolSuffix())
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(1, 10, time.Second, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		_, err := readContextFromStream(stream)
+		require.NoError(t, err)
+		got := new(ethpbv2.LightClientOptimisticUpdateCapella)
+		require.NoError(t, p1.Encoding().DecodeWithMaxLength(stream, got))
+		assert.Equal(t, blk.Block.Slot, got.AttestedHeader.Beacon.Slot)
+		assert.DeepEqual(t, blk.Block.Body.ExecutionPayload.BlockHash, got.AttestedHeader.Execution.BlockHash)
+		assert.Equal(t, 4, len(got.AttestedHeader.ExecutionBranch))
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientOptimisticUpdateRPCHandler(ctx, nil, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestValidateLightClientOptimisticUpdate(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	// Genesis is far enough in the past for the updates of the test to be due.
+	genesis := time.Now().Add(-time.Duration(100*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	r := &Service{
+		cfg: &config{
+			p2p:   p,
+			clock: startup.NewClock(genesis, [32]byte{}),
+		},
+	}
+	local := &ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: testLightClientHeader(10),
+		SyncAggregate:  testLightClientSyncAggregate(),
+		SignatureSlot:  11,
+	}
+	r.lcUpdates.optimistic = local
+	r.lcUpdates.optimisticMsg = local
+
+	digest, err := r.currentForkDigest()
+	require.NoError(t, err)
+	topic := fmt.Sprintf(p2p.LightClientOptimisticUpdateTopicFormat, digest) + p.Encoding().ProtocolSuffix()
+	pubsubMsg := func(update *ethpbv2.LightClientOptimisticUpdate) *pubsub.Message {
+		b, err := update.MarshalSSZ()
+		require.NoError(t, err)
+		return &pubsub.Message{
+			Message: &pubsubpb.Message{
+				Data:  snappy.Encode(nil, b),
+				Topic: &topic,
+			},
+		}
+	}
+
+	different := &ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: testLightClientHeader(10),
+		SyncAggregate:  testLightClientSyncAggregate(),
+		SignatureSlot:  12,
+	}
+	res, err := r.validateLightClientOptimisticUpdate(context.Background(), "foobar", pubsubMsg(different))
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, res, "update not matching the local one should be ignored")
+
+	res, err = r.validateLightClientOptimisticUpdate(context.Background(), "foobar", pubsubMsg(local))
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, res)
+
+	res, err = r.validateLightClientOptimisticUpdate(context.Background(), "foobar", pubsubMsg(local))
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, res, "already forwarded update should be ignored")
+
+	future := &ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: testLightClientHeader(1000),
+		SyncAggregate:  testLightClientSyncAggregate(),
+		SignatureSlot:  1001,
+	}
+	r.lcUpdates.optimistic = future
+	r.lcUpdates.optimisticMsg = future
+	res, err = r.validateLightClientOptimisticUpdate(context.Background(), "foobar", pubsubMsg(future))
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, res, "update received before its signature slot should be ignored")
+}
+
+func TestValidateLightClientOptimisticUpdate_Capella(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+
+	p := p2ptest.NewTestP2P(t)
+	d := db.SetupDB(t)
+	ctx := context.Background()
+	blk := util.NewBeaconBlockCapella()
+	blk.Block.Slot = 10
+	blk.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte("blockhash"), fieldparams.RootLength)
+	util.SaveBlock(t, ctx, d, blk)
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+
+	// Genesis is far enough in the past for the update of the test to be due.
+	genesis := time.Now().Add(-time.Duration(100*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	r := &Service{
+		ctx: ctx,
+		cfg: &config{
+			beaconDB: d,
+			p2p:      p,
+			clock:    startup.NewClock(genesis, [32]byte{}),
+		},
+	}
+	r.lcUpdates.optimistic = &ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: &ethpbv1.BeaconBlockHeader{
+			Slot:       blk.Block.Slot,
+			ParentRoot: blk.Block.ParentRoot,
+			StateRoot:  blk.Block.StateRoot,
+			BodyRoot:   bodyRoot[:],
+		},
+		SyncAggregate: testLightClientSyncAggregate(),
+		SignatureSlot: 11,
+	}
+	r.lcUpdates.optimisticMsg, err = r.lightClientMessageForFork(r.lcUpdates.optimistic.AttestedHeader.Slot, r.lcUpdates.optimistic)
+	require.NoError(t, err)
+	local, ok := r.lcUpdates.optimisticMsg.(*ethpbv2.LightClientOptimisticUpdateCapella)
+	require.Equal(t, true, ok)
+
+	digest, err := r.currentForkDigest()
+	require.NoError(t, err)
+	topic := fmt.Sprintf(p2p.LightClientOptimisticUpdateTopicFormat, digest) + p.Encoding().ProtocolSuffix()
+	b, err := local.MarshalSSZ()
+	require.NoError(t, err)
+	msg := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  snappy.Encode(nil, b),
+			Topic: &topic,
+		},
+	}
+	res, err := r.validateLightClientOptimisticUpdate(ctx, "foobar", msg)
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, res)
+}
+
+func TestLightClientHeader_PrunedBlock(t *testing.T) {
+	d := db.SetupDB(t)
+	ctx := context.Background()
+	header := &ethpbv2.LightClientHeaderDeneb{
+		Beacon: testLightClientHeader(10),
+		Execution: &enginev1.ExecutionPayloadHeaderDeneb{
+			ParentHash:       make([]byte, fieldparams.RootLength),
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        bytesutil.PadTo([]byte("blockhash"), fieldparams.RootLength),
+			TransactionsRoot: make([]byte, fieldparams.RootLength),
+			WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+		},
+		ExecutionBranch: make([][]byte, 4),
+	}
+	header.Beacon.BodyRoot = bytesutil.PadTo([]byte("body"), fieldparams.RootLength)
+	for i := range header.ExecutionBranch {
+		header.ExecutionBranch[i] = make([]byte, fieldparams.RootLength)
+	}
+	r := &Service{cfg: &config{beaconDB: d}}
+
+	// Neither the block nor its light client header are known.
+	_, err := r.lightClientHeader(ctx, header.Beacon)
+	require.ErrorContains(t, "could not find block", err)
+
+	// The saved light client header is served without the block.
+	require.NoError(t, d.SaveLightClientHeader(ctx, header))
+	got, err := r.lightClientHeader(ctx, header.Beacon)
+	require.NoError(t, err)
+	assert.DeepEqual(t, header, got)
+}
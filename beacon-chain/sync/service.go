@@ -36,6 +36,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/backfill/coverage"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
@@ -59,6 +60,7 @@ const seenSyncContributionSize = 512 // Maximum of SYNC_COMMITTEE_SIZE as specif
 const seenExitSize = 100
 const seenProposerSlashingSize = 100
 const badBlockSize = 1000
+const lightClientBootstrapSize = 16
 const syncMetricsInterval = 10 * time.Second
 
 var (
@@ -153,11 +155,16 @@ type Service struct {
 	syncContributionBitsOverlapCache *lru.Cache
 	signatureChan                    chan *signatureVerifier
 	clockWaiter                      startup.ClockWaiter
+	clockLock                        sync.RWMutex
 	initialSyncComplete              chan struct{}
 	verifierWaiter                   *verification.InitializerWaiter
 	newBlobVerifier                  verification.NewBlobVerifier
 	availableBlocker                 coverage.AvailableBlocker
 	ctxMap                           ContextByteVersions
+	lcUpdates                        lightClientUpdates
+	lcBootstrapCache                 *lru.Cache
+	replayClock                      *startup.Clock
+	replayChain                      replayGenesisSetter
 }
 
 // NewService initializes new regular sync service.
@@ -225,6 +232,9 @@ func (s *Service) Start() {
 
 	go s.verifierRoutine()
 	go s.registerHandlers()
+	if features.Get().EnableLightClient {
+		go s.lightClientUpdatesRoutine()
+	}
 
 	s.cfg.p2p.AddConnectionHandler(s.reValidatePeer, s.sendGoodbye)
 	s.cfg.p2p.AddDisconnectionHandler(func(_ context.Context, _ peer.ID) error {
@@ -264,7 +274,7 @@ func (s *Service) Stop() error {
 func (s *Service) Status() error {
 	// If our head slot is on a previous epoch and our peers are reporting their head block are
 	// in the most recent epoch, then we might be out of sync.
-	if headEpoch := slots.ToEpoch(s.cfg.chain.HeadSlot()); headEpoch+1 < slots.ToEpoch(s.cfg.clock.CurrentSlot()) &&
+	if headEpoch := slots.ToEpoch(s.cfg.chain.HeadSlot()); headEpoch+1 < slots.ToEpoch(s.clock().CurrentSlot()) &&
 		headEpoch+1 < s.cfg.p2p.Peers().HighestEpoch() {
 		return errors.New("out of sync")
 	}
@@ -285,6 +295,7 @@ func (s *Service) initCaches() {
 	s.seenAttesterSlashingCache = make(map[uint64]bool)
 	s.seenProposerSlashingCache = lruwrpr.New(seenProposerSlashingSize)
 	s.badBlockCache = lruwrpr.New(badBlockSize)
+	s.lcBootstrapCache = lruwrpr.New(lightClientBootstrapSize)
 }
 
 func (s *Service) waitForChainStart() {
@@ -293,7 +304,7 @@ func (s *Service) waitForChainStart() {
 		log.WithError(err).Error("sync service failed to receive genesis data")
 		return
 	}
-	s.cfg.clock = clock
+	s.setClock(clock)
 	startTime := clock.GenesisTime()
 	log.WithField("startTime", startTime).Debug("Received state initialized event")
 
@@ -325,7 +336,7 @@ func (s *Service) registerHandlers() {
 			log.WithError(err).Error("Could not retrieve current fork digest")
 			return
 		}
-		currentEpoch := slots.ToEpoch(slots.CurrentSlot(uint64(s.cfg.clock.GenesisTime().Unix())))
+		currentEpoch := slots.ToEpoch(slots.CurrentSlot(uint64(s.clock().GenesisTime().Unix())))
 		s.registerSubscribers(currentEpoch, digest)
 		go s.forkWatcher()
 		return
@@ -352,6 +363,19 @@ func (s *Service) chainIsStarted() bool {
 	return s.chainStarted.IsSet()
 }
 
+// clock returns the clock of the service, which is shifted when replaying gossip.
+func (s *Service) clock() *startup.Clock {
+	s.clockLock.RLock()
+	defer s.clockLock.RUnlock()
+	return s.cfg.clock
+}
+
+func (s *Service) setClock(c *startup.Clock) {
+	s.clockLock.Lock()
+	defer s.clockLock.Unlock()
+	s.cfg.clock = c
+}
+
 // Checker defines a struct which can verify whether a node is currently
 // synchronizing a chain with the rest of peers in the network.
 type Checker interface {
@@ -123,7 +123,7 @@ func (s *Service) validateBlob(ctx context.Context, pid peer.ID, msg *pubsub.Mes
 
 	fields := blobFields(blob)
 	sinceSlotStartTime := receivedTime.Sub(startTime)
-	validationTime := s.cfg.clock.Now().Sub(receivedTime)
+	validationTime := s.clock().Now().Sub(receivedTime)
 	fields["sinceSlotStartTime"] = sinceSlotStartTime
 	fields["validationTime"] = validationTime
 	log.WithFields(fields).Debug("Received blob sidecar gossip")
@@ -9,6 +9,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	"github.com/prysmaticlabs/prysm/v5/network/authorization"
 	"github.com/urfave/cli/v2"
 )
 
@@ -31,9 +33,48 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 	if len(jwtSecret) > 0 {
 		opts = append(opts, execution.WithHttpEndpointAndJWTSecret(endpoint, jwtSecret))
 	}
+	fallbacks, err := parseFallbackEndpoints(c, jwtSecret)
+	if err != nil {
+		return nil, err
+	}
+	if len(fallbacks) > 0 {
+		policy, err := execution.ParseFailoverPolicy(c.String(flags.ExecutionFailoverPolicy.Name))
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, execution.WithFallbackEndpoints(fallbacks), execution.WithFailoverPolicy(policy))
+	}
 	return opts, nil
 }
 
+// Parses the fallback execution endpoints, each authenticated with its own JWT secret, or with the secret of the
+// primary endpoint when no fallback secret is provided.
+func parseFallbackEndpoints(c *cli.Context, jwtSecret []byte) ([]network.Endpoint, error) {
+	urls := c.StringSlice(flags.ExecutionFallbackEndpoints.Name)
+	secretFiles := c.StringSlice(flags.ExecutionFallbackJWTSecrets.Name)
+	if len(secretFiles) > 0 && len(secretFiles) != len(urls) {
+		return nil, fmt.Errorf("%d fallback JWT secrets provided with %s for %d fallback endpoints, "+
+			"one secret is required per endpoint", len(secretFiles), flags.ExecutionFallbackJWTSecrets.Name, len(urls))
+	}
+	endpoints := make([]network.Endpoint, len(urls))
+	for i, url := range urls {
+		secret := jwtSecret
+		if len(secretFiles) > 0 {
+			var err error
+			secret, err = readJWTSecret(secretFiles[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not read JWT secret file of fallback execution endpoint %d", i)
+			}
+		}
+		endpoints[i] = network.HttpEndpoint(url)
+		if len(secret) > 0 {
+			endpoints[i].Auth.Method = authorization.Bearer
+			endpoints[i].Auth.Value = string(secret)
+		}
+	}
+	return endpoints, nil
+}
+
 // Parses a JWT secret from a file path. This secret is required when connecting to execution nodes
 // over HTTP, and must be the same one used in Prysm and the execution node server Prysm is connecting to.
 // The engine API specification here https://github.com/ethereum/execution-apis/blob/main/src/engine/authentication.md
@@ -47,6 +88,10 @@ func parseJWTSecretFromFile(c *cli.Context) ([]byte, error) {
 	if jwtSecretFile == "" {
 		return nil, nil
 	}
+	return readJWTSecret(jwtSecretFile)
+}
+
+func readJWTSecret(jwtSecretFile string) ([]byte, error) {
 	enc, err := file.ReadFileAsBytes(jwtSecretFile)
 	if err != nil {
 		return nil, err
@@ -99,3 +99,43 @@ func TestPowchainPreregistration_EmptyWeb3Provider(t *testing.T) {
 	_, err := parseExecutionChainEndpoint(ctx)
 	assert.ErrorContains(t, "you need to specify", err)
 }
+
+func Test_parseFallbackEndpoints(t *testing.T) {
+	secret := bytesutil.ToBytes32([]byte("fallback"))
+	secretPath := filepath.Join(t.TempDir(), "fallbackhex")
+	require.NoError(t, file.WriteFile(secretPath, []byte(fmt.Sprintf("%#x", secret))))
+	primarySecret := bytesutil.PadTo([]byte("primary"), 32)
+
+	t.Run("primary secret is used without fallback secrets", func(t *testing.T) {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.Var(cli.NewStringSlice("http://a:8551", "http://b:8551"), flags.ExecutionFallbackEndpoints.Name, "")
+		set.Var(cli.NewStringSlice(), flags.ExecutionFallbackJWTSecrets.Name, "")
+		ctx := cli.NewContext(&app, set, nil)
+		endpoints, err := parseFallbackEndpoints(ctx, primarySecret)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(endpoints))
+		assert.Equal(t, "http://b:8551", endpoints[1].Url)
+		assert.Equal(t, string(primarySecret), endpoints[1].Auth.Value)
+	})
+	t.Run("fallback secrets", func(t *testing.T) {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.Var(cli.NewStringSlice("http://a:8551"), flags.ExecutionFallbackEndpoints.Name, "")
+		set.Var(cli.NewStringSlice(secretPath), flags.ExecutionFallbackJWTSecrets.Name, "")
+		ctx := cli.NewContext(&app, set, nil)
+		endpoints, err := parseFallbackEndpoints(ctx, primarySecret)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(endpoints))
+		assert.Equal(t, string(secret[:]), endpoints[0].Auth.Value)
+	})
+	t.Run("one secret per endpoint", func(t *testing.T) {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.Var(cli.NewStringSlice("http://a:8551", "http://b:8551"), flags.ExecutionFallbackEndpoints.Name, "")
+		set.Var(cli.NewStringSlice(secretPath), flags.ExecutionFallbackJWTSecrets.Name, "")
+		ctx := cli.NewContext(&app, set, nil)
+		_, err := parseFallbackEndpoints(ctx, primarySecret)
+		require.ErrorContains(t, "one secret is required per endpoint", err)
+	})
+}
@@ -3,6 +3,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/urfave/cli/v2"
@@ -15,6 +17,18 @@ var (
 		Usage: "A MEV builder relay string http endpoint, this will be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
 		Value: "",
 	}
+	// MevExtraRelayEndpoints provides HTTP access endpoints to additional MEV relays, queried along with MevRelayEndpoint.
+	MevExtraRelayEndpoints = &cli.StringSliceFlag{
+		Name: "http-mev-extra-relay",
+		Usage: "An additional MEV builder relay string http endpoint, queried along with --http-mev-relay. " +
+			"This flag can be provided multiple times to query several relays and pick the highest value bid.",
+	}
+	// MevRelayTimeout sets the maximum time to wait for an individual relay to return a header.
+	MevRelayTimeout = &cli.DurationFlag{
+		Name:  "http-mev-relay-timeout",
+		Usage: "Maximum time to wait for a single MEV relay to respond with a header bid. Relays that do not respond in time are ignored for that slot.",
+		Value: 950 * time.Millisecond,
+	}
 	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
 		Name:  "max-builder-consecutive-missed-slots",
 		Usage: "Number of consecutive skip slot to fallback from using relay/builder to local execution engine for block construction",
@@ -24,6 +38,13 @@ var (
 		Name:  "max-builder-epoch-missed-slots",
 		Usage: "Number of total skip slot to fallback from using relay/builder to local execution engine for block construction in last epoch rolling window",
 	}
+	// BuilderRevealMissCooldownSlots is the number of slots for which the builder is not used after it did not reveal a payload.
+	BuilderRevealMissCooldownSlots = &cli.Uint64Flag{
+		Name: "builder-reveal-miss-cooldown-slots",
+		Usage: "Number of slots to fallback from using relay/builder to local execution engine for block construction after a builder " +
+			"did not reveal the payload of a blinded block. Set to 0 to keep using the builder.",
+		Value: 64,
+	}
 	// LocalBlockValueBoost sets a percentage boost for local block construction while using a custom builder.
 	LocalBlockValueBoost = &cli.Uint64Flag{
 		Name: "local-block-value-boost",
@@ -55,6 +76,26 @@ var (
 			"This is not required if using an IPC connection.",
 		Value: "",
 	}
+	// ExecutionFallbackEndpoints provides additional execution client endpoints receiving the engine API calls.
+	ExecutionFallbackEndpoints = &cli.StringSliceFlag{
+		Name: "execution-fallback-endpoint",
+		Usage: "Additional execution client http endpoints, which receive the same engine API calls as --execution-endpoint. " +
+			"Their verdicts on payloads are reconciled according to --execution-failover-policy. Can be used multiple times.",
+	}
+	// ExecutionFallbackJWTSecrets provides the JWT secret files of the fallback execution endpoints.
+	ExecutionFallbackJWTSecrets = &cli.StringSliceFlag{
+		Name: "execution-fallback-jwt-secret",
+		Usage: "Paths to the JWT secret files of the fallback execution endpoints, in the same order as " +
+			"--execution-fallback-endpoint. The --jwt-secret file is used for all of them when not set.",
+	}
+	// ExecutionFailoverPolicy defines how the verdicts of the execution endpoints are reconciled.
+	ExecutionFailoverPolicy = &cli.StringFlag{
+		Name: "execution-failover-policy",
+		Usage: "How the verdicts of the execution endpoints on payloads are reconciled: primary-with-fallback follows the " +
+			"first endpoint with a VALID or INVALID verdict, first-valid accepts a payload any endpoint finds valid, and " +
+			"majority requires more than half of the endpoints to agree, the payload being imported optimistically otherwise.",
+		Value: "primary-with-fallback",
+	}
 	// JwtId is the id field of the JWT claims. The consensus layer client MAY use this to communicate a unique identifier for the individual consensus layer client
 	JwtId = &cli.StringFlag{
 		Name:  "jwt-id",
@@ -150,6 +191,20 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the beaconDB.",
 		Value: 2048,
 	}
+	// HistoricalStateArchive keeps the state of every finalized epoch boundary as a diff against the latest archived point.
+	HistoricalStateArchive = &cli.BoolFlag{
+		Name: "historical-state-archive",
+		Usage: "Saves the state of every finalized epoch boundary as a compact diff against the state of the latest archived point, " +
+			"so that any historical state is regenerated by replaying at most one epoch of blocks. " +
+			"The finalized history of an existing database is migrated in the background.",
+	}
+	// HistoryRetentionEpochs prunes the finalized history older than the given number of epochs.
+	HistoryRetentionEpochs = &cli.Uint64Flag{
+		Name: "history-retention-epochs",
+		Usage: "Prunes finalized blocks, states and their indices older than this number of epochs in the background. " +
+			"The retention window never goes below the weak subjectivity period or MIN_EPOCHS_FOR_BLOCK_REQUESTS. " +
+			"The default of 0 keeps the full history.",
+	}
 	// BlockBatchLimit specifies the requested block batch size.
 	BlockBatchLimit = &cli.IntFlag{
 		Name:  "block-batch-limit",
@@ -266,4 +321,22 @@ var (
 		Usage: "Directory for the slasher database",
 		Value: cmd.DefaultDataDir(),
 	}
+	// GossipRecordDir enables the recording of received gossip messages into the directory.
+	GossipRecordDir = &cli.StringFlag{
+		Name: "gossip-record-dir",
+		Usage: "Records every received gossip message, with the result of its validation, into the directory. " +
+			"A recording can be replayed with `prysmctl p2p replay` to reproduce gossip validation issues.",
+	}
+	// GossipRecordMaxFileSizeMB sets the size of a gossip recording file beyond which a new file is started.
+	GossipRecordMaxFileSizeMB = &cli.IntFlag{
+		Name:  "gossip-record-max-file-size-mb",
+		Usage: "Size in megabytes of a gossip recording file beyond which a new file is started.",
+		Value: 256,
+	}
+	// GossipRecordMaxFiles sets the number of gossip recording files kept on disk.
+	GossipRecordMaxFiles = &cli.IntFlag{
+		Name:  "gossip-record-max-files",
+		Usage: "Number of gossip recording files kept on disk, the oldest files are deleted first.",
+		Value: 16,
+	}
 )
@@ -44,6 +44,9 @@ var appFlags = []cli.Flag{
 	flags.ExecutionEngineEndpoint,
 	flags.ExecutionEngineHeaders,
 	flags.ExecutionJWTSecretFlag,
+	flags.ExecutionFallbackEndpoints,
+	flags.ExecutionFallbackJWTSecrets,
+	flags.ExecutionFailoverPolicy,
 	flags.RPCHost,
 	flags.RPCPort,
 	flags.CertFlag,
@@ -64,6 +67,8 @@ var appFlags = []cli.Flag{
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.HistoricalStateArchive,
+	flags.HistoryRetentionEpochs,
 	flags.DisableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
@@ -78,11 +83,19 @@ var appFlags = []cli.Flag{
 	flags.TerminalBlockHashOverride,
 	flags.TerminalBlockHashActivationEpochOverride,
 	flags.MevRelayEndpoint,
+	flags.MevExtraRelayEndpoints,
+	flags.MevRelayTimeout,
 	flags.MaxBuilderEpochMissedSlots,
+	flags.BuilderRevealMissCooldownSlots,
 	flags.MaxBuilderConsecutiveMissedSlots,
 	flags.EngineEndpointTimeoutSeconds,
 	flags.LocalBlockValueBoost,
+	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
+	cmd.BackupIntervalFlag,
+	cmd.BackupRetentionFlag,
+	cmd.BackupCompressFlag,
+	cmd.BackupFullEveryFlag,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
 	cmd.RPCMaxPageSizeFlag,
@@ -104,6 +117,9 @@ var appFlags = []cli.Flag{
 	cmd.P2PDenyList,
 	cmd.PubsubQueueSize,
 	cmd.DataDirFlag,
+	flags.GossipRecordDir,
+	flags.GossipRecordMaxFileSizeMB,
+	flags.GossipRecordMaxFiles,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
 	cmd.TracingProcessNameFlag,
@@ -137,12 +153,22 @@ var appFlags = []cli.Flag{
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
 	checkpoint.RemoteURL,
+	checkpoint.ExtraRemoteURLs,
+	checkpoint.Quorum,
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
 	flags.SlasherDirFlag,
 	flags.JwtId,
 	storage.BlobStoragePathFlag,
 	storage.BlobRetentionEpochFlag,
+	storage.BlobS3EndpointFlag,
+	storage.BlobS3BucketFlag,
+	storage.BlobS3PrefixFlag,
+	storage.BlobS3RegionFlag,
+	storage.BlobS3AccessKeyFlag,
+	storage.BlobS3SecretKeyFlag,
+	storage.BlobS3CacheSizeFlag,
+	storage.BlobArchiveModeFlag,
 	bflags.EnableExperimentalBackfill,
 	bflags.BackfillBatchSize,
 	bflags.BackfillWorkerCount,
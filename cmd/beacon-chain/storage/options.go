@@ -24,6 +24,44 @@ var (
 		Value:   uint64(params.BeaconConfig().MinEpochsForBlobsSidecarsRequest),
 		Aliases: []string{"extend-blob-retention-epoch"},
 	}
+	// BlobS3EndpointFlag enables storing blobs in an S3-compatible object store, with --blob-path as local cache.
+	BlobS3EndpointFlag = &cli.StringFlag{
+		Name:  "blob-s3-endpoint",
+		Usage: "URL of an S3-compatible object store to keep blob sidecars in, so that several nodes can share them. The --blob-path directory is then used as a local cache.",
+	}
+	BlobS3BucketFlag = &cli.StringFlag{
+		Name:  "blob-s3-bucket",
+		Usage: "Bucket of the object store set with --blob-s3-endpoint holding the blob sidecars.",
+	}
+	BlobS3PrefixFlag = &cli.StringFlag{
+		Name:  "blob-s3-prefix",
+		Usage: "Optional key prefix for blob sidecars in the object store bucket.",
+	}
+	BlobS3RegionFlag = &cli.StringFlag{
+		Name:  "blob-s3-region",
+		Usage: "Region of the object store used to sign requests.",
+		Value: "us-east-1",
+	}
+	BlobS3AccessKeyFlag = &cli.StringFlag{
+		Name:    "blob-s3-access-key",
+		Usage:   "Access key of the object store. Requests are not signed if empty.",
+		EnvVars: []string{"AWS_ACCESS_KEY_ID"},
+	}
+	BlobS3SecretKeyFlag = &cli.StringFlag{
+		Name:    "blob-s3-secret-key",
+		Usage:   "Secret key of the object store.",
+		EnvVars: []string{"AWS_SECRET_ACCESS_KEY"},
+	}
+	BlobS3CacheSizeFlag = &cli.IntFlag{
+		Name:  "blob-s3-cache-size",
+		Usage: "Number of blob sidecars kept in the --blob-path directory when blobs are stored in an object store. The least recently used blobs are removed first.",
+		Value: filesystem.DefaultObjectStoreCacheSize,
+	}
+	// BlobArchiveModeFlag keeps blobs past the retention period, so that imported blob archives can be served.
+	BlobArchiveModeFlag = &cli.BoolFlag{
+		Name:  "blob-archive-mode",
+		Usage: "Keeps blob sidecars after the retention period instead of pruning them, and serves them over the blob sidecars API. Older blobs can be loaded with `prysmctl blobs import`.",
+	}
 )
 
 // BeaconNodeOptions sets configuration values on the node.BeaconNode value at node startup.
@@ -35,12 +73,39 @@ func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 	if err != nil {
 		return nil, err
 	}
-	opts := []node.Option{node.WithBlobStorageOptions(
+	blobOpts := []filesystem.BlobStorageOption{
 		filesystem.WithBlobRetentionEpochs(e), filesystem.WithBasePath(blobStoragePath(c)),
-	)}
+		filesystem.WithArchiveMode(c.Bool(BlobArchiveModeFlag.Name)),
+	}
+	if c.IsSet(BlobS3EndpointFlag.Name) {
+		cfg, err := blobObjectStoreConfig(c)
+		if err != nil {
+			return nil, err
+		}
+		blobOpts = append(blobOpts, filesystem.WithObjectStore(cfg))
+	}
+	opts := []node.Option{node.WithBlobStorageOptions(blobOpts...)}
 	return opts, nil
 }
 
+var errNoBlobS3Bucket = errors.New("an object store bucket is required")
+
+func blobObjectStoreConfig(c *cli.Context) (filesystem.ObjectStoreConfig, error) {
+	cfg := filesystem.ObjectStoreConfig{
+		Endpoint:  c.String(BlobS3EndpointFlag.Name),
+		Bucket:    c.String(BlobS3BucketFlag.Name),
+		Prefix:    c.String(BlobS3PrefixFlag.Name),
+		Region:    c.String(BlobS3RegionFlag.Name),
+		AccessKey: c.String(BlobS3AccessKeyFlag.Name),
+		SecretKey: c.String(BlobS3SecretKeyFlag.Name),
+		CacheSize: c.Int(BlobS3CacheSizeFlag.Name),
+	}
+	if cfg.Bucket == "" {
+		return cfg, errors.Wrapf(errNoBlobS3Bucket, "--%s must be set along with --%s", BlobS3BucketFlag.Name, BlobS3EndpointFlag.Name)
+	}
+	return cfg, nil
+}
+
 func blobStoragePath(c *cli.Context) string {
 	blobsPath := c.Path(BlobStoragePathFlag.Name)
 	if blobsPath == "" {
@@ -28,6 +28,19 @@ var (
 			"As an additional safety measure, it is strongly recommended to only use this option in conjunction with " +
 			"--weak-subjectivity-checkpoint flag",
 	}
+	// ExtraRemoteURLs are the urls of the beacon nodes which must agree with the one of RemoteURL on the finalized
+	// checkpoint.
+	ExtraRemoteURLs = &cli.StringSliceFlag{
+		Name: "checkpoint-sync-extra-url",
+		Usage: "URL of an additional synced beacon node to obtain checkpoint sync data from, along with --checkpoint-sync-url. " +
+			"Can be given multiple times, the finalized checkpoint must then be agreed on by --checkpoint-sync-quorum of them.",
+	}
+	// Quorum is the number of checkpoint sync urls that must agree on the finalized checkpoint.
+	Quorum = &cli.IntFlag{
+		Name: "checkpoint-sync-quorum",
+		Usage: "Number of --checkpoint-sync-url and --checkpoint-sync-extra-url beacon nodes which must agree on the epoch " +
+			"and root of the finalized checkpoint before checkpoint sync proceeds. Must be a majority of them, defaults to all of them.",
+	}
 )
 
 // BeaconNodeOptions is responsible for determining if the checkpoint sync options have been used, and if so,
@@ -37,10 +50,16 @@ func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 	blockPath := c.Path(BlockPath.Name)
 	statePath := c.Path(StatePath.Name)
 	remoteURL := c.String(RemoteURL.Name)
+	extraURLs := c.StringSlice(ExtraRemoteURLs.Name)
+	if remoteURL == "" && len(extraURLs) > 0 {
+		return nil, fmt.Errorf("--%s specified, but not --%s", ExtraRemoteURLs.Name, RemoteURL.Name)
+	}
 	if remoteURL != "" {
+		remoteURLs := append([]string{remoteURL}, extraURLs...)
+		quorum := c.Int(Quorum.Name)
 		opt := func(node *node.BeaconNode) error {
 			var err error
-			node.CheckpointInitializer, err = checkpoint.NewAPIInitializer(remoteURL)
+			node.CheckpointInitializer, err = checkpoint.NewAPIInitializer(remoteURLs, quorum)
 			if err != nil {
 				return errors.Wrap(err, "error while constructing beacon node api client for checkpoint sync")
 			}
@@ -110,8 +110,13 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ExecutionEngineEndpoint,
 			flags.ExecutionEngineHeaders,
 			flags.ExecutionJWTSecretFlag,
+			flags.ExecutionFallbackEndpoints,
+			flags.ExecutionFallbackJWTSecrets,
+			flags.ExecutionFailoverPolicy,
 			flags.SetGCPercent,
 			flags.SlotsPerArchivedPoint,
+			flags.HistoricalStateArchive,
+			flags.HistoryRetentionEpochs,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.BlobBatchLimit,
@@ -126,7 +131,10 @@ var appHelpFlagGroups = []flagGroup{
 			flags.MinPeersPerSubnet,
 			flags.MaxConcurrentDials,
 			flags.MevRelayEndpoint,
+			flags.MevExtraRelayEndpoints,
+			flags.MevRelayTimeout,
 			flags.MaxBuilderEpochMissedSlots,
+			flags.BuilderRevealMissCooldownSlots,
 			flags.MaxBuilderConsecutiveMissedSlots,
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
@@ -135,10 +143,20 @@ var appHelpFlagGroups = []flagGroup{
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
+			checkpoint.ExtraRemoteURLs,
+			checkpoint.Quorum,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
 			storage.BlobStoragePathFlag,
 			storage.BlobRetentionEpochFlag,
+			storage.BlobS3EndpointFlag,
+			storage.BlobS3BucketFlag,
+			storage.BlobS3PrefixFlag,
+			storage.BlobS3RegionFlag,
+			storage.BlobS3AccessKeyFlag,
+			storage.BlobS3SecretKeyFlag,
+			storage.BlobS3CacheSizeFlag,
+			storage.BlobArchiveModeFlag,
 			backfill.EnableExperimentalBackfill,
 			backfill.BackfillWorkerCount,
 			backfill.BackfillBatchSize,
@@ -170,6 +188,9 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
+			flags.GossipRecordDir,
+			flags.GossipRecordMaxFileSizeMB,
+			flags.GossipRecordMaxFiles,
 		},
 	},
 	{
@@ -194,7 +215,12 @@ var appHelpFlagGroups = []flagGroup{
 	{
 		Name: "deprecated",
 		Flags: []cli.Flag{
+			cmd.EnableBackupWebhookFlag,
 			cmd.BackupWebhookOutputDir,
+			cmd.BackupIntervalFlag,
+			cmd.BackupRetentionFlag,
+			cmd.BackupCompressFlag,
+			cmd.BackupFullEveryFlag,
 		},
 	},
 }
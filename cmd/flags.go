@@ -52,6 +52,29 @@ var (
 		Name:  "db-backup-output-dir",
 		Usage: "Output directory for db backups.",
 	}
+	// BackupIntervalFlag schedules db backups at a regular interval.
+	BackupIntervalFlag = &cli.DurationFlag{
+		Name: "db-backup-interval",
+		Usage: "Interval between scheduled db backups (ex: 24h). Each backup is written to its own directory in " +
+			"--db-backup-output-dir along with a manifest of checksums. Backups are not scheduled when unset.",
+	}
+	// BackupRetentionFlag sets the number of db backups to keep.
+	BackupRetentionFlag = &cli.IntFlag{
+		Name:  "db-backup-retention",
+		Usage: "Number of most recent scheduled or webhook db backups to keep, older ones are removed. 0 keeps all of them.",
+		Value: 7,
+	}
+	// BackupCompressFlag enables the compression of db backups.
+	BackupCompressFlag = &cli.BoolFlag{
+		Name:  "db-backup-compress",
+		Usage: "Compresses scheduled and webhook db backups with gzip.",
+	}
+	// BackupFullEveryFlag sets the number of db backups between two full backups.
+	BackupFullEveryFlag = &cli.IntFlag{
+		Name: "db-backup-full-every",
+		Usage: "Writes a full db backup every N backups. The backups in between only store the parts of the db file " +
+			"changed since the previous backup, and are restored from it. Every backup is full when lower than 2.",
+	}
 	// EnableTracingFlag defines a flag to enable p2p message tracing.
 	EnableTracingFlag = &cli.BoolFlag{
 		Name:  "enable-tracing",
@@ -258,7 +281,7 @@ var (
 	// which will be used to restore the database.
 	RestoreSourceFileFlag = &cli.StringFlag{
 		Name:  "restore-source-file",
-		Usage: "Filepath to the backed-up database file which will be used to restore the database",
+		Usage: "Filepath to the backed-up database file which will be used to restore the database, or to a backup directory with a manifest",
 	}
 	// RestoreTargetDirFlag specifies the target directory of the restored database.
 	RestoreTargetDirFlag = &cli.StringFlag{
@@ -0,0 +1,14 @@
+package blobs
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "blobs",
+		Usage: "commands to archive blob sidecars beyond the retention period",
+		Subcommands: []*cli.Command{
+			exportCmd,
+			importCmd,
+		},
+	},
+}
@@ -0,0 +1,106 @@
+package blobs
+
+import (
+	"bufio"
+	"math"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var exportFlags = struct {
+	BlobPath  string
+	StartSlot uint64
+	EndSlot   uint64
+	Out       string
+}{}
+
+var exportCmd = &cli.Command{
+	Name:  "export",
+	Usage: "Write the blob sidecars of a slot range to a blob archive file.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionExport(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export blob sidecars")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.PathFlag{
+			Name:        "blob-path",
+			Usage:       "path to the blob storage directory of the beacon node",
+			Destination: &exportFlags.BlobPath,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-slot",
+			Usage:       "first slot of the range to export",
+			Destination: &exportFlags.StartSlot,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-slot",
+			Usage:       "last slot of the range to export, defaults to the latest blob in storage",
+			Destination: &exportFlags.EndSlot,
+			Value:       math.MaxUint64,
+			DefaultText: "latest",
+		},
+		&cli.PathFlag{
+			Name:        "out",
+			Usage:       "path of the blob archive file to write",
+			Destination: &exportFlags.Out,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionExport(_ *cli.Context) error {
+	f := exportFlags
+	if f.EndSlot < f.StartSlot {
+		return errors.Errorf("end slot %d is before start slot %d", f.EndSlot, f.StartSlot)
+	}
+	bs, err := filesystem.NewBlobStorage(filesystem.WithBasePath(f.BlobPath))
+	if err != nil {
+		return err
+	}
+	out, err := os.OpenFile(f.Out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "could not create blob archive %s", f.Out)
+	}
+	n, err := exportRange(bs, out, primitives.Slot(f.StartSlot), primitives.Slot(f.EndSlot))
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		if rerr := os.Remove(f.Out); rerr != nil {
+			log.WithError(rerr).WithField("path", f.Out).Error("Could not remove incomplete blob archive")
+		}
+		return err
+	}
+	log.WithFields(log.Fields{
+		"path":     f.Out,
+		"sidecars": n,
+	}).Info("Exported blob sidecars")
+	return nil
+}
+
+func exportRange(bs *filesystem.BlobStorage, out *os.File, start, end primitives.Slot) (int, error) {
+	bw := bufio.NewWriter(out)
+	w, err := filesystem.NewBlobArchiveWriter(bw)
+	if err != nil {
+		return 0, err
+	}
+	n, err := bs.ArchiveRange(w, start, end)
+	if err != nil {
+		return n, err
+	}
+	if err := w.Close(); err != nil {
+		return n, err
+	}
+	if err := bw.Flush(); err != nil {
+		return n, errors.Wrap(err, "could not write blob archive")
+	}
+	return n, out.Sync()
+}
@@ -0,0 +1,174 @@
+package blobs
+
+import (
+	"bytes"
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var importFlags = struct {
+	BlobPath string
+	DBPath   string
+	Archive  string
+}{}
+
+var importCmd = &cli.Command{
+	Name:  "import",
+	Usage: "Verify the blob sidecars of a blob archive file against the blocks of the beacon node db and save them to blob storage. The beacon node should be stopped and run with --blob-archive-mode, otherwise blobs outside the retention period are pruned.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionImport(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not import blob sidecars")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.PathFlag{
+			Name:        "blob-path",
+			Usage:       "path to the blob storage directory of the beacon node",
+			Destination: &importFlags.BlobPath,
+			Required:    true,
+		},
+		&cli.PathFlag{
+			Name:        "db-path",
+			Usage:       "path to directory containing beaconchain.db, whose blocks the blob sidecars are verified against",
+			Destination: &importFlags.DBPath,
+			Required:    true,
+		},
+		&cli.PathFlag{
+			Name:        "archive",
+			Usage:       "path of the blob archive file to import",
+			Destination: &importFlags.Archive,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionImport(cliCtx *cli.Context) error {
+	f := importFlags
+	dbFile := kv.StoreDatafilePath(f.DBPath)
+	exists, err := file.Exists(dbFile, file.Regular)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Errorf("no beacon db found at %s", dbFile)
+	}
+	ctx := cliCtx.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := kzg.Start(); err != nil {
+		return errors.Wrap(err, "could not load the KZG trusted setup")
+	}
+	in, err := os.Open(f.Archive)
+	if err != nil {
+		return errors.Wrapf(err, "could not open blob archive %s", f.Archive)
+	}
+	defer func() {
+		if err := in.Close(); err != nil {
+			log.WithError(err).Error("Could not close blob archive")
+		}
+	}()
+	fi, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	a, err := filesystem.OpenBlobArchive(in, fi.Size())
+	if err != nil {
+		return errors.Wrapf(err, "could not read blob archive %s", f.Archive)
+	}
+	bs, err := filesystem.NewBlobStorage(filesystem.WithBasePath(f.BlobPath), filesystem.WithArchiveMode(true))
+	if err != nil {
+		return err
+	}
+	store, err := kv.NewKVStore(ctx, f.DBPath)
+	if err != nil {
+		return errors.Wrap(err, "could not open db")
+	}
+	n, err := importArchive(ctx, a, store, bs)
+	if cErr := store.Close(); cErr != nil && err == nil {
+		err = errors.Wrap(cErr, "could not close db")
+	}
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"path":     f.Archive,
+		"sidecars": n,
+	}).Info("Imported blob sidecars")
+	return nil
+}
+
+// importArchive verifies the sidecars in the archive one block at a time, and saves them to blob storage. The block
+// of the sidecars must be in the db and commit to them, and their kzg proofs and commitment inclusion proofs must
+// be valid.
+func importArchive(ctx context.Context, a *filesystem.BlobArchive, db iface.ReadOnlyDatabase, bs *filesystem.BlobStorage) (int, error) {
+	entries := a.Entries()
+	imported := 0
+	for start := 0; start < len(entries); {
+		end := start + 1
+		for end < len(entries) && entries[end].Root == entries[start].Root {
+			end++
+		}
+		root := entries[start].Root
+		commitments, err := blockKzgCommitments(ctx, db, root)
+		if err != nil {
+			return imported, err
+		}
+		sidecars := make([]blocks.ROBlob, 0, end-start)
+		for _, e := range entries[start:end] {
+			sc, err := a.Read(e)
+			if err != nil {
+				return imported, err
+			}
+			if sc.Index >= uint64(len(commitments)) || !bytes.Equal(sc.KzgCommitment, commitments[sc.Index]) {
+				return imported, errors.Errorf("blob sidecar with root %#x at index %d does not match the kzg commitments of its block", e.Root, e.Index)
+			}
+			if err := blocks.VerifyKZGInclusionProof(sc); err != nil {
+				return imported, errors.Wrapf(err, "invalid commitment inclusion proof for blob sidecar with root %#x at index %d", e.Root, e.Index)
+			}
+			sidecars = append(sidecars, sc)
+		}
+		if err := kzg.Verify(sidecars...); err != nil {
+			return imported, errors.Wrapf(err, "invalid kzg proof for blob sidecars with root %#x", entries[start].Root)
+		}
+		for _, sc := range sidecars {
+			if err := bs.Save(blocks.NewVerifiedROBlob(sc)); err != nil {
+				return imported, errors.Wrapf(err, "could not save blob sidecar with root %#x at index %d", sc.BlockRoot(), sc.Index)
+			}
+			imported++
+		}
+		start = end
+	}
+	return imported, nil
+}
+
+// blockKzgCommitments returns the blob kzg commitments of the block with the given root in the db.
+func blockKzgCommitments(ctx context.Context, db iface.ReadOnlyDatabase, root [32]byte) ([][]byte, error) {
+	b, err := db.Block(ctx, root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get block with root %#x", root)
+	}
+	if err := blocks.BeaconBlockIsNil(b); err != nil {
+		return nil, errors.Errorf("block with root %#x of blob sidecars is not in the db", root)
+	}
+	if b.Version() < version.Deneb {
+		return nil, errors.Errorf("block with root %#x of blob sidecars is before deneb", root)
+	}
+	commitments, err := b.Block().Body().BlobKzgCommitments()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get kzg commitments of block with root %#x", root)
+	}
+	return commitments, nil
+}
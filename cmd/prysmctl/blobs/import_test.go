@@ -0,0 +1,123 @@
+package blobs
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// zeroBlobSidecars returns a block committing to empty blobs and their sidecars, which have a valid kzg
+// commitment and proof at the point at infinity.
+func zeroBlobSidecars(t *testing.T, nblobs int) (interfaces.ReadOnlySignedBeaconBlock, []blocks.ROBlob) {
+	infinity := make([]byte, fieldparams.BLSPubkeyLength)
+	infinity[0] = 0xc0
+	b := util.NewBeaconBlockDeneb()
+	b.Block.Slot = 100
+	b.Block.Body.BlobKzgCommitments = make([][]byte, nblobs)
+	for i := range b.Block.Body.BlobKzgCommitments {
+		b.Block.Body.BlobKzgCommitments[i] = infinity
+	}
+	sb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	header, err := sb.Header()
+	require.NoError(t, err)
+	body, err := blocks.NewBeaconBlockBody(b.Block.Body)
+	require.NoError(t, err)
+	sidecars := make([]blocks.ROBlob, nblobs)
+	for i := range sidecars {
+		proof, err := blocks.MerkleProofKZGCommitment(body, i)
+		require.NoError(t, err)
+		sc, err := blocks.NewROBlob(&ethpb.BlobSidecar{
+			Index:                    uint64(i),
+			Blob:                     make([]byte, fieldparams.BlobSize),
+			KzgCommitment:            infinity,
+			KzgProof:                 infinity,
+			SignedBlockHeader:        header,
+			CommitmentInclusionProof: proof,
+		})
+		require.NoError(t, err)
+		sidecars[i] = sc
+	}
+	return sb, sidecars
+}
+
+func writeArchive(t *testing.T, sidecars []blocks.ROBlob) *filesystem.BlobArchive {
+	buf := &bytes.Buffer{}
+	w, err := filesystem.NewBlobArchiveWriter(buf)
+	require.NoError(t, err)
+	for _, sc := range sidecars {
+		require.NoError(t, w.Write(sc))
+	}
+	require.NoError(t, w.Close())
+	a, err := filesystem.OpenBlobArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	return a
+}
+
+func TestImportArchive(t *testing.T) {
+	require.NoError(t, kzg.Start())
+	ctx := context.Background()
+
+	t.Run("valid", func(t *testing.T) {
+		b, sidecars := zeroBlobSidecars(t, 3)
+		db := dbtest.SetupDB(t)
+		require.NoError(t, db.SaveBlock(ctx, b))
+		bs := filesystem.NewEphemeralBlobStorage(t, filesystem.WithArchiveMode(true))
+		n, err := importArchive(ctx, writeArchive(t, sidecars), db, bs)
+		require.NoError(t, err)
+		require.Equal(t, 3, n)
+		mask, err := bs.Indices(sidecars[0].BlockRoot())
+		require.NoError(t, err)
+		require.DeepEqual(t, [fieldparams.MaxBlobsPerBlock]bool{true, true, true}, mask)
+	})
+	t.Run("block not in db", func(t *testing.T) {
+		_, sidecars := zeroBlobSidecars(t, 1)
+		bs := filesystem.NewEphemeralBlobStorage(t, filesystem.WithArchiveMode(true))
+		n, err := importArchive(ctx, writeArchive(t, sidecars), dbtest.SetupDB(t), bs)
+		require.ErrorContains(t, "is not in the db", err)
+		require.Equal(t, 0, n)
+		mask, err := bs.Indices(sidecars[0].BlockRoot())
+		require.NoError(t, err)
+		require.DeepEqual(t, [fieldparams.MaxBlobsPerBlock]bool{}, mask)
+	})
+	t.Run("commitment not in block", func(t *testing.T) {
+		b, sidecars := zeroBlobSidecars(t, 1)
+		db := dbtest.SetupDB(t)
+		require.NoError(t, db.SaveBlock(ctx, b))
+		sidecars[0].KzgCommitment = bytes.Repeat([]byte{1}, fieldparams.BLSPubkeyLength)
+		bs := filesystem.NewEphemeralBlobStorage(t, filesystem.WithArchiveMode(true))
+		_, err := importArchive(ctx, writeArchive(t, sidecars), db, bs)
+		require.ErrorContains(t, "does not match the kzg commitments of its block", err)
+	})
+	t.Run("invalid kzg proof", func(t *testing.T) {
+		b, sidecars := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 100, 2)
+		db := dbtest.SetupDB(t)
+		require.NoError(t, db.SaveBlock(ctx, b))
+		bs := filesystem.NewEphemeralBlobStorage(t, filesystem.WithArchiveMode(true))
+		n, err := importArchive(ctx, writeArchive(t, sidecars), db, bs)
+		require.ErrorContains(t, "invalid kzg proof", err)
+		require.Equal(t, 0, n)
+		mask, err := bs.Indices(sidecars[0].BlockRoot())
+		require.NoError(t, err)
+		require.DeepEqual(t, [fieldparams.MaxBlobsPerBlock]bool{}, mask)
+	})
+	t.Run("invalid inclusion proof", func(t *testing.T) {
+		b, sidecars := zeroBlobSidecars(t, 1)
+		db := dbtest.SetupDB(t)
+		require.NoError(t, db.SaveBlock(ctx, b))
+		sidecars[0].CommitmentInclusionProof[0] = bytes.Repeat([]byte{1}, 32)
+		bs := filesystem.NewEphemeralBlobStorage(t, filesystem.WithArchiveMode(true))
+		_, err := importArchive(ctx, writeArchive(t, sidecars), db, bs)
+		require.ErrorContains(t, "invalid commitment inclusion proof", err)
+	})
+}
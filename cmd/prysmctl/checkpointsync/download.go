@@ -12,8 +12,8 @@ import (
 )
 
 var downloadFlags = struct {
-	BeaconNodeHost string
-	Timeout        time.Duration
+	Quorum  int
+	Timeout time.Duration
 }{}
 
 var downloadCmd = &cli.Command{
@@ -27,11 +27,16 @@ var downloadCmd = &cli.Command{
 		return nil
 	},
 	Flags: []cli.Flag{
-		&cli.StringFlag{
-			Name:        "beacon-node-host",
-			Usage:       "host:port for beacon node connection",
-			Destination: &downloadFlags.BeaconNodeHost,
-			Value:       "localhost:3500",
+		&cli.StringSliceFlag{
+			Name: "beacon-node-host",
+			Usage: "host:port for beacon node connection. Can be given multiple times to require --quorum of the " +
+				"beacon nodes to agree on the finalized checkpoint",
+			Value: cli.NewStringSlice("localhost:3500"),
+		},
+		&cli.IntFlag{
+			Name:        "quorum",
+			Usage:       "number of beacon nodes which must agree on the epoch, block root and state root of the finalized checkpoint. default: all of them",
+			Destination: &downloadFlags.Quorum,
 		},
 		&cli.DurationFlag{
 			Name:        "http-timeout",
@@ -42,12 +47,12 @@ var downloadCmd = &cli.Command{
 	},
 }
 
-func cliActionDownload(_ *cli.Context) error {
+func cliActionDownload(cliCtx *cli.Context) error {
 	ctx := context.Background()
 	f := downloadFlags
 
 	opts := []client.ClientOpt{client.WithTimeout(f.Timeout)}
-	client, err := beacon.NewClient(downloadFlags.BeaconNodeHost, opts...)
+	clients, err := beacon.NewClients(cliCtx.StringSlice("beacon-node-host"), opts...)
 	if err != nil {
 		return err
 	}
@@ -57,7 +62,7 @@ func cliActionDownload(_ *cli.Context) error {
 		return err
 	}
 
-	od, err := beacon.DownloadFinalizedData(ctx, client)
+	od, err := beacon.DownloadFinalizedData(ctx, clients, f.Quorum)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,173 @@
+package db
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/pruner"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	bolt "go.etcd.io/bbolt"
+)
+
+// compactTxMaxSize is the number of bytes copied by a single transaction when compacting the db.
+const compactTxMaxSize = 64 * 1024 * 1024
+
+var pruneFlags = struct {
+	Path            string
+	RetentionEpochs uint64
+	BatchSize       int
+}{}
+
+var pruneCmd = &cli.Command{
+	Name: "prune",
+	Usage: "delete the finalized blocks and states older than a retention window from the db of a stopped beacon node, " +
+		"then compact the db file",
+	Action: func(cliCtx *cli.Context) error {
+		if err := pruneAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not prune db")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &pruneFlags.Path,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name: "retention-epochs",
+			Usage: "number of epochs of finalized history to keep before the finalized checkpoint, " +
+				"which is never less than the weak subjectivity period or MIN_EPOCHS_FOR_BLOCK_REQUESTS",
+			Destination: &pruneFlags.RetentionEpochs,
+			Required:    true,
+		},
+		&cli.IntFlag{
+			Name:        "batch-size",
+			Usage:       "number of blocks deleted per db transaction",
+			Destination: &pruneFlags.BatchSize,
+			Value:       1024,
+		},
+	},
+}
+
+func pruneAction(cliCtx *cli.Context) error {
+	flags := pruneFlags
+	dbFile := kv.StoreDatafilePath(flags.Path)
+	exists, err := file.Exists(dbFile, file.Regular)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Errorf("no beacon db found at %s", dbFile)
+	}
+	ctx := cliCtx.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	store, err := kv.NewKVStore(ctx, flags.Path)
+	if err != nil {
+		return errors.Wrap(err, "could not open db")
+	}
+	deleted, err := pruneStore(ctx, store, primitives.Epoch(flags.RetentionEpochs), flags.BatchSize)
+	if cErr := store.Close(); cErr != nil && err == nil {
+		err = errors.Wrap(cErr, "could not close db")
+	}
+	if err != nil {
+		return err
+	}
+	log.WithField("prunedBlocks", deleted).Info("Pruned finalized history")
+	return compactDB(dbFile)
+}
+
+// pruneStore deletes the finalized history of the store older than the retention window, counted back from
+// the finalized checkpoint since no clock is available offline.
+func pruneStore(ctx context.Context, store *kv.Store, retention primitives.Epoch, batchSize int) (int, error) {
+	cp, err := store.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get the finalized checkpoint")
+	}
+	st, err := store.State(ctx, bytesutil.ToBytes32(cp.Root))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get the finalized state")
+	}
+	if st == nil || st.IsNil() {
+		log.Warn("Finalized state not found in the db, the retention window only accounts for MIN_EPOCHS_FOR_BLOCK_REQUESTS")
+	}
+	window, err := pruner.RetentionWindow(ctx, st, retention)
+	if err != nil {
+		return 0, err
+	}
+	if cp.Epoch <= window {
+		log.WithField("retentionEpochs", window).Info("The finalized history is within the retention window, nothing to prune")
+		return 0, nil
+	}
+	before, err := slots.EpochStart(cp.Epoch - window)
+	if err != nil {
+		return 0, err
+	}
+	tracker, err := backfill.NewUpdater(ctx, store)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not read the backfill status")
+	}
+	log.WithField("beforeSlot", before).WithField("retentionEpochs", window).Info("Pruning finalized history")
+	return pruner.PruneBefore(ctx, store, tracker, before, batchSize, 0)
+}
+
+// compactDB rewrites the db file without the free pages left by pruning, which bolt never returns to the
+// file system.
+func compactDB(dbFile string) error {
+	before, err := os.Stat(dbFile)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	log.WithField("path", dbFile).Info("Compacting db, this may take a while")
+
+	src, err := bolt.Open(dbFile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		return errors.Wrap(err, "could not open db")
+	}
+	compacted := dbFile + ".compact"
+	dst, err := bolt.Open(compacted, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		_ = src.Close()
+		return errors.Wrap(err, "could not create compacted db")
+	}
+	if err := bolt.Compact(dst, src, compactTxMaxSize); err != nil {
+		_ = src.Close()
+		_ = dst.Close()
+		_ = os.Remove(compacted)
+		return errors.Wrap(err, "could not compact db")
+	}
+	if err := src.Close(); err != nil {
+		return errors.Wrap(err, "could not close db")
+	}
+	if err := dst.Close(); err != nil {
+		return errors.Wrap(err, "could not close compacted db")
+	}
+	if err := os.Rename(compacted, dbFile); err != nil {
+		return errors.Wrap(err, "could not replace db with the compacted db")
+	}
+	after, err := os.Stat(dbFile)
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"sizeBefore": before.Size(),
+		"sizeAfter":  after.Size(),
+		"duration":   time.Since(start),
+	}).Info("Compacted db")
+	return nil
+}
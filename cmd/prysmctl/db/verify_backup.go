@@ -0,0 +1,194 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/backup"
+	validatorkv "github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	beaconDatabase    = "beacondb"
+	validatorDatabase = "validatordb"
+	// backupExtension is the extension of the database files written by the beacon and validator db backups.
+	backupExtension = ".backup"
+)
+
+var verifyBackupFlags = struct {
+	Path     string
+	Database string
+}{}
+
+var verifyBackupCmd = &cli.Command{
+	Name: "verify-backup",
+	Usage: "check the checksums of a beacon or validator db backup, then open it read-only to check its integrity, " +
+		"genesis, finalized checkpoint and slashing protection",
+	Action: func(cliCtx *cli.Context) error {
+		if err := verifyBackupAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Backup verification failed")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to a backup directory with a manifest, or to a single backup file",
+			Destination: &verifyBackupFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name: "database",
+			Usage: fmt.Sprintf("kind of database backed up, %s or %s. Read from the manifest or the backup file name when not set",
+				beaconDatabase, validatorDatabase),
+			Destination: &verifyBackupFlags.Database,
+		},
+	},
+}
+
+func verifyBackupAction(cliCtx *cli.Context) error {
+	flags := verifyBackupFlags
+	ctx := cliCtx.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	p, err := file.ExpandPath(flags.Path)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(p)
+	if err != nil {
+		return err
+	}
+
+	database := flags.Database
+	var files []string
+	if info.IsDir() {
+		m, err := backup.ReadManifest(p)
+		if err != nil {
+			return err
+		}
+		if err := m.VerifyChecksums(p); err != nil {
+			return err
+		}
+		log.WithField("files", len(m.Files)).WithField("createdAt", m.CreatedAt).Info("Backup checksums match the manifest")
+		if database == "" {
+			database = m.Database
+		}
+		if m.Snapshot != nil {
+			// The database file is restored from the chain of backups of an incremental backup, and checked against
+			// its checksum.
+			tmp, err := os.MkdirTemp("", "prysm-verify-backup")
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := os.RemoveAll(tmp); err != nil {
+					log.WithError(err).Error("Could not remove restored backup")
+				}
+			}()
+			restored := filepath.Join(tmp, m.Snapshot.Name)
+			log.WithField("base", m.Base).Info("Restoring backup")
+			if err := backup.Restore(p, restored); err != nil {
+				return err
+			}
+			files = append(files, restored)
+		} else {
+			for _, f := range m.Files {
+				files = append(files, filepath.Join(p, filepath.FromSlash(f.Path)))
+			}
+		}
+	} else {
+		files = []string{p}
+	}
+	if database == "" {
+		database = databaseFromName(filepath.Base(p))
+	}
+	if database != beaconDatabase && database != validatorDatabase {
+		return errors.Errorf("unknown database kind %q, set --database to %s or %s", database, beaconDatabase, validatorDatabase)
+	}
+
+	verified := 0
+	for _, f := range files {
+		if !strings.HasSuffix(strings.TrimSuffix(f, backup.CompressedExtension), backupExtension) {
+			continue
+		}
+		if err := verifyBackupFile(ctx, database, f); err != nil {
+			return errors.Wrapf(err, "invalid backup file %s", f)
+		}
+		verified++
+	}
+	if verified == 0 {
+		log.Warn("No db file found in the backup, only the checksums were verified")
+		return nil
+	}
+	log.WithField("database", database).Info("Backup verified")
+	return nil
+}
+
+// verifyBackupFile decompresses the backup file if needed, then opens it read-only to check its content.
+func verifyBackupFile(ctx context.Context, database, path string) error {
+	if strings.HasSuffix(path, backup.CompressedExtension) {
+		tmp, err := os.MkdirTemp("", "prysm-verify-backup")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := os.RemoveAll(tmp); err != nil {
+				log.WithError(err).Error("Could not remove decompressed backup")
+			}
+		}()
+		decompressed := filepath.Join(tmp, strings.TrimSuffix(filepath.Base(path), backup.CompressedExtension))
+		log.WithField("path", path).Info("Decompressing backup file")
+		if err := backup.Decompress(path, decompressed); err != nil {
+			return errors.Wrap(err, "could not decompress backup file")
+		}
+		path = decompressed
+	}
+
+	switch database {
+	case beaconDatabase:
+		report, err := kv.VerifyBackup(ctx, path)
+		if err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{
+			"genesisRoot":    fmt.Sprintf("%#x", report.GenesisRoot),
+			"fromOrigin":     report.FromOrigin,
+			"finalizedEpoch": report.FinalizedEpoch,
+			"finalizedRoot":  fmt.Sprintf("%#x", report.FinalizedRoot),
+		}).Info("Beacon db backup is consistent")
+	case validatorDatabase:
+		report, err := validatorkv.VerifyBackup(ctx, path)
+		if err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{
+			"genesisValidatorsRoot": fmt.Sprintf("%#x", report.GenesisValidatorsRoot),
+			"publicKeys":            report.PublicKeys,
+			"attestations":          report.Attestations,
+			"proposals":             report.Proposals,
+		}).Info("Validator db backup is consistent")
+	}
+	return nil
+}
+
+// databaseFromName guesses the kind of database from the name of a backup file or directory.
+func databaseFromName(name string) string {
+	switch {
+	case strings.Contains(name, beaconDatabase):
+		return beaconDatabase
+	case strings.Contains(name, validatorDatabase):
+		return validatorDatabase
+	default:
+		return ""
+	}
+}
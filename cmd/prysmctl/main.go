@@ -3,6 +3,7 @@ package main
 import (
 	"os"
 
+	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/blobs"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/checkpointsync"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/db"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/p2p"
@@ -26,6 +27,7 @@ func main() {
 }
 
 func init() {
+	prysmctlCommands = append(prysmctlCommands, blobs.Commands...)
 	prysmctlCommands = append(prysmctlCommands, checkpointsync.Commands...)
 	prysmctlCommands = append(prysmctlCommands, db.Commands...)
 	prysmctlCommands = append(prysmctlCommands, p2p.Commands...)
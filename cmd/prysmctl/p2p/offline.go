@@ -0,0 +1,118 @@
+package p2p
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	payloadattribute "github.com/prysmaticlabs/prysm/v5/consensus-types/payload-attribute"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// offlineP2P stands in for the p2p service of a beacon node which is not connected to any peer. Only the
+// methods used when validating and handling gossip messages are implemented, and nothing is broadcast.
+type offlineP2P struct {
+	p2p.P2P
+	peers *peers.Status
+}
+
+func newOfflineP2P(ctx context.Context) *offlineP2P {
+	return &offlineP2P{peers: peers.NewStatus(ctx, &peers.StatusConfig{ScorerParams: &scorers.Config{}})}
+}
+
+func (*offlineP2P) PeerID() peer.ID {
+	return ""
+}
+
+func (*offlineP2P) Encoding() encoder.NetworkEncoding {
+	return &encoder.SszNetworkEncoder{}
+}
+
+func (o *offlineP2P) Peers() *peers.Status {
+	return o.peers
+}
+
+func (*offlineP2P) Broadcast(context.Context, proto.Message) error {
+	return nil
+}
+
+func (*offlineP2P) BroadcastAttestation(context.Context, uint64, ethpb.Att) error {
+	return nil
+}
+
+func (*offlineP2P) BroadcastSyncCommitteeMessage(context.Context, uint64, *ethpb.SyncCommitteeMessage) error {
+	return nil
+}
+
+func (*offlineP2P) BroadcastBlob(context.Context, uint64, *ethpb.BlobSidecar) error {
+	return nil
+}
+
+// offlineEngine stands in for an execution client which is still syncing, so that blocks are imported
+// optimistically.
+type offlineEngine struct {
+	execution.EngineCaller
+}
+
+func (*offlineEngine) NewPayload(context.Context, interfaces.ExecutionData, []common.Hash, *common.Hash) ([]byte, error) {
+	return nil, execution.ErrAcceptedSyncingPayloadStatus
+}
+
+func (*offlineEngine) ForkchoiceUpdated(context.Context, *pb.ForkchoiceState, payloadattribute.Attributer) (*pb.PayloadIDBytes, []byte, error) {
+	return nil, nil, execution.ErrAcceptedSyncingPayloadStatus
+}
+
+// replayNotifier provides the event feeds of the offline beacon node.
+type replayNotifier struct {
+	stateFeed               event.Feed
+	blockFeed               event.Feed
+	operationFeed           event.Feed
+	slasherAttestationsFeed event.Feed
+	slasherBlockHeadersFeed event.Feed
+}
+
+func (n *replayNotifier) StateFeed() *event.Feed {
+	return &n.stateFeed
+}
+
+func (n *replayNotifier) BlockFeed() *event.Feed {
+	return &n.blockFeed
+}
+
+func (n *replayNotifier) OperationFeed() *event.Feed {
+	return &n.operationFeed
+}
+
+// replaySyncChecker reports the offline beacon node as done with initial sync, so that gossip messages are
+// validated, but not as synced to the wall clock, so that the blockchain service does not prepare payloads for
+// the current slot.
+type replaySyncChecker struct{}
+
+func (replaySyncChecker) Initialized() bool {
+	return true
+}
+
+func (replaySyncChecker) Syncing() bool {
+	return false
+}
+
+func (replaySyncChecker) Synced() bool {
+	return false
+}
+
+func (replaySyncChecker) Status() error {
+	return nil
+}
+
+func (replaySyncChecker) Resync() error {
+	return nil
+}
@@ -0,0 +1,393 @@
+package p2p
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/blstoexec"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/synccommittee"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/recorder"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var replayFlags = struct {
+	Recording string
+	DataDir   string
+	WorkDir   string
+}{}
+
+var replayCmd = &cli.Command{
+	Name: "replay",
+	Usage: "Replays a gossip recording, made with the --gossip-record-dir flag of the beacon node, through the gossip " +
+		"validators and handlers of the beacon node, against a copy of a beacon node database. No network is used.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionReplay(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not replay gossip recording")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		cmd.ChainConfigFileFlag,
+		&cli.StringFlag{
+			Name:        "recording",
+			Usage:       "gossip recording directory, or a single recording file, to replay",
+			Destination: &replayFlags.Recording,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "data directory of the beacon node whose database is replayed against. It is copied and left untouched",
+			Destination: &replayFlags.DataDir,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "work-dir",
+			Usage:       "directory where the database is copied and modified by the replay. A temporary directory is used if unset",
+			Destination: &replayFlags.WorkDir,
+		},
+	},
+}
+
+// replaySummary counts the replayed records by their recorded and replayed results.
+type replaySummary struct {
+	total      int
+	skipped    int
+	matched    int
+	mismatched int
+	unhandled  int
+}
+
+func cliActionReplay(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
+		if err := params.LoadChainConfigFile(chainConfigFileName, nil); err != nil {
+			return err
+		}
+	}
+	p2ptypes.InitializeDataMaps()
+
+	summary, err := replayRecording(cliCtx.Context, replayFlags.Recording, replayFlags.DataDir, replayFlags.WorkDir)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"total":      summary.total,
+		"skipped":    summary.skipped,
+		"matched":    summary.matched,
+		"mismatched": summary.mismatched,
+		"unhandled":  summary.unhandled,
+	}).Info("Replayed gossip recording")
+	return nil
+}
+
+// replayRecording replays the recording at the given path against a copy, made in the work directory, of the
+// database of the data directory.
+func replayRecording(ctx context.Context, recording, dataDir, workDir string) (*replaySummary, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if workDir == "" {
+		dir, err := os.MkdirTemp("", "gossip-replay")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create work directory")
+		}
+		defer func() {
+			if err := os.RemoveAll(dir); err != nil {
+				log.WithError(err).Error("Could not remove work directory")
+			}
+		}()
+		workDir = dir
+	}
+	if err := copySnapshot(dataDir, workDir); err != nil {
+		return nil, err
+	}
+
+	r, err := newReplayer(ctx, workDir)
+	if err != nil {
+		return nil, err
+	}
+	defer r.close()
+
+	summary := &replaySummary{}
+	if err := recorder.Read(recording, func(rec *recorder.Record) error {
+		r.replay(ctx, rec, summary)
+		return ctx.Err()
+	}); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// copySnapshot copies the beacon node database and blobs of the data directory into the work directory.
+func copySnapshot(dataDir, workDir string) error {
+	dbDir := filepath.Join(workDir, kv.BeaconNodeDbDirName)
+	if err := file.CopyDir(filepath.Join(dataDir, kv.BeaconNodeDbDirName), dbDir); err != nil {
+		return errors.Wrapf(err, "could not copy the database of %s", dataDir)
+	}
+	blobsDir := filepath.Join(dataDir, "blobs")
+	exists, err := file.HasDir(blobsDir)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	if err := file.CopyDir(blobsDir, filepath.Join(workDir, "blobs")); err != nil {
+		return errors.Wrapf(err, "could not copy the blobs of %s", dataDir)
+	}
+	return nil
+}
+
+// replayer holds the services of an offline beacon node, which validate and handle the replayed messages.
+type replayer struct {
+	db    *kv.Store
+	chain *blockchain.Service
+	sync  *sync.Service
+}
+
+func newReplayer(ctx context.Context, workDir string) (*replayer, error) {
+	db, err := kv.NewKVStore(ctx, filepath.Join(workDir, kv.BeaconNodeDbDirName))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open database")
+	}
+	r := &replayer{db: db}
+	if err := r.start(ctx, workDir); err != nil {
+		r.close()
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *replayer) start(ctx context.Context, workDir string) error {
+	blobStorage, err := filesystem.NewBlobStorage(
+		filesystem.WithBasePath(filepath.Join(workDir, "blobs")),
+		filesystem.WithBlobRetentionEpochs(params.BeaconConfig().MinEpochsForBlobsSidecarsRequest),
+		filesystem.WithArchiveMode(true),
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not open blob storage")
+	}
+	depositCache, err := depositsnapshot.New()
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit cache")
+	}
+	fc := doublylinkedtree.New()
+	sg := stategen.New(r.db, fc)
+	finalized, err := finalizedState(ctx, r.db, sg)
+	if err != nil {
+		return err
+	}
+
+	notifier := &replayNotifier{}
+	p := newOfflineP2P(ctx)
+	synchronizer := startup.NewClockSynchronizer()
+	attPool := attestations.NewPool()
+	exitPool := voluntaryexits.NewPool()
+	slashingPool := slashings.NewPool()
+	blsToExecPool := blstoexec.NewPool()
+	syncCommsPool := synccommittee.NewPool()
+	attService, err := attestations.NewService(ctx, &attestations.Config{Pool: attPool})
+	if err != nil {
+		return errors.Wrap(err, "could not create attestation service")
+	}
+	r.chain, err = blockchain.NewService(ctx,
+		blockchain.WithForkChoiceStore(fc),
+		blockchain.WithDatabase(r.db),
+		blockchain.WithDepositCache(depositCache),
+		blockchain.WithExecutionEngineCaller(&offlineEngine{}),
+		blockchain.WithAttestationPool(attPool),
+		blockchain.WithExitPool(exitPool),
+		blockchain.WithSlashingPool(slashingPool),
+		blockchain.WithBLSToExecPool(blsToExecPool),
+		blockchain.WithP2PBroadcaster(p),
+		blockchain.WithStateNotifier(notifier),
+		blockchain.WithAttestationService(attService),
+		blockchain.WithStateGen(sg),
+		blockchain.WithSlasherAttestationsFeed(&notifier.slasherAttestationsFeed),
+		blockchain.WithFinalizedStateAtStartUp(finalized),
+		blockchain.WithClockSynchronizer(synchronizer),
+		blockchain.WithBlobStorage(blobStorage),
+		blockchain.WithTrackedValidatorsCache(cache.NewTrackedValidatorsCache()),
+		blockchain.WithPayloadIDCache(cache.NewPayloadIDCache()),
+		blockchain.WithSyncChecker(replaySyncChecker{}),
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not create blockchain service")
+	}
+	if err := r.chain.StartFromSavedState(finalized); err != nil {
+		return errors.Wrap(err, "could not start blockchain service")
+	}
+	if err := importUnfinalizedBlocks(ctx, r.db, r.chain); err != nil {
+		return err
+	}
+
+	syncComplete := make(chan struct{})
+	close(syncComplete)
+	r.sync = sync.NewService(ctx,
+		sync.WithDatabase(r.db),
+		sync.WithP2P(p),
+		sync.WithChainService(r.chain),
+		sync.WithInitialSync(replaySyncChecker{}),
+		sync.WithBlockNotifier(notifier),
+		sync.WithAttestationNotifier(notifier),
+		sync.WithOperationNotifier(notifier),
+		sync.WithAttestationPool(attPool),
+		sync.WithExitPool(exitPool),
+		sync.WithSlashingPool(slashingPool),
+		sync.WithSyncCommsPool(syncCommsPool),
+		sync.WithBlsToExecPool(blsToExecPool),
+		sync.WithStateGen(sg),
+		sync.WithSlasherAttestationsFeed(&notifier.slasherAttestationsFeed),
+		sync.WithSlasherBlockHeadersFeed(&notifier.slasherBlockHeadersFeed),
+		sync.WithClockWaiter(synchronizer),
+		sync.WithInitialSyncComplete(syncComplete),
+		sync.WithStateNotifier(notifier),
+		sync.WithBlobStorage(blobStorage),
+		sync.WithVerifierWaiter(verification.NewInitializerWaiter(synchronizer, forkchoice.NewROForkChoice(fc), sg)),
+	)
+	return r.sync.PrepareReplay(ctx)
+}
+
+// finalizedState loads the finalized state of the database, as the beacon node does at start up.
+func finalizedState(ctx context.Context, db *kv.Store, sg *stategen.State) (state.BeaconState, error) {
+	cp, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	root := bytesutil.ToBytes32(cp.Root)
+	if root == params.BeaconConfig().ZeroHash {
+		root, err = db.GenesisBlockRoot(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get genesis block root")
+		}
+	}
+	st, err := sg.StateByRoot(ctx, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized state")
+	}
+	return st, nil
+}
+
+// importUnfinalizedBlocks imports the blocks of the database which are descendants of the finalized block into
+// fork choice, which only holds the finalized block when the blockchain service starts.
+func importUnfinalizedBlocks(ctx context.Context, db *kv.Store, chain *blockchain.Service) error {
+	finalized := chain.FinalizedCheckpt()
+	fBlk, err := db.Block(ctx, bytesutil.ToBytes32(finalized.Root))
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	head, err := db.HeadBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head block")
+	}
+	if fBlk == nil || fBlk.IsNil() || head == nil || head.IsNil() || head.Block().Slot() <= fBlk.Block().Slot() {
+		return nil
+	}
+	blks, roots, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(fBlk.Block().Slot()+1).SetEndSlot(head.Block().Slot()))
+	if err != nil {
+		return errors.Wrap(err, "could not get unfinalized blocks")
+	}
+	order := make([]int, len(blks))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return blks[order[i]].Block().Slot() < blks[order[j]].Block().Slot()
+	})
+	imported := 0
+	for _, i := range order {
+		if !chain.InForkchoice(blks[i].Block().ParentRoot()) {
+			continue
+		}
+		if err := chain.ReceiveBlock(ctx, blks[i], roots[i], nil); err != nil {
+			log.WithError(err).WithField("slot", blks[i].Block().Slot()).Warn("Could not import unfinalized block")
+			continue
+		}
+		imported++
+	}
+	log.WithField("blocks", imported).Info("Imported unfinalized blocks into fork choice")
+	return nil
+}
+
+// replay runs a record through the gossip validator and handler of its topic, and compares the result with the
+// recorded one. Messages which were not validated when they were received are skipped.
+func (r *replayer) replay(ctx context.Context, rec *recorder.Record, summary *replaySummary) {
+	summary.total++
+	switch rec.Result {
+	case recorder.ResultAccept, recorder.ResultReject, recorder.ResultIgnore:
+	default:
+		summary.skipped++
+		return
+	}
+	pid, err := peer.Decode(rec.Peer)
+	if err != nil {
+		pid = peer.ID(rec.Peer)
+	}
+	res, err := r.sync.ReplayGossip(ctx, rec.Topic, pid, rec.Data, rec.ArrivalTime)
+	replayed := validationResult(res)
+	fields := logrus.Fields{
+		"topic":    rec.Topic,
+		"peer":     rec.Peer,
+		"arrival":  rec.ArrivalTime,
+		"recorded": rec.Result,
+		"replayed": replayed,
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+	switch {
+	case res == pubsub.ValidationAccept && err != nil:
+		summary.unhandled++
+		log.WithFields(fields).Warn("Replayed message was accepted but could not be handled")
+	case replayed == rec.Result:
+		summary.matched++
+		log.WithFields(fields).Debug("Replayed message")
+	default:
+		summary.mismatched++
+		log.WithFields(fields).Warn("Replayed message result differs from the recording")
+	}
+}
+
+func validationResult(res pubsub.ValidationResult) string {
+	switch res {
+	case pubsub.ValidationAccept:
+		return recorder.ResultAccept
+	case pubsub.ValidationReject:
+		return recorder.ResultReject
+	default:
+		return recorder.ResultIgnore
+	}
+}
+
+func (r *replayer) close() {
+	if err := r.db.Close(); err != nil {
+		log.WithError(err).Error("Could not close database")
+	}
+}
@@ -0,0 +1,68 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/recorder"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestReplayRecording(t *testing.T) {
+	// The database serves the embedded genesis state of named networks, use a custom name so that it serves ours.
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ConfigName = "replay-test"
+	params.OverrideBeaconConfig(cfg)
+	p2ptypes.InitializeDataMaps()
+	ctx := context.Background()
+
+	dataDir := t.TempDir()
+	st, keys := util.DeterministicGenesisState(t, 64)
+	db, err := kv.NewKVStore(ctx, filepath.Join(dataDir, kv.BeaconNodeDbDirName))
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisData(ctx, st))
+	require.NoError(t, db.Close())
+
+	blk, err := util.GenerateFullBlock(st.Copy(), keys, util.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	blk.Signature, err = signing.ComputeDomainAndSign(st, 0, blk.Block, params.BeaconConfig().DomainBeaconProposer, keys[blk.Block.ProposerIndex])
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	_, err = (&encoder.SszNetworkEncoder{}).EncodeGossip(buf, blk)
+	require.NoError(t, err)
+	digest, err := forks.ForkDigestFromEpoch(0, st.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	topic := fmt.Sprintf("/eth2/%x/beacon_block/ssz_snappy", digest)
+	genesis := time.Unix(int64(st.GenesisTime()), 0)
+
+	recording := t.TempDir()
+	w, err := recorder.NewWriter(recording, 1<<20, 1)
+	require.NoError(t, err)
+	for i, r := range []string{recorder.ResultAccept, recorder.ResultIgnore, recorder.ResultDuplicate} {
+		require.NoError(t, w.Write(&recorder.Record{
+			Topic:       topic,
+			Peer:        "16Uiu2HAkum7hhuMpWqFj3yNLcmQBGmThmqw2ohaCRThXQuKU9ohs",
+			ArrivalTime: genesis.Add(time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second + time.Duration(i)*time.Second),
+			Data:        buf.Bytes(),
+			Result:      r,
+		}))
+	}
+	require.NoError(t, w.Close())
+
+	summary, err := replayRecording(ctx, recording, dataDir, "")
+	require.NoError(t, err)
+	assert.DeepEqual(t, &replaySummary{total: 3, skipped: 1, matched: 2}, summary)
+}
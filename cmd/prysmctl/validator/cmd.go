@@ -155,6 +155,7 @@ var Commands = []*cli.Command{
 					flags.VoluntaryExitPublicKeysFlag,
 					flags.BeaconRPCProviderFlag,
 					flags.Web3SignerURLFlag,
+					flags.Web3SignerAdditionalURLsFlag,
 					flags.Web3SignerPublicValidatorKeysFlag,
 					flags.InteropNumValidators,
 					flags.InteropStartIndex,
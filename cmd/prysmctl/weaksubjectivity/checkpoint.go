@@ -12,8 +12,8 @@ import (
 )
 
 var checkpointFlags = struct {
-	BeaconNodeHost string
-	Timeout        time.Duration
+	Quorum  int
+	Timeout time.Duration
 }{}
 
 var checkpointCmd = &cli.Command{
@@ -27,11 +27,16 @@ var checkpointCmd = &cli.Command{
 		return nil
 	},
 	Flags: []cli.Flag{
-		&cli.StringFlag{
-			Name:        "beacon-node-host",
-			Usage:       "host:port for beacon node to query",
-			Destination: &checkpointFlags.BeaconNodeHost,
-			Value:       "http://localhost:3500",
+		&cli.StringSliceFlag{
+			Name: "beacon-node-host",
+			Usage: "host:port for beacon node to query. Can be given multiple times to require --quorum of the " +
+				"beacon nodes to agree on the weak subjectivity checkpoint",
+			Value: cli.NewStringSlice("http://localhost:3500"),
+		},
+		&cli.IntFlag{
+			Name:        "quorum",
+			Usage:       "number of beacon nodes which must agree on the weak subjectivity checkpoint. default: all of them",
+			Destination: &checkpointFlags.Quorum,
 		},
 		&cli.DurationFlag{
 			Name:        "http-timeout",
@@ -42,17 +47,17 @@ var checkpointCmd = &cli.Command{
 	},
 }
 
-func cliActionCheckpoint(_ *cli.Context) error {
+func cliActionCheckpoint(cliCtx *cli.Context) error {
 	ctx := context.Background()
 	f := checkpointFlags
 
 	opts := []client.ClientOpt{client.WithTimeout(f.Timeout)}
-	client, err := beacon.NewClient(checkpointFlags.BeaconNodeHost, opts...)
+	clients, err := beacon.NewClients(cliCtx.StringSlice("beacon-node-host"), opts...)
 	if err != nil {
 		return err
 	}
 
-	ws, err := beacon.ComputeWeakSubjectivityCheckpoint(ctx, client)
+	ws, err := beacon.ComputeWeakSubjectivityCheckpoint(ctx, clients, f.Quorum)
 	if err != nil {
 		return err
 	}
@@ -145,6 +145,38 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "reencrypt",
+			Description: "re-encrypts all accounts of a wallet with a new password and key derivation function. The " +
+				"current accounts keystore is kept as a backup, and is only replaced once the re-encrypted one is " +
+				"verified to decrypt. The validator client must be stopped while the wallet is re-encrypted",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.NewWalletPasswordFileFlag,
+				flags.KDFFlag,
+				flags.KDFCostFlag,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := accountsReencrypt(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not re-encrypt accounts")
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "voluntary-exit",
 			Description: "Performs a voluntary exit on selected accounts",
@@ -155,6 +187,7 @@ var Commands = &cli.Command{
 				flags.VoluntaryExitPublicKeysFlag,
 				flags.BeaconRPCProviderFlag,
 				flags.Web3SignerURLFlag,
+				flags.Web3SignerAdditionalURLsFlag,
 				flags.Web3SignerPublicValidatorKeysFlag,
 				flags.InteropNumValidators,
 				flags.InteropStartIndex,
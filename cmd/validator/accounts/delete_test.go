@@ -77,6 +77,9 @@ type testWalletConfig struct {
 	accountPasswordFile     string
 	walletPasswordFile      string
 	backupPasswordFile      string
+	newWalletPasswordFile   string
+	kdf                     string
+	kdfCost                 int
 	backupPublicKeys        string
 	voluntaryExitPublicKeys string
 	deletePublicKeys        string
@@ -107,11 +110,20 @@ func setupWalletCtx(
 	set.Bool(flags.SkipMnemonic25thWordCheckFlag.Name, true, "")
 	set.Bool(flags.ExitAllFlag.Name, cfg.exitAll, "")
 	set.String(flags.GRPCHeadersFlag.Name, cfg.grpcHeaders, "")
+	set.String(flags.NewWalletPasswordFileFlag.Name, cfg.newWalletPasswordFile, "")
+	set.String(flags.KDFFlag.Name, flags.KDFFlag.Value, "")
+	set.Int(flags.KDFCostFlag.Name, flags.KDFCostFlag.Value, "")
 
 	if cfg.privateKeyFile != "" {
 		set.String(flags.ImportPrivateKeyFileFlag.Name, cfg.privateKeyFile, "")
 		assert.NoError(tb, set.Set(flags.ImportPrivateKeyFileFlag.Name, cfg.privateKeyFile))
 	}
+	if cfg.kdf != "" {
+		assert.NoError(tb, set.Set(flags.KDFFlag.Name, cfg.kdf))
+	}
+	if cfg.kdfCost != 0 {
+		assert.NoError(tb, set.Set(flags.KDFCostFlag.Name, strconv.Itoa(cfg.kdfCost)))
+	}
 	assert.NoError(tb, set.Set(flags.WalletDirFlag.Name, cfg.walletDir))
 	assert.NoError(tb, set.Set(flags.SkipMnemonic25thWordCheckFlag.Name, "true"))
 	assert.NoError(tb, set.Set(flags.KeysDirFlag.Name, cfg.keysDir))
@@ -127,6 +139,7 @@ func setupWalletCtx(
 	assert.NoError(tb, set.Set(flags.SkipDepositConfirmationFlag.Name, strconv.FormatBool(cfg.skipDepositConfirm)))
 	assert.NoError(tb, set.Set(flags.ExitAllFlag.Name, strconv.FormatBool(cfg.exitAll)))
 	assert.NoError(tb, set.Set(flags.GRPCHeadersFlag.Name, cfg.grpcHeaders))
+	assert.NoError(tb, set.Set(flags.NewWalletPasswordFileFlag.Name, cfg.newWalletPasswordFile))
 	return cli.NewContext(&app, set, nil)
 }
 
@@ -0,0 +1,44 @@
+package accounts
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v5/io/prompt"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
+	"github.com/urfave/cli/v2"
+)
+
+func accountsReencrypt(c *cli.Context) error {
+	kdfParams := &keystore.KDFParams{
+		Function: c.String(flags.KDFFlag.Name),
+		Cost:     c.Int(flags.KDFCostFlag.Name),
+	}
+	if err := kdfParams.Validate(); err != nil {
+		return err
+	}
+	w, _, err := walletWithKeymanager(c)
+	if err != nil {
+		return err
+	}
+	newWalletPassword, err := prompt.InputPassword(
+		c,
+		flags.NewWalletPasswordFileFlag,
+		"Enter a new password for your wallet",
+		"Confirm new password",
+		true,
+		prompt.ValidatePasswordInput,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not determine new wallet password")
+	}
+	acc, err := accounts.NewCLIManager(
+		accounts.WithWallet(w),
+		accounts.WithNewWalletPassword(newWalletPassword),
+		accounts.WithKDFParams(kdfParams),
+	)
+	if err != nil {
+		return err
+	}
+	return acc.Reencrypt(c.Context)
+}
@@ -0,0 +1,64 @@
+package accounts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+)
+
+func TestReencryptAccounts_Noninteractive(t *testing.T) {
+	local.ResetCaches()
+	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
+	newPassword := "n3wPassw0rd$2024"
+	newPasswordFilePath := filepath.Join(t.TempDir(), "newpassword.txt")
+	require.NoError(t, os.WriteFile(newPasswordFilePath, []byte(newPassword), os.ModePerm))
+	cfg := &testWalletConfig{
+		walletDir:             walletDir,
+		keymanagerKind:        keymanager.Local,
+		walletPasswordFile:    passwordFilePath,
+		newWalletPasswordFile: newPasswordFilePath,
+		kdf:                   "argon2",
+	}
+	cliCtx := setupWalletCtx(t, cfg)
+	acc, err := accounts.NewCLIManager(
+		accounts.WithWalletDir(walletDir),
+		accounts.WithKeymanagerType(keymanager.Local),
+		accounts.WithWalletPassword(password),
+	)
+	require.NoError(t, err)
+	w, err := acc.WalletCreate(cliCtx.Context)
+	require.NoError(t, err)
+	km, err := local.NewKeymanager(cliCtx.Context, &local.SetupConfig{Wallet: w, ListenForChanges: false})
+	require.NoError(t, err)
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	require.NoError(t, km.ImportKeypairs(cliCtx.Context, [][]byte{privKey.Marshal()}, [][]byte{privKey.PublicKey().Marshal()}))
+
+	require.ErrorContains(t, "unsupported KDF", accountsReencrypt(cliCtx))
+
+	cfg.kdf = "scrypt"
+	cfg.kdfCost = 1024
+	require.NoError(t, accountsReencrypt(setupWalletCtx(t, cfg)))
+
+	backups, err := filepath.Glob(filepath.Join(w.AccountsDir(), local.AccountsPath, local.AccountsKeystoreFileName+".backup-*"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(backups))
+	local.ResetCaches()
+	w, err = wallet.OpenWallet(cliCtx.Context, &wallet.Config{WalletDir: walletDir, WalletPassword: newPassword})
+	require.NoError(t, err)
+	km2, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	pubKeys, err := km2.FetchValidatingPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pubKeys))
+	assert.DeepEqual(t, privKey.PublicKey().Marshal(), pubKeys[0][:])
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/urfave/cli/v2"
 )
@@ -31,8 +32,9 @@ var (
 	}
 	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
 	BeaconRPCProviderFlag = &cli.StringFlag{
-		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint.",
+		Name: "beacon-rpc-provider",
+		Usage: "Beacon node RPC provider endpoint. Multiple comma-separated endpoints can be provided, " +
+			"in which case duties fail over between the beacon nodes and blocks and attestations are submitted to all healthy nodes.",
 		Value: "127.0.0.1:4000",
 	}
 	// BeaconRPCGatewayProviderFlag defines a beacon node JSON-RPC endpoint.
@@ -43,8 +45,9 @@ var (
 	}
 	// BeaconRESTApiProviderFlag defines a beacon node REST API endpoint.
 	BeaconRESTApiProviderFlag = &cli.StringFlag{
-		Name:  "beacon-rest-api-provider",
-		Usage: "Beacon node REST API provider endpoint.",
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node REST API provider endpoint. Multiple comma-separated endpoints can be provided, " +
+			"in which case duties fail over between the beacon nodes and blocks and attestations are submitted to all healthy nodes.",
 		Value: "http://127.0.0.1:3500",
 	}
 	// CertFlag defines a flag for the node's TLS certificate.
@@ -253,6 +256,25 @@ var (
 		Usage: "Path to a directory where accounts will be backed up into a zip file.",
 		Value: DefaultValidatorDir(),
 	}
+	// NewWalletPasswordFileFlag for re-encrypting the accounts of a wallet with a new password.
+	NewWalletPasswordFileFlag = &cli.StringFlag{
+		Name:  "new-wallet-password-file",
+		Usage: "Path to a plain-text, .txt file containing the new password to re-encrypt your wallet with.",
+	}
+	// KDFFlag defines the key derivation function used to re-encrypt the accounts of a wallet.
+	KDFFlag = &cli.StringFlag{
+		Name: "kdf",
+		Usage: fmt.Sprintf("Key derivation function to re-encrypt the wallet with, either %s, %s or %s. Wallets encrypted "+
+			"with %s can only be read by Prysm.", keystore.PBKDF2KDF, keystore.ScryptKDF, keystore.Argon2idKDF, keystore.Argon2idKDF),
+		Value: keystore.PBKDF2KDF,
+	}
+	// KDFCostFlag defines the cost of the key derivation function used to re-encrypt the accounts of a wallet.
+	KDFCostFlag = &cli.IntFlag{
+		Name: "kdf-cost",
+		Usage: "Cost of the key derivation function to re-encrypt the wallet with, which is the iteration count of " +
+			"pbkdf2, the power of two N parameter of scrypt or the memory in KiB of argon2id.",
+		Value: keystore.DefaultKDFCost,
+	}
 	// SlashingProtectionJSONFileFlag is used to enter the file path of the slashing protection JSON.
 	SlashingProtectionJSONFileFlag = &cli.StringFlag{
 		Name:  "slashing-protection-json-file",
@@ -292,6 +314,13 @@ var (
 		Usage: "URL for consensys' web3signer software to use with the Prysm validator client.",
 		Value: "",
 	}
+	// Web3SignerAdditionalURLsFlag defines the URLs of further web3signers to connect to next to the one of Web3SignerURLFlag.
+	// example:--validators-external-signer-additional-urls=http://localhost:9001,http://localhost:9002
+	Web3SignerAdditionalURLsFlag = &cli.StringSliceFlag{
+		Name: "validators-external-signer-additional-urls",
+		Usage: "Comma separated list of URLs of further web3signers to use next to the one of --validators-external-signer-url. " +
+			"Every key is signed by the web3signers listing it on their public keys endpoint, failing over between them when one is unhealthy.",
+	}
 	// Web3SignerPublicValidatorKeysFlag defines a comma-separated list of hex string public keys or external url for web3signer to use for validator signing.
 	// example with external url: --validators-external-signer-public-keys= https://web3signer.com/api/v1/eth2/publicKeys
 	// example with public key: --validators-external-signer-public-keys=0xa99a...e44c,0xb89b...4a0b
@@ -300,6 +329,13 @@ var (
 		Name:  "validators-external-signer-public-keys",
 		Usage: "Comma separated list of public keys OR an external url endpoint for the validator to retrieve public keys from for usage with web3signer.",
 	}
+	// ThresholdConfigFileFlag defines the path to the configuration file of the threshold keymanager.
+	ThresholdConfigFileFlag = &cli.StringFlag{
+		Name: "threshold-config-file",
+		Usage: "Path to the JSON configuration file of a threshold keymanager, which holds a share of a validator key and signs " +
+			"with the validator clients holding the other shares. The validator client serves the partial signatures of its " +
+			"share to them over HTTPS at the listen address of the file.",
+	}
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
@@ -325,6 +361,22 @@ var (
 		Usage: "Allows users to specify the output directory to export their slashing protection EIP-3076 standard JSON File.",
 		Value: "",
 	}
+	// SlashingProtectionExportPubKeysFlag restricts a slashing protection export to some public keys.
+	SlashingProtectionExportPubKeysFlag = &cli.StringSliceFlag{
+		Name:  "slashing-protection-export-public-keys",
+		Usage: "Comma separated list of public keys to export the slashing protection history of. Every public key is exported if not set.",
+	}
+	// SlashingProtectionExportSinceEpochFlag restricts a slashing protection export to the history from an epoch.
+	SlashingProtectionExportSinceEpochFlag = &cli.Uint64Flag{
+		Name:  "slashing-protection-export-since-epoch",
+		Usage: "Only exports the blocks and attestations signed from this epoch, for incremental exports.",
+	}
+	// SlashingProtectionDryRunFlag reports what an import of a slashing protection history would change without importing it.
+	SlashingProtectionDryRunFlag = &cli.BoolFlag{
+		Name: "slashing-protection-dry-run",
+		Usage: "Reports, for every public key, the new records, the conflicting records and the watermarks which would move " +
+			"with the import of the slashing protection JSON file, without importing it.",
+	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
 		Name:  "graffiti-file",
@@ -374,6 +426,20 @@ var (
 		Usage: "Sets the maximum size for one batch of validator registrations. Use a non-positive value to disable batching.",
 		Value: 0,
 	}
+	// DoppelgangerEpochsFlag sets the number of epochs a validating key waits for before signing when doppelganger protection is enabled.
+	DoppelgangerEpochsFlag = &cli.Uint64Flag{
+		Name: "doppelganger-epochs",
+		Usage: `Sets the number of epochs every validating key, whether loaded at startup, imported or discovered on a
+		remote signer, is checked for liveness in the network before it signs, when doppelganger protection is enabled.`,
+		Value: 2,
+	}
+	// DutyHistoryRetentionFlag enables the duty history of the validators, and sets how long it is kept for.
+	DutyHistoryRetentionFlag = &cli.Uint64Flag{
+		Name: "duty-history-retention-epochs",
+		Usage: "Records every duty of the validators, and its outcome, in the validator database, and keeps them for " +
+			"this number of epochs. The history is served by the /eth/v1/validator/{pubkey}/duties_history endpoint. " +
+			"Duties are not recorded when set to 0.",
+	}
 	// EnableDistributed enables the usage of prysm validator client in a Distributed Validator Cluster.
 	EnableDistributed = &cli.BoolFlag{
 		Name:  "distributed",
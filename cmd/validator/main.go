@@ -78,17 +78,25 @@ var appFlags = []cli.Flag{
 	flags.AuthTokenPathFlag,
 	// Consensys' Web3Signer flags
 	flags.Web3SignerURLFlag,
+	flags.Web3SignerAdditionalURLsFlag,
 	flags.Web3SignerPublicValidatorKeysFlag,
+	flags.ThresholdConfigFileFlag,
 	flags.SuggestedFeeRecipientFlag,
 	flags.ProposerSettingsURLFlag,
 	flags.ProposerSettingsFlag,
 	flags.EnableBuilderFlag,
 	flags.BuilderGasLimitFlag,
 	flags.ValidatorsRegistrationBatchSizeFlag,
+	flags.DoppelgangerEpochsFlag,
+	flags.DutyHistoryRetentionFlag,
 	////////////////////
 	cmd.DisableMonitoringFlag,
 	cmd.MonitoringHostFlag,
 	cmd.BackupWebhookOutputDir,
+	cmd.BackupIntervalFlag,
+	cmd.BackupRetentionFlag,
+	cmd.BackupCompressFlag,
+	cmd.BackupFullEveryFlag,
 	cmd.EnableBackupWebhookFlag,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
@@ -1,21 +1,25 @@
 package historycmd
 
 import (
-	"encoding/json"
+	"bufio"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/userprompt"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
 	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
-	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
 	"github.com/urfave/cli/v2"
 )
 
@@ -30,9 +34,9 @@ const (
 // Steps:
 // 1. Parse a path to the validator's datadir from the CLI context.
 // 2. Open the validator database.
-// 3. Call the function which actually exports the data from
-// the validator's db into an EIP standard slashing protection format
-// 4. Format and save the JSON file to a user's specified output directory.
+// 3. Parse the public keys and the epoch the export is restricted to, if any.
+// 4. Stream the data from the validator's db, one public key at a time, into
+// an EIP standard slashing protection JSON file in a user's specified output directory.
 func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 	var (
 		validatorDB iface.ValidatorDB
@@ -94,14 +98,19 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 		}
 	}()
 
-	// Export the slashing protection history from the validator's database.
-	eipJSON, err := slashingprotection.ExportStandardProtectionJSON(cliCtx.Context, validatorDB)
+	filter, err := exportFilter(cliCtx)
 	if err != nil {
-		return errors.Wrap(err, "could not export slashing protection history")
+		return err
+	}
+
+	// Export the slashing protection history from the validator's database into the output file.
+	count, err := writeToOutput(cliCtx, validatorDB, filter)
+	if err != nil {
+		return errors.Wrap(err, "could not write slashing protection history to output file")
 	}
 
 	// Check if JSON data is empty and issue a warning about common problems to the user.
-	if eipJSON == nil || len(eipJSON.Data) == 0 {
+	if count == 0 && filter == nil {
 		log.Fatal(
 			"No slashing protection data was found in your database. This is likely because an older version of " +
 				"Prysm would place your validator database in your wallet directory as a validator.db file. Now, " +
@@ -111,15 +120,29 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 		)
 	}
 
-	// Write the result to the output file
-	if err := writeToOutput(cliCtx, eipJSON); err != nil {
-		return errors.Wrap(err, "could not write slashing protection history to output file")
-	}
-
 	return nil
 }
 
-func writeToOutput(cliCtx *cli.Context, eipJSON *format.EIPSlashingProtectionFormat) error {
+// exportFilter returns the public keys and the epoch the export is restricted to, or nil if every
+// public key is exported with its whole history.
+func exportFilter(cliCtx *cli.Context) (*slashingprotection.ExportFilter, error) {
+	pubKeys := cliCtx.StringSlice(flags.SlashingProtectionExportPubKeysFlag.Name)
+	sinceEpoch := cliCtx.Uint64(flags.SlashingProtectionExportSinceEpochFlag.Name)
+	if len(pubKeys) == 0 && sinceEpoch == 0 {
+		return nil, nil
+	}
+	filter := &slashingprotection.ExportFilter{SinceEpoch: primitives.Epoch(sinceEpoch)}
+	for _, hexKey := range pubKeys {
+		pubKey, err := helpers.PubKeyFromHex(strings.TrimSpace(hexKey))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse public key %s", hexKey)
+		}
+		filter.PubKeys = append(filter.PubKeys, pubKey)
+	}
+	return filter, nil
+}
+
+func writeToOutput(cliCtx *cli.Context, validatorDB iface.ValidatorDB, filter *slashingprotection.ExportFilter) (int, error) {
 	// Get the output directory where the slashing protection history file will be stored
 	outputDir, err := userprompt.InputDirectory(
 		cliCtx,
@@ -128,43 +151,57 @@ func writeToOutput(cliCtx *cli.Context, eipJSON *format.EIPSlashingProtectionFor
 	)
 
 	if err != nil {
-		return errors.Wrap(err, "could not get slashing protection json file")
+		return 0, errors.Wrap(err, "could not get slashing protection json file")
 	}
 
 	if outputDir == "" {
-		return errors.New("output directory not specified")
+		return 0, errors.New("output directory not specified")
 	}
 
 	// Check is the output directory already exists, if not, create it
 	exists, err := file.HasDir(outputDir)
 	if err != nil {
-		return errors.Wrapf(err, "could not check if output directory %s already exists", outputDir)
+		return 0, errors.Wrapf(err, "could not check if output directory %s already exists", outputDir)
 	}
 
 	if !exists {
 		if err := file.MkdirAll(outputDir); err != nil {
-			return errors.Wrapf(err, "could not create output directory %s", outputDir)
+			return 0, errors.Wrapf(err, "could not create output directory %s", outputDir)
 		}
 	}
 
-	// Write into the output file
+	// Stream into a temporary file, so that a failed export never leaves a partial output file.
 	outputFilePath := filepath.Join(outputDir, jsonExportFileName)
 	log.Infof("Writing slashing protection export JSON file to %s", outputFilePath)
 
-	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
+	tmpFilePath := outputFilePath + ".tmp"
+	f, err := os.OpenFile(filepath.Clean(tmpFilePath), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, params.BeaconIoConfig().ReadWritePermissions)
 	if err != nil {
-		return errors.Wrap(err, "could not JSON marshal slashing protection history")
+		return 0, errors.Wrapf(err, "could not create file %s", tmpFilePath)
 	}
-
-	if err := file.WriteFile(outputFilePath, encoded); err != nil {
-		return errors.Wrapf(err, "could not write file to path %s", outputFilePath)
+	w := bufio.NewWriter(f)
+	count, err := slashingprotection.StreamStandardProtectionJSON(cliCtx.Context, validatorDB, w, filter)
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if rmErr := os.Remove(tmpFilePath); rmErr != nil {
+			log.WithError(rmErr).Errorf("Could not remove %s", tmpFilePath)
+		}
+		return 0, errors.Wrap(err, "could not export slashing protection history")
+	}
+	if err := os.Rename(tmpFilePath, outputFilePath); err != nil {
+		return 0, errors.Wrapf(err, "could not write file to path %s", outputFilePath)
 	}
 
 	log.Infof(
-		"Successfully wrote %s. You can import this file using Prysm's "+
+		"Successfully wrote %s with the history of %d public keys. You can import this file using Prysm's "+
 			"validator slashing-protection-history import command in another machine",
-		outputFilePath,
+		outputFilePath, count,
 	)
 
-	return nil
+	return count, nil
 }
@@ -1,8 +1,10 @@
 package historycmd
 
 import (
-	"bytes"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
@@ -13,6 +15,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
 
@@ -22,9 +26,10 @@ import (
 // Steps:
 // 1. Parse a path to the validator's datadir from the CLI context.
 // 2. Open the validator database.
-// 3. Read the JSON file from user input.
+// 3. Open the JSON file from user input.
 // 4. Call the function which actually imports the data from
-// the standard slashing protection JSON file into our database.
+// the standard slashing protection JSON file into our database, and reports
+// what changes for every public key. Nothing is imported on a dry run.
 func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 	var (
 		valDB iface.ValidatorDB
@@ -93,21 +98,58 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 		)
 	}
 
-	// Read the JSON file from user input.
-	enc, err := file.ReadFileAsBytes(protectionFilePath)
+	// Open the JSON file from user input, it is read one public key at a time.
+	f, err := os.Open(filepath.Clean(protectionFilePath))
 	if err != nil {
 		return err
 	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close %s", protectionFilePath)
+		}
+	}()
 
 	// Import the data from the standard slashing protection JSON file into our database.
-	log.Infof("Starting import of slashing protection file %s", protectionFilePath)
-	buf := bytes.NewBuffer(enc)
+	dryRun := cliCtx.Bool(flags.SlashingProtectionDryRunFlag.Name)
+	if dryRun {
+		log.Infof("Starting dry run of the import of slashing protection file %s", protectionFilePath)
+	} else {
+		log.Infof("Starting import of slashing protection file %s", protectionFilePath)
+	}
 
-	if err := valDB.ImportStandardProtectionJSON(cliCtx.Context, buf); err != nil {
+	report, err := slashingprotection.MergeStandardProtectionJSON(cliCtx.Context, valDB, f, dryRun)
+	if err != nil {
 		return errors.Wrapf(err, "could not import slashing protection JSON file %s", protectionFilePath)
 	}
+	logMergeReport(report)
 
+	if dryRun {
+		log.Infof("Dry run complete, nothing was imported into %s", dataDir)
+		return nil
+	}
 	log.Infof("Slashing protection JSON successfully imported into %s", dataDir)
 
 	return nil
 }
+
+func logMergeReport(report *slashingprotection.MergeReport) {
+	for _, k := range report.Keys {
+		l := log.WithFields(logrus.Fields{
+			"pubkey":                k.Pubkey,
+			"newSignedBlocks":       k.NewSignedBlocks,
+			"newSignedAttestations": k.NewSignedAttestations,
+		})
+		for _, w := range k.Watermarks {
+			from := w.From
+			if from == "" {
+				from = "none"
+			}
+			l = l.WithField(w.Name, fmt.Sprintf("%s -> %s", from, w.To))
+		}
+		if len(k.Conflicts) > 0 {
+			l.WithField("conflicts", strings.Join(k.Conflicts, ", ")).Warn("Slashing protection history of public key conflicts with the database")
+			continue
+		}
+		l.Info("Slashing protection history of public key")
+	}
+}
@@ -1,6 +1,7 @@
 package historycmd
 
 import (
+	"context"
 	"encoding/json"
 	"flag"
 	"path/filepath"
@@ -12,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
 	dbTest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
 	mocks "github.com/prysmaticlabs/prysm/v5/validator/testing"
@@ -29,6 +31,9 @@ func setupCliCtx(
 	set.String(cmd.DataDirFlag.Name, dbPath, "")
 	set.String(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath, "")
 	set.String(flags.SlashingProtectionExportDirFlag.Name, outputDir, "")
+	set.Bool(flags.SlashingProtectionDryRunFlag.Name, false, "")
+	set.Var(cli.NewStringSlice(), flags.SlashingProtectionExportPubKeysFlag.Name, "")
+	set.Uint64(flags.SlashingProtectionExportSinceEpochFlag.Name, 0, "")
 	require.NoError(tb, set.Set(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath))
 	assert.NoError(tb, set.Set(cmd.DataDirFlag.Name, dbPath))
 	assert.NoError(tb, set.Set(flags.SlashingProtectionExportDirFlag.Name, outputDir))
@@ -188,3 +193,48 @@ func TestImportExportSlashingProtectionCli_EmptyData(t *testing.T) {
 		require.DeepEqual(t, make([]*format.SignedAttestation, 0), item.SignedAttestations)
 	}
 }
+
+// TestImportExportSlashingProtectionCli_DryRunAndFilter checks that a dry run imports nothing,
+// and that an export can be restricted to some public keys.
+func TestImportExportSlashingProtectionCli_DryRunAndFilter(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "slashing-exports")
+	require.NoError(t, file.MkdirAll(outputPath))
+
+	pubKeys, err := mocks.CreateRandomPubKeys(3)
+	require.NoError(t, err)
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(mockJSON)
+	require.NoError(t, err)
+	protectionFilePath := filepath.Join(outputPath, "slashing_history_import.json")
+	require.NoError(t, file.WriteFile(protectionFilePath, encoded))
+
+	validatorDB := dbTest.SetupDB(t, pubKeys, false)
+	dbPath := validatorDB.DatabasePath()
+	require.NoError(t, validatorDB.Close())
+
+	cliCtx := setupCliCtx(t, dbPath, protectionFilePath, outputPath)
+	require.NoError(t, cliCtx.Set(flags.SlashingProtectionDryRunFlag.Name, "true"))
+	require.NoError(t, importSlashingProtectionJSON(cliCtx))
+
+	validatorDB, err = kv.NewKVStore(context.Background(), dbPath, nil)
+	require.NoError(t, err)
+	proposals, err := validatorDB.ProposalHistoryForPubKey(context.Background(), pubKeys[0])
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(proposals))
+	require.NoError(t, validatorDB.Close())
+
+	cliCtx = setupCliCtx(t, dbPath, protectionFilePath, outputPath)
+	require.NoError(t, importSlashingProtectionJSON(cliCtx))
+	require.NoError(t, cliCtx.Set(flags.SlashingProtectionExportPubKeysFlag.Name, mockJSON.Data[1].Pubkey))
+	require.NoError(t, exportSlashingProtectionJSON(cliCtx))
+
+	enc, err := file.ReadFileAsBytes(filepath.Join(outputPath, jsonExportFileName))
+	require.NoError(t, err)
+	receivedJSON := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(enc, receivedJSON))
+	require.Equal(t, 1, len(receivedJSON.Data))
+	assert.Equal(t, mockJSON.Data[1].Pubkey, receivedJSON.Data[0].Pubkey)
+	assert.Equal(t, len(mockJSON.Data[1].SignedBlocks), len(receivedJSON.Data[0].SignedBlocks))
+}
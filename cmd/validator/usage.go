@@ -62,6 +62,10 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.ForceClearDB,
 			cmd.EnableBackupWebhookFlag,
 			cmd.BackupWebhookOutputDir,
+			cmd.BackupIntervalFlag,
+			cmd.BackupRetentionFlag,
+			cmd.BackupCompressFlag,
+			cmd.BackupFullEveryFlag,
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
 			cmd.TracingEndpointFlag,
@@ -126,7 +130,9 @@ var appHelpFlagGroups = []flagGroup{
 		Name: "remote signer",
 		Flags: []cli.Flag{
 			flags.Web3SignerURLFlag,
+			flags.Web3SignerAdditionalURLsFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
+			flags.ThresholdConfigFileFlag,
 		},
 	},
 	{
@@ -144,6 +150,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.DisableAccountMetricsFlag,
 			flags.EnableDistributed,
 			flags.AuthTokenPathFlag,
+			flags.DoppelgangerEpochsFlag,
+			flags.DutyHistoryRetentionFlag,
 		},
 	},
 	{
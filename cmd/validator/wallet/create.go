@@ -120,6 +120,9 @@ func ConstructCLIManagerOpts(cliCtx *cli.Context, keymanagerKind keymanager.Kind
 	if keymanagerKind == keymanager.Web3Signer {
 		return []accounts.Option{}, errors.New("web3signer keymanager does not require persistent wallets.")
 	}
+	if keymanagerKind == keymanager.Threshold {
+		return []accounts.Option{}, errors.New("threshold keymanager does not require persistent wallets.")
+	}
 	return cliOpts, nil
 }
 
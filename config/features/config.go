@@ -76,6 +76,7 @@ type Flags struct {
 	SaveInvalidBlock           bool // SaveInvalidBlock saves invalid block to temp.
 	SaveInvalidBlob            bool // SaveInvalidBlob saves invalid blob to temp.
 	EIP6110ValidatorIndexCache bool // EIP6110ValidatorIndexCache specifies whether to use the new validator index cache.
+	EnableForkChoiceSnapshots  bool // EnableForkChoiceSnapshots periodically saves fork choice to the database and restores it at startup.
 
 	// KeystoreImportDebounceInterval specifies the time duration the validator waits to reload new keys if they have
 	// changed on disk. This feature is for advanced use cases only.
@@ -259,6 +260,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(eip6110ValidatorCache)
 		cfg.EIP6110ValidatorIndexCache = true
 	}
+	if ctx.IsSet(enableForkChoiceSnapshots.Name) {
+		logEnabled(enableForkChoiceSnapshots)
+		cfg.EnableForkChoiceSnapshots = true
+	}
 
 	cfg.AggregateIntervals = [3]time.Duration{aggregateFirstInterval.Value, aggregateSecondInterval.Value, aggregateThirdInterval.Value}
 	Init(cfg)
@@ -103,7 +103,8 @@ var (
 	}
 	enableDoppelGangerProtection = &cli.BoolFlag{
 		Name: "enable-doppelganger",
-		Usage: `Enables the validator to perform a doppelganger check. 
+		Usage: `Enables the validator to perform a doppelganger check. Every validating key, whether loaded at startup,
+		imported or discovered on a remote signer, only signs once it was not seen live in the network for --doppelganger-epochs.
 		This is not a foolproof method to find duplicate instances in the network. 
 		Your validator will still be vulnerable if it is being run in unsafe configurations.`,
 	}
@@ -171,6 +172,10 @@ var (
 		Name:  "eip6110-validator-cache",
 		Usage: "Enables the EIP-6110 validator cache.",
 	}
+	enableForkChoiceSnapshots = &cli.BoolFlag{
+		Name:  "enable-forkchoice-snapshots",
+		Usage: "Periodically saves fork choice to the database and restores it at startup instead of rebuilding it from the finalized checkpoint.",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -229,6 +234,7 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	BlobSaveFsync,
 	EnableQUIC,
 	eip6110ValidatorCache,
+	enableForkChoiceSnapshots,
 }...)...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -78,10 +78,13 @@ func verifyOption(key string, option *validatorpb.ProposerOptionPayload) error {
 
 // BuilderConfig is the struct representation of the JSON config file set in the validator through the CLI.
 // GasLimit is a number set to help the network decide on the maximum gas in each block.
+// BoostFactor is the builder boost factor sent with block requests, a percentage applied to the builder
+// bid when the beacon node compares it to the local payload. The beacon node default is used when unset.
 type BuilderConfig struct {
-	Enabled  bool             `json:"enabled" yaml:"enabled"`
-	GasLimit validator.Uint64 `json:"gas_limit,omitempty" yaml:"gas_limit,omitempty"`
-	Relays   []string         `json:"relays,omitempty" yaml:"relays,omitempty"`
+	Enabled     bool             `json:"enabled" yaml:"enabled"`
+	GasLimit    validator.Uint64 `json:"gas_limit,omitempty" yaml:"gas_limit,omitempty"`
+	Relays      []string         `json:"relays,omitempty" yaml:"relays,omitempty"`
+	BoostFactor *uint64          `json:"boost_factor,omitempty" yaml:"boost_factor,omitempty"`
 }
 
 // BuilderConfigFromConsensus converts protobuf to a builder config used in in-memory storage
@@ -98,6 +101,10 @@ func BuilderConfigFromConsensus(from *validatorpb.BuilderConfig) *BuilderConfig
 		copy(relays, from.Relays)
 		c.Relays = relays
 	}
+	if from.BoostFactor != nil {
+		f := *from.BoostFactor
+		c.BoostFactor = &f
+	}
 	return c
 }
 
@@ -230,6 +237,10 @@ func (bc *BuilderConfig) Clone() *BuilderConfig {
 		copy(relays, bc.Relays)
 		c.Relays = relays
 	}
+	if bc.BoostFactor != nil {
+		f := *bc.BoostFactor
+		c.BoostFactor = &f
+	}
 	return c
 }
 
@@ -255,5 +266,9 @@ func (bc *BuilderConfig) ToConsensus() *validatorpb.BuilderConfig {
 		c.Relays = relays
 	}
 	c.GasLimit = bc.GasLimit
+	if bc.BoostFactor != nil {
+		f := *bc.BoostFactor
+		c.BoostFactor = &f
+	}
 	return c
 }
@@ -16,6 +16,7 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 	key1hex := "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
 	key1, err := hexutil.Decode(key1hex)
 	require.NoError(t, err)
+	boostFactor := uint64(150)
 	settings := &Settings{
 		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*Option{
 			bytesutil.ToBytes48(key1): {
@@ -23,9 +24,10 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 					FeeRecipient: common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"),
 				},
 				BuilderConfig: &BuilderConfig{
-					Enabled:  true,
-					GasLimit: validator.Uint64(40000000),
-					Relays:   []string{"https://example-relay.com"},
+					Enabled:     true,
+					GasLimit:    validator.Uint64(40000000),
+					Relays:      []string{"https://example-relay.com"},
+					BoostFactor: &boostFactor,
 				},
 			},
 		},
@@ -66,6 +68,17 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 		require.Equal(t, config.Enabled, clone.Enabled)
 		require.Equal(t, config.GasLimit, clone.GasLimit)
 	})
+	t.Run("Builder boost factor", func(t *testing.T) {
+		config := settings.ProposeConfig[bytesutil.ToBytes48(key1)].BuilderConfig
+		clone := config.Clone()
+		require.DeepEqual(t, config, clone)
+		*clone.BoostFactor = 10
+		require.Equal(t, uint64(150), *config.BoostFactor)
+		payload := config.ToConsensus()
+		require.Equal(t, uint64(150), payload.GetBoostFactor())
+		require.DeepEqual(t, config, BuilderConfigFromConsensus(payload))
+		require.Equal(t, (*uint64)(nil), BuilderConfigFromConsensus(settings.DefaultConfig.BuilderConfig.ToConsensus()).BoostFactor)
+	})
 	t.Run("To Payload and SettingFromConsensus", func(t *testing.T) {
 		payload := settings.ToConsensus()
 		option, ok := settings.ProposeConfig[bytesutil.ToBytes48(key1)]
@@ -12,11 +12,12 @@ import (
 )
 
 const (
-	bodyLength    = 12 // The number of elements in the BeaconBlockBody Container
-	logBodyLength = 4  // The log 2 of bodyLength
-	kzgPosition   = 11 // The index of the KZG commitment list in the Body
-	kzgRootIndex  = 54 // The Merkle index of the KZG commitment list's root in the Body's Merkle tree
-	KZGOffset     = kzgRootIndex * field_params.MaxBlobCommitmentsPerBlock
+	bodyLength      = 12 // The number of elements in the BeaconBlockBody Container
+	logBodyLength   = 4  // The log 2 of bodyLength
+	kzgPosition     = 11 // The index of the KZG commitment list in the Body
+	payloadPosition = 9  // The index of the execution payload in the Body
+	kzgRootIndex    = 54 // The Merkle index of the KZG commitment list's root in the Body's Merkle tree
+	KZGOffset       = kzgRootIndex * field_params.MaxBlobCommitmentsPerBlock
 )
 
 var (
@@ -80,6 +81,42 @@ func MerkleProofKZGCommitment(body interfaces.ReadOnlyBeaconBlockBody, index int
 	return proof, nil
 }
 
+// PayloadProof constructs a Merkle proof of inclusion of the execution payload
+// into the Beacon Block with the given `body`
+func PayloadProof(body interfaces.ReadOnlyBeaconBlockBody) ([][]byte, error) {
+	bodyVersion := body.Version()
+	if bodyVersion < version.Capella || bodyVersion > version.Deneb {
+		return nil, errUnsupportedBeaconBlockBody
+	}
+	membersRoots, err := topLevelRoots(body)
+	if err != nil {
+		return nil, err
+	}
+	if bodyVersion >= version.Deneb {
+		// Unlike for the KZG commitment proofs, the root of the KZG commitment list is part of this proof.
+		commitments, err := body.BlobKzgCommitments()
+		if err != nil {
+			return nil, err
+		}
+		root, err := commitmentsRoot(commitments)
+		if err != nil {
+			return nil, err
+		}
+		copy(membersRoots[kzgPosition], root[:])
+	}
+	sparse, err := trie.GenerateTrieFromItems(membersRoots, logBodyLength)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := sparse.MerkleProof(payloadPosition)
+	if err != nil {
+		return nil, err
+	}
+	// sparse.MerkleProof always includes the length of the slice this is
+	// why we remove the last element that is not needed in the proof
+	return proof[:len(proof)-1], nil
+}
+
 // leavesFromCommitments hashes each commitment to construct a slice of roots
 func leavesFromCommitments(commitments [][]byte) [][]byte {
 	leaves := make([][]byte, len(commitments))
@@ -91,6 +128,11 @@ func leavesFromCommitments(commitments [][]byte) [][]byte {
 	return leaves
 }
 
+// commitmentsRoot computes the root of the KZG commitment list.
+func commitmentsRoot(commitments [][]byte) ([32]byte, error) {
+	return ssz.ByteArrayRootWithLimit(leavesFromCommitments(commitments), field_params.MaxBlobCommitmentsPerBlock)
+}
+
 // makeChunk constructs a chunk from a KZG commitment.
 func makeChunk(commitment []byte) [][32]byte {
 	chunk := make([][32]byte, 2)
@@ -104,6 +104,82 @@ func Test_MerkleProofKZGCommitment(t *testing.T) {
 	require.Equal(t, true, trie.VerifyMerkleProof(root[:], chunk[0][:], uint64(index+KZGOffset), proof))
 }
 
+func Test_PayloadProof(t *testing.T) {
+	payload := func() *enginev1.ExecutionPayloadDeneb {
+		return &enginev1.ExecutionPayloadDeneb{
+			ParentHash:    make([]byte, fieldparams.RootLength),
+			FeeRecipient:  make([]byte, 20),
+			StateRoot:     make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+			LogsBloom:     make([]byte, 256),
+			PrevRandao:    make([]byte, fieldparams.RootLength),
+			BaseFeePerGas: make([]byte, fieldparams.RootLength),
+			BlockHash:     make([]byte, fieldparams.RootLength),
+			Transactions:  make([][]byte, 0),
+			ExtraData:     make([]byte, 0),
+			BlockNumber:   10,
+		}
+	}
+	syncAggregate := &ethpb.SyncAggregate{
+		SyncCommitteeBits:      make([]byte, fieldparams.SyncAggregateSyncCommitteeBytesLength),
+		SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+	}
+	eth1Data := &ethpb.Eth1Data{
+		DepositRoot: make([]byte, fieldparams.RootLength),
+		BlockHash:   make([]byte, fieldparams.RootLength),
+	}
+	kzg := make([]byte, 48)
+	_, err := rand.Read(kzg)
+	require.NoError(t, err)
+	capellaPayload := payload()
+	bodies := map[string]interface{}{
+		"capella": &ethpb.BeaconBlockBodyCapella{
+			SyncAggregate: syncAggregate,
+			Eth1Data:      eth1Data,
+			ExecutionPayload: &enginev1.ExecutionPayloadCapella{
+				ParentHash:    capellaPayload.ParentHash,
+				FeeRecipient:  capellaPayload.FeeRecipient,
+				StateRoot:     capellaPayload.StateRoot,
+				ReceiptsRoot:  capellaPayload.ReceiptsRoot,
+				LogsBloom:     capellaPayload.LogsBloom,
+				PrevRandao:    capellaPayload.PrevRandao,
+				BaseFeePerGas: capellaPayload.BaseFeePerGas,
+				BlockHash:     capellaPayload.BlockHash,
+				Transactions:  capellaPayload.Transactions,
+				ExtraData:     capellaPayload.ExtraData,
+			},
+		},
+		"deneb": &ethpb.BeaconBlockBodyDeneb{
+			SyncAggregate:      syncAggregate,
+			Eth1Data:           eth1Data,
+			ExecutionPayload:   payload(),
+			BlobKzgCommitments: [][]byte{kzg},
+		},
+	}
+	for name, pb := range bodies {
+		t.Run(name, func(t *testing.T) {
+			body, err := NewBeaconBlockBody(pb)
+			require.NoError(t, err)
+			proof, err := PayloadProof(body)
+			require.NoError(t, err)
+			require.Equal(t, logBodyLength, len(proof))
+
+			root, err := body.HashTreeRoot()
+			require.NoError(t, err)
+			execution, err := body.Execution()
+			require.NoError(t, err)
+			payloadRoot, err := execution.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, true, trie.VerifyMerkleProof(root[:], payloadRoot[:], payloadPosition, proof))
+		})
+	}
+
+	body, err := NewBeaconBlockBody(&ethpb.BeaconBlockBodyAltair{})
+	require.NoError(t, err)
+	_, err = PayloadProof(body)
+	require.ErrorIs(t, errUnsupportedBeaconBlockBody, err)
+}
+
 // This test explains the calculation of the KZG commitment root's Merkle index
 // in the Body's Merkle tree based on the index of the KZG commitment list in the Body.
 func Test_KZGRootIndex(t *testing.T) {
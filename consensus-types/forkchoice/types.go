@@ -52,3 +52,72 @@ type Node struct {
 	ParentRoot               []byte
 	ExecutionBlockHash       []byte
 }
+
+// Explanation breaks down the weight of a block in fork choice and whether it can become head.
+type Explanation struct {
+	BlockRoot                []byte
+	ParentRoot               []byte
+	Slot                     primitives.Slot
+	Weight                   uint64
+	Balance                  uint64
+	VoteBalance              uint64 // balance of the latest votes for this block, as last accounted.
+	Votes                    uint64
+	PendingVoteBalance       uint64 // balance of the new latest votes for this block, accounted at the next head computation.
+	PendingVotes             uint64
+	SlashedVotes             uint64 // latest votes of equivocating validators, which carry no weight.
+	ChildrenWeight           uint64
+	Children                 []*ChildWeight
+	ProposerBoost            uint64 // proposer boost applied to this block.
+	DescendantProposerBoost  uint64 // proposer boost included in the weight through a descendant.
+	CommitteeWeight          uint64
+	JustifiedEpoch           primitives.Epoch
+	UnrealizedJustifiedEpoch primitives.Epoch
+	FinalizedEpoch           primitives.Epoch
+	UnrealizedFinalizedEpoch primitives.Epoch
+	JustifiedCheckpoint      *eth.Checkpoint
+	FinalizedCheckpoint      *eth.Checkpoint
+	ViableForHead            bool
+	LeadsToViableHead        bool
+	Canonical                bool
+	Head                     bool
+	BestDescendant           []byte
+	ExecutionOptimistic      bool
+	Timestamp                uint64
+	ArrivedEarly             bool
+	WeakHead                 bool // weight under the reorg weight threshold of the committee weight.
+	StrongParent             bool // weight above the reorg parent weight threshold of the committee weight.
+}
+
+type ChildWeight struct {
+	BlockRoot []byte
+	Weight    uint64
+}
+
+// Simulation describes hypothetical blocks and attestations to apply to a copy of fork choice.
+type Simulation struct {
+	Blocks       []*SimulatedBlock
+	Attestations []*SimulatedAttestation
+}
+
+// SimulatedBlock is a hypothetical block. A block without justified or finalized epochs inherits the ones of its parent.
+type SimulatedBlock struct {
+	Slot           primitives.Slot
+	BlockRoot      [32]byte
+	ParentRoot     [32]byte
+	JustifiedEpoch *primitives.Epoch
+	FinalizedEpoch *primitives.Epoch
+	ProposerBoost  bool
+}
+
+// SimulatedAttestation is a hypothetical latest vote of validators.
+type SimulatedAttestation struct {
+	ValidatorIndices []uint64
+	BlockRoot        [32]byte
+	TargetEpoch      primitives.Epoch
+}
+
+// SimulationResult is the head of fork choice before and after a simulation.
+type SimulationResult struct {
+	PreviousHeadRoot []byte
+	Head             *Explanation
+}
@@ -76,6 +76,23 @@ func NewAggregateSignature() common.Signature {
 	return blst.NewAggregateSignature()
 }
 
+// SplitSecretKey splits a secret key into n key shares with indices 1 to n, any threshold of which can recover its
+// signatures.
+func SplitSecretKey(secretKey SecretKey, threshold, n uint64) ([]SecretKey, error) {
+	return blst.SplitSecretKey(secretKey, threshold, n)
+}
+
+// RecoverSignature recovers a signature from the signatures of a threshold of key shares with Lagrange interpolation.
+func RecoverSignature(partials []common.Signature, indices []uint64) (common.Signature, error) {
+	return blst.RecoverSignature(partials, indices)
+}
+
+// RecoverPublicKey recovers a public key from the public keys of a threshold of key shares with Lagrange
+// interpolation.
+func RecoverPublicKey(partials []common.PublicKey, indices []uint64) (common.PublicKey, error) {
+	return blst.RecoverPublicKey(partials, indices)
+}
+
 // RandKey creates a new private key using a random input.
 func RandKey() (common.SecretKey, error) {
 	return blst.RandKey()
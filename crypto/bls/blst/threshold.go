@@ -0,0 +1,161 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+
+package blst
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls/common"
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// SplitSecretKey splits a secret key into n key shares with Shamir secret sharing, so that any threshold of them
+// can recover signatures of the secret key. The share at position i of the result has the index i+1, which is the
+// point at which the sharing polynomial is evaluated.
+func SplitSecretKey(secretKey common.SecretKey, threshold, n uint64) ([]common.SecretKey, error) {
+	if threshold == 0 || threshold > n {
+		return nil, fmt.Errorf("threshold must be between 1 and the number of shares %d, got %d", n, threshold)
+	}
+	// The coefficients of the polynomial of degree threshold-1 whose value at 0 is the secret key.
+	coefficients := make([]*blst.Scalar, threshold)
+	coefficients[0] = new(blst.Scalar).Deserialize(secretKey.Marshal())
+	if coefficients[0] == nil {
+		return nil, common.ErrSecretUnmarshal
+	}
+	for i := uint64(1); i < threshold; i++ {
+		var ikm [32]byte
+		if _, err := rand.NewGenerator().Read(ikm[:]); err != nil {
+			return nil, err
+		}
+		coefficients[i] = blst.KeyGen(ikm[:])
+	}
+
+	shares := make([]common.SecretKey, n)
+	for i := uint64(0); i < n; i++ {
+		x, err := indexScalar(i + 1)
+		if err != nil {
+			return nil, err
+		}
+		// Evaluate the polynomial at x with Horner's method.
+		share := *coefficients[threshold-1]
+		for j := int(threshold) - 2; j >= 0; j-- {
+			product, _ := share.Mul(x)
+			sum, _ := product.Add(coefficients[j])
+			share = *sum
+		}
+		shares[i], err = SecretKeyFromBytes(share.Serialize())
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create key share %d", i+1)
+		}
+	}
+	return shares, nil
+}
+
+// RecoverSignature recovers the signature of a secret key from the signatures of the same message by at least a
+// threshold of its key shares, where indices holds the index of the key share of each partial signature.
+func RecoverSignature(partials []common.Signature, indices []uint64) (common.Signature, error) {
+	coefficients, err := lagrangeCoefficients(indices)
+	if err != nil {
+		return nil, err
+	}
+	if len(partials) != len(coefficients) {
+		return nil, fmt.Errorf("got %d partial signatures for %d indices", len(partials), len(coefficients))
+	}
+	recovered := new(blst.P2)
+	for i, partial := range partials {
+		sig, ok := partial.(*Signature)
+		if !ok {
+			return nil, errors.New("could not convert partial signature")
+		}
+		var point blst.P2
+		point.FromAffine(sig.s)
+		recovered.AddAssign(point.MultAssign(coefficients[i]))
+	}
+	return &Signature{s: recovered.ToAffine()}, nil
+}
+
+// RecoverPublicKey recovers the public key of a secret key from the public keys of at least a threshold of its key
+// shares, where indices holds the index of each key share.
+func RecoverPublicKey(partials []common.PublicKey, indices []uint64) (common.PublicKey, error) {
+	coefficients, err := lagrangeCoefficients(indices)
+	if err != nil {
+		return nil, err
+	}
+	if len(partials) != len(coefficients) {
+		return nil, fmt.Errorf("got %d partial public keys for %d indices", len(partials), len(coefficients))
+	}
+	recovered := new(blst.P1)
+	for i, partial := range partials {
+		pubKey, ok := partial.(*PublicKey)
+		if !ok {
+			return nil, errors.New("could not convert partial public key")
+		}
+		var point blst.P1
+		point.FromAffine(pubKey.p)
+		recovered.AddAssign(point.MultAssign(coefficients[i]))
+	}
+	return &PublicKey{p: recovered.ToAffine()}, nil
+}
+
+// lagrangeCoefficients returns the coefficients which interpolate the values of a polynomial at the given indices
+// into its value at 0.
+func lagrangeCoefficients(indices []uint64) ([]*blst.Scalar, error) {
+	if len(indices) == 0 {
+		return nil, errors.New("no indices to interpolate")
+	}
+	xs := make([]*blst.Scalar, len(indices))
+	seen := make(map[uint64]bool, len(indices))
+	for i, index := range indices {
+		if seen[index] {
+			return nil, fmt.Errorf("duplicate index %d", index)
+		}
+		seen[index] = true
+		x, err := indexScalar(index)
+		if err != nil {
+			return nil, err
+		}
+		xs[i] = x
+	}
+	coefficients := make([]*blst.Scalar, len(indices))
+	for i := range xs {
+		numerator, err := indexScalar(1)
+		if err != nil {
+			return nil, err
+		}
+		denominator, err := indexScalar(1)
+		if err != nil {
+			return nil, err
+		}
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			// The coefficient of the index i is the product of x_j / (x_j - x_i) over all other indices j.
+			difference, ok := xs[j].Sub(xs[i])
+			if !ok {
+				return nil, fmt.Errorf("indices %d and %d are equal in the scalar field", indices[i], indices[j])
+			}
+			numerator, _ = numerator.Mul(xs[j])
+			denominator, _ = denominator.Mul(difference)
+		}
+		coefficients[i], _ = numerator.Mul(denominator.Inverse())
+	}
+	return coefficients, nil
+}
+
+// indexScalar converts the index of a key share into a scalar. Index 0 is the secret key itself and not a share.
+func indexScalar(index uint64) (*blst.Scalar, error) {
+	if index == 0 {
+		return nil, errors.New("key share index must be positive")
+	}
+	var b [scalarBytes]byte
+	binary.BigEndian.PutUint64(b[scalarBytes-8:], index)
+	x := new(blst.Scalar).Deserialize(b[:])
+	if x == nil {
+		return nil, fmt.Errorf("could not convert index %d to scalar", index)
+	}
+	return x, nil
+}
@@ -0,0 +1,68 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+
+package blst
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls/common"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSplitSecretKey_RecoverSignature(t *testing.T) {
+	secretKey, err := RandKey()
+	require.NoError(t, err)
+	shares, err := SplitSecretKey(secretKey, 3, 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(shares))
+	msg := []byte("message")
+	expected := secretKey.Sign(msg)
+
+	for _, indices := range [][]uint64{{1, 2, 3}, {5, 3, 1}, {2, 4, 5}, {1, 2, 3, 4, 5}} {
+		partials := make([]common.Signature, len(indices))
+		pubKeys := make([]common.PublicKey, len(indices))
+		for i, index := range indices {
+			partials[i] = shares[index-1].Sign(msg)
+			pubKeys[i] = shares[index-1].PublicKey()
+		}
+		sig, err := RecoverSignature(partials, indices)
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected.Marshal(), sig.Marshal())
+		assert.Equal(t, true, sig.Verify(secretKey.PublicKey(), msg))
+		pubKey, err := RecoverPublicKey(pubKeys, indices)
+		require.NoError(t, err)
+		assert.Equal(t, true, pubKey.Equals(secretKey.PublicKey()))
+	}
+
+	// Less than a threshold of partial signatures recovers a different signature.
+	sig, err := RecoverSignature([]common.Signature{shares[0].Sign(msg), shares[1].Sign(msg)}, []uint64{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, false, sig.Verify(secretKey.PublicKey(), msg))
+}
+
+func TestSplitSecretKey_InvalidThreshold(t *testing.T) {
+	secretKey, err := RandKey()
+	require.NoError(t, err)
+	_, err = SplitSecretKey(secretKey, 0, 3)
+	assert.ErrorContains(t, "threshold must be between 1", err)
+	_, err = SplitSecretKey(secretKey, 4, 3)
+	assert.ErrorContains(t, "threshold must be between 1", err)
+	shares, err := SplitSecretKey(secretKey, 1, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, secretKey.Marshal(), shares[1].Marshal())
+}
+
+func TestRecoverSignature_InvalidIndices(t *testing.T) {
+	secretKey, err := RandKey()
+	require.NoError(t, err)
+	sig := secretKey.Sign([]byte("message"))
+	_, err = RecoverSignature([]common.Signature{sig, sig}, []uint64{1, 1})
+	assert.ErrorContains(t, "duplicate index 1", err)
+	_, err = RecoverSignature([]common.Signature{sig}, []uint64{0})
+	assert.ErrorContains(t, "index must be positive", err)
+	_, err = RecoverSignature([]common.Signature{sig}, []uint64{1, 2})
+	assert.ErrorContains(t, "got 1 partial signatures for 2 indices", err)
+	_, err = RecoverSignature(nil, nil)
+	assert.ErrorContains(t, "no indices", err)
+}
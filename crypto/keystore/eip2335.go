@@ -0,0 +1,281 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"unicode/utf8"
+
+	"github.com/minio/sha256-simd"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// ScryptKDF is the scrypt key derivation function of EIP-2335 keystores.
+	ScryptKDF = "scrypt"
+	// PBKDF2KDF is the PBKDF2 key derivation function of EIP-2335 keystores.
+	PBKDF2KDF = "pbkdf2"
+	// Argon2idKDF is the argon2id key derivation function. EIP-2335 does not define it, so keystores encrypted with
+	// it can only be decrypted by DecryptEIP2335, not by other clients.
+	Argon2idKDF = "argon2id"
+	// DefaultKDFCost is the default cost of the key derivation function of EIP-2335 keystores, which is the n
+	// parameter of scrypt, the c parameter of PBKDF2 and the memory in KiB of argon2id.
+	DefaultKDFCost = 262144
+	// EIP2335Version is the version of EIP-2335 keystores. It is the only version defined by the EIP.
+	EIP2335Version = 4
+
+	eip2335KeyLen  = 32
+	eip2335ScryptR = 8
+	eip2335ScryptP = 1
+	eip2335PRF     = "hmac-sha256"
+	// The time and parallelism parameters of argon2id are those recommended by RFC 9106, its cost is the memory.
+	eip2335Argon2T = 3
+	eip2335Argon2P = 4
+	// The argon2id parameters of a keystore are bounded before deriving its key, so that a crafted keystore cannot
+	// exhaust the memory or the CPU of the node decrypting it. The memory is bounded to 4 GiB.
+	eip2335Argon2MaxM      = 1 << 22
+	eip2335Argon2MaxT      = 16
+	eip2335Argon2MaxP      = 16
+	eip2335Argon2MaxKeyLen = 64
+	// eip2335ChecksumErr is the error returned when the checksum of a keystore does not match, which usually means
+	// that the password is wrong. It is the error message of the keystore decryptor.
+	eip2335ChecksumErr = "invalid checksum"
+)
+
+// KDFParams defines the key derivation function used to encrypt an EIP-2335 keystore and its cost.
+type KDFParams struct {
+	Function string
+	Cost     int
+}
+
+// DefaultKDFParams returns the key derivation function used by default to encrypt EIP-2335 keystores.
+func DefaultKDFParams() *KDFParams {
+	return &KDFParams{Function: PBKDF2KDF, Cost: DefaultKDFCost}
+}
+
+// Validate checks that the key derivation function is supported and that its cost is valid for it.
+func (p *KDFParams) Validate() error {
+	if p == nil {
+		return errors.New("nil KDF parameters")
+	}
+	switch p.Function {
+	case ScryptKDF:
+		if p.Cost < 2 || bits.OnesCount(uint(p.Cost)) != 1 {
+			return fmt.Errorf("scrypt cost must be a power of two greater than 1, got %d", p.Cost)
+		}
+	case PBKDF2KDF:
+		if p.Cost < 1 {
+			return fmt.Errorf("pbkdf2 cost must be positive, got %d", p.Cost)
+		}
+	case Argon2idKDF:
+		if p.Cost < 8*eip2335Argon2P || p.Cost > eip2335Argon2MaxM {
+			return fmt.Errorf("argon2id cost must be between %d and %d KiB, got %d", 8*eip2335Argon2P, eip2335Argon2MaxM, p.Cost)
+		}
+	default:
+		return fmt.Errorf("unsupported KDF %q, expected %s, %s or %s", p.Function, ScryptKDF, PBKDF2KDF, Argon2idKDF)
+	}
+	return nil
+}
+
+type eip2335Module struct {
+	Function string                 `json:"function"`
+	Params   map[string]interface{} `json:"params"`
+	Message  string                 `json:"message"`
+}
+
+type eip2335Crypto struct {
+	KDF      eip2335Module `json:"kdf"`
+	Checksum eip2335Module `json:"checksum"`
+	Cipher   eip2335Module `json:"cipher"`
+}
+
+// EncryptEIP2335 encrypts a secret with a password into the crypto module of an EIP-2335 keystore, deriving the
+// encryption key with the given key derivation function.
+func EncryptEIP2335(secret []byte, password string, params *KDFParams) (map[string]interface{}, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("no secret to encrypt")
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	normalized := normalizeEIP2335Password(password)
+	kdf := eip2335Module{Function: params.Function, Params: map[string]interface{}{
+		"dklen": eip2335KeyLen,
+		"salt":  hex.EncodeToString(salt),
+	}}
+	var decryptionKey []byte
+	switch params.Function {
+	case ScryptKDF:
+		var err error
+		decryptionKey, err = scrypt.Key(normalized, salt, params.Cost, eip2335ScryptR, eip2335ScryptP, eip2335KeyLen)
+		if err != nil {
+			return nil, fmt.Errorf("could not derive key with scrypt: %w", err)
+		}
+		kdf.Params["n"] = params.Cost
+		kdf.Params["r"] = eip2335ScryptR
+		kdf.Params["p"] = eip2335ScryptP
+	case PBKDF2KDF:
+		decryptionKey = pbkdf2.Key(normalized, salt, params.Cost, eip2335KeyLen, sha256.New)
+		kdf.Params["c"] = params.Cost
+		kdf.Params["prf"] = eip2335PRF
+	case Argon2idKDF:
+		decryptionKey = argon2.IDKey(normalized, salt, eip2335Argon2T, uint32(params.Cost), eip2335Argon2P, eip2335KeyLen)
+		kdf.Params["m"] = params.Cost
+		kdf.Params["t"] = eip2335Argon2T
+		kdf.Params["p"] = eip2335Argon2P
+	}
+
+	cipherText, err := aesCTRXOR(decryptionKey[:16], secret, iv)
+	if err != nil {
+		return nil, err
+	}
+	checksum := sha256.Sum256(append(decryptionKey[16:32:32], cipherText...))
+
+	// Go through JSON to return the same generic representation as a decoded keystore.
+	encoded, err := json.Marshal(&eip2335Crypto{
+		KDF:      kdf,
+		Checksum: eip2335Module{Function: "sha256", Params: map[string]interface{}{}, Message: hex.EncodeToString(checksum[:])},
+		Cipher: eip2335Module{Function: "aes-128-ctr", Params: map[string]interface{}{
+			"iv": hex.EncodeToString(iv),
+		}, Message: hex.EncodeToString(cipherText)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	cryptoFields := make(map[string]interface{})
+	if err := json.Unmarshal(encoded, &cryptoFields); err != nil {
+		return nil, err
+	}
+	return cryptoFields, nil
+}
+
+// DecryptEIP2335 decrypts the crypto module of an EIP-2335 keystore with a password, whatever its key derivation
+// function and cost.
+func DecryptEIP2335(cryptoFields map[string]interface{}, password string) ([]byte, error) {
+	if kdf, ok := cryptoFields["kdf"].(map[string]interface{}); ok && kdf["function"] == Argon2idKDF {
+		return decryptArgon2id(cryptoFields, password)
+	}
+	return keystorev4.New().Decrypt(cryptoFields, password)
+}
+
+// decryptArgon2id decrypts the crypto module of a keystore encrypted with an argon2id key, which the keystore
+// decryptor does not support.
+func decryptArgon2id(cryptoFields map[string]interface{}, password string) ([]byte, error) {
+	encoded, err := json.Marshal(cryptoFields)
+	if err != nil {
+		return nil, err
+	}
+	c := &eip2335Crypto{}
+	if err := json.Unmarshal(encoded, c); err != nil {
+		return nil, fmt.Errorf("could not decode keystore crypto module: %w", err)
+	}
+	salt, err := hex.DecodeString(stringParam(c.KDF.Params, "salt"))
+	if err != nil {
+		return nil, errors.New("invalid KDF salt")
+	}
+	m, t, p, dkLen := uintParam(c.KDF.Params, "m"), uintParam(c.KDF.Params, "t"), uintParam(c.KDF.Params, "p"), uintParam(c.KDF.Params, "dklen")
+	if m == 0 || m > eip2335Argon2MaxM || t == 0 || t > eip2335Argon2MaxT || p == 0 || p > eip2335Argon2MaxP ||
+		dkLen < eip2335KeyLen || dkLen > eip2335Argon2MaxKeyLen {
+		return nil, errors.New("invalid KDF parameters")
+	}
+	if c.Cipher.Function != "aes-128-ctr" {
+		return nil, fmt.Errorf("unsupported cipher %q", c.Cipher.Function)
+	}
+	cipherText, err := hex.DecodeString(c.Cipher.Message)
+	if err != nil {
+		return nil, errors.New("invalid cipher message")
+	}
+	iv, err := hex.DecodeString(stringParam(c.Cipher.Params, "iv"))
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, errors.New("invalid IV")
+	}
+	checksum, err := hex.DecodeString(c.Checksum.Message)
+	if err != nil {
+		return nil, errors.New("invalid checksum message")
+	}
+
+	decryptionKey := argon2.IDKey(normalizeEIP2335Password(password), salt, t, m, uint8(p), dkLen)
+	expected := sha256.Sum256(append(decryptionKey[16:32:32], cipherText...))
+	if subtle.ConstantTimeCompare(expected[:], checksum) != 1 {
+		return nil, errors.New(eip2335ChecksumErr)
+	}
+	return aesCTRXOR(decryptionKey[:16], cipherText, iv)
+}
+
+func stringParam(params map[string]interface{}, name string) string {
+	v, _ := params[name].(string)
+	return v
+}
+
+// uintParam returns the numeric parameter, or 0 if it is missing or not a 32 bits unsigned integer.
+func uintParam(params map[string]interface{}, name string) uint32 {
+	v, ok := params[name].(float64)
+	if !ok || v < 0 || v > math.MaxUint32 || v != math.Trunc(v) {
+		return 0
+	}
+	return uint32(v)
+}
+
+// KDFParamsOf returns the key derivation function and cost of the crypto module of an EIP-2335 keystore.
+func KDFParamsOf(cryptoFields map[string]interface{}) (*KDFParams, error) {
+	kdf, ok := cryptoFields["kdf"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("keystore has no kdf module")
+	}
+	function, ok := kdf["function"].(string)
+	if !ok {
+		return nil, errors.New("keystore has no kdf function")
+	}
+	params, ok := kdf["params"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("keystore has no kdf params")
+	}
+	costParam := "c"
+	switch function {
+	case ScryptKDF:
+		costParam = "n"
+	case Argon2idKDF:
+		costParam = "m"
+	}
+	cost, ok := params[costParam].(float64)
+	if !ok {
+		return nil, fmt.Errorf("keystore has no numeric %s kdf parameter", costParam)
+	}
+	return &KDFParams{Function: function, Cost: int(cost)}, nil
+}
+
+// normalizeEIP2335Password normalizes a password with NFKD and strips its control characters. It normalizes exactly as
+// the keystore decryptor does, which keeps only the first rune of every normalized segment, so that the keystores
+// written here can always be decrypted.
+func normalizeEIP2335Password(password string) []byte {
+	var normalized []byte
+	iter := &norm.Iter{}
+	iter.InitString(norm.NFKD, password)
+	for !iter.Done() {
+		r, _ := utf8.DecodeRune(iter.Next())
+		if r < 0x20 || r == 0x7f {
+			continue
+		}
+		normalized = norm.NFKD.Append(normalized, []byte(string(r))...)
+	}
+	return normalized
+}
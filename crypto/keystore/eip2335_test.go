@@ -0,0 +1,114 @@
+package keystore
+
+import (
+	"math"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestEncryptEIP2335(t *testing.T) {
+	secret := []byte("a secret to encrypt in a keystore")
+	for _, params := range []*KDFParams{
+		{Function: ScryptKDF, Cost: 1024},
+		{Function: PBKDF2KDF, Cost: 1000},
+		{Function: Argon2idKDF, Cost: 64},
+	} {
+		t.Run(params.Function, func(t *testing.T) {
+			cryptoFields, err := EncryptEIP2335(secret, "password", params)
+			require.NoError(t, err)
+
+			got, err := KDFParamsOf(cryptoFields)
+			require.NoError(t, err)
+			assert.DeepEqual(t, params, got)
+
+			// The keystore must be readable by the reference decryptor, unless its KDF is not defined by EIP-2335.
+			decrypted, err := keystorev4.New().Decrypt(cryptoFields, "password")
+			if params.Function == Argon2idKDF {
+				require.ErrorContains(t, "unsupported KDF", err)
+			} else {
+				require.NoError(t, err)
+				assert.DeepEqual(t, secret, decrypted)
+			}
+			decrypted, err = DecryptEIP2335(cryptoFields, "password")
+			require.NoError(t, err)
+			assert.DeepEqual(t, secret, decrypted)
+
+			_, err = DecryptEIP2335(cryptoFields, "wrong password")
+			assert.ErrorContains(t, "invalid checksum", err)
+		})
+	}
+}
+
+func TestEncryptEIP2335_NormalizesPassword(t *testing.T) {
+	secret := []byte("secret")
+	// Control characters are stripped and the password is NFKD normalized.
+	cryptoFields, err := EncryptEIP2335(secret, "pass\x7fwordÅ", &KDFParams{Function: PBKDF2KDF, Cost: 1})
+	require.NoError(t, err)
+	decrypted, err := keystorev4.New().Decrypt(cryptoFields, "passwordÅ")
+	require.NoError(t, err)
+	assert.DeepEqual(t, secret, decrypted)
+}
+
+func TestKDFParams_Validate(t *testing.T) {
+	tests := []struct {
+		params *KDFParams
+		err    string
+	}{
+		{params: DefaultKDFParams()},
+		{params: &KDFParams{Function: ScryptKDF, Cost: DefaultKDFCost}},
+		{params: nil, err: "nil KDF parameters"},
+		{params: &KDFParams{Function: ScryptKDF, Cost: 1000}, err: "power of two"},
+		{params: &KDFParams{Function: ScryptKDF, Cost: 1}, err: "power of two"},
+		{params: &KDFParams{Function: PBKDF2KDF, Cost: 0}, err: "must be positive"},
+		{params: &KDFParams{Function: Argon2idKDF, Cost: DefaultKDFCost}},
+		{params: &KDFParams{Function: Argon2idKDF, Cost: 31}, err: "argon2id cost must be between"},
+		{params: &KDFParams{Function: Argon2idKDF, Cost: 1<<22 + 1}, err: "argon2id cost must be between"},
+		{params: &KDFParams{Function: "argon2", Cost: 1}, err: "unsupported KDF"},
+	}
+	for _, tt := range tests {
+		err := tt.params.Validate()
+		if tt.err == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.ErrorContains(t, tt.err, err)
+		}
+	}
+	_, err := EncryptEIP2335([]byte("secret"), "password", &KDFParams{Function: "argon2", Cost: 1})
+	assert.ErrorContains(t, "unsupported KDF", err)
+}
+
+func TestKDFParamsOf_Invalid(t *testing.T) {
+	_, err := KDFParamsOf(map[string]interface{}{})
+	assert.ErrorContains(t, "no kdf module", err)
+	_, err = KDFParamsOf(map[string]interface{}{"kdf": map[string]interface{}{
+		"function": "scrypt",
+		"params":   map[string]interface{}{"c": float64(1)},
+	}})
+	assert.ErrorContains(t, "no numeric n kdf parameter", err)
+}
+
+func TestDecryptEIP2335_Argon2idInvalid(t *testing.T) {
+	cryptoFields, err := EncryptEIP2335([]byte("secret"), "password", &KDFParams{Function: Argon2idKDF, Cost: 64})
+	require.NoError(t, err)
+	cryptoFields["kdf"].(map[string]interface{})["params"].(map[string]interface{})["p"] = float64(0)
+	_, err = DecryptEIP2335(cryptoFields, "password")
+	assert.ErrorContains(t, "invalid KDF parameters", err)
+
+	// Parameters costing more than the bounds are refused before deriving the key.
+	for name, v := range map[string]float64{"m": math.MaxUint32, "t": 1 << 20, "p": math.MaxUint8, "dklen": 1 << 30} {
+		cryptoFields, err = EncryptEIP2335([]byte("secret"), "password", &KDFParams{Function: Argon2idKDF, Cost: 64})
+		require.NoError(t, err)
+		cryptoFields["kdf"].(map[string]interface{})["params"].(map[string]interface{})[name] = v
+		_, err = DecryptEIP2335(cryptoFields, "password")
+		assert.ErrorContains(t, "invalid KDF parameters", err, name)
+	}
+
+	cryptoFields, err = EncryptEIP2335([]byte("secret"), "password", &KDFParams{Function: Argon2idKDF, Cost: 64})
+	require.NoError(t, err)
+	cryptoFields["cipher"].(map[string]interface{})["message"] = "00"
+	_, err = DecryptEIP2335(cryptoFields, "password")
+	assert.ErrorContains(t, "invalid checksum", err)
+}
@@ -0,0 +1,281 @@
+package backup
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+const (
+	// DeltaExtension is the extension of the file holding the blocks of the database file changed since the base of
+	// an incremental backup.
+	DeltaExtension = ".delta"
+	// blockSize is the size of the blocks incremental backups are taken in. It is a multiple of the bolt page size,
+	// and the database files are copied page by page, so that unchanged pages produce unchanged blocks.
+	blockSize = 4 << 20
+)
+
+// Snapshot describes a database file by the checksums of its blocks, which incremental backups are taken against.
+type Snapshot struct {
+	Name   string   `json:"name"`
+	Size   int64    `json:"size"`
+	SHA256 string   `json:"sha256"`
+	Blocks []string `json:"blocks"`
+	// Changed lists, in order, the blocks stored in the delta file of an incremental backup. The other blocks are
+	// restored from the backups of its chain.
+	Changed []int `json:"changed,omitempty"`
+}
+
+// snapshot describes the database file written by the exporter in the manifest. The file is then replaced by a delta
+// file holding the blocks changed since the latest backup, unless a full backup is due.
+func (s *Service) snapshot(outputDir, dir string, m *Manifest) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, e := range entries {
+		if e.Type().IsRegular() {
+			names = append(names, e.Name())
+		}
+	}
+	if len(names) != 1 {
+		return nil
+	}
+	p := filepath.Join(dir, names[0])
+	snap, err := readSnapshot(p)
+	if err != nil {
+		return err
+	}
+	snap.Name = names[0]
+	m.Snapshot = snap
+
+	base, prev, err := s.incrementalBase(outputDir)
+	if err != nil || prev == nil {
+		return err
+	}
+	for i, sum := range snap.Blocks {
+		if i >= len(prev.Blocks) || prev.Blocks[i] != sum {
+			snap.Changed = append(snap.Changed, i)
+		}
+	}
+	if err := writeDelta(p, p+DeltaExtension, snap.Changed); err != nil {
+		return errors.Wrap(err, "could not write delta file")
+	}
+	if err := os.Remove(p); err != nil {
+		return err
+	}
+	m.Base = base
+	return nil
+}
+
+// incrementalBase returns the name and the snapshot of the latest backup of the output directory, if the next backup
+// can be taken against it. It returns a nil snapshot when the next backup must be full.
+func (s *Service) incrementalBase(outputDir string) (string, *Snapshot, error) {
+	if s.cfg.FullEvery < 2 {
+		return "", nil, nil
+	}
+	backups, err := s.listBackups(outputDir)
+	if err != nil || len(backups) == 0 {
+		return "", nil, err
+	}
+	latest := backups[len(backups)-1]
+	name := latest
+	// The chain of the next backup would be one backup longer than the chain of the latest one.
+	for length := 1; ; length++ {
+		m, err := ReadManifest(filepath.Join(outputDir, name))
+		if err != nil {
+			log.WithError(err).WithField("backup", name).Warn("Could not read the manifest of a previous backup, writing a full backup")
+			return "", nil, nil
+		}
+		if m.Snapshot == nil || length >= s.cfg.FullEvery {
+			return "", nil, nil
+		}
+		if m.Base == "" {
+			break
+		}
+		name = m.Base
+	}
+	m, err := ReadManifest(filepath.Join(outputDir, latest))
+	if err != nil {
+		return "", nil, err
+	}
+	return latest, m.Snapshot, nil
+}
+
+// readSnapshot computes the checksums of the file and of its blocks.
+func readSnapshot(p string) (*Snapshot, error) {
+	f, err := os.Open(p) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	snap := &Snapshot{}
+	h := sha256.New()
+	buf := make([]byte, blockSize)
+	for {
+		n, err := io.ReadFull(f, buf)
+		if n > 0 {
+			sum := sha256.Sum256(buf[:n])
+			snap.Blocks = append(snap.Blocks, hex.EncodeToString(sum[:]))
+			snap.Size += int64(n)
+			h.Write(buf[:n])
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read %s", p)
+		}
+	}
+	snap.SHA256 = hex.EncodeToString(h.Sum(nil))
+	return snap, nil
+}
+
+// writeDelta writes the given blocks of the file src, in order, to the delta file dst.
+func writeDelta(src, dst string, blocks []int) error {
+	in, err := os.Open(src) // #nosec G304
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return err
+	}
+	for _, i := range blocks {
+		if _, err := io.Copy(out, io.NewSectionReader(in, int64(i)*blockSize, blockSize)); err != nil {
+			_ = out.Close()
+			return err
+		}
+	}
+	if err := out.Sync(); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// Restore writes the database file of the backup in the given directory to dst, replacing it if it exists. The
+// database file of an incremental backup is rebuilt from the backups of its chain, which are read from the same
+// output directory. The checksums of every backup of the chain, and of the restored file, are verified.
+func Restore(dir, dst string) error {
+	type link struct {
+		dir string
+		m   *Manifest
+	}
+	var chain []link
+	visited := make(map[string]bool)
+	for d := filepath.Clean(dir); ; {
+		if visited[d] {
+			return errors.Errorf("backup %s is its own base", d)
+		}
+		visited[d] = true
+		m, err := ReadManifest(d)
+		if err != nil {
+			return errors.Wrapf(err, "could not read backup %s", d)
+		}
+		if err := m.VerifyChecksums(d); err != nil {
+			return errors.Wrapf(err, "invalid backup %s", d)
+		}
+		if m.Snapshot == nil {
+			return errors.Errorf("backup %s does not describe its database file", d)
+		}
+		chain = append(chain, link{dir: d, m: m})
+		if m.Base == "" {
+			break
+		}
+		d = filepath.Join(filepath.Dir(d), m.Base)
+	}
+
+	partial := dst + partialSuffix
+	if err := os.RemoveAll(partial); err != nil {
+		return err
+	}
+	full := chain[len(chain)-1]
+	src := filepath.Join(full.dir, full.m.Snapshot.Name)
+	if full.m.Compressed {
+		if err := Decompress(src+CompressedExtension, partial); err != nil {
+			return err
+		}
+	} else if err := file.CopyFile(src, partial); err != nil {
+		return err
+	}
+	for i := len(chain) - 2; i >= 0; i-- {
+		if err := applyDelta(chain[i].dir, chain[i].m, partial); err != nil {
+			return errors.Wrapf(err, "could not apply backup %s", chain[i].dir)
+		}
+	}
+
+	want := chain[0].m.Snapshot
+	size, sum, err := checksum(partial)
+	if err != nil {
+		return err
+	}
+	if size != want.Size || sum != want.SHA256 {
+		return errors.Errorf("restored database file has size %d, sha256 %s, the backup lists size %d, sha256 %s",
+			size, sum, want.Size, want.SHA256)
+	}
+	return os.Rename(partial, dst)
+}
+
+// applyDelta writes the blocks of the delta file of the incremental backup over the database file p, then truncates
+// the file to the size of the database at the time of the backup.
+func applyDelta(dir string, m *Manifest, p string) error {
+	in, err := os.Open(filepath.Join(dir, m.Snapshot.Name+DeltaExtension+compressedSuffix(m))) // #nosec G304
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+	var r io.Reader = in
+	if m.Compressed {
+		zr, err := gzip.NewReader(in)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = zr.Close()
+		}()
+		r = zr
+	}
+	out, err := os.OpenFile(p, os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return err
+	}
+	if err := out.Truncate(m.Snapshot.Size); err != nil {
+		_ = out.Close()
+		return err
+	}
+	for _, i := range m.Snapshot.Changed {
+		n := min(int64(blockSize), m.Snapshot.Size-int64(i)*blockSize)
+		if n <= 0 {
+			_ = out.Close()
+			return errors.Errorf("block %d is beyond the end of the database file", i)
+		}
+		if _, err := io.CopyN(io.NewOffsetWriter(out, int64(i)*blockSize), r, n); err != nil { // #nosec G110
+			_ = out.Close()
+			return errors.Wrapf(err, "could not copy block %d", i)
+		}
+	}
+	return out.Close()
+}
+
+func compressedSuffix(m *Manifest) string {
+	if m.Compressed {
+		return CompressedExtension
+	}
+	return ""
+}
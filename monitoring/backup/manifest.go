@@ -0,0 +1,191 @@
+package backup
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+const (
+	// ManifestFileName is the name of the manifest file written in the directory of every managed backup.
+	ManifestFileName = "manifest.json"
+	// CompressedExtension is the extension of the backup files compressed with gzip.
+	CompressedExtension = ".gz"
+)
+
+// Manifest describes the files of a backup, along with their checksums.
+type Manifest struct {
+	Database   string    `json:"database"`
+	CreatedAt  time.Time `json:"created_at"`
+	Compressed bool      `json:"compressed"`
+	// Base is the name of the backup directory an incremental backup was taken against, which is in the same output
+	// directory. It is empty for a full backup.
+	Base  string         `json:"base,omitempty"`
+	Files []ManifestFile `json:"files"`
+	// Snapshot describes the database file of the backup. It is only set when the database was backed up to a
+	// single file, which is the only case where backups can be incremental.
+	Snapshot *Snapshot `json:"snapshot,omitempty"`
+}
+
+// ManifestFile is a single file of a backup. Its path is relative to the backup directory.
+type ManifestFile struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// ReadManifest reads the manifest of the backup in the given directory.
+func ReadManifest(dir string) (*Manifest, error) {
+	enc, err := file.ReadFileAsBytes(filepath.Join(dir, ManifestFileName))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read backup manifest")
+	}
+	m := &Manifest{}
+	if err := json.Unmarshal(enc, m); err != nil {
+		return nil, errors.Wrap(err, "could not decode backup manifest")
+	}
+	return m, nil
+}
+
+// VerifyChecksums checks that every file listed in the manifest is in the backup directory, with the listed size
+// and checksum.
+func (m *Manifest) VerifyChecksums(dir string) error {
+	if len(m.Files) == 0 {
+		return errors.New("backup manifest does not list any file")
+	}
+	for _, f := range m.Files {
+		size, sum, err := checksum(filepath.Join(dir, filepath.FromSlash(f.Path)))
+		if err != nil {
+			return err
+		}
+		if size != f.Size || sum != f.SHA256 {
+			return errors.Errorf("checksum mismatch for %s: size %d, sha256 %s, manifest lists size %d, sha256 %s",
+				f.Path, size, sum, f.Size, f.SHA256)
+		}
+	}
+	return nil
+}
+
+// writeManifest compresses the files of the backup directory if requested, then lists them with their checksums in
+// the manifest of the backup.
+func writeManifest(dir string, m *Manifest) error {
+	var paths []string
+	if err := filepath.WalkDir(dir, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			paths = append(paths, p)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, p := range paths {
+		if m.Compressed {
+			if err := compress(p); err != nil {
+				return errors.Wrapf(err, "could not compress %s", p)
+			}
+			p += CompressedExtension
+		}
+		size, sum, err := checksum(p)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		m.Files = append(m.Files, ManifestFile{Path: filepath.ToSlash(rel), Size: size, SHA256: sum})
+	}
+	enc, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return file.WriteFile(filepath.Join(dir, ManifestFileName), enc)
+}
+
+func checksum(p string) (int64, string, error) {
+	f, err := os.Open(p) // #nosec G304
+	if err != nil {
+		return 0, "", err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", errors.Wrapf(err, "could not compute checksum of %s", p)
+	}
+	return size, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// compress replaces the file with its gzip compressed version.
+func compress(p string) error {
+	src, err := os.Open(p) // #nosec G304
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+	dst, err := os.OpenFile(p+CompressedExtension, os.O_CREATE|os.O_EXCL|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(dst)
+	if _, err := io.Copy(zw, src); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	return os.Remove(p)
+}
+
+// Decompress writes the content of the gzip compressed backup file src to dst.
+func Decompress(src, dst string) error {
+	if !strings.HasSuffix(src, CompressedExtension) {
+		return errors.Errorf("%s is not a compressed backup file", src)
+	}
+	in, err := os.Open(src) // #nosec G304
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+	zr, err := gzip.NewReader(in)
+	if err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, zr); err != nil { // #nosec G110
+		_ = out.Close()
+		return err
+	}
+	if err := zr.Close(); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
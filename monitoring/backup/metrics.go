@@ -0,0 +1,21 @@
+package backup
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backupDuration = promauto.NewSummary(prometheus.SummaryOpts{
+		Name: "db_backup_duration_seconds",
+		Help: "Time taken to write, compress and checksum a database backup.",
+	})
+	backupFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "db_backup_failures_total",
+		Help: "Number of database backups which failed.",
+	})
+	lastBackupTimestamp = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "db_backup_last_success_timestamp_seconds",
+		Help: "Unix time of the start of the latest successful database backup.",
+	})
+)
@@ -0,0 +1,236 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/sirupsen/logrus"
+)
+
+// partialSuffix marks the directory of a backup which is still being written.
+const partialSuffix = ".partial"
+
+// Config options for the backup service.
+type Config struct {
+	Exporter Exporter
+	// Database names the backed up database, ex: "beacondb". It prefixes the backup directories.
+	Database string
+	// OutputDir is the directory backups are written to, unless another one is given to Backup.
+	OutputDir string
+	// Interval between scheduled backups. No backup is scheduled if it is zero.
+	Interval time.Duration
+	// Retention is the number of most recent backups kept in the output directory. All are kept if it is zero.
+	Retention int
+	Compress  bool
+	// FullEvery is the length of the chains of backups, each made of a full backup followed by incremental backups
+	// storing the blocks of the database file changed since the previous backup. Every backup is full if it is
+	// lower than two.
+	FullEvery int
+}
+
+// Service writes a backup of a database at a regular interval. Every backup is written to its own directory,
+// optionally compressed, with a manifest listing the checksums of its files. Backups are either full, or incremental
+// against the previous backup. The oldest backups are removed according to the retention count, unless a retained
+// incremental backup is restored from them. The service is an Exporter itself, so that the backups requested from the
+// HTTP handler are managed the same way.
+type Service struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	cfg     *Config
+	lock    sync.Mutex
+	lastErr error
+}
+
+// NewService creates a backup service with the given config.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg.Exporter == nil || cfg.Database == "" || cfg.OutputDir == "" {
+		return nil, errors.New("incomplete backup configuration")
+	}
+	if cfg.Retention < 0 {
+		return nil, errors.Errorf("backup retention must not be negative, got %d", cfg.Retention)
+	}
+	if cfg.FullEvery < 0 {
+		return nil, errors.Errorf("full backup interval must not be negative, got %d", cfg.FullEvery)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}, nil
+}
+
+// Start the scheduled backups in the background.
+func (s *Service) Start() {
+	if s.cfg.Interval == 0 {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"interval":  s.cfg.Interval,
+		"retention": s.cfg.Retention,
+		"fullEvery": s.cfg.FullEvery,
+		"outputDir": s.cfg.OutputDir,
+	}).Info("Scheduling database backups")
+	go s.run()
+}
+
+// Stop the scheduled backups.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status returns the error of the latest backup, if it failed.
+func (s *Service) Status() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.lastErr
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Backup(s.ctx, "", false); err != nil {
+				log.WithError(err).Error("Scheduled database backup failed")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Backup writes a new backup of the database to the output directory, or to the given directory if not empty,
+// then removes the backups exceeding the retention count from that directory.
+func (s *Service) Backup(ctx context.Context, outputDir string, permissionOverride bool) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	start := time.Now()
+	dir, err := s.backup(ctx, outputDir, permissionOverride)
+	s.lastErr = err
+	if err != nil {
+		backupFailures.Inc()
+		return err
+	}
+	backupDuration.Observe(time.Since(start).Seconds())
+	lastBackupTimestamp.Set(float64(start.Unix()))
+	log.WithFields(logrus.Fields{
+		"backup":   dir,
+		"duration": time.Since(start),
+	}).Info("Database backup completed")
+	return nil
+}
+
+func (s *Service) backup(ctx context.Context, outputDir string, permissionOverride bool) (string, error) {
+	if outputDir == "" {
+		outputDir = s.cfg.OutputDir
+	}
+	outputDir, err := file.ExpandPath(outputDir)
+	if err != nil {
+		return "", err
+	}
+	if err := file.HandleBackupDir(outputDir, permissionOverride); err != nil {
+		return "", err
+	}
+	// Backups interrupted by a restart are never completed.
+	if err := s.removeBackups(outputDir, func(name string) bool { return strings.HasSuffix(name, partialSuffix) }); err != nil {
+		return "", err
+	}
+
+	created := time.Now().UTC()
+	name := fmt.Sprintf("%s_%s", s.cfg.Database, created.Format("20060102T150405.000Z"))
+	partial := filepath.Join(outputDir, name+partialSuffix)
+	if err := s.cfg.Exporter.Backup(ctx, partial, permissionOverride); err != nil {
+		return "", err
+	}
+	m := &Manifest{Database: s.cfg.Database, CreatedAt: created, Compressed: s.cfg.Compress}
+	if err := s.snapshot(outputDir, partial, m); err != nil {
+		return "", errors.Wrap(err, "could not snapshot backup")
+	}
+	if err := writeManifest(partial, m); err != nil {
+		return "", errors.Wrap(err, "could not write backup manifest")
+	}
+	dir := filepath.Join(outputDir, name)
+	if err := os.Rename(partial, dir); err != nil {
+		return "", err
+	}
+	return dir, s.applyRetention(outputDir)
+}
+
+// applyRetention removes the oldest backups of the directory beyond the retention count, except the backups the
+// retained incremental backups are restored from.
+func (s *Service) applyRetention(dir string) error {
+	if s.cfg.Retention == 0 {
+		return nil
+	}
+	backups, err := s.listBackups(dir)
+	if err != nil {
+		return err
+	}
+	if len(backups) <= s.cfg.Retention {
+		return nil
+	}
+	retained := make(map[string]bool)
+	for _, b := range backups[len(backups)-s.cfg.Retention:] {
+		for name := b; name != "" && !retained[name]; name = baseOf(dir, name) {
+			retained[name] = true
+		}
+	}
+	return s.removeBackups(dir, func(name string) bool {
+		return !strings.HasSuffix(name, partialSuffix) && !retained[name]
+	})
+}
+
+// baseOf returns the name of the backup an incremental backup was taken against, or an empty string for a full backup.
+func baseOf(dir, name string) string {
+	m, err := ReadManifest(filepath.Join(dir, name))
+	if err != nil {
+		log.WithError(err).WithField("backup", name).Warn("Could not read backup manifest")
+		return ""
+	}
+	return m.Base
+}
+
+// listBackups returns the names of the complete backups of the directory, from the oldest to the latest.
+func (s *Service) listBackups(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var backups []string
+	for _, e := range entries {
+		if e.IsDir() && strings.HasPrefix(e.Name(), s.cfg.Database+"_") && !strings.HasSuffix(e.Name(), partialSuffix) {
+			backups = append(backups, e.Name())
+		}
+	}
+	// Backup names end with their creation time, which sorts chronologically.
+	sort.Strings(backups)
+	return backups, nil
+}
+
+func (s *Service) removeBackups(dir string, remove func(name string) bool) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if !e.IsDir() || !strings.HasPrefix(e.Name(), s.cfg.Database+"_") || !remove(e.Name()) {
+			continue
+		}
+		log.WithField("backup", e.Name()).Debug("Removing database backup")
+		if err := os.RemoveAll(filepath.Join(dir, e.Name())); err != nil {
+			return errors.Wrapf(err, "could not remove backup %s", e.Name())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type fileExporter struct {
+	content []byte
+}
+
+func (e *fileExporter) Backup(_ context.Context, outputDir string, permissionOverride bool) error {
+	if err := file.HandleBackupDir(outputDir, permissionOverride); err != nil {
+		return err
+	}
+	return file.WriteFile(filepath.Join(outputDir, "prysm_testdb.backup"), e.content)
+}
+
+func TestService_Backup(t *testing.T) {
+	ctx := context.Background()
+	outputDir := filepath.Join(t.TempDir(), "backups")
+	content := []byte("database content")
+	s, err := NewService(ctx, &Config{
+		Exporter:  &fileExporter{content: content},
+		Database:  "testdb",
+		OutputDir: outputDir,
+		Retention: 2,
+		Compress:  true,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s.Backup(ctx, "", false))
+	entries, err := os.ReadDir(outputDir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	dir := filepath.Join(outputDir, entries[0].Name())
+
+	m, err := ReadManifest(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "testdb", m.Database)
+	assert.Equal(t, true, m.Compressed)
+	require.Equal(t, 1, len(m.Files))
+	assert.Equal(t, "prysm_testdb.backup"+CompressedExtension, m.Files[0].Path)
+	require.NoError(t, m.VerifyChecksums(dir))
+
+	decompressed := filepath.Join(t.TempDir(), "decompressed")
+	require.NoError(t, Decompress(filepath.Join(dir, m.Files[0].Path), decompressed))
+	got, err := os.ReadFile(decompressed)
+	require.NoError(t, err)
+	assert.DeepEqual(t, content, got)
+
+	// A tampered backup file no longer matches the manifest.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, m.Files[0].Path), []byte("tampered"), 0600))
+	require.ErrorContains(t, "checksum mismatch", m.VerifyChecksums(dir))
+}
+
+func TestService_Retention(t *testing.T) {
+	ctx := context.Background()
+	outputDir := filepath.Join(t.TempDir(), "backups")
+	s, err := NewService(ctx, &Config{
+		Exporter:  &fileExporter{content: []byte("database content")},
+		Database:  "testdb",
+		OutputDir: outputDir,
+		Retention: 2,
+	})
+	require.NoError(t, err)
+
+	// Backups from previous runs, one of which was interrupted.
+	for _, name := range []string{"testdb_20200101T000000.000Z", "testdb_20200102T000000.000Z", "testdb_20200103T000000.000Z" + partialSuffix, "other"} {
+		require.NoError(t, file.MkdirAll(filepath.Join(outputDir, name)))
+	}
+	require.NoError(t, s.Backup(ctx, "", false))
+
+	entries, err := os.ReadDir(outputDir)
+	require.NoError(t, err)
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name()
+	}
+	require.Equal(t, 3, len(names), "unexpected backups %v", names)
+	assert.Equal(t, "other", names[0])
+	assert.Equal(t, "testdb_20200102T000000.000Z", names[1])
+	assert.NoError(t, s.Status())
+}
+
+func TestNewService_IncompleteConfig(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{Database: "testdb", OutputDir: t.TempDir()})
+	require.ErrorContains(t, "incomplete backup configuration", err)
+	_, err = NewService(context.Background(), &Config{Exporter: &fileExporter{}, Database: "testdb", OutputDir: t.TempDir(), Retention: -1})
+	require.ErrorContains(t, "backup retention must not be negative", err)
+}
+
+func TestService_IncrementalBackups(t *testing.T) {
+	ctx := context.Background()
+	outputDir := filepath.Join(t.TempDir(), "backups")
+	exporter := &fileExporter{}
+	s, err := NewService(ctx, &Config{
+		Exporter:  exporter,
+		Database:  "testdb",
+		OutputDir: outputDir,
+		Retention: 2,
+		Compress:  true,
+		FullEvery: 3,
+	})
+	require.NoError(t, err)
+
+	content := make([]byte, 2*blockSize+100)
+	for i := range content {
+		content[i] = byte(i % 251)
+	}
+	backup := func(content []byte) (string, *Manifest) {
+		exporter.content = content
+		// Backup directories are named after their creation time, in milliseconds.
+		time.Sleep(2 * time.Millisecond)
+		require.NoError(t, s.Backup(ctx, "", false))
+		backups, err := s.listBackups(outputDir)
+		require.NoError(t, err)
+		dir := filepath.Join(outputDir, backups[len(backups)-1])
+		m, err := ReadManifest(dir)
+		require.NoError(t, err)
+
+		restored := filepath.Join(t.TempDir(), "restored.db")
+		require.NoError(t, Restore(dir, restored))
+		got, err := os.ReadFile(restored)
+		require.NoError(t, err)
+		require.DeepEqual(t, content, got)
+		return filepath.Base(dir), m
+	}
+
+	first, m := backup(content)
+	assert.Equal(t, "", m.Base)
+	assert.Equal(t, 3, len(m.Snapshot.Blocks))
+
+	content[blockSize+1]++
+	second, m := backup(content)
+	assert.Equal(t, first, m.Base)
+	assert.DeepEqual(t, []int{1}, m.Snapshot.Changed)
+	assert.Equal(t, "prysm_testdb.backup"+DeltaExtension+CompressedExtension, m.Files[0].Path)
+
+	content = append(content, make([]byte, blockSize)...)
+	content[0]++
+	third, m := backup(content)
+	assert.Equal(t, second, m.Base)
+	assert.DeepEqual(t, []int{0, 2, 3}, m.Snapshot.Changed)
+
+	// The chain is full, the next backup starts a new one. The previous chain is still needed by the third backup.
+	fourth, m := backup(content)
+	assert.Equal(t, "", m.Base)
+	backups, err := s.listBackups(outputDir)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{first, second, third, fourth}, backups)
+
+	// A shrinking database is restored as well, and the previous chain is no longer retained.
+	_, m = backup(content[:blockSize/2])
+	assert.Equal(t, fourth, m.Base)
+	assert.DeepEqual(t, []int{0}, m.Snapshot.Changed)
+	backups, err = s.listBackups(outputDir)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(backups))
+	assert.Equal(t, fourth, backups[0])
+
+	// Restoring checks the whole chain.
+	require.NoError(t, os.WriteFile(filepath.Join(outputDir, fourth, "prysm_testdb.backup"+CompressedExtension), []byte("tampered"), 0600))
+	require.ErrorContains(t, "checksum mismatch", Restore(filepath.Join(outputDir, backups[1]), filepath.Join(t.TempDir(), "restored.db")))
+}
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 75d48d13b4efa7867468bae2df70b80e9606b9a44e621915d2093a4f20ae111f
+// Hash: 75cef5a06932f36097fefc8b43a17c47edcad6146800deae6e6e65706b3a57bb
 package eth
 
 import (
@@ -19277,6 +19277,959 @@ func (p *PendingConsolidation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// MarshalSSZ ssz marshals the ForkChoiceSnapshot object
+func (f *ForkChoiceSnapshot) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the ForkChoiceSnapshot object to a target array
+func (f *ForkChoiceSnapshot) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(425)
+
+	// Field (0) 'Version'
+	dst = ssz.MarshalUint64(dst, f.Version)
+
+	// Field (1) 'GenesisTime'
+	dst = ssz.MarshalUint64(dst, f.GenesisTime)
+
+	// Field (2) 'JustifiedCheckpoint'
+	if f.JustifiedCheckpoint == nil {
+		f.JustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = f.JustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'UnrealizedJustifiedCheckpoint'
+	if f.UnrealizedJustifiedCheckpoint == nil {
+		f.UnrealizedJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = f.UnrealizedJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'UnrealizedFinalizedCheckpoint'
+	if f.UnrealizedFinalizedCheckpoint == nil {
+		f.UnrealizedFinalizedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = f.UnrealizedFinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'PrevJustifiedCheckpoint'
+	if f.PrevJustifiedCheckpoint == nil {
+		f.PrevJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = f.PrevJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'FinalizedCheckpoint'
+	if f.FinalizedCheckpoint == nil {
+		f.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = f.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (7) 'ProposerBoostRoot'
+	if size := len(f.ProposerBoostRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ProposerBoostRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.ProposerBoostRoot...)
+
+	// Field (8) 'PreviousProposerBoostRoot'
+	if size := len(f.PreviousProposerBoostRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.PreviousProposerBoostRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.PreviousProposerBoostRoot...)
+
+	// Field (9) 'PreviousProposerBoostScore'
+	dst = ssz.MarshalUint64(dst, f.PreviousProposerBoostScore)
+
+	// Field (10) 'CommitteeWeight'
+	dst = ssz.MarshalUint64(dst, f.CommitteeWeight)
+
+	// Field (11) 'OriginRoot'
+	if size := len(f.OriginRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.OriginRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.OriginRoot...)
+
+	// Field (12) 'HeadRoot'
+	if size := len(f.HeadRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.HeadRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.HeadRoot...)
+
+	// Field (13) 'HighestReceivedRoot'
+	if size := len(f.HighestReceivedRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.HighestReceivedRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.HighestReceivedRoot...)
+
+	// Offset (14) 'ReceivedBlocksLastEpoch'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(f.ReceivedBlocksLastEpoch) * 8
+
+	// Field (15) 'AllTipsAreInvalid'
+	dst = ssz.MarshalBool(dst, f.AllTipsAreInvalid)
+
+	// Field (16) 'NumActiveValidators'
+	dst = ssz.MarshalUint64(dst, f.NumActiveValidators)
+
+	// Offset (17) 'Nodes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(f.Nodes) * 193
+
+	// Offset (18) 'SlashedIndices'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(f.SlashedIndices) * 8
+
+	// Offset (19) 'Votes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(f.Votes) * 72
+
+	// Offset (20) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(f.Balances) * 8
+
+	// Offset (21) 'JustifiedBalances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(f.JustifiedBalances) * 8
+
+	// Field (14) 'ReceivedBlocksLastEpoch'
+	if size := len(f.ReceivedBlocksLastEpoch); size > 32 {
+		err = ssz.ErrListTooBigFn("--.ReceivedBlocksLastEpoch", size, 32)
+		return
+	}
+	for ii := 0; ii < len(f.ReceivedBlocksLastEpoch); ii++ {
+		dst = ssz.MarshalUint64(dst, f.ReceivedBlocksLastEpoch[ii])
+	}
+
+	// Field (17) 'Nodes'
+	if size := len(f.Nodes); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Nodes", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(f.Nodes); ii++ {
+		if dst, err = f.Nodes[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (18) 'SlashedIndices'
+	if size := len(f.SlashedIndices); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.SlashedIndices", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(f.SlashedIndices); ii++ {
+		dst = ssz.MarshalUint64(dst, f.SlashedIndices[ii])
+	}
+
+	// Field (19) 'Votes'
+	if size := len(f.Votes); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Votes", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(f.Votes); ii++ {
+		if dst, err = f.Votes[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (20) 'Balances'
+	if size := len(f.Balances); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(f.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, f.Balances[ii])
+	}
+
+	// Field (21) 'JustifiedBalances'
+	if size := len(f.JustifiedBalances); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.JustifiedBalances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(f.JustifiedBalances); ii++ {
+		dst = ssz.MarshalUint64(dst, f.JustifiedBalances[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ForkChoiceSnapshot object
+func (f *ForkChoiceSnapshot) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 425 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o14, o17, o18, o19, o20, o21 uint64
+
+	// Field (0) 'Version'
+	f.Version = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'GenesisTime'
+	f.GenesisTime = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'JustifiedCheckpoint'
+	if f.JustifiedCheckpoint == nil {
+		f.JustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = f.JustifiedCheckpoint.UnmarshalSSZ(buf[16:56]); err != nil {
+		return err
+	}
+
+	// Field (3) 'UnrealizedJustifiedCheckpoint'
+	if f.UnrealizedJustifiedCheckpoint == nil {
+		f.UnrealizedJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = f.UnrealizedJustifiedCheckpoint.UnmarshalSSZ(buf[56:96]); err != nil {
+		return err
+	}
+
+	// Field (4) 'UnrealizedFinalizedCheckpoint'
+	if f.UnrealizedFinalizedCheckpoint == nil {
+		f.UnrealizedFinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = f.UnrealizedFinalizedCheckpoint.UnmarshalSSZ(buf[96:136]); err != nil {
+		return err
+	}
+
+	// Field (5) 'PrevJustifiedCheckpoint'
+	if f.PrevJustifiedCheckpoint == nil {
+		f.PrevJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = f.PrevJustifiedCheckpoint.UnmarshalSSZ(buf[136:176]); err != nil {
+		return err
+	}
+
+	// Field (6) 'FinalizedCheckpoint'
+	if f.FinalizedCheckpoint == nil {
+		f.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = f.FinalizedCheckpoint.UnmarshalSSZ(buf[176:216]); err != nil {
+		return err
+	}
+
+	// Field (7) 'ProposerBoostRoot'
+	if cap(f.ProposerBoostRoot) == 0 {
+		f.ProposerBoostRoot = make([]byte, 0, len(buf[216:248]))
+	}
+	f.ProposerBoostRoot = append(f.ProposerBoostRoot, buf[216:248]...)
+
+	// Field (8) 'PreviousProposerBoostRoot'
+	if cap(f.PreviousProposerBoostRoot) == 0 {
+		f.PreviousProposerBoostRoot = make([]byte, 0, len(buf[248:280]))
+	}
+	f.PreviousProposerBoostRoot = append(f.PreviousProposerBoostRoot, buf[248:280]...)
+
+	// Field (9) 'PreviousProposerBoostScore'
+	f.PreviousProposerBoostScore = ssz.UnmarshallUint64(buf[280:288])
+
+	// Field (10) 'CommitteeWeight'
+	f.CommitteeWeight = ssz.UnmarshallUint64(buf[288:296])
+
+	// Field (11) 'OriginRoot'
+	if cap(f.OriginRoot) == 0 {
+		f.OriginRoot = make([]byte, 0, len(buf[296:328]))
+	}
+	f.OriginRoot = append(f.OriginRoot, buf[296:328]...)
+
+	// Field (12) 'HeadRoot'
+	if cap(f.HeadRoot) == 0 {
+		f.HeadRoot = make([]byte, 0, len(buf[328:360]))
+	}
+	f.HeadRoot = append(f.HeadRoot, buf[328:360]...)
+
+	// Field (13) 'HighestReceivedRoot'
+	if cap(f.HighestReceivedRoot) == 0 {
+		f.HighestReceivedRoot = make([]byte, 0, len(buf[360:392]))
+	}
+	f.HighestReceivedRoot = append(f.HighestReceivedRoot, buf[360:392]...)
+
+	// Offset (14) 'ReceivedBlocksLastEpoch'
+	if o14 = ssz.ReadOffset(buf[392:396]); o14 > size {
+		return ssz.ErrOffset
+	}
+
+	if o14 < 425 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (15) 'AllTipsAreInvalid'
+	f.AllTipsAreInvalid = ssz.UnmarshalBool(buf[396:397])
+
+	// Field (16) 'NumActiveValidators'
+	f.NumActiveValidators = ssz.UnmarshallUint64(buf[397:405])
+
+	// Offset (17) 'Nodes'
+	if o17 = ssz.ReadOffset(buf[405:409]); o17 > size || o14 > o17 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (18) 'SlashedIndices'
+	if o18 = ssz.ReadOffset(buf[409:413]); o18 > size || o17 > o18 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (19) 'Votes'
+	if o19 = ssz.ReadOffset(buf[413:417]); o19 > size || o18 > o19 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (20) 'Balances'
+	if o20 = ssz.ReadOffset(buf[417:421]); o20 > size || o19 > o20 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (21) 'JustifiedBalances'
+	if o21 = ssz.ReadOffset(buf[421:425]); o21 > size || o20 > o21 {
+		return ssz.ErrOffset
+	}
+
+	// Field (14) 'ReceivedBlocksLastEpoch'
+	{
+		buf = tail[o14:o17]
+		num, err := ssz.DivideInt2(len(buf), 8, 32)
+		if err != nil {
+			return err
+		}
+		f.ReceivedBlocksLastEpoch = ssz.ExtendUint64(f.ReceivedBlocksLastEpoch, num)
+		for ii := 0; ii < num; ii++ {
+			f.ReceivedBlocksLastEpoch[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (17) 'Nodes'
+	{
+		buf = tail[o17:o18]
+		num, err := ssz.DivideInt2(len(buf), 193, 1099511627776)
+		if err != nil {
+			return err
+		}
+		f.Nodes = make([]*ForkChoiceSnapshotNode, num)
+		for ii := 0; ii < num; ii++ {
+			if f.Nodes[ii] == nil {
+				f.Nodes[ii] = new(ForkChoiceSnapshotNode)
+			}
+			if err = f.Nodes[ii].UnmarshalSSZ(buf[ii*193 : (ii+1)*193]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (18) 'SlashedIndices'
+	{
+		buf = tail[o18:o19]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		f.SlashedIndices = ssz.ExtendUint64(f.SlashedIndices, num)
+		for ii := 0; ii < num; ii++ {
+			f.SlashedIndices[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (19) 'Votes'
+	{
+		buf = tail[o19:o20]
+		num, err := ssz.DivideInt2(len(buf), 72, 1099511627776)
+		if err != nil {
+			return err
+		}
+		f.Votes = make([]*ForkChoiceSnapshotVote, num)
+		for ii := 0; ii < num; ii++ {
+			if f.Votes[ii] == nil {
+				f.Votes[ii] = new(ForkChoiceSnapshotVote)
+			}
+			if err = f.Votes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (20) 'Balances'
+	{
+		buf = tail[o20:o21]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		f.Balances = ssz.ExtendUint64(f.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			f.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (21) 'JustifiedBalances'
+	{
+		buf = tail[o21:]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		f.JustifiedBalances = ssz.ExtendUint64(f.JustifiedBalances, num)
+		for ii := 0; ii < num; ii++ {
+			f.JustifiedBalances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ForkChoiceSnapshot object
+func (f *ForkChoiceSnapshot) SizeSSZ() (size int) {
+	size = 425
+
+	// Field (14) 'ReceivedBlocksLastEpoch'
+	size += len(f.ReceivedBlocksLastEpoch) * 8
+
+	// Field (17) 'Nodes'
+	size += len(f.Nodes) * 193
+
+	// Field (18) 'SlashedIndices'
+	size += len(f.SlashedIndices) * 8
+
+	// Field (19) 'Votes'
+	size += len(f.Votes) * 72
+
+	// Field (20) 'Balances'
+	size += len(f.Balances) * 8
+
+	// Field (21) 'JustifiedBalances'
+	size += len(f.JustifiedBalances) * 8
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ForkChoiceSnapshot object
+func (f *ForkChoiceSnapshot) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the ForkChoiceSnapshot object with a hasher
+func (f *ForkChoiceSnapshot) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Version'
+	hh.PutUint64(f.Version)
+
+	// Field (1) 'GenesisTime'
+	hh.PutUint64(f.GenesisTime)
+
+	// Field (2) 'JustifiedCheckpoint'
+	if err = f.JustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (3) 'UnrealizedJustifiedCheckpoint'
+	if err = f.UnrealizedJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'UnrealizedFinalizedCheckpoint'
+	if err = f.UnrealizedFinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'PrevJustifiedCheckpoint'
+	if err = f.PrevJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (6) 'FinalizedCheckpoint'
+	if err = f.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (7) 'ProposerBoostRoot'
+	if size := len(f.ProposerBoostRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ProposerBoostRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.ProposerBoostRoot)
+
+	// Field (8) 'PreviousProposerBoostRoot'
+	if size := len(f.PreviousProposerBoostRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.PreviousProposerBoostRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.PreviousProposerBoostRoot)
+
+	// Field (9) 'PreviousProposerBoostScore'
+	hh.PutUint64(f.PreviousProposerBoostScore)
+
+	// Field (10) 'CommitteeWeight'
+	hh.PutUint64(f.CommitteeWeight)
+
+	// Field (11) 'OriginRoot'
+	if size := len(f.OriginRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.OriginRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.OriginRoot)
+
+	// Field (12) 'HeadRoot'
+	if size := len(f.HeadRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.HeadRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.HeadRoot)
+
+	// Field (13) 'HighestReceivedRoot'
+	if size := len(f.HighestReceivedRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.HighestReceivedRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.HighestReceivedRoot)
+
+	// Field (14) 'ReceivedBlocksLastEpoch'
+	{
+		if size := len(f.ReceivedBlocksLastEpoch); size > 32 {
+			err = ssz.ErrListTooBigFn("--.ReceivedBlocksLastEpoch", size, 32)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range f.ReceivedBlocksLastEpoch {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(f.ReceivedBlocksLastEpoch))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(32, numItems, 8))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(32, numItems, 8))
+		}
+	}
+
+	// Field (15) 'AllTipsAreInvalid'
+	hh.PutBool(f.AllTipsAreInvalid)
+
+	// Field (16) 'NumActiveValidators'
+	hh.PutUint64(f.NumActiveValidators)
+
+	// Field (17) 'Nodes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(f.Nodes))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range f.Nodes {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1099511627776)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+		}
+	}
+
+	// Field (18) 'SlashedIndices'
+	{
+		if size := len(f.SlashedIndices); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.SlashedIndices", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range f.SlashedIndices {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(f.SlashedIndices))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+	}
+
+	// Field (19) 'Votes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(f.Votes))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range f.Votes {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1099511627776)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+		}
+	}
+
+	// Field (20) 'Balances'
+	{
+		if size := len(f.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range f.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(f.Balances))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+	}
+
+	// Field (21) 'JustifiedBalances'
+	{
+		if size := len(f.JustifiedBalances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.JustifiedBalances", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range f.JustifiedBalances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(f.JustifiedBalances))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the ForkChoiceSnapshotNode object
+func (f *ForkChoiceSnapshotNode) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the ForkChoiceSnapshotNode object to a target array
+func (f *ForkChoiceSnapshotNode) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(f.Slot))
+
+	// Field (1) 'Root'
+	if size := len(f.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Root", size, 32)
+		return
+	}
+	dst = append(dst, f.Root...)
+
+	// Field (2) 'ParentRoot'
+	if size := len(f.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.ParentRoot...)
+
+	// Field (3) 'PayloadHash'
+	if size := len(f.PayloadHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.PayloadHash", size, 32)
+		return
+	}
+	dst = append(dst, f.PayloadHash...)
+
+	// Field (4) 'TargetRoot'
+	if size := len(f.TargetRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.TargetRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.TargetRoot...)
+
+	// Field (5) 'JustifiedEpoch'
+	dst = ssz.MarshalUint64(dst, uint64(f.JustifiedEpoch))
+
+	// Field (6) 'UnrealizedJustifiedEpoch'
+	dst = ssz.MarshalUint64(dst, uint64(f.UnrealizedJustifiedEpoch))
+
+	// Field (7) 'FinalizedEpoch'
+	dst = ssz.MarshalUint64(dst, uint64(f.FinalizedEpoch))
+
+	// Field (8) 'UnrealizedFinalizedEpoch'
+	dst = ssz.MarshalUint64(dst, uint64(f.UnrealizedFinalizedEpoch))
+
+	// Field (9) 'Balance'
+	dst = ssz.MarshalUint64(dst, f.Balance)
+
+	// Field (10) 'Weight'
+	dst = ssz.MarshalUint64(dst, f.Weight)
+
+	// Field (11) 'Optimistic'
+	dst = ssz.MarshalBool(dst, f.Optimistic)
+
+	// Field (12) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, f.Timestamp)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ForkChoiceSnapshotNode object
+func (f *ForkChoiceSnapshotNode) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 193 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Slot'
+	f.Slot = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Root'
+	if cap(f.Root) == 0 {
+		f.Root = make([]byte, 0, len(buf[8:40]))
+	}
+	f.Root = append(f.Root, buf[8:40]...)
+
+	// Field (2) 'ParentRoot'
+	if cap(f.ParentRoot) == 0 {
+		f.ParentRoot = make([]byte, 0, len(buf[40:72]))
+	}
+	f.ParentRoot = append(f.ParentRoot, buf[40:72]...)
+
+	// Field (3) 'PayloadHash'
+	if cap(f.PayloadHash) == 0 {
+		f.PayloadHash = make([]byte, 0, len(buf[72:104]))
+	}
+	f.PayloadHash = append(f.PayloadHash, buf[72:104]...)
+
+	// Field (4) 'TargetRoot'
+	if cap(f.TargetRoot) == 0 {
+		f.TargetRoot = make([]byte, 0, len(buf[104:136]))
+	}
+	f.TargetRoot = append(f.TargetRoot, buf[104:136]...)
+
+	// Field (5) 'JustifiedEpoch'
+	f.JustifiedEpoch = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Epoch(ssz.UnmarshallUint64(buf[136:144]))
+
+	// Field (6) 'UnrealizedJustifiedEpoch'
+	f.UnrealizedJustifiedEpoch = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Epoch(ssz.UnmarshallUint64(buf[144:152]))
+
+	// Field (7) 'FinalizedEpoch'
+	f.FinalizedEpoch = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Epoch(ssz.UnmarshallUint64(buf[152:160]))
+
+	// Field (8) 'UnrealizedFinalizedEpoch'
+	f.UnrealizedFinalizedEpoch = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Epoch(ssz.UnmarshallUint64(buf[160:168]))
+
+	// Field (9) 'Balance'
+	f.Balance = ssz.UnmarshallUint64(buf[168:176])
+
+	// Field (10) 'Weight'
+	f.Weight = ssz.UnmarshallUint64(buf[176:184])
+
+	// Field (11) 'Optimistic'
+	f.Optimistic = ssz.UnmarshalBool(buf[184:185])
+
+	// Field (12) 'Timestamp'
+	f.Timestamp = ssz.UnmarshallUint64(buf[185:193])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ForkChoiceSnapshotNode object
+func (f *ForkChoiceSnapshotNode) SizeSSZ() (size int) {
+	size = 193
+	return
+}
+
+// HashTreeRoot ssz hashes the ForkChoiceSnapshotNode object
+func (f *ForkChoiceSnapshotNode) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the ForkChoiceSnapshotNode object with a hasher
+func (f *ForkChoiceSnapshotNode) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(uint64(f.Slot))
+
+	// Field (1) 'Root'
+	if size := len(f.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Root", size, 32)
+		return
+	}
+	hh.PutBytes(f.Root)
+
+	// Field (2) 'ParentRoot'
+	if size := len(f.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.ParentRoot)
+
+	// Field (3) 'PayloadHash'
+	if size := len(f.PayloadHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.PayloadHash", size, 32)
+		return
+	}
+	hh.PutBytes(f.PayloadHash)
+
+	// Field (4) 'TargetRoot'
+	if size := len(f.TargetRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.TargetRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.TargetRoot)
+
+	// Field (5) 'JustifiedEpoch'
+	hh.PutUint64(uint64(f.JustifiedEpoch))
+
+	// Field (6) 'UnrealizedJustifiedEpoch'
+	hh.PutUint64(uint64(f.UnrealizedJustifiedEpoch))
+
+	// Field (7) 'FinalizedEpoch'
+	hh.PutUint64(uint64(f.FinalizedEpoch))
+
+	// Field (8) 'UnrealizedFinalizedEpoch'
+	hh.PutUint64(uint64(f.UnrealizedFinalizedEpoch))
+
+	// Field (9) 'Balance'
+	hh.PutUint64(f.Balance)
+
+	// Field (10) 'Weight'
+	hh.PutUint64(f.Weight)
+
+	// Field (11) 'Optimistic'
+	hh.PutBool(f.Optimistic)
+
+	// Field (12) 'Timestamp'
+	hh.PutUint64(f.Timestamp)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the ForkChoiceSnapshotVote object
+func (f *ForkChoiceSnapshotVote) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the ForkChoiceSnapshotVote object to a target array
+func (f *ForkChoiceSnapshotVote) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'CurrentRoot'
+	if size := len(f.CurrentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.CurrentRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.CurrentRoot...)
+
+	// Field (1) 'NextRoot'
+	if size := len(f.NextRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.NextRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.NextRoot...)
+
+	// Field (2) 'NextEpoch'
+	dst = ssz.MarshalUint64(dst, uint64(f.NextEpoch))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ForkChoiceSnapshotVote object
+func (f *ForkChoiceSnapshotVote) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 72 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'CurrentRoot'
+	if cap(f.CurrentRoot) == 0 {
+		f.CurrentRoot = make([]byte, 0, len(buf[0:32]))
+	}
+	f.CurrentRoot = append(f.CurrentRoot, buf[0:32]...)
+
+	// Field (1) 'NextRoot'
+	if cap(f.NextRoot) == 0 {
+		f.NextRoot = make([]byte, 0, len(buf[32:64]))
+	}
+	f.NextRoot = append(f.NextRoot, buf[32:64]...)
+
+	// Field (2) 'NextEpoch'
+	f.NextEpoch = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Epoch(ssz.UnmarshallUint64(buf[64:72]))
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ForkChoiceSnapshotVote object
+func (f *ForkChoiceSnapshotVote) SizeSSZ() (size int) {
+	size = 72
+	return
+}
+
+// HashTreeRoot ssz hashes the ForkChoiceSnapshotVote object
+func (f *ForkChoiceSnapshotVote) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the ForkChoiceSnapshotVote object with a hasher
+func (f *ForkChoiceSnapshotVote) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'CurrentRoot'
+	if size := len(f.CurrentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.CurrentRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.CurrentRoot)
+
+	// Field (1) 'NextRoot'
+	if size := len(f.NextRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.NextRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.NextRoot)
+
+	// Field (2) 'NextEpoch'
+	hh.PutUint64(uint64(f.NextEpoch))
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
 // MarshalSSZ ssz marshals the Status object
 func (s *Status) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
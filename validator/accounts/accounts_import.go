@@ -295,7 +295,9 @@ func readKeystoreFile(_ context.Context, keystoreFilePath string) (*keymanager.K
 	if err := json.Unmarshal(keystoreBytes, keystoreFile); err != nil {
 		return nil, errors.Wrap(err, "could not decode keystore json")
 	}
-	if keystoreFile.Pubkey == "" {
+	// Some tools omit the optional pubkey and path fields, so a keystore is identified by its crypto module instead.
+	// The public key is then derived from the private key once the keystore is decrypted.
+	if !hasCryptoModules(keystoreFile.Crypto) {
 		return nil, errors.New("could not decode keystore json")
 	}
 	if keystoreFile.Description == "" && keystoreFile.Name != "" {
@@ -304,6 +306,16 @@ func readKeystoreFile(_ context.Context, keystoreFilePath string) (*keymanager.K
 	return keystoreFile, nil
 }
 
+// hasCryptoModules checks that the crypto field of a keystore has the kdf, checksum and cipher modules of EIP-2335.
+func hasCryptoModules(cryptoFields map[string]interface{}) bool {
+	for _, module := range []string{"kdf", "checksum", "cipher"} {
+		if _, ok := cryptoFields[module].(map[string]interface{}); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func createKeystoreFromPrivateKey(privKey bls.SecretKey, walletPassword string) (*keymanager.Keystore, error) {
 	encryptor := keystorev4.New()
 	id, err := uuid.NewRandom()
@@ -11,6 +11,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -201,3 +202,80 @@ func Test_MarshalOmitsName(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, string(bytes), `{"version":1,"description":"hmm"}`)
 }
+
+func Test_processDirectory_KeystoreVariants(t *testing.T) {
+	local.ResetCaches()
+	keysDir := filepath.Join(t.TempDir(), "keysDir")
+	require.NoError(t, os.MkdirAll(keysDir, os.ModePerm))
+	accountPassword := "accountPassw0rd$"
+	privKeys := make([]bls.SecretKey, 3)
+	variants := make([]map[string]interface{}, 3)
+	for i := range privKeys {
+		privKey, err := bls.RandKey()
+		require.NoError(t, err)
+		privKeys[i] = privKey
+		kdf := &keystore.KDFParams{Function: keystore.ScryptKDF, Cost: 1024}
+		if i == 2 {
+			kdf = &keystore.KDFParams{Function: keystore.Argon2idKDF, Cost: 64}
+		}
+		cryptoFields, err := keystore.EncryptEIP2335(privKey.Marshal(), accountPassword, kdf)
+		require.NoError(t, err)
+		variants[i] = map[string]interface{}{"crypto": cryptoFields, "uuid": fmt.Sprintf("uuid-%d", i), "version": 4}
+	}
+	// A keystore with a path and public key, one with a description, and one without the optional fields encrypted
+	// with argon2id.
+	variants[0]["path"] = "m/12381/3600/0/0/0"
+	variants[0]["pubkey"] = fmt.Sprintf("%x", privKeys[0].PublicKey().Marshal())
+	variants[1]["description"] = "a keystore with a description"
+	for i, variant := range variants {
+		encoded, err := json.Marshal(variant)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(keysDir, fmt.Sprintf("keystore-%d.json", i)), encoded, params.BeaconIoConfig().ReadWritePermissions))
+	}
+	// Files which are not keystores are skipped.
+	require.NoError(t, os.WriteFile(filepath.Join(keysDir, "deposit_data.json"), []byte(`[{"pubkey":"aa"}]`), params.BeaconIoConfig().ReadWritePermissions))
+	require.NoError(t, os.WriteFile(filepath.Join(keysDir, "other.json"), []byte(`{"pubkey":"aa","crypto":{}}`), params.BeaconIoConfig().ReadWritePermissions))
+
+	keystores, err := processDirectory(context.Background(), keysDir, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(keystores))
+	assert.Equal(t, "a keystore with a description", keystores[1].Description)
+
+	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:          walletDir,
+		keymanagerKind:     keymanager.Local,
+		walletPasswordFile: passwordFilePath,
+	})
+	acc, err := NewCLIManager(
+		WithWalletDir(walletDir),
+		WithKeymanagerType(keymanager.Local),
+		WithWalletPassword(password),
+	)
+	require.NoError(t, err)
+	w, err := acc.WalletCreate(cliCtx.Context)
+	require.NoError(t, err)
+	km, err := local.NewKeymanager(cliCtx.Context, &local.SetupConfig{Wallet: w, ListenForChanges: false})
+	require.NoError(t, err)
+	statuses, err := ImportAccounts(cliCtx.Context, &ImportAccountsConfig{
+		Keystores:       keystores,
+		Importer:        km,
+		AccountPassword: accountPassword,
+	})
+	require.NoError(t, err)
+	for i, status := range statuses {
+		assert.Equal(t, keymanager.StatusImported, status.Status)
+		// Keystores without a public key are reported by their derived public key.
+		assert.Equal(t, fmt.Sprintf("%x", privKeys[i].PublicKey().Marshal()), keystores[i].Pubkey)
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(pubKeys))
+	imported := make(map[[48]byte]bool)
+	for _, pubKey := range pubKeys {
+		imported[pubKey] = true
+	}
+	for _, privKey := range privKeys {
+		assert.Equal(t, true, imported[bytesutil.ToBytes48(privKey.PublicKey().Marshal())])
+	}
+}
@@ -0,0 +1,39 @@
+package accounts
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+)
+
+// Reencrypt rewrites the accounts keystore of the wallet with a new password and key derivation function. The
+// current keystore is kept as a backup next to the new one, which only replaces it once it is verified to decrypt.
+func (acm *CLIManager) Reencrypt(ctx context.Context) error {
+	if acm.wallet == nil {
+		return errors.New("no wallet to re-encrypt")
+	}
+	switch acm.wallet.KeymanagerKind() {
+	case keymanager.Local, keymanager.Derived:
+	default:
+		return errors.Errorf("cannot re-encrypt the accounts of a %s wallet", acm.wallet.KeymanagerKind())
+	}
+	if acm.newWalletPassword == "" {
+		return errors.New("new wallet password is required to re-encrypt accounts")
+	}
+	kdfParams := acm.kdfParams
+	if kdfParams == nil {
+		kdfParams = keystore.DefaultKDFParams()
+	}
+	backupPath, err := local.ReencryptAccountsKeystore(ctx, acm.wallet, acm.newWalletPassword, kdfParams)
+	if err != nil {
+		return errors.Wrap(err, "could not re-encrypt accounts keystore")
+	}
+	log.WithField("backup", backupPath).Info(
+		"Successfully re-encrypted accounts. Remember to update the wallet password file of your validator client " +
+			"with the new password, and to delete the backup once it is no longer needed",
+	)
+	return nil
+}
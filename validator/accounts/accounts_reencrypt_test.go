@@ -0,0 +1,67 @@
+package accounts
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+)
+
+func TestCLIManager_Reencrypt(t *testing.T) {
+	local.ResetCaches()
+	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:          walletDir,
+		keymanagerKind:     keymanager.Local,
+		walletPasswordFile: passwordFilePath,
+	})
+	acc, err := NewCLIManager(
+		WithWalletDir(walletDir),
+		WithKeymanagerType(keymanager.Local),
+		WithWalletPassword(password),
+	)
+	require.NoError(t, err)
+	w, err := acc.WalletCreate(cliCtx.Context)
+	require.NoError(t, err)
+	km, err := local.NewKeymanager(cliCtx.Context, &local.SetupConfig{Wallet: w, ListenForChanges: false})
+	require.NoError(t, err)
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	require.NoError(t, km.ImportKeypairs(cliCtx.Context, [][]byte{privKey.Marshal()}, [][]byte{privKey.PublicKey().Marshal()}))
+
+	_, err = NewCLIManager(WithKDFParams(&keystore.KDFParams{Function: "argon2", Cost: 1}))
+	require.ErrorContains(t, "unsupported KDF", err)
+	acc, err = NewCLIManager(WithWallet(w))
+	require.NoError(t, err)
+	require.ErrorContains(t, "new wallet password is required", acc.Reencrypt(cliCtx.Context))
+
+	newPassword := "n3wPassw0rd$2024"
+	acc, err = NewCLIManager(
+		WithWallet(w),
+		WithNewWalletPassword(newPassword),
+		WithKDFParams(&keystore.KDFParams{Function: keystore.ScryptKDF, Cost: 1024}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, acc.Reencrypt(cliCtx.Context))
+
+	// The wallet only opens with the new password and still holds the account.
+	local.ResetCaches()
+	w, err = wallet.OpenWallet(cliCtx.Context, &wallet.Config{WalletDir: walletDir, WalletPassword: password})
+	require.NoError(t, err)
+	_, err = w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	assert.ErrorContains(t, keymanager.IncorrectPasswordErrMsg, err)
+	w, err = wallet.OpenWallet(cliCtx.Context, &wallet.Config{WalletDir: walletDir, WalletPassword: newPassword})
+	require.NoError(t, err)
+	newKm, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	pubKeys, err := newKm.FetchValidatingPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pubKeys))
+	assert.DeepEqual(t, privKey.PublicKey().Marshal(), pubKeys[0][:])
+}
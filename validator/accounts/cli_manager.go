@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 	grpcutil "github.com/prysmaticlabs/prysm/v5/api/grpc"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
 	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
 	iface "github.com/prysmaticlabs/prysm/v5/validator/client/iface"
@@ -69,6 +70,8 @@ type CLIManager struct {
 	beaconApiEndpoint    string
 	beaconApiTimeout     time.Duration
 	inputReader          io.Reader
+	newWalletPassword    string
+	kdfParams            *keystore.KDFParams
 }
 
 func (acm *CLIManager) prepareBeaconClients(ctx context.Context) (*iface.ValidatorClient, *iface.NodeClient, error) {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"google.golang.org/grpc"
@@ -252,3 +253,22 @@ func WithCustomReader(reader io.Reader) Option {
 		return nil
 	}
 }
+
+// WithNewWalletPassword specifies the new password to re-encrypt the wallet with.
+func WithNewWalletPassword(newWalletPassword string) Option {
+	return func(acc *CLIManager) error {
+		acc.newWalletPassword = newWalletPassword
+		return nil
+	}
+}
+
+// WithKDFParams specifies the key derivation function to re-encrypt the wallet with.
+func WithKDFParams(kdfParams *keystore.KDFParams) Option {
+	return func(acc *CLIManager) error {
+		if err := kdfParams.Validate(); err != nil {
+			return err
+		}
+		acc.kdfParams = kdfParams
+		return nil
+	}
+}
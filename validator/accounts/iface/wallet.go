@@ -5,12 +5,14 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/threshold"
 )
 
 // InitKeymanagerConfig defines configuration options for initializing a keymanager.
 type InitKeymanagerConfig struct {
 	ListenForChanges bool
 	Web3SignerConfig *remoteweb3signer.SetupConfig
+	ThresholdConfig  *threshold.SetupConfig
 }
 
 // Wallet defines a struct which has capabilities and knowledge of how
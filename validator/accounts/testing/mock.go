@@ -91,9 +91,10 @@ func (_ *Wallet) InitializeKeymanager(_ context.Context, _ iface.InitKeymanagerC
 }
 
 type Validator struct {
-	Km               keymanager.IKeymanager
-	graffiti         string
-	proposerSettings *proposer.Settings
+	Km                keymanager.IKeymanager
+	graffiti          string
+	proposerSettings  *proposer.Settings
+	doppelgangerState iface2.DoppelgangerState
 }
 
 func (_ *Validator) LogSubmittedSyncCommitteeMessages() {}
@@ -130,6 +131,10 @@ func (_ *Validator) LogValidatorGainsAndLosses(_ context.Context, _ primitives.S
 	panic("implement me")
 }
 
+func (_ *Validator) RecordDutyHistory(_ context.Context, _ primitives.Slot) error {
+	panic("implement me")
+}
+
 func (_ *Validator) UpdateDuties(_ context.Context, _ primitives.Slot) error {
 	panic("implement me")
 }
@@ -234,6 +239,17 @@ func (m *Validator) DeleteGraffiti(_ context.Context, _ [fieldparams.BLSPubkeyLe
 	return nil
 }
 
+// DoppelgangerStatus for mocking
+func (m *Validator) DoppelgangerStatus(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte) (*iface2.DoppelgangerStatus, error) {
+	return &iface2.DoppelgangerStatus{State: m.doppelgangerState}, nil
+}
+
+// OverrideDoppelganger for mocking
+func (m *Validator) OverrideDoppelganger(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte) error {
+	m.doppelgangerState = iface2.DoppelgangerOverridden
+	return nil
+}
+
 func (*Validator) StartEventStream(_ context.Context, _ []string, _ chan<- *event.Event) {
 	panic("implement me")
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/threshold"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -264,6 +265,17 @@ func NewWalletForWeb3Signer() *Wallet {
 	}
 }
 
+// NewWalletForThreshold returns a new wallet for a threshold keymanager which is temporary and not stored locally.
+func NewWalletForThreshold() *Wallet {
+	// wallet is just a temporary wallet for the threshold keymanager used to call initialize keymanager.
+	return &Wallet{
+		walletDir:      "",
+		accountsPath:   "",
+		keymanagerKind: keymanager.Threshold,
+		walletPassword: "",
+	}
+}
+
 // OpenWallet instantiates a wallet from a specified path. It checks the
 // type of keymanager associated with the wallet by reading files in the wallet
 // path, if applicable. If a wallet does not exist, returns an appropriate error.
@@ -359,6 +371,14 @@ func (w *Wallet) InitializeKeymanager(ctx context.Context, cfg iface.InitKeymana
 		if err != nil {
 			return nil, errors.Wrap(err, "could not initialize web3signer keymanager")
 		}
+	case keymanager.Threshold:
+		if cfg.ThresholdConfig == nil {
+			return nil, errors.New("threshold config is nil")
+		}
+		km, err = threshold.NewKeymanager(ctx, cfg.ThresholdConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize threshold keymanager")
+		}
 	default:
 		return nil, fmt.Errorf("keymanager kind not supported: %s", w.keymanagerKind)
 	}
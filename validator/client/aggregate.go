@@ -18,6 +18,7 @@ import (
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	dbCommon "github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -53,6 +54,9 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 	v.aggregatedSlotCommitteeIDCache.Add(k, true)
 	v.aggregatedSlotCommitteeIDCacheLock.Unlock()
 
+	record := v.startDutyRecord(dbCommon.AggregationDuty, slot, pubKey)
+	defer v.endDutyRecord(record)
+
 	var slotSig []byte
 	if v.distributed {
 		slotSig, err = v.attSelection(attSelectionKey{slot: slot, index: duty.ValidatorIndex})
@@ -123,6 +127,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 		}
 		return
 	}
+	markDutySubmitted(record, res.AggregateAndProof.Aggregate.Data.BeaconBlockRoot)
 
 	if err := v.saveSubmittedAtt(res.AggregateAndProof.Aggregate.Data, pubKey[:], true); err != nil {
 		log.WithError(err).Error("Could not add aggregator indices to logs")
@@ -22,6 +22,7 @@ import (
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	dbCommon "github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -55,6 +56,9 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 	lock.Lock()
 	defer lock.Unlock()
 
+	record := v.startDutyRecord(dbCommon.AttestationDuty, slot, pubKey)
+	defer v.endDutyRecord(record)
+
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 	log := log.WithField("pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).WithField("slot", slot)
 	duty, err := v.duty(pubKey)
@@ -154,6 +158,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 		tracing.AnnotateError(span, err)
 		return
 	}
+	markDutySubmitted(record, data.BeaconBlockRoot)
 
 	if err := v.saveSubmittedAtt(data, pubKey[:], false); err != nil {
 		log.WithError(err).Error("Could not save validator index for logging")
@@ -332,6 +332,11 @@ func (c beaconApiChainClient) ValidatorPerformance(ctx context.Context, in *ethp
 		return nil, err
 	}
 
+	inclusionSlots := make([]primitives.Slot, len(resp.InclusionSlots))
+	for i, slot := range resp.InclusionSlots {
+		inclusionSlots[i] = primitives.Slot(slot)
+	}
+
 	return &ethpb.ValidatorPerformanceResponse{
 		CurrentEffectiveBalances:      resp.CurrentEffectiveBalances,
 		CorrectlyVotedSource:          resp.CorrectlyVotedSource,
@@ -342,6 +347,7 @@ func (c beaconApiChainClient) ValidatorPerformance(ctx context.Context, in *ethp
 		MissingValidators:             resp.MissingValidators,
 		PublicKeys:                    resp.PublicKeys,
 		InactivityScores:              resp.InactivityScores,
+		InclusionSlots:                inclusionSlots,
 	}, nil
 }
 
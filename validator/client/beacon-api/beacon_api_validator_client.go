@@ -77,7 +77,7 @@ func (c *beaconApiValidatorClient) AttestationData(ctx context.Context, in *ethp
 
 func (c *beaconApiValidatorClient) BeaconBlock(ctx context.Context, in *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
 	return wrapInMetrics[*ethpb.GenericBeaconBlock]("BeaconBlock", func() (*ethpb.GenericBeaconBlock, error) {
-		return c.beaconBlock(ctx, in.Slot, in.RandaoReveal, in.Graffiti)
+		return c.beaconBlock(ctx, in.Slot, in.RandaoReveal, in.Graffiti, in.BuilderBoostFactor)
 	})
 }
 
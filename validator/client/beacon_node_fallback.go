@@ -0,0 +1,582 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/client/event"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/sirupsen/logrus"
+)
+
+// Weights of the signals used to rank the configured beacon nodes. Only healthy nodes can be selected,
+// among them a node loses points for every slot its head is behind the most advanced node, for
+// reporting that it is syncing and for having a head different from the head of the majority of nodes.
+const (
+	beaconNodeBaseScore           = 100
+	beaconNodeSyncingPenalty      = 50
+	beaconNodeHeadMismatchPenalty = 20
+	beaconNodeSlotBehindPenalty   = 2
+)
+
+var beaconNodeFailoverCount = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_beacon_node_failovers_total",
+		Help: "Number of beacon node API calls that were retried with or submitted to another beacon node after an error.",
+	},
+	[]string{"action"},
+)
+
+var _ = iface.ValidatorClient(&beaconNodeFallback{})
+
+// beaconNode holds the clients used to talk to one of the configured beacon nodes.
+type beaconNode struct {
+	host            string
+	validatorClient iface.ValidatorClient
+	nodeClient      iface.NodeClient
+	chainClient     iface.ChainClient
+}
+
+// beaconNodeStatus is the outcome of a health check of a single beacon node.
+type beaconNodeStatus struct {
+	healthy   bool
+	syncing   bool
+	headKnown bool
+	headSlot  primitives.Slot
+	headRoot  [32]byte
+}
+
+// beaconNodeFallback is a validator client spreading the duties of the validator over several beacon nodes.
+// Calls are sent to the primary node and retried on the other nodes, in order of preference, when they fail.
+// Blocks, attestations, aggregates and sync committee messages, as well as the preparation calls that
+// configure a beacon node for the validators, are broadcast to all healthy nodes. Blinded blocks are only
+// submitted to the node which built them, as the other nodes cannot unblind them.
+//
+// The primary node is the healthy node with the best score, which is updated every time the health of the
+// nodes is checked through the health tracker.
+type beaconNodeFallback struct {
+	nodes []*beaconNode
+	// jsonRestHandler is shared by the REST clients that are not part of the fallback,
+	// it is pointed at the primary node every time the primary node changes.
+	jsonRestHandler beaconApi.JsonRestHandler
+	healthTracker   *beacon.NodeHealthTracker
+	lock            sync.RWMutex
+	primary         int
+	scores          []int
+	healthy         []bool
+	eventStreamNode *beaconNode
+	// blindedBlockNodes are the nodes which built the blinded blocks of the slots to propose.
+	blindedBlockNodes map[primitives.Slot]*beaconNode
+}
+
+func newBeaconNodeFallback(nodes []*beaconNode, jsonRestHandler beaconApi.JsonRestHandler) *beaconNodeFallback {
+	healthy := make([]bool, len(nodes))
+	for i := range healthy {
+		// Nodes are assumed to be healthy until they are checked.
+		healthy[i] = true
+	}
+	f := &beaconNodeFallback{
+		nodes:             nodes,
+		jsonRestHandler:   jsonRestHandler,
+		scores:            make([]int, len(nodes)),
+		healthy:           healthy,
+		blindedBlockNodes: make(map[primitives.Slot]*beaconNode),
+	}
+	f.healthTracker = beacon.NewNodeHealthTracker(f)
+	return f
+}
+
+// IsHealthy checks the health of every beacon node, makes the best scoring node the primary node
+// and returns true if at least one node is healthy.
+func (f *beaconNodeFallback) IsHealthy(ctx context.Context) bool {
+	statuses := make([]beaconNodeStatus, len(f.nodes))
+	var wg sync.WaitGroup
+	for i, n := range f.nodes {
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			statuses[i] = n.status(ctx)
+		}(i, n)
+	}
+	wg.Wait()
+	scores := scoreBeaconNodes(statuses)
+	healthy := make([]bool, len(statuses))
+	for i, s := range statuses {
+		healthy[i] = s.healthy
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.scores = scores
+	f.healthy = healthy
+	best := f.primary
+	for i, score := range scores {
+		// The current primary node is kept on a tie to avoid switching back and forth, unless it is unhealthy.
+		if healthy[i] && (!healthy[best] || score > scores[best]) {
+			best = i
+		}
+	}
+	if best != f.primary {
+		log.WithFields(logrus.Fields{
+			"previousHost": f.nodes[f.primary].host,
+			"newHost":      f.nodes[best].host,
+			"score":        scores[best],
+		}).Info("Switching primary beacon node")
+		f.setPrimary(best)
+	}
+	return healthy[f.primary]
+}
+
+func (n *beaconNode) status(ctx context.Context) beaconNodeStatus {
+	s := beaconNodeStatus{healthy: n.nodeClient.HealthTracker().CheckHealth(ctx)}
+	if !s.healthy {
+		return s
+	}
+	syncStatus, err := n.nodeClient.SyncStatus(ctx, &empty.Empty{})
+	if err != nil {
+		log.WithError(err).WithField("host", n.host).Debug("Could not get sync status of beacon node")
+		s.healthy = false
+		return s
+	}
+	s.syncing = syncStatus.Syncing
+	head, err := n.chainClient.ChainHead(ctx, &empty.Empty{})
+	if err != nil {
+		log.WithError(err).WithField("host", n.host).Debug("Could not get chain head of beacon node")
+		return s
+	}
+	s.headKnown = true
+	s.headSlot = head.HeadSlot
+	s.headRoot = bytesutil.ToBytes32(head.HeadBlockRoot)
+	return s
+}
+
+// scoreBeaconNodes ranks beacon nodes based on their status. Unhealthy nodes get a negative score.
+func scoreBeaconNodes(statuses []beaconNodeStatus) []int {
+	var highestSlot primitives.Slot
+	votes := make(map[[32]byte]int)
+	for _, s := range statuses {
+		if !s.healthy || !s.headKnown {
+			continue
+		}
+		if s.headSlot > highestSlot {
+			highestSlot = s.headSlot
+		}
+		votes[s.headRoot]++
+	}
+	// The majority head is only taken into account when a single head has the most votes.
+	var majorityHead [32]byte
+	hasMajority := false
+	maxVotes := 0
+	for root, count := range votes {
+		switch {
+		case count > maxVotes:
+			maxVotes, majorityHead, hasMajority = count, root, true
+		case count == maxVotes:
+			hasMajority = false
+		}
+	}
+
+	maxSlotsBehind := params.BeaconConfig().SlotsPerEpoch
+	scores := make([]int, len(statuses))
+	for i, s := range statuses {
+		if !s.healthy {
+			scores[i] = -1
+			continue
+		}
+		score := beaconNodeBaseScore
+		if s.syncing {
+			score -= beaconNodeSyncingPenalty
+		}
+		slotsBehind := maxSlotsBehind
+		if s.headKnown && highestSlot-s.headSlot < maxSlotsBehind {
+			slotsBehind = highestSlot - s.headSlot
+		}
+		score -= int(slotsBehind) * beaconNodeSlotBehindPenalty
+		if !s.headKnown || (hasMajority && s.headRoot != majorityHead) {
+			score -= beaconNodeHeadMismatchPenalty
+		}
+		scores[i] = score
+	}
+	return scores
+}
+
+// setPrimary must be called while holding the lock.
+func (f *beaconNodeFallback) setPrimary(i int) {
+	f.primary = i
+	if f.jsonRestHandler != nil {
+		f.jsonRestHandler.SetHost(f.nodes[i].host)
+	}
+}
+
+func (f *beaconNodeFallback) primaryNode() *beaconNode {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.nodes[f.primary]
+}
+
+// preferredNodes returns all beacon nodes, starting with the primary node and followed by the other nodes
+// from the best to the worst score.
+func (f *beaconNodeFallback) preferredNodes() []*beaconNode {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.nodesByScore(false)
+}
+
+// broadcastNodes returns the primary node followed by all other nodes that are not known to be unhealthy.
+func (f *beaconNodeFallback) broadcastNodes() []*beaconNode {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.nodesByScore(true)
+}
+
+// nodesByScore must be called while holding the lock.
+func (f *beaconNodeFallback) nodesByScore(healthyOnly bool) []*beaconNode {
+	indices := make([]int, 0, len(f.nodes))
+	for i := range f.nodes {
+		if i == f.primary || (healthyOnly && !f.healthy[i]) {
+			continue
+		}
+		indices = append(indices, i)
+	}
+	sort.SliceStable(indices, func(a, b int) bool {
+		return f.scores[indices[a]] > f.scores[indices[b]]
+	})
+	nodes := make([]*beaconNode, 0, len(indices)+1)
+	nodes = append(nodes, f.nodes[f.primary])
+	for _, i := range indices {
+		nodes = append(nodes, f.nodes[i])
+	}
+	return nodes
+}
+
+// indexOf must be called while holding the lock.
+func (f *beaconNodeFallback) indexOf(host string) int {
+	for i, n := range f.nodes {
+		if n.host == host {
+			return i
+		}
+	}
+	return -1
+}
+
+// withFallback sends the call to the primary node and retries it on the other nodes until it succeeds.
+func withFallback[Resp any](ctx context.Context, f *beaconNodeFallback, action string, call func(c iface.ValidatorClient) (Resp, error)) (Resp, error) {
+	resp, _, err := withFallbackNode(ctx, f, action, call)
+	return resp, err
+}
+
+// withFallbackNode is withFallback, also returning the node which answered the call.
+func withFallbackNode[Resp any](ctx context.Context, f *beaconNodeFallback, action string, call func(c iface.ValidatorClient) (Resp, error)) (Resp, *beaconNode, error) {
+	var resp Resp
+	var err error
+	nodes := f.preferredNodes()
+	for i, n := range nodes {
+		resp, err = call(n.validatorClient)
+		if err == nil || ctx.Err() != nil {
+			return resp, n, err
+		}
+		if i < len(nodes)-1 {
+			log.WithError(err).WithFields(logrus.Fields{
+				"action":   action,
+				"host":     n.host,
+				"nextHost": nodes[i+1].host,
+			}).Debug("Beacon node call failed, retrying with the next beacon node")
+			beaconNodeFailoverCount.WithLabelValues(action).Inc()
+		}
+	}
+	return resp, nil, err
+}
+
+// broadcast sends the call to all healthy nodes at the same time, and returns the response of the first node that
+// succeeds. The submissions to the other nodes go on in the background, for at most a slot, even once the context
+// of the call is canceled. When every node fails, the error of the primary node is returned.
+func broadcast[Resp any](ctx context.Context, f *beaconNodeFallback, action string, call func(ctx context.Context, c iface.ValidatorClient) (Resp, error)) (Resp, error) {
+	nodes := f.broadcastNodes()
+	type result struct {
+		index int
+		resp  Resp
+		err   error
+	}
+	results := make(chan result, len(nodes))
+	submitCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second)
+	var wg sync.WaitGroup
+	for i, n := range nodes {
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			resp, err := call(submitCtx, n.validatorClient)
+			if err != nil {
+				log.WithError(err).WithFields(logrus.Fields{
+					"action": action,
+					"host":   n.host,
+				}).Debug("Beacon node could not process broadcast call")
+			}
+			results <- result{index: i, resp: resp, err: err}
+		}(i, n)
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+	}()
+
+	var primary result
+	primaryFailed := false
+	for range nodes {
+		var r result
+		select {
+		case r = <-results:
+		case <-ctx.Done():
+			var resp Resp
+			return resp, ctx.Err()
+		}
+		if r.err == nil {
+			if primaryFailed {
+				beaconNodeFailoverCount.WithLabelValues(action).Inc()
+			}
+			return r.resp, nil
+		}
+		if r.index == 0 {
+			primary, primaryFailed = r, true
+		}
+	}
+	return primary.resp, primary.err
+}
+
+func (f *beaconNodeFallback) Duties(ctx context.Context, in *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	return withFallback(ctx, f, "duties", func(c iface.ValidatorClient) (*ethpb.DutiesResponse, error) {
+		return c.Duties(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) DomainData(ctx context.Context, in *ethpb.DomainRequest) (*ethpb.DomainResponse, error) {
+	return withFallback(ctx, f, "domain_data", func(c iface.ValidatorClient) (*ethpb.DomainResponse, error) {
+		return c.DomainData(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) WaitForChainStart(ctx context.Context, in *empty.Empty) (*ethpb.ChainStartResponse, error) {
+	return withFallback(ctx, f, "wait_for_chain_start", func(c iface.ValidatorClient) (*ethpb.ChainStartResponse, error) {
+		return c.WaitForChainStart(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return withFallback(ctx, f, "wait_for_activation", func(c iface.ValidatorClient) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+		return c.WaitForActivation(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest) (*ethpb.ValidatorIndexResponse, error) {
+	return withFallback(ctx, f, "validator_index", func(c iface.ValidatorClient) (*ethpb.ValidatorIndexResponse, error) {
+		return c.ValidatorIndex(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) ValidatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest) (*ethpb.ValidatorStatusResponse, error) {
+	return withFallback(ctx, f, "validator_status", func(c iface.ValidatorClient) (*ethpb.ValidatorStatusResponse, error) {
+		return c.ValidatorStatus(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest) (*ethpb.MultipleValidatorStatusResponse, error) {
+	return withFallback(ctx, f, "multiple_validator_status", func(c iface.ValidatorClient) (*ethpb.MultipleValidatorStatusResponse, error) {
+		return c.MultipleValidatorStatus(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) BeaconBlock(ctx context.Context, in *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
+	blk, n, err := withFallbackNode(ctx, f, "beacon_block", func(c iface.ValidatorClient) (*ethpb.GenericBeaconBlock, error) {
+		return c.BeaconBlock(ctx, in)
+	})
+	if err != nil || !blk.GetIsBlinded() {
+		return blk, err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for slot := range f.blindedBlockNodes {
+		if slot < in.Slot {
+			delete(f.blindedBlockNodes, slot)
+		}
+	}
+	f.blindedBlockNodes[in.Slot] = n
+	return blk, nil
+}
+
+func (f *beaconNodeFallback) ProposeBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+	if in.GetIsBlinded() {
+		return f.proposeBlindedBeaconBlock(ctx, in)
+	}
+	return broadcast(ctx, f, "propose_beacon_block", func(ctx context.Context, c iface.ValidatorClient) (*ethpb.ProposeResponse, error) {
+		return c.ProposeBeaconBlock(ctx, in)
+	})
+}
+
+// proposeBlindedBeaconBlock submits a blinded block to the node which built it, which is the only one able to get
+// its payload from the builder. A blinded block built by an unknown node is submitted to the primary node.
+func (f *beaconNodeFallback) proposeBlindedBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+	blk, err := blocks.NewSignedBeaconBlock(in.Block)
+	if err != nil {
+		return nil, err
+	}
+	slot := blk.Block().Slot()
+	f.lock.Lock()
+	n, ok := f.blindedBlockNodes[slot]
+	delete(f.blindedBlockNodes, slot)
+	f.lock.Unlock()
+	if !ok {
+		n = f.primaryNode()
+		log.WithFields(logrus.Fields{
+			"slot": slot,
+			"host": n.host,
+		}).Debug("Unknown beacon node built the blinded block, submitting it to the primary beacon node")
+	}
+	return n.validatorClient.ProposeBeaconBlock(ctx, in)
+}
+
+func (f *beaconNodeFallback) PrepareBeaconProposer(ctx context.Context, in *ethpb.PrepareBeaconProposerRequest) (*empty.Empty, error) {
+	return broadcast(ctx, f, "prepare_beacon_proposer", func(ctx context.Context, c iface.ValidatorClient) (*empty.Empty, error) {
+		return c.PrepareBeaconProposer(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) FeeRecipientByPubKey(ctx context.Context, in *ethpb.FeeRecipientByPubKeyRequest) (*ethpb.FeeRecipientByPubKeyResponse, error) {
+	return withFallback(ctx, f, "fee_recipient_by_pubkey", func(c iface.ValidatorClient) (*ethpb.FeeRecipientByPubKeyResponse, error) {
+		return c.FeeRecipientByPubKey(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) AttestationData(ctx context.Context, in *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+	return withFallback(ctx, f, "attestation_data", func(c iface.ValidatorClient) (*ethpb.AttestationData, error) {
+		return c.AttestationData(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) ProposeAttestation(ctx context.Context, in *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	return broadcast(ctx, f, "propose_attestation", func(ctx context.Context, c iface.ValidatorClient) (*ethpb.AttestResponse, error) {
+		return c.ProposeAttestation(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest, index primitives.ValidatorIndex, committeeLength uint64) (*ethpb.AggregateSelectionResponse, error) {
+	return withFallback(ctx, f, "submit_aggregate_selection_proof", func(c iface.ValidatorClient) (*ethpb.AggregateSelectionResponse, error) {
+		return c.SubmitAggregateSelectionProof(ctx, in, index, committeeLength)
+	})
+}
+
+func (f *beaconNodeFallback) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest) (*ethpb.SignedAggregateSubmitResponse, error) {
+	return broadcast(ctx, f, "submit_signed_aggregate_selection_proof", func(ctx context.Context, c iface.ValidatorClient) (*ethpb.SignedAggregateSubmitResponse, error) {
+		return c.SubmitSignedAggregateSelectionProof(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit) (*ethpb.ProposeExitResponse, error) {
+	return broadcast(ctx, f, "propose_exit", func(ctx context.Context, c iface.ValidatorClient) (*ethpb.ProposeExitResponse, error) {
+		return c.ProposeExit(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, duties []*ethpb.DutiesResponse_Duty) (*empty.Empty, error) {
+	return broadcast(ctx, f, "subscribe_committee_subnets", func(ctx context.Context, c iface.ValidatorClient) (*empty.Empty, error) {
+		return c.SubscribeCommitteeSubnets(ctx, in, duties)
+	})
+}
+
+func (f *beaconNodeFallback) CheckDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
+	return withFallback(ctx, f, "check_doppelganger", func(c iface.ValidatorClient) (*ethpb.DoppelGangerResponse, error) {
+		return c.CheckDoppelGanger(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) SyncMessageBlockRoot(ctx context.Context, in *empty.Empty) (*ethpb.SyncMessageBlockRootResponse, error) {
+	return withFallback(ctx, f, "sync_message_block_root", func(c iface.ValidatorClient) (*ethpb.SyncMessageBlockRootResponse, error) {
+		return c.SyncMessageBlockRoot(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage) (*empty.Empty, error) {
+	return broadcast(ctx, f, "submit_sync_message", func(ctx context.Context, c iface.ValidatorClient) (*empty.Empty, error) {
+		return c.SubmitSyncMessage(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) SyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	return withFallback(ctx, f, "sync_subcommittee_index", func(c iface.ValidatorClient) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+		return c.SyncSubcommitteeIndex(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) SyncCommitteeContribution(ctx context.Context, in *ethpb.SyncCommitteeContributionRequest) (*ethpb.SyncCommitteeContribution, error) {
+	return withFallback(ctx, f, "sync_committee_contribution", func(c iface.ValidatorClient) (*ethpb.SyncCommitteeContribution, error) {
+		return c.SyncCommitteeContribution(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof) (*empty.Empty, error) {
+	return broadcast(ctx, f, "submit_signed_contribution_and_proof", func(ctx context.Context, c iface.ValidatorClient) (*empty.Empty, error) {
+		return c.SubmitSignedContributionAndProof(ctx, in)
+	})
+}
+
+func (f *beaconNodeFallback) SubmitValidatorRegistrations(ctx context.Context, in *ethpb.SignedValidatorRegistrationsV1) (*empty.Empty, error) {
+	return broadcast(ctx, f, "submit_validator_registrations", func(ctx context.Context, c iface.ValidatorClient) (*empty.Empty, error) {
+		return c.SubmitValidatorRegistrations(ctx, in)
+	})
+}
+
+// StartEventStream starts the event stream of the primary node. The stream is not moved when the primary
+// node changes, it is restarted on the new primary node once it stops.
+func (f *beaconNodeFallback) StartEventStream(ctx context.Context, topics []string, eventsChannel chan<- *event.Event) {
+	n := f.primaryNode()
+	f.lock.Lock()
+	f.eventStreamNode = n
+	f.lock.Unlock()
+	n.validatorClient.StartEventStream(ctx, topics, eventsChannel)
+}
+
+func (f *beaconNodeFallback) EventStreamIsRunning() bool {
+	f.lock.RLock()
+	n := f.eventStreamNode
+	f.lock.RUnlock()
+	return n != nil && n.validatorClient.EventStreamIsRunning()
+}
+
+func (f *beaconNodeFallback) AggregatedSelections(ctx context.Context, selections []iface.BeaconCommitteeSelection) ([]iface.BeaconCommitteeSelection, error) {
+	return withFallback(ctx, f, "aggregated_selections", func(c iface.ValidatorClient) ([]iface.BeaconCommitteeSelection, error) {
+		return c.AggregatedSelections(ctx, selections)
+	})
+}
+
+func (f *beaconNodeFallback) AggregatedSyncSelections(ctx context.Context, selections []iface.SyncCommitteeSelection) ([]iface.SyncCommitteeSelection, error) {
+	return withFallback(ctx, f, "aggregated_sync_selections", func(c iface.ValidatorClient) ([]iface.SyncCommitteeSelection, error) {
+		return c.AggregatedSyncSelections(ctx, selections)
+	})
+}
+
+// Host returns the host of the primary node.
+func (f *beaconNodeFallback) Host() string {
+	return f.primaryNode().host
+}
+
+// SetHost makes the node with the given host the primary node.
+func (f *beaconNodeFallback) SetHost(host string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	i := f.indexOf(host)
+	if i < 0 {
+		log.WithField("host", host).Warn("Cannot switch to a beacon node that is not configured")
+		return
+	}
+	f.setPrimary(i)
+}
@@ -0,0 +1,237 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	healthTesting "github.com/prysmaticlabs/prysm/v5/api/client/beacon/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"go.uber.org/mock/gomock"
+)
+
+type mockBeaconNode struct {
+	*beaconNode
+	validatorClient *validatormock.MockValidatorClient
+	nodeClient      *validatormock.MockNodeClient
+	chainClient     *validatormock.MockChainClient
+	health          *healthTesting.MockHealthClient
+}
+
+func newMockBeaconNode(ctrl *gomock.Controller, host string) *mockBeaconNode {
+	m := &mockBeaconNode{
+		validatorClient: validatormock.NewMockValidatorClient(ctrl),
+		nodeClient:      validatormock.NewMockNodeClient(ctrl),
+		chainClient:     validatormock.NewMockChainClient(ctrl),
+		health:          healthTesting.NewMockHealthClient(ctrl),
+	}
+	m.beaconNode = &beaconNode{
+		host:            host,
+		validatorClient: m.validatorClient,
+		nodeClient:      m.nodeClient,
+		chainClient:     m.chainClient,
+	}
+	tracker := beacon.NewNodeHealthTracker(m.health)
+	m.nodeClient.EXPECT().HealthTracker().Return(tracker).AnyTimes()
+	return m
+}
+
+func (m *mockBeaconNode) expectStatus(healthy, syncing bool, headSlot primitives.Slot, headRoot byte) {
+	m.health.EXPECT().IsHealthy(gomock.Any()).Return(healthy)
+	if !healthy {
+		return
+	}
+	m.nodeClient.EXPECT().SyncStatus(gomock.Any(), gomock.Any()).Return(&ethpb.SyncStatus{Syncing: syncing}, nil)
+	root := make([]byte, 32)
+	root[0] = headRoot
+	m.chainClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadSlot: headSlot, HeadBlockRoot: root}, nil)
+}
+
+func newMockFallback(ctrl *gomock.Controller, hosts ...string) (*beaconNodeFallback, []*mockBeaconNode) {
+	mocks := make([]*mockBeaconNode, len(hosts))
+	nodes := make([]*beaconNode, len(hosts))
+	for i, host := range hosts {
+		mocks[i] = newMockBeaconNode(ctrl, host)
+		nodes[i] = mocks[i].beaconNode
+	}
+	return newBeaconNodeFallback(nodes, nil), mocks
+}
+
+func TestScoreBeaconNodes(t *testing.T) {
+	scores := scoreBeaconNodes([]beaconNodeStatus{
+		{healthy: true, headKnown: true, headSlot: 10, headRoot: [32]byte{'a'}},
+		{healthy: true, headKnown: true, headSlot: 10, headRoot: [32]byte{'a'}},
+		{healthy: true, headKnown: true, headSlot: 10, headRoot: [32]byte{'b'}},
+		{healthy: true, headKnown: true, headSlot: 7, headRoot: [32]byte{'c'}},
+		{healthy: true, syncing: true, headKnown: true, headSlot: 10, headRoot: [32]byte{'a'}},
+		{healthy: true},
+		{healthy: false},
+	})
+	assert.DeepEqual(t, []int{
+		beaconNodeBaseScore,
+		beaconNodeBaseScore,
+		beaconNodeBaseScore - beaconNodeHeadMismatchPenalty,
+		beaconNodeBaseScore - 3*beaconNodeSlotBehindPenalty - beaconNodeHeadMismatchPenalty,
+		beaconNodeBaseScore - beaconNodeSyncingPenalty,
+		beaconNodeBaseScore - 32*beaconNodeSlotBehindPenalty - beaconNodeHeadMismatchPenalty,
+		-1,
+	}, scores)
+
+	// Without a majority head no node is penalized for its head.
+	scores = scoreBeaconNodes([]beaconNodeStatus{
+		{healthy: true, headKnown: true, headSlot: 10, headRoot: [32]byte{'a'}},
+		{healthy: true, headKnown: true, headSlot: 10, headRoot: [32]byte{'b'}},
+	})
+	assert.DeepEqual(t, []int{beaconNodeBaseScore, beaconNodeBaseScore}, scores)
+}
+
+func TestBeaconNodeFallback_IsHealthy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	f, nodes := newMockFallback(ctrl, "a", "b", "c")
+
+	// The primary node stays in place while it is as good as the others.
+	nodes[0].expectStatus(true, false, 10, 1)
+	nodes[1].expectStatus(true, false, 10, 1)
+	nodes[2].expectStatus(true, false, 9, 2)
+	require.Equal(t, true, f.IsHealthy(ctx))
+	require.Equal(t, "a", f.Host())
+
+	// The primary node falls behind the others.
+	nodes[0].expectStatus(true, false, 10, 1)
+	nodes[1].expectStatus(true, false, 11, 3)
+	nodes[2].expectStatus(true, false, 11, 3)
+	require.Equal(t, true, f.IsHealthy(ctx))
+	require.Equal(t, "b", f.Host())
+
+	// The primary node becomes unhealthy.
+	nodes[0].expectStatus(true, false, 11, 3)
+	nodes[1].expectStatus(false, false, 0, 0)
+	nodes[2].expectStatus(true, true, 11, 3)
+	require.Equal(t, true, f.IsHealthy(ctx))
+	require.Equal(t, "a", f.Host())
+
+	// No node is healthy.
+	nodes[0].expectStatus(false, false, 0, 0)
+	nodes[1].expectStatus(false, false, 0, 0)
+	nodes[2].expectStatus(false, false, 0, 0)
+	require.Equal(t, false, f.IsHealthy(ctx))
+}
+
+func TestBeaconNodeFallback_FailsOver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	f, nodes := newMockFallback(ctrl, "a", "b", "c")
+	f.scores = []int{beaconNodeBaseScore, -1, beaconNodeBaseScore}
+	f.healthy = []bool{true, false, true}
+
+	want := &ethpb.AttestationData{Slot: 5}
+	gomock.InOrder(
+		nodes[0].validatorClient.EXPECT().AttestationData(gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout")),
+		// The unhealthy node is tried last.
+		nodes[2].validatorClient.EXPECT().AttestationData(gomock.Any(), gomock.Any()).Return(want, nil),
+	)
+	got, err := f.AttestationData(ctx, &ethpb.AttestationDataRequest{Slot: 5})
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+
+	gomock.InOrder(
+		nodes[0].validatorClient.EXPECT().AttestationData(gomock.Any(), gomock.Any()).Return(nil, errors.New("a")),
+		nodes[2].validatorClient.EXPECT().AttestationData(gomock.Any(), gomock.Any()).Return(nil, errors.New("c")),
+		nodes[1].validatorClient.EXPECT().AttestationData(gomock.Any(), gomock.Any()).Return(nil, errors.New("b")),
+	)
+	_, err = f.AttestationData(ctx, &ethpb.AttestationDataRequest{Slot: 5})
+	require.ErrorContains(t, "b", err)
+}
+
+func TestBeaconNodeFallback_Broadcast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	f, nodes := newMockFallback(ctrl, "a", "b", "c")
+	f.scores = []int{beaconNodeBaseScore, beaconNodeBaseScore, -1}
+	f.healthy = []bool{true, true, false}
+
+	att := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 5}}
+	nodes[0].validatorClient.EXPECT().ProposeAttestation(gomock.Any(), att).Return(nil, errors.New("timeout"))
+	nodes[1].validatorClient.EXPECT().ProposeAttestation(gomock.Any(), att).Return(&ethpb.AttestResponse{AttestationDataRoot: []byte{'b'}}, nil)
+	resp, err := f.ProposeAttestation(ctx, att)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'b'}, resp.AttestationDataRoot)
+
+	// The first success is returned without waiting for the other nodes, whose submissions go on in the background.
+	release, submitted := make(chan struct{}), make(chan struct{})
+	var submitErr error
+	nodes[0].validatorClient.EXPECT().ProposeAttestation(gomock.Any(), att).DoAndReturn(
+		func(ctx context.Context, _ *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+			<-release
+			defer close(submitted)
+			submitErr = ctx.Err()
+			return &ethpb.AttestResponse{AttestationDataRoot: []byte{'a'}}, nil
+		})
+	nodes[1].validatorClient.EXPECT().ProposeAttestation(gomock.Any(), att).Return(&ethpb.AttestResponse{AttestationDataRoot: []byte{'b'}}, nil)
+	callCtx, cancel := context.WithCancel(ctx)
+	resp, err = f.ProposeAttestation(callCtx, att)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'b'}, resp.AttestationDataRoot)
+	cancel()
+	close(release)
+	<-submitted
+	require.NoError(t, submitErr)
+
+	nodes[0].validatorClient.EXPECT().ProposeAttestation(gomock.Any(), att).Return(nil, errors.New("a"))
+	nodes[1].validatorClient.EXPECT().ProposeAttestation(gomock.Any(), att).Return(nil, errors.New("b"))
+	_, err = f.ProposeAttestation(ctx, att)
+	require.ErrorContains(t, "a", err)
+}
+
+func TestBeaconNodeFallback_SetHost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f, _ := newMockFallback(ctrl, "a", "b")
+	f.SetHost("b")
+	require.Equal(t, "b", f.Host())
+	f.SetHost("unknown")
+	require.Equal(t, "b", f.Host())
+}
+
+func TestBeaconNodeFallback_BlindedBlockToBuilder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	f, nodes := newMockFallback(ctrl, "a", "b")
+
+	blinded := &ethpb.GenericBeaconBlock{
+		Block:     &ethpb.GenericBeaconBlock_BlindedCapella{BlindedCapella: util.NewBlindedBeaconBlockCapella().Block},
+		IsBlinded: true,
+	}
+	blinded.GetBlindedCapella().Slot = 5
+	nodes[0].validatorClient.EXPECT().BeaconBlock(gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout"))
+	nodes[1].validatorClient.EXPECT().BeaconBlock(gomock.Any(), gomock.Any()).Return(blinded, nil)
+	_, err := f.BeaconBlock(ctx, &ethpb.BlockRequest{Slot: 5})
+	require.NoError(t, err)
+
+	// The blinded block is only submitted to the node which built it.
+	signed := util.NewBlindedBeaconBlockCapella()
+	signed.Block.Slot = 5
+	in := &ethpb.GenericSignedBeaconBlock{
+		Block:     &ethpb.GenericSignedBeaconBlock_BlindedCapella{BlindedCapella: signed},
+		IsBlinded: true,
+	}
+	nodes[1].validatorClient.EXPECT().ProposeBeaconBlock(gomock.Any(), in).Return(&ethpb.ProposeResponse{}, nil)
+	_, err = f.ProposeBeaconBlock(ctx, in)
+	require.NoError(t, err)
+
+	// A blinded block built by an unknown node is submitted to the primary node.
+	nodes[0].validatorClient.EXPECT().ProposeBeaconBlock(gomock.Any(), in).Return(&ethpb.ProposeResponse{}, nil)
+	_, err = f.ProposeBeaconBlock(ctx, in)
+	require.NoError(t, err)
+}
@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrDoppelgangerDisabled is returned when overriding the doppelganger window of a key while doppelganger
+// protection is not enabled.
+var ErrDoppelgangerDisabled = errors.New("doppelganger protection is not enabled")
+
+// ErrUnknownValidatingKey is returned for a doppelganger request about a key which is not validating.
+var ErrUnknownValidatingKey = errors.New("public key is not a validating key")
+
+// doppelgangerTracker holds the doppelganger window of every validating key. Each key starts its window once it is
+// first seen as a validating key, either at startup, after an import or after being discovered on a remote signer.
+// The liveness of the key is checked at the start of every epoch of the window, and the key only signs once the
+// window ended without any doppelganger being detected.
+type doppelgangerTracker struct {
+	epochs primitives.Epoch
+	lock   sync.RWMutex
+	keys   map[[fieldparams.BLSPubkeyLength]byte]*iface.DoppelgangerStatus
+}
+
+func newDoppelgangerTracker(epochs primitives.Epoch) *doppelgangerTracker {
+	return &doppelgangerTracker{
+		epochs: epochs,
+		keys:   make(map[[fieldparams.BLSPubkeyLength]byte]*iface.DoppelgangerStatus),
+	}
+}
+
+// track starts the window of the validating keys which are not tracked yet, and forgets the keys which were removed,
+// so that they go through a new window if they are added back.
+func (t *doppelgangerTracker) track(validatingKeys [][fieldparams.BLSPubkeyLength]byte, epoch primitives.Epoch) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	validating := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(validatingKeys))
+	for _, k := range validatingKeys {
+		validating[k] = true
+		t.add(k, epoch)
+	}
+	for k := range t.keys {
+		if !validating[k] {
+			delete(t.keys, k)
+		}
+	}
+}
+
+func (t *doppelgangerTracker) add(pubKey [fieldparams.BLSPubkeyLength]byte, epoch primitives.Epoch) *iface.DoppelgangerStatus {
+	if s, ok := t.keys[pubKey]; ok {
+		return s
+	}
+	s := &iface.DoppelgangerStatus{
+		State:           iface.DoppelgangerPending,
+		RegisteredEpoch: epoch,
+		SafeEpoch:       epoch + t.epochs,
+	}
+	t.keys[pubKey] = s
+	log.WithFields(logrus.Fields{
+		"pubkey":    fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+		"safeEpoch": s.SafeEpoch,
+	}).Info("Validating key entered its doppelganger window, it will not sign until the window ends")
+	return s
+}
+
+// pending returns the keys within their doppelganger window.
+func (t *doppelgangerTracker) pending() [][fieldparams.BLSPubkeyLength]byte {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	keys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	for k, s := range t.keys {
+		if s.State == iface.DoppelgangerPending {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// update the pending keys with the result of the liveness check run in the given epoch.
+func (t *doppelgangerTracker) update(epoch primitives.Epoch, duplicates map[[fieldparams.BLSPubkeyLength]byte]bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for k, s := range t.keys {
+		if s.State != iface.DoppelgangerPending {
+			continue
+		}
+		log := log.WithField("pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(k[:])))
+		if duplicates[k] {
+			s.State = iface.DoppelgangerDetected
+			s.DetectedEpoch = epoch
+			log.Error("Doppelganger detected for validating key, it will not sign until it is manually overridden")
+			continue
+		}
+		if epoch >= s.SafeEpoch {
+			s.State = iface.DoppelgangerSafe
+			log.Info("No doppelganger detected during the window of validating key, it will now sign")
+		}
+	}
+}
+
+// canSign returns true if the key ended its window without doppelganger, or was overridden.
+// Every key signs when the tracker is nil, as doppelganger protection is then disabled.
+func (t *doppelgangerTracker) canSign(pubKey [fieldparams.BLSPubkeyLength]byte) bool {
+	if t == nil {
+		return true
+	}
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	s, ok := t.keys[pubKey]
+	return ok && s.State.CanSign()
+}
+
+func (t *doppelgangerTracker) status(pubKey [fieldparams.BLSPubkeyLength]byte, epoch primitives.Epoch) *iface.DoppelgangerStatus {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	s := *t.add(pubKey, epoch)
+	return &s
+}
+
+func (t *doppelgangerTracker) override(pubKey [fieldparams.BLSPubkeyLength]byte, epoch primitives.Epoch) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.add(pubKey, epoch).State = iface.DoppelgangerOverridden
+	log.WithField("pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Warn("Doppelganger window of validating key manually overridden")
+}
+
+// checkPendingDoppelgangers runs the doppelganger check for the keys within their window. Keys are left pending
+// when the check fails, so that it is retried in the next epoch.
+func (v *validator) checkPendingDoppelgangers(ctx context.Context, epoch primitives.Epoch) {
+	pending := v.doppelganger.pending()
+	if len(pending) == 0 {
+		return
+	}
+	duplicates, err := v.doppelgangerDuplicates(ctx, pending)
+	if err != nil {
+		log.WithError(err).WithField("keyCount", len(pending)).Warn("Could not check keys within their doppelganger window")
+		return
+	}
+	v.doppelganger.update(epoch, duplicates)
+}
+
+// DoppelgangerStatus returns the doppelganger protection status of a validating key. A key which was not seen yet
+// starts its window.
+func (v *validator) DoppelgangerStatus(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*iface.DoppelgangerStatus, error) {
+	if err := v.checkValidatingKey(ctx, pubKey); err != nil {
+		return nil, err
+	}
+	if v.doppelganger == nil {
+		return &iface.DoppelgangerStatus{State: iface.DoppelgangerDisabled}, nil
+	}
+	return v.doppelganger.status(pubKey, slots.ToEpoch(slots.CurrentSlot(v.genesisTime))), nil
+}
+
+// OverrideDoppelganger allows a validating key to sign right away, whether it is within its doppelganger window or
+// a doppelganger was detected for it.
+func (v *validator) OverrideDoppelganger(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	if v.doppelganger == nil {
+		return ErrDoppelgangerDisabled
+	}
+	if err := v.checkValidatingKey(ctx, pubKey); err != nil {
+		return err
+	}
+	v.doppelganger.override(pubKey, slots.ToEpoch(slots.CurrentSlot(v.genesisTime)))
+	return nil
+}
+
+func (v *validator) checkValidatingKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	if v.km == nil {
+		return errors.New("keymanager is unavailable")
+	}
+	keys, err := v.km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating keys")
+	}
+	for _, k := range keys {
+		if k == pubKey {
+			return nil
+		}
+	}
+	return ErrUnknownValidatingKey
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	dbTest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestDoppelgangerTracker(t *testing.T) {
+	k1, k2 := [fieldparams.BLSPubkeyLength]byte{1}, [fieldparams.BLSPubkeyLength]byte{2}
+	tracker := newDoppelgangerTracker(2)
+	tracker.track([][fieldparams.BLSPubkeyLength]byte{k1, k2}, 10)
+	assert.Equal(t, false, tracker.canSign(k1))
+	assert.Equal(t, 2, len(tracker.pending()))
+
+	tracker.update(10, nil)
+	assert.Equal(t, false, tracker.canSign(k1))
+	tracker.update(11, map[[fieldparams.BLSPubkeyLength]byte]bool{k2: true})
+	assert.Equal(t, iface.DoppelgangerDetected, tracker.status(k2, 11).State)
+	assert.Equal(t, 1, len(tracker.pending()))
+	tracker.update(12, nil)
+	assert.Equal(t, true, tracker.canSign(k1))
+	assert.Equal(t, false, tracker.canSign(k2))
+
+	tracker.override(k2, 12)
+	assert.Equal(t, true, tracker.canSign(k2))
+
+	// A removed key goes through a new window once it is added back.
+	tracker.track([][fieldparams.BLSPubkeyLength]byte{k1}, 13)
+	tracker.track([][fieldparams.BLSPubkeyLength]byte{k1, k2}, 14)
+	s := tracker.status(k2, 14)
+	assert.Equal(t, iface.DoppelgangerPending, s.State)
+	assert.Equal(t, uint64(16), uint64(s.SafeEpoch))
+
+	var disabled *doppelgangerTracker
+	assert.Equal(t, true, disabled.canSign(k1))
+}
+
+func TestUpdateDuties_DoppelgangerWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+	km := genMockKeymanager(t, 2)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	v := validator{
+		km:              km,
+		validatorClient: client,
+		db:              dbTest.SetupDB(t, keys, false),
+		doppelganger:    newDoppelgangerTracker(1),
+	}
+
+	var requested [][]byte
+	client.EXPECT().Duties(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+			requested = req.PublicKeys
+			return &ethpb.DutiesResponse{}, nil
+		}).Times(2)
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any(), gomock.Any()).Return(&emptypb.Empty{}, nil).AnyTimes()
+
+	// The second key is live in the network, so it never gets duties.
+	client.EXPECT().CheckDoppelGanger(gomock.Any(), gomock.Any()).Return(&ethpb.DoppelGangerResponse{
+		Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{
+			{PublicKey: keys[0][:], DuplicateExists: false},
+			{PublicKey: keys[1][:], DuplicateExists: true},
+		},
+	}, nil)
+	slot := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, v.UpdateDuties(context.Background(), slot))
+	assert.Equal(t, 0, len(requested))
+
+	// Only the first key is still checked, and it gets duties once its window ended.
+	client.EXPECT().CheckDoppelGanger(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
+			require.Equal(t, 1, len(req.ValidatorRequests))
+			return &ethpb.DoppelGangerResponse{
+				Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{{PublicKey: keys[0][:]}},
+			}, nil
+		})
+	require.NoError(t, v.UpdateDuties(context.Background(), 2*slot))
+	require.Equal(t, 1, len(requested))
+	assert.DeepEqual(t, keys[0], bytesutil.ToBytes48(requested[0]))
+
+	status, err := v.DoppelgangerStatus(context.Background(), keys[1])
+	require.NoError(t, err)
+	assert.Equal(t, iface.DoppelgangerDetected, status.State)
+	assert.Equal(t, uint64(1), uint64(status.DetectedEpoch))
+}
+
+func TestValidator_OverrideDoppelganger(t *testing.T) {
+	km := genMockKeymanager(t, 1)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	v := &validator{km: km}
+
+	status, err := v.DoppelgangerStatus(context.Background(), keys[0])
+	require.NoError(t, err)
+	assert.Equal(t, iface.DoppelgangerDisabled, status.State)
+	require.ErrorIs(t, v.OverrideDoppelganger(context.Background(), keys[0]), ErrDoppelgangerDisabled)
+
+	v.doppelganger = newDoppelgangerTracker(2)
+	status, err = v.DoppelgangerStatus(context.Background(), keys[0])
+	require.NoError(t, err)
+	assert.Equal(t, iface.DoppelgangerPending, status.State)
+	require.NoError(t, v.OverrideDoppelganger(context.Background(), keys[0]))
+	assert.Equal(t, true, v.doppelganger.canSign(keys[0]))
+	require.ErrorIs(t, v.OverrideDoppelganger(context.Background(), [fieldparams.BLSPubkeyLength]byte{1}), ErrUnknownValidatingKey)
+}
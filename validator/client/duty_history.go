@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	dbCommon "github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+// dutyHistory holds the duties performed since the last slot, until they are saved into the database.
+type dutyHistory struct {
+	retention primitives.Epoch
+	lock      sync.Mutex
+	pending   []*dbCommon.DutyRecord
+}
+
+// drain returns the pending duty records, and empties them.
+func (h *dutyHistory) drain() []*dbCommon.DutyRecord {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	records := h.pending
+	h.pending = nil
+	return records
+}
+
+// startDutyRecord returns the record of a duty about to be performed, or nil when the duty history is disabled.
+// The record must be passed to endDutyRecord once the duty is done, whatever its outcome.
+func (v *validator) startDutyRecord(kind dbCommon.DutyKind, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) *dbCommon.DutyRecord {
+	if v.dutyHistory == nil {
+		return nil
+	}
+	return &dbCommon.DutyRecord{PubKey: pubKey, Kind: kind, Slot: slot}
+}
+
+// markDutySubmitted marks a duty as successfully submitted to the beacon node.
+func markDutySubmitted(record *dbCommon.DutyRecord, blockRoot []byte) {
+	if record == nil {
+		return
+	}
+	record.Submitted = true
+	record.BlockRoot = bytesutil.SafeCopyBytes(blockRoot)
+}
+
+// endDutyRecord queues a duty record to be saved with the other duties of the slot.
+func (v *validator) endDutyRecord(record *dbCommon.DutyRecord) {
+	if record == nil || v.dutyHistory == nil {
+		return
+	}
+	v.dutyHistory.lock.Lock()
+	defer v.dutyHistory.lock.Unlock()
+	v.dutyHistory.pending = append(v.dutyHistory.pending, record)
+}
+
+// RecordDutyHistory saves the duties performed at a slot into the database. At the end of an epoch, the outcome
+// of the attestations of the previous epoch is fetched from the beacon node, and the history older than the
+// retention period is pruned.
+func (v *validator) RecordDutyHistory(ctx context.Context, slot primitives.Slot) error {
+	if v.dutyHistory == nil {
+		return nil
+	}
+	if records := v.dutyHistory.drain(); len(records) > 0 {
+		if err := v.db.SaveDutyRecords(ctx, records); err != nil {
+			return errors.Wrap(err, "could not save duty records")
+		}
+	}
+
+	if !slots.IsEpochEnd(slot) || slot <= params.BeaconConfig().SlotsPerEpoch {
+		// The outcome of the attestations is only known at the end of the epoch, and not in the first epoch.
+		return nil
+	}
+	currentEpoch := slots.ToEpoch(slot)
+	if err := v.recordAttestationOutcomes(ctx, currentEpoch-1); err != nil {
+		return err
+	}
+
+	if currentEpoch <= v.dutyHistory.retention {
+		return nil
+	}
+	pruneSlot, err := slots.EpochStart(currentEpoch - v.dutyHistory.retention)
+	if err != nil {
+		return err
+	}
+	if err := v.db.PruneDutyHistory(ctx, pruneSlot); err != nil {
+		return errors.Wrap(err, "could not prune duty history")
+	}
+	return nil
+}
+
+// recordAttestationOutcomes sets the outcome of the attestations of an epoch, as reported by the beacon node.
+func (v *validator) recordAttestationOutcomes(ctx context.Context, epoch primitives.Epoch) error {
+	pks, err := v.km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pks) == 0 {
+		return nil
+	}
+	resp, err := v.chainClient.ValidatorPerformance(ctx, &ethpb.ValidatorPerformanceRequest{
+		PublicKeys: bytesutil.FromBytes48Array(pks),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not get validator performance")
+	}
+
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return err
+	}
+	endSlot, err := slots.EpochEnd(epoch)
+	if err != nil {
+		return err
+	}
+
+	updated := make([]*dbCommon.DutyRecord, 0)
+	for i, pk := range resp.PublicKeys {
+		if i >= len(resp.CorrectlyVotedSource) || i >= len(resp.CorrectlyVotedTarget) || i >= len(resp.CorrectlyVotedHead) {
+			break
+		}
+		outcome := &dbCommon.AttestationOutcome{
+			// Participation flags are only set when an attestation is included, so they are all the beacon node can
+			// tell when it does not report the slot of the including block.
+			Included:      resp.CorrectlyVotedSource[i] || resp.CorrectlyVotedTarget[i] || resp.CorrectlyVotedHead[i],
+			CorrectSource: resp.CorrectlyVotedSource[i],
+			CorrectTarget: resp.CorrectlyVotedTarget[i],
+			CorrectHead:   resp.CorrectlyVotedHead[i],
+		}
+		var inclusionSlot primitives.Slot
+		if i < len(resp.InclusionSlots) {
+			inclusionSlot = resp.InclusionSlots[i]
+			outcome.Included = inclusionSlot != params.BeaconConfig().FarFutureSlot
+		}
+
+		records, err := v.db.DutyHistory(ctx, bytesutil.ToBytes48(pk), startSlot, endSlot)
+		if err != nil {
+			return errors.Wrap(err, "could not get duty history")
+		}
+		for _, r := range records {
+			if r.Kind == dbCommon.AttestationDuty && r.Submitted {
+				o := *outcome
+				if outcome.Included && inclusionSlot > r.Slot {
+					o.InclusionDistance = inclusionSlot - r.Slot
+				}
+				r.Outcome = &o
+				updated = append(updated, r)
+			}
+		}
+	}
+	if len(updated) == 0 {
+		return nil
+	}
+	if err := v.db.SaveDutyRecords(ctx, updated); err != nil {
+		return errors.Wrap(err, "could not save attestation outcomes")
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	dbCommon "github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSubmitAttestation_RecordsDutyHistory(t *testing.T) {
+	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
+		t.Run(fmt.Sprintf("SlashingProtectionMinimal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
+			ctx := context.Background()
+			validator, m, validatorKey, finish := setup(t, isSlashingProtectionMinimal)
+			defer finish()
+			validator.dutyHistory = &dutyHistory{retention: 10}
+			validatorIndex := primitives.ValidatorIndex(7)
+			var pubKey [fieldparams.BLSPubkeyLength]byte
+			copy(pubKey[:], validatorKey.PublicKey().Marshal())
+			validator.duties = &ethpb.DutiesResponse{CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+				{
+					PublicKey:      validatorKey.PublicKey().Marshal(),
+					CommitteeIndex: 5,
+					Committee:      []primitives.ValidatorIndex{0, validatorIndex},
+					ValidatorIndex: validatorIndex,
+				},
+			}}
+
+			beaconBlockRoot := bytesutil.ToBytes32([]byte("A"))
+			gomock.InOrder(
+				m.validatorClient.EXPECT().AttestationData(gomock.Any(), gomock.Any()).Return(&ethpb.AttestationData{
+					BeaconBlockRoot: beaconBlockRoot[:],
+					Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+					Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				}, nil),
+				m.validatorClient.EXPECT().AttestationData(gomock.Any(), gomock.Any()).Return(nil, errors.New("uh oh")),
+			)
+			m.validatorClient.EXPECT().DomainData(gomock.Any(), gomock.Any()).
+				Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil).AnyTimes()
+			m.validatorClient.EXPECT().ProposeAttestation(gomock.Any(), gomock.Any()).Return(&ethpb.AttestResponse{}, nil)
+
+			validator.SubmitAttestation(ctx, 30, pubKey)
+			validator.SubmitAttestation(ctx, 31, pubKey)
+
+			// Records are only saved once the slot is over.
+			records, err := validator.db.DutyHistory(ctx, pubKey, 0, 100)
+			require.NoError(t, err)
+			assert.Equal(t, 0, len(records))
+
+			require.NoError(t, validator.RecordDutyHistory(ctx, 30))
+			records, err = validator.db.DutyHistory(ctx, pubKey, 0, 100)
+			require.NoError(t, err)
+			require.Equal(t, 2, len(records))
+			assert.DeepEqual(t, &dbCommon.DutyRecord{
+				PubKey:    pubKey,
+				Kind:      dbCommon.AttestationDuty,
+				Slot:      30,
+				Submitted: true,
+				BlockRoot: beaconBlockRoot[:],
+			}, records[0])
+			assert.Equal(t, primitives.Slot(31), records[1].Slot)
+			assert.Equal(t, false, records[1].Submitted)
+		})
+	}
+}
+
+func TestRecordDutyHistory_AttestationOutcomesAndPruning(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	validator, _, validatorKey, finish := setup(t, false)
+	defer finish()
+	ctrl := gomock.NewController(t)
+	chainClient := validatormock.NewMockChainClient(ctrl)
+	validator.chainClient = chainClient
+	validator.dutyHistory = &dutyHistory{retention: 1}
+	var pubKey [fieldparams.BLSPubkeyLength]byte
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, validator.db.SaveDutyRecords(ctx, []*dbCommon.DutyRecord{
+		{PubKey: pubKey, Kind: dbCommon.AttestationDuty, Slot: 1, Submitted: true},
+		{PubKey: pubKey, Kind: dbCommon.AttestationDuty, Slot: slotsPerEpoch + 1, Submitted: true},
+		{PubKey: pubKey, Kind: dbCommon.ProposalDuty, Slot: slotsPerEpoch + 2, Submitted: true},
+	}))
+
+	chainClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys:           [][]byte{pubKey[:]},
+		CorrectlyVotedSource: []bool{true},
+		CorrectlyVotedTarget: []bool{true},
+		CorrectlyVotedHead:   []bool{true},
+		InclusionSlots:       []primitives.Slot{slotsPerEpoch + 3},
+	}, nil)
+
+	// At the end of the third epoch, the attestations of the second epoch get their outcome, and the first epoch is
+	// pruned.
+	require.NoError(t, validator.RecordDutyHistory(ctx, 3*slotsPerEpoch-1))
+	records, err := validator.db.DutyHistory(ctx, pubKey, 0, 3*slotsPerEpoch)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	assert.DeepEqual(t, &dbCommon.AttestationOutcome{
+		Included:          true,
+		InclusionDistance: 2,
+		CorrectSource:     true,
+		CorrectTarget:     true,
+		CorrectHead:       true,
+	}, records[0].Outcome)
+	assert.Equal(t, dbCommon.ProposalDuty, records[1].Kind)
+	assert.Equal(t, true, records[1].Outcome == nil)
+}
+
+func TestRecordDutyHistory_AttestationNotIncluded(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	validator, _, validatorKey, finish := setup(t, false)
+	defer finish()
+	ctrl := gomock.NewController(t)
+	chainClient := validatormock.NewMockChainClient(ctrl)
+	validator.chainClient = chainClient
+	validator.dutyHistory = &dutyHistory{retention: 1}
+	var pubKey [fieldparams.BLSPubkeyLength]byte
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, validator.db.SaveDutyRecords(ctx, []*dbCommon.DutyRecord{
+		{PubKey: pubKey, Kind: dbCommon.AttestationDuty, Slot: slotsPerEpoch + 1, Submitted: true},
+	}))
+
+	chainClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys:           [][]byte{pubKey[:]},
+		CorrectlyVotedSource: []bool{false},
+		CorrectlyVotedTarget: []bool{false},
+		CorrectlyVotedHead:   []bool{false},
+		InclusionSlots:       []primitives.Slot{params.BeaconConfig().FarFutureSlot},
+	}, nil)
+
+	require.NoError(t, validator.RecordDutyHistory(ctx, 3*slotsPerEpoch-1))
+	records, err := validator.db.DutyHistory(ctx, pubKey, 0, 3*slotsPerEpoch)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.DeepEqual(t, &dbCommon.AttestationOutcome{}, records[0].Outcome)
+}
+
+func TestRecordDutyHistory_Disabled(t *testing.T) {
+	validator, _, _, finish := setup(t, false)
+	defer finish()
+	assert.Equal(t, true, validator.startDutyRecord(dbCommon.ProposalDuty, 1, [fieldparams.BLSPubkeyLength]byte{}) == nil)
+	require.NoError(t, validator.RecordDutyHistory(context.Background(), params.BeaconConfig().SlotsPerEpoch*3-1))
+}
@@ -33,6 +33,55 @@ const (
 	RoleSyncCommitteeAggregator
 )
 
+// DoppelgangerState defines whether a validating key may sign, according to doppelganger protection.
+type DoppelgangerState int8
+
+const (
+	// DoppelgangerDisabled means that doppelganger protection is not enabled, so the key signs without restriction.
+	DoppelgangerDisabled DoppelgangerState = iota
+	// DoppelgangerPending means that the key is within its doppelganger window and does not sign yet.
+	DoppelgangerPending
+	// DoppelgangerSafe means that no doppelganger was detected during the window of the key, which now signs.
+	DoppelgangerSafe
+	// DoppelgangerDetected means that the key was found live in the network, so it never signs.
+	DoppelgangerDetected
+	// DoppelgangerOverridden means that the key was manually allowed to sign, regardless of its window.
+	DoppelgangerOverridden
+)
+
+func (s DoppelgangerState) String() string {
+	switch s {
+	case DoppelgangerDisabled:
+		return "disabled"
+	case DoppelgangerPending:
+		return "pending"
+	case DoppelgangerSafe:
+		return "safe"
+	case DoppelgangerDetected:
+		return "detected"
+	case DoppelgangerOverridden:
+		return "overridden"
+	default:
+		return "unknown"
+	}
+}
+
+// CanSign returns true if the key may sign in this state.
+func (s DoppelgangerState) CanSign() bool {
+	return s == DoppelgangerDisabled || s == DoppelgangerSafe || s == DoppelgangerOverridden
+}
+
+// DoppelgangerStatus is the doppelganger protection status of a validating key.
+type DoppelgangerStatus struct {
+	State DoppelgangerState
+	// RegisteredEpoch is the epoch the doppelganger window of the key started in.
+	RegisteredEpoch primitives.Epoch
+	// SafeEpoch is the first epoch the key may sign in, if no doppelganger is detected until then.
+	SafeEpoch primitives.Epoch
+	// DetectedEpoch is the epoch a doppelganger was detected in, if any.
+	DetectedEpoch primitives.Epoch
+}
+
 // Validator interface defines the primary methods of a validator client.
 type Validator interface {
 	Done()
@@ -43,6 +92,7 @@ type Validator interface {
 	NextSlot() <-chan primitives.Slot
 	SlotDeadline(slot primitives.Slot) time.Time
 	LogValidatorGainsAndLosses(ctx context.Context, slot primitives.Slot) error
+	RecordDutyHistory(ctx context.Context, slot primitives.Slot) error
 	UpdateDuties(ctx context.Context, slot primitives.Slot) error
 	RolesAt(ctx context.Context, slot primitives.Slot) (map[[fieldparams.BLSPubkeyLength]byte][]ValidatorRole, error) // validator pubKey -> roles
 	SubmitAttestation(ctx context.Context, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte)
@@ -57,6 +107,8 @@ type Validator interface {
 	Keymanager() (keymanager.IKeymanager, error)
 	HandleKeyReload(ctx context.Context, currentKeys [][fieldparams.BLSPubkeyLength]byte) (bool, error)
 	CheckDoppelGanger(ctx context.Context) error
+	DoppelgangerStatus(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*DoppelgangerStatus, error)
+	OverrideDoppelganger(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error
 	PushProposerSettings(ctx context.Context, km keymanager.IKeymanager, slot primitives.Slot, deadline time.Time) error
 	SignValidatorRegistrationRequest(ctx context.Context, signer SigningFunc, newValidatorRegistration *ethpb.ValidatorRegistrationV1) (*ethpb.SignedValidatorRegistrationV1, error)
 	StartEventStream(ctx context.Context, topics []string, eventsChan chan<- *event.Event)
@@ -26,9 +26,11 @@ import (
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	dbCommon "github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
@@ -55,6 +57,9 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 	lock.Lock()
 	defer lock.Unlock()
 
+	record := v.startDutyRecord(dbCommon.ProposalDuty, slot, pubKey)
+	defer v.endDutyRecord(record)
+
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 	span.AddAttributes(trace.StringAttribute("validator", fmtKey))
 	log := log.WithField("pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])))
@@ -80,9 +85,10 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 
 	// Request block from beacon node
 	b, err := v.validatorClient.BeaconBlock(ctx, &ethpb.BlockRequest{
-		Slot:         slot,
-		RandaoReveal: randaoReveal,
-		Graffiti:     g,
+		Slot:               slot,
+		RandaoReveal:       randaoReveal,
+		Graffiti:           g,
+		BuilderBoostFactor: v.builderBoostFactor(pubKey),
 	})
 	if err != nil {
 		log.WithField("slot", slot).WithError(err).Error("Failed to request block from beacon node")
@@ -170,6 +176,10 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 		}
 		return
 	}
+	markDutySubmitted(record, blkResp.BlockRoot)
+	if record != nil {
+		record.PayloadValue = b.PayloadValue
+	}
 
 	span.AddAttributes(
 		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blkResp.BlockRoot)),
@@ -428,6 +438,26 @@ func signVoluntaryExit(
 	return sig.Marshal(), nil
 }
 
+// builderBoostFactor returns the builder boost factor to request a block with for the given public key, or nil
+// to leave it to the beacon node. A builder config set for the key takes priority over the default one, and the
+// factor is only used when the builder is enabled.
+func (v *validator) builderBoostFactor(pubKey [fieldparams.BLSPubkeyLength]byte) *wrapperspb.UInt64Value {
+	settings := v.ProposerSettings()
+	if settings == nil {
+		return nil
+	}
+	var config *proposer.BuilderConfig
+	if option, ok := settings.ProposeConfig[pubKey]; ok && option != nil && option.BuilderConfig != nil {
+		config = option.BuilderConfig
+	} else if settings.DefaultConfig != nil {
+		config = settings.DefaultConfig.BuilderConfig
+	}
+	if config == nil || !config.Enabled || config.BoostFactor == nil {
+		return nil
+	}
+	return &wrapperspb.UInt64Value{Value: *config.BoostFactor}
+}
+
 // Graffiti gets the graffiti from cli or file for the validator public key.
 func (v *validator) Graffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
 	if v.proposerSettings != nil {
@@ -31,6 +31,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type mocks struct {
@@ -1119,6 +1120,62 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 	}
 }
 
+func TestBuilderBoostFactor(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	otherKey := [fieldparams.BLSPubkeyLength]byte{'b'}
+	low, high := uint64(20), uint64(150)
+	tests := []struct {
+		name     string
+		settings *proposer.Settings
+		want     *wrapperspb.UInt64Value
+	}{
+		{
+			name: "no proposer settings",
+		},
+		{
+			name: "key specific boost factor",
+			settings: &proposer.Settings{
+				ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+					pubKey: {BuilderConfig: &proposer.BuilderConfig{Enabled: true, BoostFactor: &high}},
+				},
+				DefaultConfig: &proposer.Option{BuilderConfig: &proposer.BuilderConfig{Enabled: true, BoostFactor: &low}},
+			},
+			want: &wrapperspb.UInt64Value{Value: high},
+		},
+		{
+			name: "default boost factor",
+			settings: &proposer.Settings{
+				ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+					otherKey: {BuilderConfig: &proposer.BuilderConfig{Enabled: true, BoostFactor: &high}},
+				},
+				DefaultConfig: &proposer.Option{BuilderConfig: &proposer.BuilderConfig{Enabled: true, BoostFactor: &low}},
+			},
+			want: &wrapperspb.UInt64Value{Value: low},
+		},
+		{
+			name: "builder disabled for key",
+			settings: &proposer.Settings{
+				ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+					pubKey: {BuilderConfig: &proposer.BuilderConfig{Enabled: false, BoostFactor: &high}},
+				},
+				DefaultConfig: &proposer.Option{BuilderConfig: &proposer.BuilderConfig{Enabled: true, BoostFactor: &low}},
+			},
+		},
+		{
+			name: "no boost factor set",
+			settings: &proposer.Settings{
+				DefaultConfig: &proposer.Option{BuilderConfig: &proposer.BuilderConfig{Enabled: true}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &validator{proposerSettings: tt.settings}
+			require.DeepEqual(t, tt.want, v.builderBoostFactor(pubKey))
+		})
+	}
+}
+
 func Test_validator_DeleteGraffiti(t *testing.T) {
 	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
 	tests := []struct {
@@ -275,6 +275,9 @@ func performRoles(slotCtx context.Context, allRoles map[[48]byte][]iface.Validat
 		if err := v.LogValidatorGainsAndLosses(slotCtx, slot); err != nil {
 			log.WithError(err).Error("Could not report validator's rewards/penalties")
 		}
+		if err := v.RecordDutyHistory(slotCtx, slot); err != nil {
+			log.WithError(err).Error("Could not record duty history")
+		}
 	}()
 }
 
@@ -15,6 +15,7 @@ import (
 	grpcutil "github.com/prysmaticlabs/prysm/v5/api/grpc"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
@@ -32,6 +33,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/threshold"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -51,12 +53,22 @@ type ValidatorService struct {
 	graffitiStruct          *graffiti.Graffiti
 	interopKeysConfig       *local.InteropKeymanagerConfig
 	web3SignerConfig        *remoteweb3signer.SetupConfig
+	thresholdConfig         *threshold.SetupConfig
 	proposerSettings        *proposer.Settings
 	validatorsRegBatchSize  int
 	useWeb                  bool
 	emitAccountMetrics      bool
 	logValidatorPerformance bool
 	distributed             bool
+	doppelgangerEpochs      primitives.Epoch
+	dutyHistoryRetention    primitives.Epoch
+	beaconNodeConns         []beaconNodeConn
+}
+
+// beaconNodeConn is the gRPC connection to one of the beacon nodes, when several gRPC endpoints are configured.
+type beaconNodeConn struct {
+	endpoint string
+	conn     *grpc.ClientConn
 }
 
 // Config for the validator service.
@@ -77,12 +89,19 @@ type Config struct {
 	GraffitiStruct          *graffiti.Graffiti
 	InteropKmConfig         *local.InteropKeymanagerConfig
 	Web3SignerConfig        *remoteweb3signer.SetupConfig
+	ThresholdConfig         *threshold.SetupConfig
 	ProposerSettings        *proposer.Settings
 	ValidatorsRegBatchSize  int
 	UseWeb                  bool
 	LogValidatorPerformance bool
 	EmitAccountMetrics      bool
 	Distributed             bool
+	// DoppelgangerEpochs is the number of epochs a validating key waits for, without any doppelganger detected,
+	// before it signs. It only applies when doppelganger protection is enabled.
+	DoppelgangerEpochs primitives.Epoch
+	// DutyHistoryRetention is the number of epochs the duties of the validators, and their outcome, are kept in
+	// the database for. Duties are not recorded when it is 0.
+	DutyHistoryRetention primitives.Epoch
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -100,12 +119,15 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		graffitiStruct:          cfg.GraffitiStruct,
 		interopKeysConfig:       cfg.InteropKmConfig,
 		web3SignerConfig:        cfg.Web3SignerConfig,
+		thresholdConfig:         cfg.ThresholdConfig,
 		proposerSettings:        cfg.ProposerSettings,
 		validatorsRegBatchSize:  cfg.ValidatorsRegBatchSize,
 		useWeb:                  cfg.UseWeb,
 		emitAccountMetrics:      cfg.EmitAccountMetrics,
 		logValidatorPerformance: cfg.LogValidatorPerformance,
 		distributed:             cfg.Distributed,
+		doppelgangerEpochs:      cfg.DoppelgangerEpochs,
+		dutyHistoryRetention:    cfg.DutyHistoryRetention,
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -133,6 +155,17 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		cfg.BeaconApiTimeout,
 	)
 
+	// Each beacon node gets its own connection, so that the validator client can fail over between them.
+	if endpoints := strings.Split(cfg.BeaconNodeGRPCEndpoint, ","); len(endpoints) > 1 && !features.Get().EnableBeaconRESTApi {
+		for _, endpoint := range endpoints {
+			conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+			if err != nil {
+				return s, errors.Wrapf(err, "could not dial beacon node %s", endpoint)
+			}
+			s.beaconNodeConns = append(s.beaconNodeConns, beaconNodeConn{endpoint: endpoint, conn: conn})
+		}
+	}
+
 	return s, nil
 }
 
@@ -200,6 +233,7 @@ func (v *ValidatorService) Start() {
 		db:                             v.db,
 		km:                             nil,
 		web3SignerConfig:               v.web3SignerConfig,
+		thresholdConfig:                v.thresholdConfig,
 		proposerSettings:               v.proposerSettings,
 		signedValidatorRegistrations:   make(map[[fieldparams.BLSPubkeyLength]byte]*ethpb.SignedValidatorRegistrationV1),
 		validatorsRegBatchSize:         v.validatorsRegBatchSize,
@@ -216,6 +250,16 @@ func (v *ValidatorService) Start() {
 		useWeb:                         v.useWeb,
 		distributed:                    v.distributed,
 	}
+	if features.Get().EnableDoppelGanger {
+		valStruct.doppelganger = newDoppelgangerTracker(v.doppelgangerEpochs)
+	}
+	if v.dutyHistoryRetention > 0 {
+		valStruct.dutyHistory = &dutyHistory{retention: v.dutyHistoryRetention}
+	}
+	if fallback := v.beaconNodeFallback(hosts, restHandler); fallback != nil {
+		valStruct.validatorClient = fallback
+		valStruct.nodeFallback = fallback
+	}
 
 	v.validator = valStruct
 	go run(v.ctx, v.validator)
@@ -225,12 +269,52 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	for _, c := range v.beaconNodeConns {
+		if err := c.conn.Close(); err != nil {
+			log.WithError(err).WithField("endpoint", c.endpoint).Error("Could not close beacon node connection")
+		}
+	}
 	if v.conn != nil {
 		return v.conn.GetGrpcClientConn().Close()
 	}
 	return nil
 }
 
+// beaconNodeFallback returns a validator client that fails over between all configured beacon nodes,
+// or nil when only one beacon node is configured.
+func (v *ValidatorService) beaconNodeFallback(restHosts []string, restHandler beaconApi.JsonRestHandler) *beaconNodeFallback {
+	var nodes []*beaconNode
+	if features.Get().EnableBeaconRESTApi {
+		if len(restHosts) < 2 {
+			return nil
+		}
+		for _, host := range restHosts {
+			handler := beaconApi.NewBeaconApiJsonRestHandler(http.Client{Timeout: v.conn.GetBeaconApiTimeout()}, host)
+			nodes = append(nodes, &beaconNode{
+				host:            host,
+				validatorClient: validatorclientfactory.NewValidatorClient(v.conn, handler),
+				nodeClient:      nodeclientfactory.NewNodeClient(v.conn, handler),
+				chainClient:     beaconChainClientFactory.NewChainClient(v.conn, handler),
+			})
+		}
+		return newBeaconNodeFallback(nodes, restHandler)
+	}
+
+	if len(v.beaconNodeConns) < 2 {
+		return nil
+	}
+	for _, c := range v.beaconNodeConns {
+		conn := validatorHelpers.NewNodeConnection(c.conn, "", v.conn.GetBeaconApiTimeout())
+		nodes = append(nodes, &beaconNode{
+			host:            c.endpoint,
+			validatorClient: validatorclientfactory.NewValidatorClient(conn, restHandler),
+			nodeClient:      nodeclientfactory.NewNodeClient(conn, restHandler),
+			chainClient:     beaconChainClientFactory.NewChainClient(conn, restHandler),
+		})
+	}
+	return newBeaconNodeFallback(nodes, nil)
+}
+
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
 	if v.conn == nil {
@@ -348,3 +432,17 @@ func (v *ValidatorService) DeleteGraffiti(ctx context.Context, pubKey [fieldpara
 	}
 	return v.validator.DeleteGraffiti(ctx, pubKey)
 }
+
+func (v *ValidatorService) DoppelgangerStatus(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*iface.DoppelgangerStatus, error) {
+	if v.validator == nil {
+		return nil, errors.New("validator is unavailable")
+	}
+	return v.validator.DoppelgangerStatus(ctx, pubKey)
+}
+
+func (v *ValidatorService) OverrideDoppelganger(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	if v.validator == nil {
+		return errors.New("validator is unavailable")
+	}
+	return v.validator.OverrideDoppelganger(ctx, pubKey)
+}
@@ -21,6 +21,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	dbCommon "github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -33,6 +34,9 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot primiti
 
 	v.waitOneThirdOrValidBlock(ctx, slot)
 
+	record := v.startDutyRecord(dbCommon.SyncCommitteeMessageDuty, slot, pubKey)
+	defer v.endDutyRecord(record)
+
 	res, err := v.validatorClient.SyncMessageBlockRoot(ctx, &emptypb.Empty{})
 	if err != nil {
 		log.WithError(err).Error("Could not request sync message block root to sign")
@@ -82,6 +86,7 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot primiti
 		log.WithError(err).Error("Could not submit sync committee message")
 		return
 	}
+	markDutySubmitted(record, res.Root)
 
 	msgSlot := msg.Slot
 	slotTime := time.Unix(int64(v.genesisTime+uint64(msgSlot)*params.BeaconConfig().SecondsPerSlot), 0)
@@ -128,6 +133,10 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot p
 
 	v.waitToSlotTwoThirds(ctx, slot)
 
+	// The duty is only recorded when the validator aggregates at least one of its subcommittees.
+	var record *dbCommon.DutyRecord
+	defer func() { v.endDutyRecord(record) }()
+
 	for i, comIdx := range indexRes.Indices {
 		isAggregator, err := altair.IsSyncCommitteeAggregator(selectionProofs[i])
 		if err != nil {
@@ -137,6 +146,9 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot p
 		if !isAggregator {
 			continue
 		}
+		if record == nil {
+			record = v.startDutyRecord(dbCommon.SyncCommitteeContributionDuty, slot, pubKey)
+		}
 		subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
 		subnet := uint64(comIdx) / subCommitteeSize
 		contribution, err := v.validatorClient.SyncCommitteeContribution(ctx, &ethpb.SyncCommitteeContributionRequest{
@@ -175,6 +187,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot p
 			log.WithError(err).Error("Could not submit signed contribution and proof")
 			return
 		}
+		markDutySubmitted(record, contribution.BlockRoot)
 
 		contributionSlot := contributionAndProof.Contribution.Slot
 		slotTime := time.Unix(int64(v.genesisTime+uint64(contributionSlot)*params.BeaconConfig().SecondsPerSlot), 0)
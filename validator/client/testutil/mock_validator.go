@@ -33,6 +33,7 @@ type FakeValidator struct {
 	AttestToBlockHeadCalled           bool
 	ProposeBlockCalled                bool
 	LogValidatorGainsAndLossesCalled  bool
+	RecordDutyHistoryCalled           bool
 	SaveProtectionsCalled             bool
 	DeleteProtectionCalled            bool
 	SlotDeadlineCalled                bool
@@ -52,6 +53,7 @@ type FakeValidator struct {
 	UpdateDutiesRet                   error
 	ProposerSettingsErr               error
 	RolesAtRet                        []iface.ValidatorRole
+	DoppelgangerState                 iface.DoppelgangerState
 	Balances                          map[[fieldparams.BLSPubkeyLength]byte]uint64
 	IndexToPubkeyMap                  map[uint64][fieldparams.BLSPubkeyLength]byte
 	PubkeyToIndexMap                  map[[fieldparams.BLSPubkeyLength]byte]uint64
@@ -155,6 +157,12 @@ func (fv *FakeValidator) LogValidatorGainsAndLosses(_ context.Context, _ primiti
 	return nil
 }
 
+// RecordDutyHistory for mocking.
+func (fv *FakeValidator) RecordDutyHistory(_ context.Context, _ primitives.Slot) error {
+	fv.RecordDutyHistoryCalled = true
+	return nil
+}
+
 // ResetAttesterProtectionData for mocking.
 func (fv *FakeValidator) ResetAttesterProtectionData() {
 	fv.DeleteProtectionCalled = true
@@ -311,6 +319,17 @@ func (fv *FakeValidator) DeleteGraffiti(_ context.Context, _ [fieldparams.BLSPub
 	return nil
 }
 
+// DoppelgangerStatus for mocking
+func (fv *FakeValidator) DoppelgangerStatus(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte) (*iface.DoppelgangerStatus, error) {
+	return &iface.DoppelgangerStatus{State: fv.DoppelgangerState}, nil
+}
+
+// OverrideDoppelganger for mocking
+func (fv *FakeValidator) OverrideDoppelganger(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte) error {
+	fv.DoppelgangerState = iface.DoppelgangerOverridden
+	return nil
+}
+
 func (*FakeValidator) StartEventStream(_ context.Context, _ []string, _ chan<- *event.Event) {
 
 }
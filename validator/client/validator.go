@@ -47,6 +47,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/threshold"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
@@ -77,6 +78,8 @@ type validator struct {
 	startBalances                      map[[fieldparams.BLSPubkeyLength]byte]uint64
 	prevEpochBalances                  map[[fieldparams.BLSPubkeyLength]byte]uint64
 	blacklistedPubkeys                 map[[fieldparams.BLSPubkeyLength]byte]bool
+	doppelganger                       *doppelgangerTracker
+	dutyHistory                        *dutyHistory
 	pubkeyToValidatorIndex             map[[fieldparams.BLSPubkeyLength]byte]primitives.ValidatorIndex
 	wallet                             *wallet.Wallet
 	walletInitializedChan              chan *wallet.Wallet
@@ -87,12 +90,14 @@ type validator struct {
 	beaconNodeHosts                    []string
 	currentHostIndex                   uint64
 	validatorClient                    iface.ValidatorClient
+	nodeFallback                       *beaconNodeFallback
 	chainClient                        iface.ChainClient
 	nodeClient                         iface.NodeClient
 	prysmChainClient                   iface.PrysmChainClient
 	db                                 db.Database
 	km                                 keymanager.IKeymanager
 	web3SignerConfig                   *remoteweb3signer.SetupConfig
+	thresholdConfig                    *threshold.SetupConfig
 	proposerSettings                   *proposer.Settings
 	signedValidatorRegistrations       map[[fieldparams.BLSPubkeyLength]byte]*ethpb.SignedValidatorRegistrationV1
 	validatorsRegBatchSize             int
@@ -162,7 +167,11 @@ func (v *validator) WaitForKeymanagerInitialization(ctx context.Context) error {
 			if v.web3SignerConfig != nil {
 				v.web3SignerConfig.GenesisValidatorsRoot = genesisRoot
 			}
-			keyManager, err := v.wallet.InitializeKeymanager(ctx, accountsiface.InitKeymanagerConfig{ListenForChanges: true, Web3SignerConfig: v.web3SignerConfig})
+			keyManager, err := v.wallet.InitializeKeymanager(ctx, accountsiface.InitKeymanagerConfig{
+				ListenForChanges: true,
+				Web3SignerConfig: v.web3SignerConfig,
+				ThresholdConfig:  v.thresholdConfig,
+			})
 			if err != nil {
 				return errors.Wrap(err, "could not initialize key manager")
 			}
@@ -437,12 +446,35 @@ func (v *validator) CheckDoppelGanger(ctx context.Context) error {
 	if len(pubkeys) == 0 {
 		return nil
 	}
+	resp, err := v.doppelGangerResponses(ctx, pubkeys)
+	if err != nil {
+		return err
+	}
+	return buildDuplicateError(resp)
+}
+
+// doppelgangerDuplicates returns the given keys which have duplicates active in the network.
+func (v *validator) doppelgangerDuplicates(ctx context.Context, pubkeys [][fieldparams.BLSPubkeyLength]byte) (map[[fieldparams.BLSPubkeyLength]byte]bool, error) {
+	resp, err := v.doppelGangerResponses(ctx, pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	duplicates := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	for _, valRes := range resp {
+		if valRes.DuplicateExists {
+			duplicates[bytesutil.ToBytes48(valRes.PublicKey)] = true
+		}
+	}
+	return duplicates, nil
+}
+
+func (v *validator) doppelGangerResponses(ctx context.Context, pubkeys [][fieldparams.BLSPubkeyLength]byte) ([]*ethpb.DoppelGangerResponse_ValidatorResponse, error) {
 	req := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
 	for _, pkey := range pubkeys {
 		copiedKey := pkey
 		attRec, err := v.db.AttestationHistoryForPubKey(ctx, copiedKey)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if len(attRec) == 0 {
 			// If no history exists we simply send in a zero
@@ -457,7 +489,7 @@ func (v *validator) CheckDoppelGanger(ctx context.Context) error {
 		}
 		r := retrieveLatestRecord(attRec)
 		if copiedKey != r.PubKey {
-			return errors.New("attestation record mismatched public key")
+			return nil, errors.New("attestation record mismatched public key")
 		}
 		req.ValidatorRequests = append(req.ValidatorRequests,
 			&ethpb.DoppelGangerRequest_ValidatorRequest{
@@ -468,14 +500,14 @@ func (v *validator) CheckDoppelGanger(ctx context.Context) error {
 	}
 	resp, err := v.validatorClient.CheckDoppelGanger(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	// If nothing is returned by the beacon node, we return an
 	// error as it is unsafe for us to proceed.
 	if resp == nil || resp.Responses == nil || len(resp.Responses) == 0 {
-		return errors.New("beacon node returned 0 responses for doppelganger check")
+		return nil, errors.New("beacon node returned 0 responses for doppelganger check")
 	}
-	return buildDuplicateError(resp.Responses)
+	return resp.Responses, nil
 }
 
 func buildDuplicateError(response []*ethpb.DoppelGangerResponse_ValidatorResponse) error {
@@ -539,23 +571,38 @@ func (v *validator) UpdateDuties(ctx context.Context, slot primitives.Slot) erro
 		return err
 	}
 
-	// Filter out the slashable public keys from the duties request.
+	// Keys which were not seen yet start their doppelganger window, and those within it are checked.
+	epoch := slots.ToEpoch(slot)
+	if v.doppelganger != nil {
+		v.doppelganger.track(validatingKeys, epoch)
+		v.checkPendingDoppelgangers(ctx, epoch)
+	}
+
+	// Filter out the slashable public keys, and those which may not sign yet
+	// because of doppelganger protection, from the duties request.
 	filteredKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(validatingKeys))
 	v.blacklistedPubkeysLock.RLock()
 	for _, pubKey := range validatingKeys {
-		if ok := v.blacklistedPubkeys[pubKey]; !ok {
-			filteredKeys = append(filteredKeys, pubKey)
-		} else {
+		if ok := v.blacklistedPubkeys[pubKey]; ok {
 			log.WithField(
 				"pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
 			).Warn("Not including slashable public key from slashing protection import " +
 				"in request to update validator duties")
+			continue
 		}
+		if !v.doppelganger.canSign(pubKey) {
+			log.WithField(
+				"pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			).Debug("Not including public key which may not sign because of doppelganger protection " +
+				"in request to update validator duties")
+			continue
+		}
+		filteredKeys = append(filteredKeys, pubKey)
 	}
 	v.blacklistedPubkeysLock.RUnlock()
 
 	req := &ethpb.DutiesRequest{
-		Epoch:      primitives.Epoch(slot / params.BeaconConfig().SlotsPerEpoch),
+		Epoch:      epoch,
 		PublicKeys: bytesutil.FromBytes48Array(filteredKeys),
 	}
 
@@ -1113,6 +1160,9 @@ func (v *validator) EventStreamIsRunning() bool {
 }
 
 func (v *validator) HealthTracker() *beacon.NodeHealthTracker {
+	if v.nodeFallback != nil {
+		return v.nodeFallback.healthTracker
+	}
 	return v.nodeClient.HealthTracker()
 }
 
@@ -1125,6 +1175,14 @@ func (v *validator) ChangeHost() {
 		log.Infof("Beacon node at %s is not responding, no backup node configured", v.Host())
 		return
 	}
+	if v.nodeFallback != nil {
+		// The fallback may have switched to another beacon node on its own.
+		for i, host := range v.beaconNodeHosts {
+			if host == v.Host() {
+				v.currentHostIndex = uint64(i)
+			}
+		}
+	}
 	next := (v.currentHostIndex + 1) % uint64(len(v.beaconNodeHosts))
 	log.Infof("Beacon node at %s is not responding, switching to %s...", v.beaconNodeHosts[v.currentHostIndex], v.beaconNodeHosts[next])
 	v.validatorClient.SetHost(v.beaconNodeHosts[next])
@@ -0,0 +1,77 @@
+package common
+
+import (
+	"fmt"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// DutyKind is the kind of a duty recorded in the duty history of a validator.
+type DutyKind uint8
+
+const (
+	// AttestationDuty is the attestation of a validator at a slot.
+	AttestationDuty DutyKind = iota + 1
+	// ProposalDuty is the block proposal of a validator at a slot.
+	ProposalDuty
+	// AggregationDuty is the aggregation of the attestations of a committee by a validator at a slot.
+	AggregationDuty
+	// SyncCommitteeMessageDuty is the sync committee message of a validator at a slot.
+	SyncCommitteeMessageDuty
+	// SyncCommitteeContributionDuty is the sync committee contribution of an aggregating validator at a slot.
+	SyncCommitteeContributionDuty
+)
+
+var dutyKindNames = map[DutyKind]string{
+	AttestationDuty:               "attestation",
+	ProposalDuty:                  "proposal",
+	AggregationDuty:               "aggregation",
+	SyncCommitteeMessageDuty:      "sync_committee_message",
+	SyncCommitteeContributionDuty: "sync_committee_contribution",
+}
+
+// String returns the name of the duty kind, as used by the API.
+func (k DutyKind) String() string {
+	if name, ok := dutyKindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", k)
+}
+
+// DutyKindFromString returns the duty kind with the given name.
+func DutyKindFromString(name string) (DutyKind, error) {
+	for k, n := range dutyKindNames {
+		if n == name {
+			return k, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown duty kind %s", name)
+}
+
+// DutyRecord is a duty of a validator, and its outcome.
+type DutyRecord struct {
+	PubKey [fieldparams.BLSPubkeyLength]byte `json:"-"`
+	Kind   DutyKind                          `json:"kind"`
+	Slot   primitives.Slot                   `json:"slot"`
+	// Submitted is false when the duty could not be completed, e.g. the beacon node could not be reached.
+	Submitted bool `json:"submitted"`
+	// BlockRoot is the root of the proposed block, or of the head block voted by an attestation,
+	// an aggregate or a sync committee message.
+	BlockRoot []byte `json:"block_root,omitempty"`
+	// PayloadValue is the value of the execution payload of a proposed block, in wei.
+	PayloadValue string `json:"payload_value,omitempty"`
+	// Outcome of an attestation, set once the epoch of the attestation is processed.
+	Outcome *AttestationOutcome `json:"outcome,omitempty"`
+}
+
+// AttestationOutcome is how an attestation was included in the chain.
+type AttestationOutcome struct {
+	Included bool `json:"included"`
+	// InclusionDistance is 0 when it is unknown, as with beacon nodes which do not report the slot of the block
+	// including the attestation.
+	InclusionDistance primitives.Slot `json:"inclusion_distance"`
+	CorrectSource     bool            `json:"correct_source"`
+	CorrectTarget     bool            `json:"correct_target"`
+	CorrectHead       bool            `json:"correct_head"`
+}
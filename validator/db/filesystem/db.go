@@ -35,6 +35,7 @@ type (
 		configurationMu    sync.RWMutex
 		pkToSlashingMu     map[[fieldparams.BLSPubkeyLength]byte]*sync.RWMutex
 		slashingMuMapMu    sync.Mutex
+		dutyHistoryMu      sync.RWMutex
 		databaseParentPath string
 		databasePath       string
 	}
@@ -150,6 +151,17 @@ func (s *Store) Backup(_ context.Context, outputDir string, permissionOverride b
 		return errors.Wrap(err, "could not copy slashing protection directory")
 	}
 
+	// Copy the duty history directory to the backup directory, if any duty was recorded.
+	hasDutyHistory, err := file.HasDir(s.dutyHistoryDirPath())
+	if err != nil {
+		return errors.Wrap(err, "could not check if duty history directory exists")
+	}
+	if hasDutyHistory {
+		if err := file.CopyDir(s.dutyHistoryDirPath(), path.Join(backupPath, dutyHistoryDirName)); err != nil {
+			return errors.Wrap(err, "could not copy duty history directory")
+		}
+	}
+
 	return nil
 }
 
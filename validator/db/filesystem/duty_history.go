@@ -0,0 +1,232 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+const dutyHistoryDirName = "duty-history"
+
+// SaveDutyRecords saves the given duty records, replacing the records of the same public key, slot and kind.
+// The records of a public key are stored in one file per epoch.
+func (s *Store) SaveDutyRecords(_ context.Context, records []*common.DutyRecord) error {
+	type epochFile struct {
+		pubKey [fieldparams.BLSPubkeyLength]byte
+		epoch  primitives.Epoch
+	}
+	recordsByFile := make(map[epochFile][]*common.DutyRecord)
+	for _, r := range records {
+		f := epochFile{pubKey: r.PubKey, epoch: slots.ToEpoch(r.Slot)}
+		recordsByFile[f] = append(recordsByFile[f], r)
+	}
+
+	s.dutyHistoryMu.Lock()
+	defer s.dutyHistoryMu.Unlock()
+
+	for f, newRecords := range recordsByFile {
+		existing, err := s.dutyRecords(f.pubKey, f.epoch)
+		if err != nil {
+			return err
+		}
+		for _, r := range newRecords {
+			replaced := false
+			for i, e := range existing {
+				if e.Slot == r.Slot && e.Kind == r.Kind {
+					existing[i] = r
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				existing = append(existing, r)
+			}
+		}
+		sort.Slice(existing, func(i, j int) bool {
+			if existing[i].Slot != existing[j].Slot {
+				return existing[i].Slot < existing[j].Slot
+			}
+			return existing[i].Kind < existing[j].Kind
+		})
+
+		dirPath := s.pubkeyDutyHistoryDirPath(f.pubKey)
+		if err := file.MkdirAll(dirPath); err != nil {
+			return errors.Wrapf(err, "could not create directory %s", dirPath)
+		}
+		enc, err := json.Marshal(existing)
+		if err != nil {
+			return errors.Wrap(err, "could not encode duty records")
+		}
+		filePath := s.pubkeyDutyHistoryFilePath(f.pubKey, f.epoch)
+		if err := file.WriteFile(filePath, enc); err != nil {
+			return errors.Wrapf(err, "could not write into %s", filePath)
+		}
+	}
+
+	return nil
+}
+
+// DutyHistory returns the duty records of a public key from startSlot to endSlot included, sorted by slot.
+func (s *Store) DutyHistory(
+	_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, startSlot, endSlot primitives.Slot,
+) ([]*common.DutyRecord, error) {
+	s.dutyHistoryMu.RLock()
+	defer s.dutyHistoryMu.RUnlock()
+
+	epochs, err := s.dutyHistoryEpochs(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*common.DutyRecord, 0)
+	for _, epoch := range epochs {
+		if epoch < slots.ToEpoch(startSlot) || epoch > slots.ToEpoch(endSlot) {
+			continue
+		}
+		epochRecords, err := s.dutyRecords(pubKey, epoch)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range epochRecords {
+			if r.Slot >= startSlot && r.Slot <= endSlot {
+				records = append(records, r)
+			}
+		}
+	}
+
+	return records, nil
+}
+
+// PruneDutyHistory deletes the duty records of every public key before the given slot.
+func (s *Store) PruneDutyHistory(_ context.Context, beforeSlot primitives.Slot) error {
+	s.dutyHistoryMu.Lock()
+	defer s.dutyHistoryMu.Unlock()
+
+	entries, err := os.ReadDir(s.dutyHistoryDirPath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not read duty history directory")
+	}
+
+	beforeEpoch := slots.ToEpoch(beforeSlot)
+	for _, entry := range entries {
+		pubKeyBytes, err := hexutil.Decode(entry.Name())
+		if err != nil || len(pubKeyBytes) != fieldparams.BLSPubkeyLength {
+			continue
+		}
+		pubKey := [fieldparams.BLSPubkeyLength]byte(pubKeyBytes)
+
+		epochs, err := s.dutyHistoryEpochs(pubKey)
+		if err != nil {
+			return err
+		}
+		for _, epoch := range epochs {
+			if epoch > beforeEpoch {
+				break
+			}
+			if epoch < beforeEpoch {
+				if err := os.Remove(s.pubkeyDutyHistoryFilePath(pubKey, epoch)); err != nil {
+					return errors.Wrap(err, "could not remove duty history file")
+				}
+				continue
+			}
+
+			// The epoch of beforeSlot is only partly pruned.
+			records, err := s.dutyRecords(pubKey, epoch)
+			if err != nil {
+				return err
+			}
+			kept := make([]*common.DutyRecord, 0, len(records))
+			for _, r := range records {
+				if r.Slot >= beforeSlot {
+					kept = append(kept, r)
+				}
+			}
+			enc, err := json.Marshal(kept)
+			if err != nil {
+				return errors.Wrap(err, "could not encode duty records")
+			}
+			if err := file.WriteFile(s.pubkeyDutyHistoryFilePath(pubKey, epoch), enc); err != nil {
+				return errors.Wrap(err, "could not write duty history file")
+			}
+		}
+	}
+
+	return nil
+}
+
+// dutyHistoryEpochs returns the sorted epochs for which a public key has a duty history file.
+func (s *Store) dutyHistoryEpochs(pubKey [fieldparams.BLSPubkeyLength]byte) ([]primitives.Epoch, error) {
+	entries, err := os.ReadDir(s.pubkeyDutyHistoryDirPath(pubKey))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read duty history directory")
+	}
+
+	epochs := make([]primitives.Epoch, 0, len(entries))
+	for _, entry := range entries {
+		epoch, err := strconv.ParseUint(strings.TrimSuffix(entry.Name(), ".json"), 10, 64)
+		if err != nil {
+			continue
+		}
+		epochs = append(epochs, primitives.Epoch(epoch))
+	}
+	sort.Slice(epochs, func(i, j int) bool { return epochs[i] < epochs[j] })
+
+	return epochs, nil
+}
+
+// dutyRecords returns the duty records of a public key in an epoch.
+func (s *Store) dutyRecords(pubKey [fieldparams.BLSPubkeyLength]byte, epoch primitives.Epoch) ([]*common.DutyRecord, error) {
+	filePath := filepath.Clean(s.pubkeyDutyHistoryFilePath(pubKey, epoch))
+	enc, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", filePath)
+	}
+
+	records := make([]*common.DutyRecord, 0)
+	if err := json.Unmarshal(enc, &records); err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s", filePath)
+	}
+	for _, r := range records {
+		r.PubKey = pubKey
+	}
+
+	return records, nil
+}
+
+// dutyHistoryDirPath returns the path of the duty history directory.
+func (s *Store) dutyHistoryDirPath() string {
+	return path.Join(s.databasePath, dutyHistoryDirName)
+}
+
+// pubkeyDutyHistoryDirPath returns the path of the duty history directory of a public key.
+func (s *Store) pubkeyDutyHistoryDirPath(pubKey [fieldparams.BLSPubkeyLength]byte) string {
+	return path.Join(s.dutyHistoryDirPath(), hexutil.Encode(pubKey[:]))
+}
+
+// pubkeyDutyHistoryFilePath returns the path of the duty history file of a public key for an epoch.
+func (s *Store) pubkeyDutyHistoryFilePath(pubKey [fieldparams.BLSPubkeyLength]byte, epoch primitives.Epoch) string {
+	return path.Join(s.pubkeyDutyHistoryDirPath(pubKey), fmt.Sprintf("%d.json", epoch))
+}
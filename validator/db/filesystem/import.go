@@ -2,7 +2,6 @@ package filesystem
 
 import (
 	"context"
-	"encoding/json"
 	"io"
 	"strings"
 
@@ -20,31 +19,34 @@ import (
 // by Ethereum validators and imports its data into Prysm's internal minimal representation of slashing
 // protection in the validator client's database.
 func (s *Store) ImportStandardProtectionJSON(ctx context.Context, r io.Reader) error {
-	// Read the JSON file
-	encodedJSON, err := io.ReadAll(r)
+	// Decode the JSON file one public key at a time
+	dec, err := format.NewDecoder(r)
 	if err != nil {
-		return errors.Wrap(err, "could not read slashing protection JSON file")
-	}
-
-	// Unmarshal the JSON file
-	interchangeJSON := &format.EIPSlashingProtectionFormat{}
-	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
 		return errors.Wrap(err, "could not unmarshal slashing protection JSON file")
 	}
 
 	// If there is no data in the JSON file, we can return early.
-	if interchangeJSON.Data == nil {
+	if !dec.HasData() {
 		return nil
 	}
 
 	// We validate the `MetadataV0` field of the slashing protection JSON file.
-	if err := helpers.ValidateMetadata(ctx, s, interchangeJSON); err != nil {
+	if err := helpers.ValidateMetadata(ctx, s, &format.EIPSlashingProtectionFormat{Metadata: dec.Metadata()}); err != nil {
 		return errors.Wrap(err, "slashing protection JSON metadata was incorrect")
 	}
 
-	// Save blocks proposals and attestations into the database
-	bar := common.InitializeProgressBar(len(interchangeJSON.Data), "Save blocks proposals and attestations:")
-	for _, item := range interchangeJSON.Data {
+	// Save blocks proposals and attestations into the database.
+	// The number of public keys is unknown until the whole file is read.
+	bar := common.InitializeProgressBar(-1, "Save blocks proposals and attestations:")
+	for {
+		item, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+		}
+
 		// Update progress bar
 		if err := bar.Add(1); err != nil {
 			return errors.Wrap(err, "could not update progress bar")
@@ -74,7 +76,7 @@ func (s *Store) ImportStandardProtectionJSON(ctx context.Context, r io.Reader) e
 		}
 	}
 
-	return nil
+	return bar.Finish()
 }
 
 func importBlockProposals(ctx context.Context, pubkey [fieldparams.BLSPubkeyLength]byte, item *format.ProtectionData, validatorDB iface.ValidatorDB) error {
@@ -82,4 +82,9 @@ type ValidatorDB interface {
 
 	// EIP-3076 slashing protection related methods
 	ImportStandardProtectionJSON(ctx context.Context, r io.Reader) error
+
+	// Duty history related methods
+	SaveDutyRecords(ctx context.Context, records []*common.DutyRecord) error
+	DutyHistory(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, startSlot, endSlot primitives.Slot) ([]*common.DutyRecord, error)
+	PruneDutyHistory(ctx context.Context, beforeSlot primitives.Slot) error
 }
@@ -6,7 +6,10 @@ import (
 	"path"
 	"time"
 
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
@@ -37,46 +40,159 @@ func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride
 	backupPath := path.Join(backupsDir, fmt.Sprintf("prysm_validatordb_%d.backup", time.Now().Unix()))
 	log.WithField("backup", backupPath).Info("Writing backup database")
 
-	copyDB, err := bolt.Open(
+	// The database file is copied page by page from a single read transaction, so the backup is a consistent
+	// snapshot and its pages match the pages of the database, which incremental backups rely on.
+	return s.db.View(func(tx *bolt.Tx) error {
+		return tx.CopyFile(backupPath, params.BeaconIoConfig().ReadWritePermissions)
+	})
+}
+
+// BackupReport summarizes the slashing protection data found in a validator database backup by VerifyBackup.
+type BackupReport struct {
+	GenesisValidatorsRoot [32]byte
+	// PublicKeys is the number of public keys with an attestation or a proposal history.
+	PublicKeys   int
+	Attestations int
+	Proposals    int
+}
+
+// VerifyBackup opens the validator database backup at the given path read-only and sanity checks it: every page
+// must be reachable and consistent, the genesis validators root must be set, and the attestation and proposal
+// histories of every public key must be well formed.
+func VerifyBackup(ctx context.Context, backupPath string) (*BackupReport, error) {
+	_, span := trace.StartSpan(ctx, "ValidatorDB.VerifyBackup")
+	defer span.End()
+
+	boltDB, err := bolt.Open(
 		backupPath,
 		params.BeaconIoConfig().ReadWritePermissions,
-		&bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout},
+		&bolt.Options{ReadOnly: true, Timeout: params.BeaconIoConfig().BoltTimeout},
 	)
 	if err != nil {
-		return err
+		return nil, errors.Wrapf(err, "could not open backup %s", backupPath)
 	}
 	defer func() {
-		if err := copyDB.Close(); err != nil {
+		if err := boltDB.Close(); err != nil {
 			log.WithError(err).Error("Failed to close backup database")
 		}
 	}()
 
-	return s.db.View(func(tx *bolt.Tx) error {
-		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
-			log.Debugf("Copying bucket %s\n with %d keys", name, b.Stats().KeyN)
-			return copyDB.Update(func(tx2 *bolt.Tx) error {
-				b2, err := tx2.CreateBucketIfNotExists(name)
-				if err != nil {
-					return err
+	report := &BackupReport{}
+	err = boltDB.View(func(tx *bolt.Tx) error {
+		var checkErr error
+		// The channel must be drained before the transaction is closed.
+		for err := range tx.Check() {
+			if checkErr == nil {
+				checkErr = errors.Wrap(err, "database integrity check failed")
+			}
+		}
+		if checkErr != nil {
+			return checkErr
+		}
+
+		genesisBkt := tx.Bucket(genesisInfoBucket)
+		if genesisBkt == nil || tx.Bucket(pubKeysBucket) == nil || tx.Bucket(historicProposalsBucket) == nil {
+			return errors.New("backup is not a validator database")
+		}
+		root := genesisBkt.Get(genesisValidatorsRootKey)
+		if len(root) != fieldparams.RootLength {
+			return errors.Errorf("genesis validators root has length %d, expected %d", len(root), fieldparams.RootLength)
+		}
+		report.GenesisValidatorsRoot = bytesutil.ToBytes32(root)
+
+		keys := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+		if err := tx.Bucket(pubKeysBucket).ForEach(func(pubKey, _ []byte) error {
+			if len(pubKey) != fieldparams.BLSPubkeyLength {
+				return errors.Errorf("attestation history found for a public key of length %d", len(pubKey))
+			}
+			keys[bytesutil.ToBytes48(pubKey)] = true
+			n, err := verifyAttestationHistory(tx.Bucket(pubKeysBucket).Bucket(pubKey))
+			if err != nil {
+				return errors.Wrapf(err, "invalid attestation history for public key %#x", pubKey)
+			}
+			report.Attestations += n
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, bkt := range [][]byte{lowestSignedSourceBucket, lowestSignedTargetBucket} {
+			if err := verifyEpochValues(tx.Bucket(bkt)); err != nil {
+				return errors.Wrapf(err, "invalid %s bucket", bkt)
+			}
+		}
+
+		if err := tx.Bucket(historicProposalsBucket).ForEach(func(pubKey, _ []byte) error {
+			if len(pubKey) != fieldparams.BLSPubkeyLength {
+				return errors.Errorf("proposal history found for a public key of length %d", len(pubKey))
+			}
+			keys[bytesutil.ToBytes48(pubKey)] = true
+			bkt := tx.Bucket(historicProposalsBucket).Bucket(pubKey)
+			if bkt == nil {
+				return errors.Errorf("proposal history of public key %#x is not a bucket", pubKey)
+			}
+			return bkt.ForEach(func(slot, signingRoot []byte) error {
+				if len(slot) != 8 || (len(signingRoot) != 0 && len(signingRoot) != fieldparams.RootLength) {
+					return errors.Errorf("invalid proposal history entry for public key %#x", pubKey)
 				}
-				return b.ForEach(createNestedBuckets(b, b2, b2.Put))
+				report.Proposals++
+				return nil
 			})
-		})
+		}); err != nil {
+			return err
+		}
+		report.PublicKeys = len(keys)
+		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
 }
 
-// Walks through each buckets and looks out for nested buckets so that
-// the backup db also includes them.
-func createNestedBuckets(srcBucket, dstBucket *bolt.Bucket, fn func(k, v []byte) error) func(k, v []byte) error {
-	return func(k, v []byte) error {
-		bkt := srcBucket.Bucket(k)
-		if bkt != nil {
-			b2, err := dstBucket.CreateBucketIfNotExists(k)
-			if err != nil {
-				return err
+// verifyAttestationHistory checks the encoding of the attestation history of a public key, and returns the number
+// of attestations it holds.
+func verifyAttestationHistory(pkBucket *bolt.Bucket) (int, error) {
+	if pkBucket == nil {
+		return 0, errors.New("not a bucket")
+	}
+	n := 0
+	if signingRoots := pkBucket.Bucket(attestationSigningRootsBucket); signingRoots != nil {
+		if err := signingRoots.ForEach(func(target, signingRoot []byte) error {
+			if len(target) != 8 || (len(signingRoot) != 0 && len(signingRoot) != fieldparams.RootLength) {
+				return errors.New("invalid signing root entry")
+			}
+			n++
+			return nil
+		}); err != nil {
+			return 0, err
+		}
+	}
+	for _, name := range [][]byte{attestationSourceEpochsBucket, attestationTargetEpochsBucket} {
+		bkt := pkBucket.Bucket(name)
+		if bkt == nil {
+			continue
+		}
+		if err := bkt.ForEach(func(epoch, epochs []byte) error {
+			if len(epoch) != 8 || len(epochs)%8 != 0 {
+				return errors.Errorf("invalid %s entry", name)
 			}
-			return bkt.ForEach(createNestedBuckets(bkt, b2, b2.Put))
+			return nil
+		}); err != nil {
+			return 0, err
 		}
-		return fn(k, v)
 	}
+	return n, nil
+}
+
+// verifyEpochValues checks that every value of the bucket is an encoded epoch.
+func verifyEpochValues(bkt *bolt.Bucket) error {
+	if bkt == nil {
+		return nil
+	}
+	return bkt.ForEach(func(pubKey, epoch []byte) error {
+		if len(pubKey) != fieldparams.BLSPubkeyLength || len(epoch) != 8 {
+			return errors.Errorf("invalid entry for public key %#x", pubKey)
+		}
+		return nil
+	})
 }
@@ -119,3 +119,40 @@ func TestStore_NestedBackup(t *testing.T) {
 	require.Equal(t, true, exists)
 	require.Equal(t, 10, int(ep))
 }
+
+func TestVerifyBackup(t *testing.T) {
+	keys := [][fieldparams.BLSPubkeyLength]byte{{'A'}, {'B'}, {'C'}}
+	db := setupDB(t, keys)
+	ctx := context.Background()
+	root := [32]byte{1}
+	idxAtt := &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: root[:],
+			Source:          &ethpb.Checkpoint{Epoch: 9, Root: root[:]},
+			Target:          &ethpb.Checkpoint{Epoch: 10, Root: root[:]},
+		},
+		Signature: make([]byte, 96),
+	}
+	backup := func() string {
+		dir := t.TempDir()
+		require.NoError(t, db.Backup(ctx, dir, true))
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(files))
+		return filepath.Join(dir, files[0].Name())
+	}
+
+	_, err := VerifyBackup(ctx, backup())
+	require.ErrorContains(t, "genesis validators root has length 0", err)
+
+	require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, root[:]))
+	require.NoError(t, db.SaveAttestationForPubKey(ctx, keys[0], [32]byte{'D'}, idxAtt))
+	require.NoError(t, db.SaveAttestationForPubKey(ctx, keys[1], [32]byte{'D'}, idxAtt))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, keys[2], 64, []byte{}))
+	report, err := VerifyBackup(ctx, backup())
+	require.NoError(t, err)
+	require.Equal(t, root, report.GenesisValidatorsRoot)
+	require.Equal(t, 3, report.PublicKeys)
+	require.Equal(t, 2, report.Attestations)
+	require.Equal(t, 1, report.Proposals)
+}
@@ -0,0 +1,102 @@
+package kv
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// dutyRecordKey sorts the duty records of a public key by slot, then by kind.
+func dutyRecordKey(slot primitives.Slot, kind common.DutyKind) []byte {
+	return append(bytesutil.SlotToBytesBigEndian(slot), byte(kind))
+}
+
+// SaveDutyRecords saves the given duty records, replacing the records of the same public key, slot and kind.
+func (s *Store) SaveDutyRecords(ctx context.Context, records []*common.DutyRecord) error {
+	_, span := trace.StartSpan(ctx, "Validator.SaveDutyRecords")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(dutyHistoryBucket)
+		for _, r := range records {
+			pkBucket, err := bucket.CreateBucketIfNotExists(r.PubKey[:])
+			if err != nil {
+				return errors.Wrap(err, "could not create duty history bucket")
+			}
+			enc, err := json.Marshal(r)
+			if err != nil {
+				return errors.Wrap(err, "could not encode duty record")
+			}
+			if err := pkBucket.Put(dutyRecordKey(r.Slot, r.Kind), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DutyHistory returns the duty records of a public key from startSlot to endSlot included, sorted by slot.
+func (s *Store) DutyHistory(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, startSlot, endSlot primitives.Slot,
+) ([]*common.DutyRecord, error) {
+	_, span := trace.StartSpan(ctx, "Validator.DutyHistory")
+	defer span.End()
+
+	records := make([]*common.DutyRecord, 0)
+	err := s.view(func(tx *bolt.Tx) error {
+		pkBucket := tx.Bucket(dutyHistoryBucket).Bucket(pubKey[:])
+		if pkBucket == nil {
+			return nil
+		}
+		c := pkBucket.Cursor()
+		for k, v := c.Seek(dutyRecordKey(startSlot, 0)); k != nil; k, v = c.Next() {
+			if bytesutil.BytesToSlotBigEndian(k[:8]) > endSlot {
+				break
+			}
+			r := &common.DutyRecord{}
+			if err := json.Unmarshal(v, r); err != nil {
+				return errors.Wrap(err, "could not decode duty record")
+			}
+			r.PubKey = pubKey
+			records = append(records, r)
+		}
+		return nil
+	})
+	return records, err
+}
+
+// PruneDutyHistory deletes the duty records of every public key before the given slot.
+func (s *Store) PruneDutyHistory(ctx context.Context, beforeSlot primitives.Slot) error {
+	_, span := trace.StartSpan(ctx, "Validator.PruneDutyHistory")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(dutyHistoryBucket)
+		pubKeys := make([][]byte, 0)
+		if err := bucket.ForEach(func(k, v []byte) error {
+			// Values are nil for the nested bucket of each public key.
+			if v == nil {
+				pubKeys = append(pubKeys, k)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, pubKey := range pubKeys {
+			c := bucket.Bucket(pubKey).Cursor()
+			for k, _ := c.First(); k != nil && bytesutil.BytesToSlotBigEndian(k[:8]) < beforeSlot; k, _ = c.First() {
+				if err := c.Delete(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,52 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+func TestStore_DutyHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	records := []*common.DutyRecord{
+		{PubKey: pubKey, Kind: common.SyncCommitteeMessageDuty, Slot: 40, Submitted: true},
+		{PubKey: pubKey, Kind: common.AttestationDuty, Slot: 40, Submitted: true, BlockRoot: []byte{1}},
+		{PubKey: pubKey, Kind: common.ProposalDuty, Slot: 10, PayloadValue: "100"},
+		{PubKey: [fieldparams.BLSPubkeyLength]byte{2}, Kind: common.AttestationDuty, Slot: 20},
+	}
+	require.NoError(t, db.SaveDutyRecords(ctx, records))
+
+	history, err := db.DutyHistory(ctx, pubKey, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(history))
+	assert.DeepEqual(t, records[2], history[0])
+	assert.DeepEqual(t, records[1], history[1])
+	assert.DeepEqual(t, records[0], history[2])
+
+	// Records are replaced by the records of the same slot and kind.
+	outcome := &common.DutyRecord{
+		PubKey: pubKey, Kind: common.AttestationDuty, Slot: 40, Submitted: true,
+		Outcome: &common.AttestationOutcome{Included: true, InclusionDistance: 1, CorrectHead: true},
+	}
+	require.NoError(t, db.SaveDutyRecords(ctx, []*common.DutyRecord{outcome}))
+	history, err = db.DutyHistory(ctx, pubKey, 11, 40)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(history))
+	assert.DeepEqual(t, outcome, history[0])
+
+	require.NoError(t, db.PruneDutyHistory(ctx, 40))
+	history, err = db.DutyHistory(ctx, pubKey, 0, primitives.Slot(100))
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(history))
+	history, err = db.DutyHistory(ctx, [fieldparams.BLSPubkeyLength]byte{2}, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(history))
+}
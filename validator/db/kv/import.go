@@ -3,7 +3,6 @@ package kv
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -23,72 +22,82 @@ import (
 // by Ethereum validators and imports its data into Prysm's internal complete representation of slashing
 // protection in the validator client's database.
 func (s *Store) ImportStandardProtectionJSON(ctx context.Context, r io.Reader) error {
-	encodedJSON, err := io.ReadAll(r)
+	// The JSON file is decoded one public key at a time, and only its internal
+	// representation is kept in memory until it is saved.
+	dec, err := format.NewDecoder(r)
 	if err != nil {
-		return errors.Wrap(err, "could not read slashing protection JSON file")
-	}
-
-	interchangeJSON := &format.EIPSlashingProtectionFormat{}
-	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
 		return errors.Wrap(err, "could not unmarshal slashing protection JSON file")
 	}
 
-	if interchangeJSON.Data == nil {
+	if !dec.HasData() {
 		log.Warn("No slashing protection data to import")
 		return nil
 	}
 
 	// We validate the `MetadataV0` field of the slashing protection JSON file.
-	if err := helpers.ValidateMetadata(ctx, s, interchangeJSON); err != nil {
+	if err := helpers.ValidateMetadata(ctx, s, &format.EIPSlashingProtectionFormat{Metadata: dec.Metadata()}); err != nil {
 		return errors.Wrap(err, "slashing protection JSON metadata was incorrect")
 	}
 
-	// We need to handle duplicate public keys in the JSON file, with potentially
-	// different signing histories for both attestations and blocks.
-	signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(interchangeJSON.Data)
-	if err != nil {
-		return errors.Wrap(err, "could not parse unique entries for blocks by public key")
-	}
-
-	signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(interchangeJSON.Data)
-	if err != nil {
-		return errors.Wrap(err, "could not parse unique entries for attestations by public key")
-	}
-
 	attestingHistoryByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte][]*common.AttestationRecord)
 	proposalHistoryByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte]common.ProposalHistoryForPubkey)
 
-	bar := common.InitializeProgressBar(len(signedBlocksByPubKey), "Transform signed blocks:")
-
-	for pubKey, signedBlocks := range signedBlocksByPubKey {
-		// Transform the processed signed blocks data from the JSON.
-		// file into the internal Prysm representation of proposal history.
+	// The number of public keys is unknown until the whole file is read.
+	bar := common.InitializeProgressBar(-1, "Parse signed blocks and attestations by public key:")
+	for {
+		validatorData, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+		}
 		if err := bar.Add(1); err != nil {
 			log.WithError(err).Debug("Could not increase progress bar")
 		}
+		if validatorData == nil {
+			continue
+		}
+		data := []*format.ProtectionData{validatorData}
 
-		proposalHistory, err := transformSignedBlocks(ctx, signedBlocks)
+		// We need to handle duplicate public keys in the JSON file, with potentially
+		// different signing histories for both attestations and blocks.
+		signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(data)
 		if err != nil {
-			return errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
+			return errors.Wrap(err, "could not parse unique entries for blocks by public key")
 		}
 
-		proposalHistoryByPubKey[pubKey] = *proposalHistory
-	}
-
-	bar = common.InitializeProgressBar(len(signedAttsByPubKey), "Transform signed attestations:")
-	for pubKey, signedAtts := range signedAttsByPubKey {
-		// Transform the processed signed attestation data from the JSON.
-		// file into the internal Prysm representation of attesting history.
-		if err := bar.Add(1); err != nil {
-			log.WithError(err).Debug("Could not increase progress bar")
+		signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(data)
+		if err != nil {
+			return errors.Wrap(err, "could not parse unique entries for attestations by public key")
 		}
 
-		historicalAtt, err := transformSignedAttestations(pubKey, signedAtts)
-		if err != nil {
-			return errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
+		for pubKey, signedBlocks := range signedBlocksByPubKey {
+			// Transform the processed signed blocks data from the JSON.
+			// file into the internal Prysm representation of proposal history.
+			proposalHistory, err := transformSignedBlocks(ctx, signedBlocks)
+			if err != nil {
+				return errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
+			}
+
+			history := proposalHistoryByPubKey[pubKey]
+			history.Proposals = append(history.Proposals, proposalHistory.Proposals...)
+			proposalHistoryByPubKey[pubKey] = history
 		}
 
-		attestingHistoryByPubKey[pubKey] = historicalAtt
+		for pubKey, signedAtts := range signedAttsByPubKey {
+			// Transform the processed signed attestation data from the JSON.
+			// file into the internal Prysm representation of attesting history.
+			historicalAtt, err := transformSignedAttestations(pubKey, signedAtts)
+			if err != nil {
+				return errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
+			}
+
+			attestingHistoryByPubKey[pubKey] = append(attestingHistoryByPubKey[pubKey], historicalAtt...)
+		}
+	}
+	if err := bar.Finish(); err != nil {
+		log.WithError(err).Debug("Could not finish progress bar")
 	}
 
 	// We validate and filter out public keys parsed from JSON to ensure we are
@@ -139,17 +148,8 @@ func (s *Store) ImportStandardProtectionJSON(ctx context.Context, r io.Reader) e
 //	  SignedBlocks: [Slot: 5, Slot: 5, Slot: 6, Slot: 7, Slot: 10, Slot: 11],
 //	 }
 func parseBlocksForUniquePublicKeys(data []*format.ProtectionData) (map[[fieldparams.BLSPubkeyLength]byte][]*format.SignedBlock, error) {
-	bar := common.InitializeProgressBar(
-		len(data),
-		"Parsing blocks for unique public keys:",
-	)
-
 	signedBlocksByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte][]*format.SignedBlock)
 	for _, validatorData := range data {
-		if err := bar.Add(1); err != nil {
-			return nil, errors.Wrap(err, "could not increase progress bar")
-		}
-
 		pubKey, err := helpers.PubKeyFromHex(validatorData.Pubkey)
 		if err != nil {
 			return nil, fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
@@ -181,17 +181,8 @@ func parseBlocksForUniquePublicKeys(data []*format.ProtectionData) (map[[fieldpa
 //	  SignedAttestations: [{Source: 5, Target: 6}, {Source: 5, Target: 6}, {Source: 6, Target: 7}],
 //	 }
 func parseAttestationsForUniquePublicKeys(data []*format.ProtectionData) (map[[fieldparams.BLSPubkeyLength]byte][]*format.SignedAttestation, error) {
-	bar := common.InitializeProgressBar(
-		len(data),
-		"Parsing attestations for unique public keys:",
-	)
-
 	signedAttestationsByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte][]*format.SignedAttestation)
 	for _, validatorData := range data {
-		if err := bar.Add(1); err != nil {
-			return nil, errors.Wrap(err, "could not increase progress bar")
-		}
-
 		pubKey, err := helpers.PubKeyFromHex(validatorData.Pubkey)
 		if err != nil {
 			return nil, fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
@@ -41,6 +41,9 @@ var (
 	// ProposerSettings stores the encoded proposer settings file
 	proposerSettingsBucket = []byte("proposer-settings-bucket")
 	proposerSettingsKey    = []byte("proposer-settings")
+
+	// Duty history of the validators, with their outcome.
+	dutyHistoryBucket = []byte("duty-history-bucket")
 )
 
 // Attestations:
@@ -55,3 +58,7 @@ var (
 // Proposals:
 // ----------
 // proposal-history-bucket-interchange -> <pubkey> --> <slot> --> <signing root>
+
+// Duties:
+// -------
+// duty-history-bucket --> <pubkey> --> <slot><duty kind> --> <duty record>
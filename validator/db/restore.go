@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/io/prompt"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
 	"github.com/urfave/cli/v2"
 )
@@ -42,7 +43,16 @@ func Restore(cliCtx *cli.Context) error {
 	if err := file.MkdirAll(targetDir); err != nil {
 		return err
 	}
-	if err := file.CopyFile(sourceFile, path.Join(targetDir, kv.ProtectionDbFileName)); err != nil {
+	isDir, err := file.HasDir(sourceFile)
+	if err != nil {
+		return errors.Wrapf(err, "could not check if %s is a directory", sourceFile)
+	}
+	if isDir {
+		// A backup directory written by the backup service, which may be incremental.
+		if err := backup.Restore(sourceFile, dbFilePath); err != nil {
+			return errors.Wrap(err, "could not restore backup")
+		}
+	} else if err := file.CopyFile(sourceFile, dbFilePath); err != nil {
 		return err
 	}
 
@@ -163,6 +163,17 @@ func (db *ValidatorDBMock) ImportStandardProtectionJSON(ctx context.Context, r i
 	panic("not implemented")
 }
 
+// Duty history related methods
+func (db *ValidatorDBMock) SaveDutyRecords(ctx context.Context, records []*common.DutyRecord) error {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) DutyHistory(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, startSlot, endSlot primitives.Slot) ([]*common.DutyRecord, error) {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) PruneDutyHistory(ctx context.Context, beforeSlot primitives.Slot) error {
+	panic("not implemented")
+}
+
 func Test_validateMetadata(t *testing.T) {
 	goodRoot := [32]byte{1}
 	goodStr := make([]byte, hex.EncodedLen(len(goodRoot)))
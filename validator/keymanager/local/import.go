@@ -9,10 +9,10 @@ import (
 	"github.com/k0kubun/go-ansi"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/schollz/progressbar/v3"
 	"github.com/sirupsen/logrus"
-	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 )
 
 // ImportKeystores into the local keymanager from an external source.
@@ -21,6 +21,7 @@ import (
 // 3) Save the copy to disk
 // 4) Reinitialize account store and updating the keymanager
 // 5) Return Statuses
+// Keystores without a public key are given the public key derived from their secret key.
 func (km *Keymanager) ImportKeystores(
 	ctx context.Context,
 	keystores []*keymanager.Keystore,
@@ -32,7 +33,6 @@ func (km *Keymanager) ImportKeystores(
 	if len(passwords) != len(keystores) {
 		return nil, ErrMismatchedNumPasswords
 	}
-	decryptor := keystorev4.New()
 	bar := initializeProgressBar(len(keystores), "Importing accounts...")
 	keys := map[string]string{}
 	statuses := make([]*keymanager.KeyStatus, len(keystores))
@@ -47,7 +47,7 @@ func (km *Keymanager) ImportKeystores(
 	for i := 0; i < len(keystores); i++ {
 		var privKeyBytes []byte
 		var pubKeyBytes []byte
-		privKeyBytes, pubKeyBytes, _, err = km.attemptDecryptKeystore(decryptor, keystores[i], passwords[i])
+		privKeyBytes, pubKeyBytes, _, err = km.attemptDecryptKeystore(keystores[i], passwords[i])
 		if err != nil {
 			statuses[i] = &keymanager.KeyStatus{
 				Status:  keymanager.StatusError,
@@ -58,6 +58,10 @@ func (km *Keymanager) ImportKeystores(
 		if err := bar.Add(1); err != nil {
 			log.Error(err)
 		}
+		// The public key is optional in a keystore, report the imported keystore by its derived public key.
+		if keystores[i].Pubkey == "" {
+			keystores[i].Pubkey = hex.EncodeToString(pubKeyBytes)
+		}
 		// if key exists prior to being added then output log that duplicate key was found
 		_, isDuplicateInArray := keys[string(pubKeyBytes)]
 		_, isDuplicateInExisting := existingPubKeys[string(pubKeyBytes)]
@@ -127,17 +131,17 @@ func (km *Keymanager) ImportKeypairs(ctx context.Context, privKeys, pubKeys [][]
 // by decrypting using a specified password. If the password fails,
 // it prompts the user for the correct password until it confirms.
 func (*Keymanager) attemptDecryptKeystore(
-	enc *keystorev4.Encryptor, keystore *keymanager.Keystore, password string,
+	ks *keymanager.Keystore, password string,
 ) ([]byte, []byte, string, error) {
 	// Attempt to decrypt the keystore with the specifies password.
 	var privKeyBytes []byte
 	var err error
-	privKeyBytes, err = enc.Decrypt(keystore.Crypto, password)
+	privKeyBytes, err = keystore.DecryptEIP2335(ks.Crypto, password)
 	doesNotDecrypt := err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg)
 	if doesNotDecrypt {
 		return nil, nil, "", fmt.Errorf(
 			"incorrect password for key 0x%s",
-			keystore.Pubkey,
+			ks.Pubkey,
 		)
 	}
 	if err != nil && !strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
@@ -146,8 +150,8 @@ func (*Keymanager) attemptDecryptKeystore(
 	var pubKeyBytes []byte
 	// Attempt to use the pubkey present in the keystore itself as a field. If unavailable,
 	// then utilize the public key directly from the private key.
-	if keystore.Pubkey != "" {
-		pubKeyBytes, err = hex.DecodeString(keystore.Pubkey)
+	if ks.Pubkey != "" {
+		pubKeyBytes, err = hex.DecodeString(ks.Pubkey)
 		if err != nil {
 			return nil, nil, "", errors.Wrap(err, "could not decode pubkey from keystore")
 		}
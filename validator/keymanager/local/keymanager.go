@@ -14,13 +14,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v5/runtime/interop"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/petnames"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
-	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 	"go.opencensus.io/trace"
 )
 
@@ -37,6 +37,9 @@ const (
 	AccountsPath = "accounts"
 	// AccountsKeystoreFileName exposes the name of the keystore file.
 	AccountsKeystoreFileName = "all-accounts.keystore.json"
+
+	// keystoreName is the name of the encryptor of EIP-2335 keystores.
+	keystoreName = "keystore"
 )
 
 // Keymanager implementation for local keystores utilizing EIP-2335.
@@ -44,6 +47,8 @@ type Keymanager struct {
 	wallet              iface.Wallet
 	accountsStore       *accountStore
 	accountsChangedFeed *event.Feed
+	// kdfParams of the accounts keystore, kept when the keystore is written again.
+	kdfParams *keystore.KDFParams
 }
 
 // SetupConfig includes configuration values for initializing
@@ -238,14 +243,17 @@ func (km *Keymanager) initializeAccountKeystore(ctx context.Context) error {
 	// by utilizing the password and initialize a new BLS secret key from
 	// its raw bytes.
 	password := km.wallet.Password()
-	decryptor := keystorev4.New()
-	enc, err := decryptor.Decrypt(keystoreFile.Crypto, password)
+	enc, err := keystore.DecryptEIP2335(keystoreFile.Crypto, password)
 	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
 		return errors.Wrap(err, "wrong password for wallet entered")
 	} else if err != nil {
 		return errors.Wrap(err, "could not decrypt keystore")
 	}
 
+	if params, err := keystore.KDFParamsOf(keystoreFile.Crypto); err == nil && params.Validate() == nil {
+		km.kdfParams = params
+	}
+
 	store := &accountStore{}
 	if err := json.Unmarshal(enc, store); err != nil {
 		return err
@@ -269,13 +277,13 @@ func (km *Keymanager) CreateAccountsKeystore(ctx context.Context, privateKeys []
 	if err := km.CreateOrUpdateInMemoryAccountsStore(ctx, privateKeys, publicKeys); err != nil {
 		return nil, err
 	}
-	return CreateAccountsKeystoreRepresentation(ctx, km.accountsStore, km.wallet.Password())
+	return createAccountsKeystoreRepresentation(km.accountsStore, km.wallet.Password(), km.kdfParams)
 }
 
 // SaveStoreAndReInitialize saves the store to disk and re-initializes the account keystore from file
 func (km *Keymanager) SaveStoreAndReInitialize(ctx context.Context, store *accountStore) error {
 	// Save the copy to disk
-	accountsKeystore, err := CreateAccountsKeystoreRepresentation(ctx, store, km.wallet.Password())
+	accountsKeystore, err := createAccountsKeystoreRepresentation(store, km.wallet.Password(), km.kdfParams)
 	if err != nil {
 		return err
 	}
@@ -314,7 +322,19 @@ func CreateAccountsKeystoreRepresentation(
 	store *accountStore,
 	walletPW string,
 ) (*AccountsKeystoreRepresentation, error) {
-	encryptor := keystorev4.New()
+	return createAccountsKeystoreRepresentation(store, walletPW, nil)
+}
+
+// createAccountsKeystoreRepresentation encrypts the accountStore with the key derivation function of kdfParams, or
+// with the default one if kdfParams is nil.
+func createAccountsKeystoreRepresentation(
+	store *accountStore,
+	walletPW string,
+	kdfParams *keystore.KDFParams,
+) (*AccountsKeystoreRepresentation, error) {
+	if kdfParams == nil {
+		kdfParams = keystore.DefaultKDFParams()
+	}
 	id, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -323,15 +343,15 @@ func CreateAccountsKeystoreRepresentation(
 	if err != nil {
 		return nil, err
 	}
-	cryptoFields, err := encryptor.Encrypt(encodedStore, walletPW)
+	cryptoFields, err := keystore.EncryptEIP2335(encodedStore, walletPW, kdfParams)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not encrypt accounts")
 	}
 	return &AccountsKeystoreRepresentation{
 		Crypto:  cryptoFields,
 		ID:      id.String(),
-		Version: encryptor.Version(),
-		Name:    encryptor.Name(),
+		Version: keystore.EIP2335Version,
+		Name:    keystoreName,
 	}, nil
 }
 
@@ -0,0 +1,155 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/sirupsen/logrus"
+)
+
+// ReencryptAccountsKeystore re-encrypts the accounts keystore of a wallet with a new password and key derivation
+// function. The current keystore is first copied to a backup file next to it, and the new keystore is written to a
+// temporary file which must decrypt to the same accounts before it atomically replaces the current keystore.
+// It returns the path of the backup file.
+func ReencryptAccountsKeystore(
+	_ context.Context,
+	wallet iface.Wallet,
+	newPassword string,
+	kdfParams *keystore.KDFParams,
+) (string, error) {
+	if err := kdfParams.Validate(); err != nil {
+		return "", err
+	}
+	dir := filepath.Join(wallet.AccountsDir(), AccountsPath)
+	keystorePath := filepath.Join(dir, AccountsKeystoreFileName)
+	encoded, err := os.ReadFile(filepath.Clean(keystorePath))
+	if err != nil {
+		return "", errors.Wrapf(err, "could not read keystore file for accounts %s", keystorePath)
+	}
+	current := &AccountsKeystoreRepresentation{}
+	if err := json.Unmarshal(encoded, current); err != nil {
+		return "", errors.Wrapf(err, "could not decode keystore file for accounts %s", AccountsKeystoreFileName)
+	}
+	encodedStore, err := keystore.DecryptEIP2335(current.Crypto, wallet.Password())
+	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+		return "", errors.Wrap(err, "wrong password for wallet entered")
+	} else if err != nil {
+		return "", errors.Wrap(err, "could not decrypt keystore")
+	}
+	store := &accountStore{}
+	if err := json.Unmarshal(encodedStore, store); err != nil {
+		return "", errors.Wrap(err, "could not decode accounts")
+	}
+
+	cryptoFields, err := keystore.EncryptEIP2335(encodedStore, newPassword, kdfParams)
+	if err != nil {
+		return "", errors.Wrap(err, "could not encrypt accounts")
+	}
+	reencrypted, err := json.MarshalIndent(&AccountsKeystoreRepresentation{
+		Crypto:  cryptoFields,
+		ID:      current.ID,
+		Version: keystore.EIP2335Version,
+		Name:    keystoreName,
+	}, "", "\t")
+	if err != nil {
+		return "", err
+	}
+
+	backupPath := filepath.Join(dir, fmt.Sprintf("%s.backup-%d", AccountsKeystoreFileName, time.Now().Unix()))
+	exists, err := file.Exists(backupPath, file.Regular)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not check if file exists: %s", backupPath)
+	}
+	if exists {
+		return "", fmt.Errorf("backup file %s already exists", backupPath)
+	}
+	if err := writeFileSynced(backupPath, encoded); err != nil {
+		return "", errors.Wrap(err, "could not back up accounts keystore")
+	}
+	tmpPath := keystorePath + ".tmp"
+	if err := writeFileSynced(tmpPath, reencrypted); err != nil {
+		return "", errors.Wrap(err, "could not write re-encrypted accounts keystore")
+	}
+	if err := verifyReencryptedKeystore(tmpPath, newPassword, encodedStore); err != nil {
+		if rmErr := os.Remove(tmpPath); rmErr != nil {
+			log.WithError(rmErr).Errorf("Could not remove %s", tmpPath)
+		}
+		return "", errors.Wrap(err, "could not verify re-encrypted accounts keystore")
+	}
+	if err := os.Rename(tmpPath, keystorePath); err != nil {
+		return "", errors.Wrap(err, "could not replace accounts keystore")
+	}
+	if err := syncDir(dir); err != nil {
+		return "", errors.Wrap(err, "could not sync accounts keystore directory")
+	}
+	log.WithFields(logrus.Fields{
+		"kdf":         kdfParams.Function,
+		"cost":        kdfParams.Cost,
+		"numAccounts": len(store.PublicKeys),
+	}).Info("Re-encrypted accounts keystore")
+	return backupPath, nil
+}
+
+// writeFileSynced writes a file and flushes it to disk before returning, so that it is complete once it is renamed
+// over the current keystore, even if the machine crashes.
+func writeFileSynced(path string, data []byte) error {
+	f, err := os.OpenFile(filepath.Clean(path), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// syncDir flushes the entries of a directory to disk, making a rename in it durable.
+func syncDir(dir string) error {
+	d, err := os.Open(filepath.Clean(dir))
+	if err != nil {
+		return err
+	}
+	if err := d.Sync(); err != nil {
+		_ = d.Close()
+		return err
+	}
+	return d.Close()
+}
+
+// verifyReencryptedKeystore reads a keystore written to disk and checks that it decrypts to the expected accounts
+// with the password.
+func verifyReencryptedKeystore(path, password string, expected []byte) error {
+	encoded, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	reencrypted := &AccountsKeystoreRepresentation{}
+	if err := json.Unmarshal(encoded, reencrypted); err != nil {
+		return err
+	}
+	decrypted, err := keystore.DecryptEIP2335(reencrypted.Crypto, password)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(decrypted, expected) {
+		return errors.New("re-encrypted accounts do not match the current ones")
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	mock "github.com/prysmaticlabs/prysm/v5/validator/accounts/testing"
+)
+
+func TestReencryptAccountsKeystore(t *testing.T) {
+	ctx := context.Background()
+	store := &accountStore{}
+	for i := 0; i < 3; i++ {
+		secretKey, err := bls.RandKey()
+		require.NoError(t, err)
+		store.PrivateKeys = append(store.PrivateKeys, secretKey.Marshal())
+		store.PublicKeys = append(store.PublicKeys, secretKey.PublicKey().Marshal())
+	}
+	accountsKeystore, err := CreateAccountsKeystoreRepresentation(ctx, store, password)
+	require.NoError(t, err)
+	encoded, err := json.MarshalIndent(accountsKeystore, "", "\t")
+	require.NoError(t, err)
+	wallet := &mock.Wallet{
+		InnerAccountsDir: t.TempDir(),
+		Files:            make(map[string]map[string][]byte),
+		WalletPassword:   password,
+	}
+	dir := filepath.Join(wallet.AccountsDir(), AccountsPath)
+	require.NoError(t, file.MkdirAll(dir))
+	keystorePath := filepath.Join(dir, AccountsKeystoreFileName)
+	require.NoError(t, file.WriteFile(keystorePath, encoded))
+
+	newPassword := "n3wPassw0rd$2024"
+	params := &keystore.KDFParams{Function: keystore.ScryptKDF, Cost: 1024}
+
+	t.Run("invalid KDF", func(t *testing.T) {
+		_, err := ReencryptAccountsKeystore(ctx, wallet, newPassword, &keystore.KDFParams{Function: keystore.ScryptKDF, Cost: 1000})
+		assert.ErrorContains(t, "power of two", err)
+	})
+	t.Run("wrong password", func(t *testing.T) {
+		wrongWallet := &mock.Wallet{InnerAccountsDir: wallet.InnerAccountsDir, WalletPassword: "wrong"}
+		_, err := ReencryptAccountsKeystore(ctx, wrongWallet, newPassword, params)
+		assert.ErrorContains(t, "wrong password for wallet entered", err)
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(entries), "no file should be written")
+	})
+
+	backupPath, err := ReencryptAccountsKeystore(ctx, wallet, newPassword, params)
+	require.NoError(t, err)
+	backup, err := os.ReadFile(backupPath)
+	require.NoError(t, err)
+	assert.DeepEqual(t, encoded, backup)
+	tmpExists, err := file.Exists(keystorePath+".tmp", file.Regular)
+	require.NoError(t, err)
+	assert.Equal(t, false, tmpExists)
+
+	reencrypted, err := os.ReadFile(keystorePath)
+	require.NoError(t, err)
+	newKeystore := &AccountsKeystoreRepresentation{}
+	require.NoError(t, json.Unmarshal(reencrypted, newKeystore))
+	assert.Equal(t, accountsKeystore.ID, newKeystore.ID)
+	gotParams, err := keystore.KDFParamsOf(newKeystore.Crypto)
+	require.NoError(t, err)
+	assert.DeepEqual(t, params, gotParams)
+	_, err = keystore.DecryptEIP2335(newKeystore.Crypto, password)
+	assert.ErrorContains(t, "invalid checksum", err)
+	decrypted, err := keystore.DecryptEIP2335(newKeystore.Crypto, newPassword)
+	require.NoError(t, err)
+	newStore := &accountStore{}
+	require.NoError(t, json.Unmarshal(decrypted, newStore))
+	assert.DeepEqual(t, store, newStore)
+
+	// The keymanager keeps the key derivation function of the keystore when it writes it again.
+	wallet.WalletPassword = newPassword
+	wallet.Files[AccountsPath] = map[string][]byte{AccountsKeystoreFileName: reencrypted}
+	km, err := NewKeymanager(ctx, &SetupConfig{Wallet: wallet})
+	require.NoError(t, err)
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	require.NoError(t, km.ImportKeypairs(ctx, [][]byte{secretKey.Marshal()}, [][]byte{secretKey.PublicKey().Marshal()}))
+	saved := &AccountsKeystoreRepresentation{}
+	require.NoError(t, json.Unmarshal(wallet.Files[AccountsPath][AccountsKeystoreFileName], saved))
+	gotParams, err = keystore.KDFParamsOf(saved.Crypto)
+	require.NoError(t, err)
+	assert.DeepEqual(t, params, gotParams)
+}
@@ -12,10 +12,10 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
-	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 )
 
 // Listen for changes to the all-accounts.keystore.json file in our wallet
@@ -111,9 +111,8 @@ func (km *Keymanager) reloadAccountsFromKeystoreFile(accountsFilePath string) {
 
 // Replaces the accounts store struct in the local keymanager with
 // the contents of a keystore file by decrypting it with the accounts password.
-func (km *Keymanager) reloadAccountsFromKeystore(keystore *AccountsKeystoreRepresentation) error {
-	decryptor := keystorev4.New()
-	encodedAccounts, err := decryptor.Decrypt(keystore.Crypto, km.wallet.Password())
+func (km *Keymanager) reloadAccountsFromKeystore(accountsKeystore *AccountsKeystoreRepresentation) error {
+	encodedAccounts, err := keystore.DecryptEIP2335(accountsKeystore.Crypto, km.wallet.Password())
 	if err != nil {
 		return errors.Wrap(err, "could not decrypt keystore file")
 	}
@@ -27,6 +27,13 @@ const (
 	ethApiNamespace = "/api/v1/eth2/sign/"
 )
 
+var (
+	// ErrPublicKeyNotFound is returned when the web3signer does not hold the key it is asked to sign with.
+	ErrPublicKeyNotFound = errors.New("public key not found")
+	// ErrSlashingProtection is returned when the web3signer refuses to sign because of its slashing protection.
+	ErrSlashingProtection = errors.New("signing operation failed due to slashing protection rules")
+)
+
 type SignRequestJson []byte
 
 // SignatureResponse is the struct representing the signing request response in json format
@@ -69,10 +76,10 @@ func (client *ApiClient) Sign(ctx context.Context, pubKey string, request SignRe
 		return nil, err
 	}
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("public key not found")
+		return nil, ErrPublicKeyNotFound
 	}
 	if resp.StatusCode == http.StatusPreconditionFailed {
-		return nil, fmt.Errorf("signing operation failed due to slashing protection rules,  Signing Request URL: %v, Status: %v", client.BaseURL.String()+requestPath, resp.StatusCode)
+		return nil, errors.Wrapf(ErrSlashingProtection, "Signing Request URL: %v, Status: %v", client.BaseURL.String()+requestPath, resp.StatusCode)
 	}
 	contentType := resp.Header.Get("Content-Type")
 	if strings.HasPrefix(contentType, "application/json") {
@@ -163,6 +170,12 @@ func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath str
 		signRequestDurationSeconds.WithLabelValues(req.Method, strconv.Itoa(resp.StatusCode)).Observe(duration.Seconds())
 	}
 	if resp.StatusCode != http.StatusOK {
+		// The request body was consumed when sending the request.
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
 		requestDump, err = httputil.DumpRequestOut(req, true)
 		if err != nil {
 			return nil, err
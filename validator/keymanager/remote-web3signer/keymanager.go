@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-playground/validator/v10"
@@ -30,6 +32,11 @@ type SetupConfig struct {
 	BaseEndpoint          string
 	GenesisValidatorsRoot []byte
 
+	// AdditionalEndpoints are the URLs of further web3signers to use next to the one at BaseEndpoint.
+	// Each key is routed to the signers that list it on their public keys endpoint, and signing fails over
+	// between those signers when one of them is unhealthy.
+	AdditionalEndpoints []string
+
 	// Either URL or keylist must be set.
 	// If the URL is set, the keymanager will fetch the public keys from the URL.
 	// caution: this option is susceptible to slashing if the web3signer's validator keys are shared across validators
@@ -44,6 +51,10 @@ type SetupConfig struct {
 // Keymanager defines the web3signer keymanager.
 type Keymanager struct {
 	client                internal.HttpSignerClient
+	signers               []*signer
+	keySigners            map[[fieldparams.BLSPubkeyLength]byte][]*signer
+	keySignersUpdated     time.Time
+	signersLock           sync.RWMutex
 	genesisValidatorsRoot []byte
 	publicKeysURL         string
 	providedPublicKeys    [][48]byte
@@ -57,12 +68,18 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if cfg.BaseEndpoint == "" || !bytesutil.IsValidRoot(cfg.GenesisValidatorsRoot) {
 		return nil, fmt.Errorf("invalid setup config, one or more configs are empty: BaseEndpoint: %v, GenesisValidatorsRoot: %#x", cfg.BaseEndpoint, cfg.GenesisValidatorsRoot)
 	}
-	client, err := internal.NewApiClient(cfg.BaseEndpoint)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not create apiClient")
+	endpoints := append([]string{cfg.BaseEndpoint}, cfg.AdditionalEndpoints...)
+	signers := make([]*signer, len(endpoints))
+	for i, endpoint := range endpoints {
+		s, err := newSigner(endpoint)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create apiClient")
+		}
+		signers[i] = s
 	}
 	return &Keymanager{
-		client:                internal.HttpSignerClient(client),
+		client:                signers[0].client,
+		signers:               signers,
 		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
 		accountsChangedFeed:   new(event.Feed),
 		publicKeysURL:         cfg.PublicKeysURL,
@@ -86,6 +103,16 @@ func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldpa
 		km.publicKeysUrlCalled = true
 		km.providedPublicKeys = providedPublicKeys
 	}
+	if len(km.signers) > 1 {
+		km.signersLock.RLock()
+		discovered := km.keySigners != nil
+		km.signersLock.RUnlock()
+		if !discovered {
+			if err := km.updateKeySigners(ctx); err != nil {
+				log.WithError(err).Warn("Could not discover the web3signers of the validator keys")
+			}
+		}
+	}
 	return km.providedPublicKeys, nil
 }
 
@@ -99,7 +126,10 @@ func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest
 
 	signRequestsTotal.Inc()
 
-	return km.client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
+	if len(km.signers) == 1 {
+		return km.client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
+	}
+	return km.signWithFailover(ctx, bytesutil.ToBytes48(request.PublicKey), signRequest)
 }
 
 // getSignRequestJson returns a json request based on the SignRequest type.
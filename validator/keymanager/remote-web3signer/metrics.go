@@ -14,6 +14,19 @@ var (
 		Name: "remote_web3signer_errored_responses_total",
 		Help: "Total number of errored responses when calling web3signer",
 	})
+	signerRequestDurationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "remote_web3signer_signer_request_duration_seconds",
+		Help:    "Time (in seconds) spent on sign requests, per web3signer",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"signer"})
+	signerErroredResponsesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "remote_web3signer_signer_errored_responses_total",
+		Help: "Total number of errored responses, per web3signer",
+	}, []string{"signer"})
+	signerFailoversTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "remote_web3signer_signer_failovers_total",
+		Help: "Total number of sign requests retried on another web3signer",
+	})
 	blockSignRequestsTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "remote_web3signer_block_sign_requests_total",
 		Help: "Total number of block sign requests",
@@ -0,0 +1,160 @@
+package remote_web3signer
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer/internal"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// publicKeysPath is the web3signer endpoint listing the keys a signer holds.
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	// signerBackoff is how long a signer that failed a request is tried after the other signers of a key.
+	signerBackoff = time.Minute
+	// keySignersRefreshInterval is the minimum time between two discoveries of the signers of the keys.
+	keySignersRefreshInterval = time.Minute
+)
+
+// signer is one of the web3signers the keymanager routes sign requests to.
+type signer struct {
+	url            string
+	host           string
+	client         internal.HttpSignerClient
+	unhealthyUntil time.Time
+}
+
+func newSigner(endpoint string) (*signer, error) {
+	client, err := internal.NewApiClient(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &signer{
+		url:    strings.TrimSuffix(endpoint, "/"),
+		host:   client.BaseURL.Host,
+		client: client,
+	}, nil
+}
+
+// updateKeySigners asks every signer for the keys it holds and routes each key to the signers holding it.
+// Signers that cannot be reached keep the keys they were known to hold.
+func (km *Keymanager) updateKeySigners(ctx context.Context) error {
+	keySigners := make(map[[fieldparams.BLSPubkeyLength]byte][]*signer)
+	var reached int
+	for _, s := range km.signers {
+		keys, err := s.client.GetPublicKeys(ctx, s.url+publicKeysPath)
+		if err != nil {
+			signerErroredResponsesTotal.WithLabelValues(s.host).Inc()
+			log.WithError(err).WithField("signer", s.host).Warn("Could not get public keys from web3signer")
+			km.signersLock.RLock()
+			for key, signers := range km.keySigners {
+				for _, known := range signers {
+					if known == s {
+						keySigners[key] = append(keySigners[key], s)
+					}
+				}
+			}
+			km.signersLock.RUnlock()
+			continue
+		}
+		reached++
+		for _, key := range keys {
+			keySigners[key] = append(keySigners[key], s)
+		}
+	}
+
+	km.signersLock.Lock()
+	defer km.signersLock.Unlock()
+	km.keySignersUpdated = time.Now()
+	if reached == 0 {
+		return errors.New("could not get public keys from any web3signer")
+	}
+	km.keySigners = keySigners
+	return nil
+}
+
+// signersForKey returns the signers to try for the key, healthy signers first. All signers are returned
+// for a key that no signer is known to hold.
+func (km *Keymanager) signersForKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) []*signer {
+	km.signersLock.RLock()
+	signers, ok := km.keySigners[pubKey]
+	refresh := !ok && time.Since(km.keySignersUpdated) >= keySignersRefreshInterval
+	km.signersLock.RUnlock()
+	if refresh {
+		if err := km.updateKeySigners(ctx); err != nil {
+			log.WithError(err).Warn("Could not discover the web3signers of the validator keys")
+		}
+		km.signersLock.RLock()
+		signers, ok = km.keySigners[pubKey]
+		km.signersLock.RUnlock()
+	}
+	if !ok {
+		signers = km.signers
+	}
+
+	now := time.Now()
+	km.signersLock.RLock()
+	defer km.signersLock.RUnlock()
+	ordered := append([]*signer{}, signers...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return !now.Before(ordered[i].unhealthyUntil) && now.Before(ordered[j].unhealthyUntil)
+	})
+	return ordered
+}
+
+// signWithFailover signs with the signers holding the key, moving to the next one when a signer fails.
+// A signer refusing to sign because of its slashing protection is never failed over from.
+func (km *Keymanager) signWithFailover(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, request internal.SignRequestJson) (bls.Signature, error) {
+	var err error
+	for i, s := range km.signersForKey(ctx, pubKey) {
+		if i > 0 {
+			signerFailoversTotal.Inc()
+			log.WithError(err).WithField("signer", s.host).Debug("Failing over to the next web3signer")
+		}
+		var sig bls.Signature
+		sig, err = km.signWith(ctx, s, pubKey, request)
+		if err == nil {
+			return sig, nil
+		}
+		if errors.Is(err, internal.ErrSlashingProtection) || ctx.Err() != nil {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+func (km *Keymanager) signWith(ctx context.Context, s *signer, pubKey [fieldparams.BLSPubkeyLength]byte, request internal.SignRequestJson) (bls.Signature, error) {
+	start := time.Now()
+	sig, err := s.client.Sign(ctx, hexutil.Encode(pubKey[:]), request)
+	signerRequestDurationSeconds.WithLabelValues(s.host).Observe(time.Since(start).Seconds())
+	if err != nil {
+		signerErroredResponsesTotal.WithLabelValues(s.host).Inc()
+		if !errors.Is(err, internal.ErrPublicKeyNotFound) && !errors.Is(err, internal.ErrSlashingProtection) {
+			km.signersLock.Lock()
+			s.unhealthyUntil = time.Now().Add(signerBackoff)
+			km.signersLock.Unlock()
+		}
+		return nil, errors.Wrapf(err, "web3signer %s could not sign", redactURL(s.url))
+	}
+	km.signersLock.Lock()
+	s.unhealthyUntil = time.Time{}
+	km.signersLock.Unlock()
+	return sig, nil
+}
+
+// redactURL strips the user information from a signer URL so that it can be logged.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.Redacted()
+}
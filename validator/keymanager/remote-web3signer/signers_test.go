@@ -0,0 +1,174 @@
+package remote_web3signer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer/internal"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer/v1/mock"
+)
+
+const (
+	stubSignature = "0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9"
+	stubKey1      = "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	stubKey2      = "0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"
+)
+
+// stubSigner is a minimal web3signer serving the public keys and sign endpoints.
+type stubSigner struct {
+	*httptest.Server
+	keys []string
+
+	sync.Mutex
+	signStatus int
+	signed     int
+}
+
+func newStubSigner(t *testing.T, keys ...string) *stubSigner {
+	s := &stubSigner{keys: keys, signStatus: http.StatusOK}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == publicKeysPath {
+			w.Header().Set("Content-Type", "application/json")
+			require.NoError(t, json.NewEncoder(w).Encode(s.keys))
+			return
+		}
+		s.Lock()
+		defer s.Unlock()
+		if s.signStatus != http.StatusOK {
+			w.WriteHeader(s.signStatus)
+			return
+		}
+		key := strings.TrimPrefix(r.URL.Path, "/api/v1/eth2/sign/")
+		for _, k := range s.keys {
+			if k == key {
+				s.signed++
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(map[string]string{"signature": stubSignature}))
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *stubSigner) setSignStatus(status int) {
+	s.Lock()
+	defer s.Unlock()
+	s.signStatus = status
+}
+
+func (s *stubSigner) signCount() int {
+	s.Lock()
+	defer s.Unlock()
+	return s.signed
+}
+
+func newMultiSignerKeymanager(t *testing.T, signers ...*stubSigner) *Keymanager {
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	var additional []string
+	for _, s := range signers[1:] {
+		additional = append(additional, s.URL)
+	}
+	k1, err := hexutil.Decode(stubKey1)
+	require.NoError(t, err)
+	k2, err := hexutil.Decode(stubKey2)
+	require.NoError(t, err)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		BaseEndpoint:          signers[0].URL,
+		AdditionalEndpoints:   additional,
+		GenesisValidatorsRoot: root,
+		ProvidedPublicKeys:    [][48]byte{bytesutil.ToBytes48(k1), bytesutil.ToBytes48(k2)},
+	})
+	require.NoError(t, err)
+	return km
+}
+
+func signStubRequest(t *testing.T, km *Keymanager, key string) error {
+	request := mock.GetMockSignRequest("AGGREGATION_SLOT")
+	pubKey, err := hexutil.Decode(key)
+	require.NoError(t, err)
+	request.PublicKey = pubKey
+	sig, err := km.Sign(context.Background(), request)
+	if err != nil {
+		return err
+	}
+	require.Equal(t, stubSignature, hexutil.Encode(sig.Marshal()))
+	return nil
+}
+
+func TestKeymanager_MultipleSigners_RoutesKeys(t *testing.T) {
+	signer1 := newStubSigner(t, stubKey1)
+	signer2 := newStubSigner(t, stubKey1, stubKey2)
+	km := newMultiSignerKeymanager(t, signer1, signer2)
+
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keys))
+	k2, err := hexutil.Decode(stubKey2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(km.keySigners[bytesutil.ToBytes48(k2)]))
+
+	require.NoError(t, signStubRequest(t, km, stubKey1))
+	require.NoError(t, signStubRequest(t, km, stubKey2))
+	require.Equal(t, 1, signer1.signCount())
+	require.Equal(t, 1, signer2.signCount())
+}
+
+func TestKeymanager_MultipleSigners_FailsOver(t *testing.T) {
+	signer1 := newStubSigner(t, stubKey1)
+	signer2 := newStubSigner(t, stubKey1)
+	km := newMultiSignerKeymanager(t, signer1, signer2)
+	_, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+
+	signer1.setSignStatus(http.StatusInternalServerError)
+	require.NoError(t, signStubRequest(t, km, stubKey1))
+	require.Equal(t, 1, signer2.signCount())
+
+	// The failed signer is tried last until it recovers.
+	signer1.setSignStatus(http.StatusOK)
+	require.NoError(t, signStubRequest(t, km, stubKey1))
+	require.Equal(t, 0, signer1.signCount())
+	require.Equal(t, 2, signer2.signCount())
+
+	// Both signers are unhealthy.
+	signer1.setSignStatus(http.StatusInternalServerError)
+	signer2.setSignStatus(http.StatusInternalServerError)
+	require.ErrorContains(t, "internal Web3Signer server error", signStubRequest(t, km, stubKey1))
+}
+
+func TestKeymanager_MultipleSigners_NoFailoverOnSlashingProtection(t *testing.T) {
+	signer1 := newStubSigner(t, stubKey1)
+	signer2 := newStubSigner(t, stubKey1)
+	km := newMultiSignerKeymanager(t, signer1, signer2)
+	_, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+
+	signer1.setSignStatus(http.StatusPreconditionFailed)
+	err = signStubRequest(t, km, stubKey1)
+	require.ErrorIs(t, err, internal.ErrSlashingProtection)
+	require.Equal(t, 0, signer2.signCount())
+}
+
+func TestKeymanager_MultipleSigners_UnreachableSigner(t *testing.T) {
+	signer1 := newStubSigner(t, stubKey1)
+	signer2 := newStubSigner(t, stubKey1, stubKey2)
+	signer1.Close()
+	km := newMultiSignerKeymanager(t, signer1, signer2)
+
+	_, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, signStubRequest(t, km, stubKey1))
+	require.Equal(t, 1, signer2.signCount())
+}
@@ -0,0 +1,152 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// PartialSignaturePath is the path at which validator clients serve the partial signatures of their key shares.
+const PartialSignaturePath = "/threshold/v1/partial_signature"
+
+// PartialSignatureRequest is the body of a request for the partial signature of a sign request.
+type PartialSignatureRequest struct {
+	// SignRequest is the hex encoded protobuf sign request.
+	SignRequest string `json:"sign_request"`
+}
+
+// PartialSignatureResponse is the partial signature of a sign request by a key share.
+type PartialSignatureResponse struct {
+	Index     string `json:"index"`
+	Signature string `json:"signature"`
+}
+
+type partialSignature struct {
+	index uint64
+	sig   bls.Signature
+	err   error
+}
+
+// requestPartialSignatures requests the partial signatures of a sign request from all the peers at once, and
+// returns the first count valid ones with the indices of their key shares.
+func (km *Keymanager) requestPartialSignatures(
+	ctx context.Context,
+	req *validatorpb.SignRequest,
+	count uint64,
+) ([]bls.Signature, []uint64, error) {
+	encoded, err := proto.Marshal(req)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not marshal sign request")
+	}
+	body, err := json.Marshal(&PartialSignatureRequest{SignRequest: hexutil.Encode(encoded)})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan *partialSignature, len(km.peers))
+	for _, p := range km.peers {
+		go func(p *peer) {
+			sig, err := km.requestPartialSignature(ctx, p, body, req.SigningRoot)
+			results <- &partialSignature{index: p.index, sig: sig, err: err}
+		}(p)
+	}
+
+	sigs := make([]bls.Signature, 0, count)
+	indices := make([]uint64, 0, count)
+	var failures []string
+	for range km.peers {
+		res := <-results
+		if res.err != nil {
+			log.WithFields(km.logFields()).WithField("peerIndex", res.index).WithError(res.err).Debug(
+				"Could not get partial signature from peer")
+			failures = append(failures, fmt.Sprintf("peer %d: %v", res.index, res.err))
+			continue
+		}
+		sigs = append(sigs, res.sig)
+		indices = append(indices, res.index)
+		if uint64(len(sigs)) == count {
+			return sigs, indices, nil
+		}
+	}
+	return nil, nil, fmt.Errorf(
+		"got %d of the %d partial signatures needed from peers: %s",
+		len(sigs),
+		count,
+		strings.Join(failures, "; "),
+	)
+}
+
+// requestPartialSignature requests the partial signature of a peer and verifies it against the public key of its
+// key share.
+func (km *Keymanager) requestPartialSignature(ctx context.Context, p *peer, body, signingRoot []byte) (bls.Signature, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(p.url, "/")+PartialSignaturePath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", api.JsonMediaType)
+	httpReq.Header.Set("Authorization", "Bearer "+p.authToken)
+	resp, err := km.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		errJson := &httputil.DefaultJsonError{}
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(errJson); err != nil || errJson.Message == "" {
+			return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		}
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, errJson.Message)
+	}
+	partial := &PartialSignatureResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(partial); err != nil {
+		return nil, errors.Wrap(err, "could not decode partial signature")
+	}
+	index, err := strconv.ParseUint(partial.Index, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse key share index")
+	}
+	if index != p.index {
+		return nil, fmt.Errorf("got partial signature of key share %d instead of %d", index, p.index)
+	}
+	sigBytes, err := hexutil.Decode(partial.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode partial signature")
+	}
+	sig, err := bls.SignatureFromBytes(sigBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid partial signature")
+	}
+	if !sig.Verify(p.publicKey, signingRoot) {
+		return nil, errors.New("partial signature does not verify against the public key of the key share")
+	}
+	return sig, nil
+}
+
+// logFields returns the fields identifying the keymanager in logs.
+func (km *Keymanager) logFields() logrus.Fields {
+	return logrus.Fields{
+		"pubkey":     fmt.Sprintf("%#x", bytesutil.Trunc(km.signer.groupPublicKey[:])),
+		"shareIndex": km.shareIndex,
+	}
+}
@@ -0,0 +1,323 @@
+package threshold
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+)
+
+// DefaultPeerTimeout is the time given to the peers to return their partial signatures of a sign request.
+const DefaultPeerTimeout = 2 * time.Second
+
+// SlashingProtection checks that a block or an attestation is not slashable and records it as signed. It is
+// implemented by the validator database.
+type SlashingProtection interface {
+	SlashableProposalCheck(
+		ctx context.Context,
+		pubKey [fieldparams.BLSPubkeyLength]byte,
+		signedBlock interfaces.ReadOnlySignedBeaconBlock,
+		signingRoot [fieldparams.RootLength]byte,
+		emitAccountMetrics bool,
+		validatorProposeFailVec *prometheus.CounterVec,
+	) error
+	SlashableAttestationCheck(
+		ctx context.Context, indexedAtt *ethpb.IndexedAttestation, pubKey [fieldparams.BLSPubkeyLength]byte,
+		signingRoot32 [32]byte,
+		emitAccountMetrics bool,
+		validatorAttestFailVec *prometheus.CounterVec,
+	) error
+}
+
+// Peer is another validator client holding a share of the same key.
+type Peer struct {
+	// Index is the index of the key share of the peer.
+	Index uint64
+	// URL is the https base URL of the partial signature server of the peer.
+	URL string
+	// PublicKey is the public key of the key share of the peer.
+	PublicKey []byte
+	// AuthToken is the bearer token shared with this peer only. It authenticates the partial signature requests
+	// between the two validator clients, in both directions.
+	AuthToken string
+}
+
+// SetupConfig includes configuration values for initializing a threshold keymanager and the server answering the
+// partial signature requests of its peers.
+type SetupConfig struct {
+	// Threshold is the number of key shares needed to recover a signature of the group key. It must be more than
+	// half of the key shares, so that two groups of validator clients can never sign conflicting objects.
+	Threshold uint64
+	// ShareIndex is the index of the key share held by this validator client.
+	ShareIndex     uint64
+	ShareSecretKey bls.SecretKey
+	// GroupPublicKey is the public key of the validator, recovered from the key shares.
+	GroupPublicKey []byte
+	Peers          []*Peer
+	// TLSConfig holds the certificate of the partial signature server, and the root CAs verifying the certificates
+	// of the peers. The system root CAs are used if it has none.
+	TLSConfig *tls.Config
+	// ApprovedExits are the voluntary exits the operator of this validator client approved. The key share only
+	// signs the voluntary exits requested by a peer if they are approved.
+	ApprovedExits []*ApprovedExit
+	// ApprovedRegistration is the fee recipient and gas limit the operator of this validator client approved. The
+	// key share only signs the validator registrations requested by a peer if they match it, and none if it is nil.
+	ApprovedRegistration *ApprovedRegistration
+	// SlashingProtection is checked before any key share signs a block or an attestation, including on behalf of
+	// a peer.
+	SlashingProtection SlashingProtection
+	// Timeout is the time given to the peers to return their partial signatures. It defaults to DefaultPeerTimeout.
+	Timeout time.Duration
+	// ListenAddress is the host:port at which the partial signature server listens.
+	ListenAddress string
+}
+
+// validate checks that the configuration describes a consistent sharing of the group key, by recovering the group
+// public key from the public keys of a threshold of the key shares.
+func (c *SetupConfig) validate() error {
+	if c == nil {
+		return errors.New("nil setup config")
+	}
+	if c.ShareSecretKey == nil {
+		return errors.New("no key share provided")
+	}
+	if c.ShareIndex == 0 {
+		return errors.New("key share index must be positive")
+	}
+	if c.Threshold == 0 || c.Threshold > uint64(len(c.Peers))+1 {
+		return fmt.Errorf("threshold must be between 1 and the number of key shares %d, got %d", len(c.Peers)+1, c.Threshold)
+	}
+	if 2*c.Threshold <= uint64(len(c.Peers))+1 {
+		return fmt.Errorf("threshold must be more than half of the %d key shares, got %d", len(c.Peers)+1, c.Threshold)
+	}
+	if c.TLSConfig == nil || len(c.TLSConfig.Certificates) == 0 {
+		return errors.New("no TLS certificate provided")
+	}
+	if c.SlashingProtection == nil {
+		return errors.New("no slashing protection provided")
+	}
+	groupPublicKey, err := bls.PublicKeyFromBytes(c.GroupPublicKey)
+	if err != nil {
+		return errors.Wrap(err, "invalid group public key")
+	}
+
+	pubKeys := []bls.PublicKey{c.ShareSecretKey.PublicKey()}
+	indices := []uint64{c.ShareIndex}
+	seen := map[uint64]bool{c.ShareIndex: true}
+	tokens := make(map[string]bool)
+	for _, p := range c.Peers {
+		if p == nil {
+			return errors.New("nil peer provided")
+		}
+		if p.Index == 0 {
+			return errors.New("key share index of peer must be positive")
+		}
+		if seen[p.Index] {
+			return fmt.Errorf("duplicate key share index %d", p.Index)
+		}
+		seen[p.Index] = true
+		u, err := url.Parse(p.URL)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("URL of peer %d must be an https URL, got %q", p.Index, p.URL)
+		}
+		if p.AuthToken == "" {
+			return fmt.Errorf("no auth token provided for peer %d", p.Index)
+		}
+		if tokens[p.AuthToken] {
+			return fmt.Errorf("auth token of peer %d is shared with another peer", p.Index)
+		}
+		tokens[p.AuthToken] = true
+		pubKey, err := bls.PublicKeyFromBytes(p.PublicKey)
+		if err != nil {
+			return errors.Wrapf(err, "invalid public key of peer %d", p.Index)
+		}
+		if uint64(len(pubKeys)) < c.Threshold {
+			pubKeys = append(pubKeys, pubKey)
+			indices = append(indices, p.Index)
+		}
+	}
+	recovered, err := bls.RecoverPublicKey(pubKeys, indices)
+	if err != nil {
+		return errors.Wrap(err, "could not recover group public key")
+	}
+	if !recovered.Equals(groupPublicKey) {
+		return errors.New("key shares do not recover the group public key")
+	}
+	return nil
+}
+
+func (c *SetupConfig) timeout() time.Duration {
+	if c.Timeout == 0 {
+		return DefaultPeerTimeout
+	}
+	return c.Timeout
+}
+
+// ApprovedExit is a voluntary exit of the validator approved by the operator.
+type ApprovedExit struct {
+	Epoch          primitives.Epoch          `json:"epoch"`
+	ValidatorIndex primitives.ValidatorIndex `json:"validator_index"`
+}
+
+// ApprovedRegistration is the fee recipient and gas limit of the validator registrations approved by the operator.
+type ApprovedRegistration struct {
+	FeeRecipient common.Address `json:"fee_recipient"`
+	GasLimit     uint64         `json:"gas_limit"`
+}
+
+// ConfigFile is the JSON file configuring a threshold keymanager. Paths are relative to the directory of the file.
+type ConfigFile struct {
+	Threshold                 uint64                `json:"threshold"`
+	ShareIndex                uint64                `json:"share_index"`
+	GroupPublicKey            string                `json:"group_public_key"`
+	ShareKeystore             string                `json:"share_keystore"`
+	ShareKeystorePasswordFile string                `json:"share_keystore_password_file"`
+	ListenAddress             string                `json:"listen_address"`
+	TLSCertFile               string                `json:"tls_cert_file"`
+	TLSKeyFile                string                `json:"tls_key_file"`
+	PeerCACertFile            string                `json:"peer_ca_cert_file,omitempty"`
+	PeerTimeout               string                `json:"peer_timeout,omitempty"`
+	Peers                     []*PeerConfig         `json:"peers"`
+	ApprovedExits             []*ApprovedExit       `json:"approved_exits,omitempty"`
+	ApprovedRegistration      *ApprovedRegistration `json:"approved_registration,omitempty"`
+}
+
+// PeerConfig is a peer in the configuration file of a threshold keymanager.
+type PeerConfig struct {
+	Index         uint64 `json:"index"`
+	URL           string `json:"url"`
+	PublicKey     string `json:"public_key"`
+	AuthTokenFile string `json:"auth_token_file"`
+}
+
+// LoadSetupConfig reads the configuration file of a threshold keymanager and decrypts its key share. The slashing
+// protection of the returned configuration is left for the caller to set.
+func LoadSetupConfig(path string) (*SetupConfig, error) {
+	path, err := file.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read threshold config file")
+	}
+	cfgFile := &ConfigFile{}
+	if err := json.Unmarshal(encoded, cfgFile); err != nil {
+		return nil, errors.Wrap(err, "could not decode threshold config file")
+	}
+	dir := filepath.Dir(path)
+	resolve := func(p string) string {
+		if p == "" || filepath.IsAbs(p) {
+			return p
+		}
+		return filepath.Join(dir, p)
+	}
+
+	groupPublicKey, err := hexutil.Decode(cfgFile.GroupPublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode group public key")
+	}
+	shareSecretKey, err := readKeyShare(resolve(cfgFile.ShareKeystore), resolve(cfgFile.ShareKeystorePasswordFile))
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := loadTLSConfig(resolve(cfgFile.TLSCertFile), resolve(cfgFile.TLSKeyFile), resolve(cfgFile.PeerCACertFile))
+	if err != nil {
+		return nil, err
+	}
+	var timeout time.Duration
+	if cfgFile.PeerTimeout != "" {
+		timeout, err = time.ParseDuration(cfgFile.PeerTimeout)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse peer timeout")
+		}
+	}
+	peers := make([]*Peer, len(cfgFile.Peers))
+	for i, p := range cfgFile.Peers {
+		if p == nil {
+			return nil, errors.New("nil peer in threshold config file")
+		}
+		pubKey, err := hexutil.Decode(p.PublicKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key of peer %d", p.Index)
+		}
+		authToken, err := file.ReadFileAsBytes(resolve(p.AuthTokenFile))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read auth token file of peer %d", p.Index)
+		}
+		peers[i] = &Peer{Index: p.Index, URL: p.URL, PublicKey: pubKey, AuthToken: strings.TrimSpace(string(authToken))}
+	}
+	return &SetupConfig{
+		Threshold:            cfgFile.Threshold,
+		ShareIndex:           cfgFile.ShareIndex,
+		ShareSecretKey:       shareSecretKey,
+		GroupPublicKey:       groupPublicKey,
+		Peers:                peers,
+		TLSConfig:            tlsConfig,
+		ApprovedExits:        cfgFile.ApprovedExits,
+		ApprovedRegistration: cfgFile.ApprovedRegistration,
+		Timeout:              timeout,
+		ListenAddress:        cfgFile.ListenAddress,
+	}, nil
+}
+
+// loadTLSConfig reads the certificate of the partial signature server and, if set, the CA certificates verifying
+// the certificates of the peers.
+func loadTLSConfig(certPath, keyPath, caPath string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load TLS certificate")
+	}
+	cfg := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS13}
+	if caPath == "" {
+		return cfg, nil
+	}
+	caCerts, err := file.ReadFileAsBytes(caPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read peer CA certificate file")
+	}
+	cfg.RootCAs = x509.NewCertPool()
+	if !cfg.RootCAs.AppendCertsFromPEM(caCerts) {
+		return nil, errors.New("no CA certificate found in peer CA certificate file")
+	}
+	return cfg, nil
+}
+
+// readKeyShare decrypts the EIP-2335 keystore of a key share with the password in a file.
+func readKeyShare(keystorePath, passwordPath string) (bls.SecretKey, error) {
+	encoded, err := file.ReadFileAsBytes(keystorePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read key share keystore")
+	}
+	ks := &keymanager.Keystore{}
+	if err := json.Unmarshal(encoded, ks); err != nil {
+		return nil, errors.Wrap(err, "could not decode key share keystore")
+	}
+	password, err := file.ReadFileAsBytes(passwordPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read key share keystore password file")
+	}
+	secret, err := keystore.DecryptEIP2335(ks.Crypto, strings.TrimRight(string(password), "\r\n"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt key share keystore")
+	}
+	return bls.SecretKeyFromBytes(secret)
+}
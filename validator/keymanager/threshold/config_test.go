@@ -0,0 +1,122 @@
+package threshold
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+)
+
+func TestLoadSetupConfig(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	shares, err := bls.SplitSecretKey(secretKey, 2, 2)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	cryptoFields, err := keystore.EncryptEIP2335(shares[0].Marshal(), "password", &keystore.KDFParams{Function: keystore.PBKDF2KDF, Cost: 1})
+	require.NoError(t, err)
+	encodedKeystore, err := json.Marshal(&keymanager.Keystore{
+		Crypto:  cryptoFields,
+		Pubkey:  hexutil.Encode(shares[0].PublicKey().Marshal())[2:],
+		Version: keystore.EIP2335Version,
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "share.json"), encodedKeystore, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "password.txt"), []byte("password\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token.txt"), []byte(testAuthToken(1, 2)+"\n"), 0600))
+	writeTestCertificate(t, dir)
+
+	cfgFile := &ConfigFile{
+		Threshold:                 2,
+		ShareIndex:                1,
+		GroupPublicKey:            hexutil.Encode(secretKey.PublicKey().Marshal()),
+		ShareKeystore:             "share.json",
+		ShareKeystorePasswordFile: "password.txt",
+		ListenAddress:             "127.0.0.1:7600",
+		TLSCertFile:               "cert.pem",
+		TLSKeyFile:                "key.pem",
+		PeerCACertFile:            filepath.Join(dir, "cert.pem"),
+		PeerTimeout:               "500ms",
+		Peers: []*PeerConfig{{
+			Index:         2,
+			URL:           "https://127.0.0.1:7601",
+			PublicKey:     hexutil.Encode(shares[1].PublicKey().Marshal()),
+			AuthTokenFile: filepath.Join(dir, "token.txt"),
+		}},
+		ApprovedExits: []*ApprovedExit{{Epoch: 10, ValidatorIndex: 5}},
+		ApprovedRegistration: &ApprovedRegistration{
+			FeeRecipient: common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9"),
+			GasLimit:     30000000,
+		},
+	}
+	encoded, err := json.Marshal(cfgFile)
+	require.NoError(t, err)
+	path := filepath.Join(dir, "threshold.json")
+	require.NoError(t, os.WriteFile(path, encoded, 0600))
+
+	cfg, err := LoadSetupConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), cfg.Threshold)
+	assert.Equal(t, uint64(1), cfg.ShareIndex)
+	assert.DeepEqual(t, shares[0].Marshal(), cfg.ShareSecretKey.Marshal())
+	assert.DeepEqual(t, secretKey.PublicKey().Marshal(), cfg.GroupPublicKey)
+	assert.Equal(t, 500*time.Millisecond, cfg.Timeout)
+	assert.Equal(t, "127.0.0.1:7600", cfg.ListenAddress)
+	require.Equal(t, 1, len(cfg.Peers))
+	assert.DeepEqual(t, &Peer{
+		Index:     2,
+		URL:       "https://127.0.0.1:7601",
+		PublicKey: shares[1].PublicKey().Marshal(),
+		AuthToken: testAuthToken(1, 2),
+	}, cfg.Peers[0])
+	assert.DeepEqual(t, []*ApprovedExit{{Epoch: 10, ValidatorIndex: 5}}, cfg.ApprovedExits)
+	assert.DeepEqual(t, cfgFile.ApprovedRegistration, cfg.ApprovedRegistration)
+	require.Equal(t, 1, len(cfg.TLSConfig.Certificates))
+	assert.NotNil(t, cfg.TLSConfig.RootCAs)
+
+	cfg.SlashingProtection = newMockSlashingProtection()
+	require.NoError(t, cfg.validate())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "password.txt"), []byte("wrong"), 0600))
+	_, err = LoadSetupConfig(path)
+	assert.ErrorContains(t, "could not decrypt key share keystore", err)
+}
+
+// writeTestCertificate writes a self-signed certificate for 127.0.0.1 to cert.pem and its key to key.pem.
+func writeTestCertificate(t *testing.T, dir string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	encodedKey, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cert.pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key.pem"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: encodedKey}), 0600))
+}
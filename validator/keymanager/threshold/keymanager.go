@@ -0,0 +1,176 @@
+// Package threshold defines a keymanager holding a share of a validator key, split with Shamir secret sharing
+// between several validator clients. It signs by exchanging partial signatures with the validator clients holding
+// the other key shares, and recovers the signature of the validator key from a threshold of them.
+package threshold
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/petnames"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+)
+
+// Keymanager defines the threshold keymanager, which signs for a single validator whose key is shared between
+// several validator clients.
+type Keymanager struct {
+	signer              *shareSigner
+	shareIndex          uint64
+	threshold           uint64
+	groupPublicKey      bls.PublicKey
+	peers               []*peer
+	httpClient          *http.Client
+	accountsChangedFeed *event.Feed
+}
+
+type peer struct {
+	index     uint64
+	url       string
+	publicKey bls.PublicKey
+	authToken string
+}
+
+// NewKeymanager instantiates a new threshold keymanager.
+func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	signer, err := newShareSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
+	groupPublicKey, err := bls.PublicKeyFromBytes(cfg.GroupPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	peers := make([]*peer, len(cfg.Peers))
+	for i, p := range cfg.Peers {
+		pubKey, err := bls.PublicKeyFromBytes(p.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		peers[i] = &peer{index: p.Index, url: p.URL, publicKey: pubKey, authToken: p.AuthToken}
+	}
+	tlsConfig := cfg.TLSConfig.Clone()
+	// The certificates of the keymanager are those of its server, the peers authenticate it by its auth token.
+	tlsConfig.Certificates = nil
+	return &Keymanager{
+		signer:         signer,
+		shareIndex:     cfg.ShareIndex,
+		threshold:      cfg.Threshold,
+		groupPublicKey: groupPublicKey,
+		peers:          peers,
+		httpClient: &http.Client{
+			Timeout:   cfg.timeout(),
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		accountsChangedFeed: new(event.Feed),
+	}, nil
+}
+
+// newShareSigner validates a setup config and returns the signer of its key share.
+func newShareSigner(cfg *SetupConfig) (*shareSigner, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid threshold setup config")
+	}
+	shareIndices := []uint64{cfg.ShareIndex}
+	for _, p := range cfg.Peers {
+		shareIndices = append(shareIndices, p.Index)
+	}
+	slices.Sort(shareIndices)
+	approvedExits := make(map[ApprovedExit]bool, len(cfg.ApprovedExits))
+	for _, e := range cfg.ApprovedExits {
+		approvedExits[*e] = true
+	}
+	return &shareSigner{
+		secretKey:            cfg.ShareSecretKey,
+		shareIndex:           cfg.ShareIndex,
+		groupPublicKey:       bytesutil.ToBytes48(cfg.GroupPublicKey),
+		slashingProtection:   cfg.SlashingProtection,
+		shareIndices:         shareIndices,
+		approvedExits:        approvedExits,
+		approvedRegistration: cfg.ApprovedRegistration,
+	}, nil
+}
+
+// FetchValidatingPublicKeys returns the public key of the validator, which is the group public key of the key
+// shares.
+func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	return [][fieldparams.BLSPubkeyLength]byte{km.signer.groupPublicKey}, nil
+}
+
+// Sign signs the message with the key share of the keymanager, requests the partial signatures of the peers, and
+// recovers the signature of the group key from the first threshold of valid partial signatures. Blocks are only
+// signed by the validator client leading the proposal of their slot, the others co-sign them.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	ownSig, err := km.signer.sign(ctx, req, km.shareIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign with key share")
+	}
+	partials := []bls.Signature{ownSig}
+	indices := []uint64{km.shareIndex}
+	if km.threshold > 1 {
+		peerPartials, peerIndices, err := km.requestPartialSignatures(ctx, req, km.threshold-1)
+		if err != nil {
+			return nil, err
+		}
+		partials = append(partials, peerPartials...)
+		indices = append(indices, peerIndices...)
+	}
+	sig, err := bls.RecoverSignature(partials, indices)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not recover signature")
+	}
+	if !sig.Verify(km.groupPublicKey, req.SigningRoot) {
+		return nil, errors.New("recovered signature does not verify against the group public key")
+	}
+	return sig, nil
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime. The key of a threshold keymanager never changes.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// ExtractKeystores is not supported for the threshold keymanager, as it never holds the validator key.
+func (*Keymanager) ExtractKeystores(
+	_ context.Context, _ []bls.PublicKey, _ string,
+) ([]*keymanager.Keystore, error) {
+	return nil, errors.New("extracting keys is not supported for a threshold keymanager")
+}
+
+// DeleteKeystores is not supported for the threshold keymanager.
+func (*Keymanager) DeleteKeystores(context.Context, [][]byte) ([]*keymanager.KeyStatus, error) {
+	return nil, errors.New("deleting keys is not supported for a threshold keymanager")
+}
+
+// ListKeymanagerAccounts lists the validator account of the keymanager along with its key share.
+func (km *Keymanager) ListKeymanagerAccounts(_ context.Context, cfg keymanager.ListKeymanagerAccountConfig) error {
+	au := aurora.NewAurora(true)
+	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("threshold").Bold())
+	fmt.Printf(
+		"(configuration file path) %s\n",
+		au.BrightGreen(filepath.Join(cfg.WalletAccountsDir, cfg.KeymanagerConfigFileName)).Bold(),
+	)
+	fmt.Println(" ")
+	fmt.Printf("%s\n", au.BrightGreen("Setup Configuration").Bold())
+	fmt.Printf("Key share %d of %d, threshold %d\n", km.shareIndex, len(km.peers)+1, km.threshold)
+	fmt.Println(" ")
+	fmt.Print("Showing 1 validator account\n")
+	fmt.Println("")
+	fmt.Printf(
+		"%s\n", au.BrightGreen(petnames.DeterministicName(km.signer.groupPublicKey[:], "-")).Bold(),
+	)
+	fmt.Printf("%s %#x\n", au.BrightCyan("[validating public key]").Bold(), km.signer.groupPublicKey)
+	fmt.Printf("%s %#x\n", au.BrightCyan("[key share public key]").Bold(), km.signer.secretKey.PublicKey().Marshal())
+	fmt.Println(" ")
+	return nil
+}
@@ -0,0 +1,501 @@
+package threshold
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+)
+
+// testAuthToken returns the auth token shared by the validator clients of two key shares.
+func testAuthToken(i, j uint64) string {
+	return fmt.Sprintf("secret-token-%d-%d", min(i, j), max(i, j))
+}
+
+// testCluster is a set of validator clients sharing a validator key, each serving the partial signatures of its
+// key share from an in-process HTTPS server.
+type testCluster struct {
+	secretKey   bls.SecretKey
+	configs     []*SetupConfig
+	servers     []*Server
+	httpServers []*httptest.Server
+}
+
+// mockSlashingProtection refuses to sign two different blocks at the same slot or two different attestations with
+// the same target, like the complete slashing protection database. Only one such database can be opened at a time in
+// a process, so it stands in for the databases of all validator clients but one.
+type mockSlashingProtection struct {
+	lock         sync.Mutex
+	proposals    map[primitives.Slot][32]byte
+	attestations map[primitives.Epoch][32]byte
+}
+
+func newMockSlashingProtection() *mockSlashingProtection {
+	return &mockSlashingProtection{
+		proposals:    make(map[primitives.Slot][32]byte),
+		attestations: make(map[primitives.Epoch][32]byte),
+	}
+}
+
+func (m *mockSlashingProtection) SlashableProposalCheck(
+	_ context.Context,
+	_ [fieldparams.BLSPubkeyLength]byte,
+	signedBlock interfaces.ReadOnlySignedBeaconBlock,
+	signingRoot [fieldparams.RootLength]byte,
+	_ bool,
+	_ *prometheus.CounterVec,
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	slot := signedBlock.Block().Slot()
+	if root, ok := m.proposals[slot]; ok && root != signingRoot {
+		return fmt.Errorf("double proposal at slot %d", slot)
+	}
+	m.proposals[slot] = signingRoot
+	return nil
+}
+
+func (m *mockSlashingProtection) SlashableAttestationCheck(
+	_ context.Context,
+	indexedAtt *ethpb.IndexedAttestation,
+	_ [fieldparams.BLSPubkeyLength]byte,
+	signingRoot [32]byte,
+	_ bool,
+	_ *prometheus.CounterVec,
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	target := indexedAtt.Data.Target.Epoch
+	if root, ok := m.attestations[target]; ok && root != signingRoot {
+		return fmt.Errorf("double vote at target %d", target)
+	}
+	m.attestations[target] = signingRoot
+	return nil
+}
+
+func setupCluster(t *testing.T, threshold, n uint64) *testCluster {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	shares, err := bls.SplitSecretKey(secretKey, threshold, n)
+	require.NoError(t, err)
+	groupPublicKey := secretKey.PublicKey().Marshal()
+
+	c := &testCluster{secretKey: secretKey, servers: make([]*Server, n)}
+	for i := range shares {
+		i := i
+		httpServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c.servers[i].server.Handler.ServeHTTP(w, r)
+		}))
+		t.Cleanup(httpServer.Close)
+		c.httpServers = append(c.httpServers, httpServer)
+	}
+	for i, share := range shares {
+		index := uint64(i) + 1
+		var others []*Peer
+		for j, httpServer := range c.httpServers {
+			if j != i {
+				others = append(others, &Peer{
+					Index:     uint64(j) + 1,
+					URL:       httpServer.URL,
+					PublicKey: shares[j].PublicKey().Marshal(),
+					AuthToken: testAuthToken(index, uint64(j)+1),
+				})
+			}
+		}
+		rootCAs := x509.NewCertPool()
+		rootCAs.AddCert(c.httpServers[i].Certificate())
+		cfg := &SetupConfig{
+			Threshold:      threshold,
+			ShareIndex:     index,
+			ShareSecretKey: share,
+			GroupPublicKey: groupPublicKey,
+			Peers:          others,
+			TLSConfig: &tls.Config{
+				Certificates: c.httpServers[i].TLS.Certificates,
+				RootCAs:      rootCAs,
+				MinVersion:   tls.VersionTLS13,
+			},
+			SlashingProtection: newMockSlashingProtection(),
+		}
+		c.servers[i], err = NewServer(cfg)
+		require.NoError(t, err)
+		c.configs = append(c.configs, cfg)
+	}
+	return c
+}
+
+func (c *testCluster) keymanager(t *testing.T, i int) *Keymanager {
+	km, err := NewKeymanager(context.Background(), c.configs[i])
+	require.NoError(t, err)
+	return km
+}
+
+func attestationSignRequest(t *testing.T, pubKey []byte, data *ethpb.AttestationData) *validatorpb.SignRequest {
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainBeaconAttester, nil, nil)
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(data, domain)
+	require.NoError(t, err)
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_AttestationData{AttestationData: data},
+	}
+}
+
+func blockSignRequest(t *testing.T, pubKey []byte, blk *ethpb.BeaconBlock) *validatorpb.SignRequest {
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainBeaconProposer, nil, nil)
+	require.NoError(t, err)
+	wb, err := blocks.NewBeaconBlock(blk)
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(wb, domain)
+	require.NoError(t, err)
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_Block{Block: blk},
+		SigningSlot:     blk.Slot,
+	}
+}
+
+func exitSignRequest(t *testing.T, pubKey []byte, exit *ethpb.VoluntaryExit) *validatorpb.SignRequest {
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainVoluntaryExit, nil, nil)
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(exit, domain)
+	require.NoError(t, err)
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_Exit{Exit: exit},
+	}
+}
+
+func registrationSignRequest(t *testing.T, pubKey []byte, reg *ethpb.ValidatorRegistrationV1) *validatorpb.SignRequest {
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(reg, domain)
+	require.NoError(t, err)
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_Registration{Registration: reg},
+	}
+}
+
+func testAttestationData(target primitives.Epoch, root byte) *ethpb.AttestationData {
+	return util.HydrateAttestationData(&ethpb.AttestationData{
+		Slot:            params.BeaconConfig().SlotsPerEpoch.Mul(uint64(target)),
+		BeaconBlockRoot: bytesOf(root),
+		Source:          &ethpb.Checkpoint{Epoch: target - 1},
+		Target:          &ethpb.Checkpoint{Epoch: target},
+	})
+}
+
+func bytesOf(b byte) []byte {
+	root := make([]byte, fieldparams.RootLength)
+	root[0] = b
+	return root
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	ctx := context.Background()
+	c := setupCluster(t, 2, 3)
+	pubKey := c.secretKey.PublicKey().Marshal()
+
+	for i := range c.configs {
+		km := c.keymanager(t, i)
+		keys, err := km.FetchValidatingPublicKeys(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(keys))
+		assert.DeepEqual(t, pubKey, keys[0][:])
+
+		req := attestationSignRequest(t, pubKey, testAttestationData(primitives.Epoch(i+1), 1))
+		sig, err := km.Sign(ctx, req)
+		require.NoError(t, err)
+		assert.DeepEqual(t, c.secretKey.Sign(req.SigningRoot).Marshal(), sig.Marshal())
+	}
+
+	// The block of slot 1 is proposed by the second of the three key shares.
+	blk := util.NewBeaconBlock().Block
+	blk.Slot = 1
+	req := blockSignRequest(t, pubKey, blk)
+	sig, err := c.keymanager(t, 1).Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, c.secretKey.Sign(req.SigningRoot).Marshal(), sig.Marshal())
+
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainRandao, nil, nil)
+	require.NoError(t, err)
+	epoch := primitives.SSZUint64(3)
+	root, err := signing.ComputeSigningRoot(&epoch, domain)
+	require.NoError(t, err)
+	sig, err = c.keymanager(t, 1).Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_Epoch{Epoch: 3},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, c.secretKey.Sign(root[:]).Marshal(), sig.Marshal())
+}
+
+func TestKeymanager_Sign_PeersDown(t *testing.T) {
+	ctx := context.Background()
+	c := setupCluster(t, 3, 4)
+	pubKey := c.secretKey.PublicKey().Marshal()
+	km := c.keymanager(t, 0)
+
+	c.httpServers[1].Close()
+	req := attestationSignRequest(t, pubKey, testAttestationData(1, 1))
+	sig, err := km.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, c.secretKey.Sign(req.SigningRoot).Marshal(), sig.Marshal())
+
+	c.httpServers[2].Close()
+	_, err = km.Sign(ctx, attestationSignRequest(t, pubKey, testAttestationData(2, 1)))
+	assert.ErrorContains(t, "got 1 of the 2 partial signatures needed from peers", err)
+}
+
+func TestKeymanager_Sign_InvalidAuthToken(t *testing.T) {
+	c := setupCluster(t, 2, 3)
+	// The auth token shared by the key shares 1 and 3 does not authenticate key share 1 to key share 2.
+	c.configs[0].Peers[0].AuthToken = testAuthToken(1, 3)
+	c.configs[0].Peers[1].AuthToken = "wrong-token"
+	km := c.keymanager(t, 0)
+	_, err := km.Sign(context.Background(), attestationSignRequest(t, c.secretKey.PublicKey().Marshal(), testAttestationData(1, 1)))
+	assert.ErrorContains(t, "peer 2: unexpected status code 401: Invalid auth token", err)
+	assert.ErrorContains(t, "peer 3: unexpected status code 401: Invalid auth token", err)
+}
+
+func TestKeymanager_Sign_UntrustedCertificate(t *testing.T) {
+	c := setupCluster(t, 2, 2)
+	c.configs[0].TLSConfig.RootCAs = x509.NewCertPool()
+	km := c.keymanager(t, 0)
+	_, err := km.Sign(context.Background(), attestationSignRequest(t, c.secretKey.PublicKey().Marshal(), testAttestationData(1, 1)))
+	assert.ErrorContains(t, "certificate", err)
+}
+
+func TestKeymanager_Sign_ConcurrentProposals(t *testing.T) {
+	ctx := context.Background()
+	c := setupCluster(t, 3, 4)
+	pubKey := c.secretKey.PublicKey().Marshal()
+
+	for slot := primitives.Slot(1); slot <= 4; slot++ {
+		// All the validator clients propose a different block at the same slot at once.
+		errs := make([]error, len(c.configs))
+		reqs := make([]*validatorpb.SignRequest, len(c.configs))
+		var wg sync.WaitGroup
+		for i := range c.configs {
+			blk := util.NewBeaconBlock().Block
+			blk.Slot = slot
+			blk.Body.Graffiti = bytesOf(byte(i))
+			reqs[i] = blockSignRequest(t, pubKey, blk)
+			km := c.keymanager(t, i)
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = km.Sign(ctx, reqs[i])
+			}(i)
+		}
+		wg.Wait()
+
+		// Only the leader of the slot signs its block, the others never sign with their own key share.
+		leader := int(uint64(slot) % uint64(len(c.configs)))
+		for i, err := range errs {
+			if i == leader {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, "not the proposal leader of the slot", err)
+			}
+		}
+
+		// The leader is refused a conflicting block by the slashing protection of every key share.
+		blk := util.NewBeaconBlock().Block
+		blk.Slot = slot
+		blk.Body.Graffiti = bytesOf(0xff)
+		_, err := c.keymanager(t, leader).Sign(ctx, blockSignRequest(t, pubKey, blk))
+		assert.ErrorContains(t, "refused by slashing protection", err)
+	}
+}
+
+func TestKeymanager_Sign_VoluntaryExit(t *testing.T) {
+	ctx := context.Background()
+	c := setupCluster(t, 2, 2)
+	pubKey := c.secretKey.PublicKey().Marshal()
+	exit := &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: 5}
+
+	_, err := c.keymanager(t, 0).Sign(ctx, exitSignRequest(t, pubKey, exit))
+	assert.ErrorContains(t, "403", err)
+	assert.ErrorContains(t, "voluntary exit not approved by the operator", err)
+
+	c.configs[1].ApprovedExits = []*ApprovedExit{{Epoch: 10, ValidatorIndex: 5}}
+	c.servers[1], err = NewServer(c.configs[1])
+	require.NoError(t, err)
+	req := exitSignRequest(t, pubKey, exit)
+	sig, err := c.keymanager(t, 0).Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, c.secretKey.Sign(req.SigningRoot).Marshal(), sig.Marshal())
+
+	// The approval only covers the given exit.
+	_, err = c.keymanager(t, 0).Sign(ctx, exitSignRequest(t, pubKey, &ethpb.VoluntaryExit{Epoch: 11, ValidatorIndex: 5}))
+	assert.ErrorContains(t, "voluntary exit not approved by the operator", err)
+}
+
+func TestKeymanager_Sign_ValidatorRegistration(t *testing.T) {
+	ctx := context.Background()
+	c := setupCluster(t, 2, 2)
+	pubKey := c.secretKey.PublicKey().Marshal()
+	feeRecipient := common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")
+	reg := &ethpb.ValidatorRegistrationV1{FeeRecipient: feeRecipient[:], GasLimit: 30000000, Timestamp: 1, Pubkey: pubKey}
+
+	_, err := c.keymanager(t, 0).Sign(ctx, registrationSignRequest(t, pubKey, reg))
+	assert.ErrorContains(t, "403", err)
+	assert.ErrorContains(t, "validator registration not approved by the operator", err)
+
+	c.configs[1].ApprovedRegistration = &ApprovedRegistration{FeeRecipient: feeRecipient, GasLimit: 30000000}
+	c.servers[1], err = NewServer(c.configs[1])
+	require.NoError(t, err)
+	req := registrationSignRequest(t, pubKey, reg)
+	sig, err := c.keymanager(t, 0).Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, c.secretKey.Sign(req.SigningRoot).Marshal(), sig.Marshal())
+
+	// A registration with another fee recipient or gas limit is refused.
+	other := &ethpb.ValidatorRegistrationV1{FeeRecipient: bytesOf(1)[:20], GasLimit: 30000000, Timestamp: 1, Pubkey: pubKey}
+	_, err = c.keymanager(t, 0).Sign(ctx, registrationSignRequest(t, pubKey, other))
+	assert.ErrorContains(t, "validator registration not approved by the operator", err)
+	other = &ethpb.ValidatorRegistrationV1{FeeRecipient: feeRecipient[:], GasLimit: 36000000, Timestamp: 1, Pubkey: pubKey}
+	_, err = c.keymanager(t, 0).Sign(ctx, registrationSignRequest(t, pubKey, other))
+	assert.ErrorContains(t, "validator registration not approved by the operator", err)
+}
+
+func TestKeymanager_Sign_SlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	c := setupCluster(t, 2, 2)
+	pubKey := c.secretKey.PublicKey().Marshal()
+	// The peer uses the slashing protection database of the validator client.
+	c.configs[1].SlashingProtection = dbtest.SetupDB(
+		t, [][fieldparams.BLSPubkeyLength]byte{[fieldparams.BLSPubkeyLength]byte(pubKey)}, false,
+	)
+	var err error
+	c.servers[1], err = NewServer(c.configs[1])
+	require.NoError(t, err)
+
+	_, err = c.keymanager(t, 0).Sign(ctx, attestationSignRequest(t, pubKey, testAttestationData(1, 1)))
+	require.NoError(t, err)
+	blk := util.NewBeaconBlock().Block
+	blk.Slot = 2
+	_, err = c.keymanager(t, 0).Sign(ctx, blockSignRequest(t, pubKey, blk))
+	require.NoError(t, err)
+
+	// Signing the same objects again is not slashable.
+	_, err = c.keymanager(t, 1).Sign(ctx, attestationSignRequest(t, pubKey, testAttestationData(1, 1)))
+	require.NoError(t, err)
+
+	// The local key share refuses a double vote.
+	_, err = c.keymanager(t, 0).Sign(ctx, attestationSignRequest(t, pubKey, testAttestationData(1, 2)))
+	assert.ErrorContains(t, "refused by slashing protection", err)
+
+	// The peer refuses a double vote and a double proposal even when the requesting validator client lost its
+	// slashing protection history.
+	c.configs[0].SlashingProtection = newMockSlashingProtection()
+	km := c.keymanager(t, 0)
+	_, err = km.Sign(ctx, attestationSignRequest(t, pubKey, testAttestationData(1, 2)))
+	assert.ErrorContains(t, "403", err)
+	assert.ErrorContains(t, "refused by slashing protection", err)
+	blk.Body.Graffiti = bytesOf(1)
+	_, err = km.Sign(ctx, blockSignRequest(t, pubKey, blk))
+	assert.ErrorContains(t, "403", err)
+}
+
+func TestKeymanager_Sign_InvalidRequest(t *testing.T) {
+	ctx := context.Background()
+	c := setupCluster(t, 2, 2)
+	pubKey := c.secretKey.PublicKey().Marshal()
+	km := c.keymanager(t, 0)
+
+	req := attestationSignRequest(t, pubKey, testAttestationData(1, 1))
+	req.SigningRoot = bytesOf(1)
+	_, err := km.Sign(ctx, req)
+	assert.ErrorContains(t, "does not match the attestation data", err)
+
+	req = attestationSignRequest(t, pubKey, testAttestationData(1, 1))
+	req.SignatureDomain, err = signing.ComputeDomain(params.BeaconConfig().DomainRandao, nil, nil)
+	require.NoError(t, err)
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, "does not match a attestation data", err)
+
+	req = attestationSignRequest(t, pubKey, testAttestationData(1, 1))
+	req.PublicKey = c.configs[0].Peers[0].PublicKey
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, "unknown public key", err)
+
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: pubKey})
+	assert.ErrorContains(t, "unsupported sign request object", err)
+}
+
+func TestSetupConfig_Validate(t *testing.T) {
+	c := setupCluster(t, 2, 3)
+	otherKey, err := bls.RandKey()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		modify func(cfg *SetupConfig)
+		err    string
+	}{
+		{name: "valid", modify: func(*SetupConfig) {}},
+		{name: "threshold too high", modify: func(cfg *SetupConfig) { cfg.Threshold = 4 }, err: "threshold must be between 1 and the number of key shares 3"},
+		{name: "threshold not a majority", modify: func(cfg *SetupConfig) { cfg.Threshold = 1 }, err: "threshold must be more than half of the 3 key shares, got 1"},
+		{name: "no share index", modify: func(cfg *SetupConfig) { cfg.ShareIndex = 0 }, err: "key share index must be positive"},
+		{name: "no TLS config", modify: func(cfg *SetupConfig) { cfg.TLSConfig = nil }, err: "no TLS certificate provided"},
+		{name: "no TLS certificate", modify: func(cfg *SetupConfig) { cfg.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS13} }, err: "no TLS certificate provided"},
+		{name: "http peer URL", modify: func(cfg *SetupConfig) { cfg.Peers[0].URL = "http://127.0.0.1:7500" }, err: "must be an https URL"},
+		{name: "no auth token", modify: func(cfg *SetupConfig) { cfg.Peers[0].AuthToken = "" }, err: "no auth token provided for peer"},
+		{name: "shared auth token", modify: func(cfg *SetupConfig) { cfg.Peers[1].AuthToken = cfg.Peers[0].AuthToken }, err: "is shared with another peer"},
+		{name: "no slashing protection", modify: func(cfg *SetupConfig) { cfg.SlashingProtection = nil }, err: "no slashing protection provided"},
+		{name: "duplicate index", modify: func(cfg *SetupConfig) { cfg.Peers[1].Index = cfg.Peers[0].Index }, err: fmt.Sprintf("duplicate key share index %d", c.configs[0].Peers[0].Index)},
+		{name: "wrong group key", modify: func(cfg *SetupConfig) { cfg.GroupPublicKey = otherKey.PublicKey().Marshal() }, err: "key shares do not recover the group public key"},
+		{name: "wrong share", modify: func(cfg *SetupConfig) { cfg.ShareSecretKey = otherKey }, err: "key shares do not recover the group public key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base := c.configs[0]
+			cfg := *base
+			cfg.Peers = make([]*Peer, len(base.Peers))
+			for i, p := range base.Peers {
+				peerCopy := *p
+				cfg.Peers[i] = &peerCopy
+			}
+			tt.modify(&cfg)
+			_, err := NewKeymanager(context.Background(), &cfg)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, tt.err, err)
+			}
+		})
+	}
+}
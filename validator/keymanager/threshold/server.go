@@ -0,0 +1,143 @@
+package threshold
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxRequestBytes bounds the size of a partial signature request, which holds at most a hex encoded block.
+const maxRequestBytes = 1 << 24
+
+// Server answers the partial signature requests of the peers of a threshold keymanager. It shares the slashing
+// protection of the validator client, so that a key share never signs a slashable block or attestation, whichever
+// validator client asks for it.
+type Server struct {
+	signer     *shareSigner
+	shareIndex uint64
+	peers      []*Peer
+	server     *http.Server
+	failStatus error
+}
+
+// NewServer creates the partial signature server of the key share of a setup config.
+func NewServer(cfg *SetupConfig) (*Server, error) {
+	signer, err := newShareSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		signer:     signer,
+		shareIndex: cfg.ShareIndex,
+		peers:      cfg.Peers,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(PartialSignaturePath, s.PartialSignature)
+	s.server = &http.Server{
+		Addr:              cfg.ListenAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: time.Second,
+		TLSConfig:         cfg.TLSConfig.Clone(),
+	}
+	return s, nil
+}
+
+// Start the partial signature server.
+func (s *Server) Start() {
+	go func() {
+		log.WithField("address", s.server.Addr).Info("Starting threshold partial signature server")
+		if err := s.server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.WithError(err).Errorf("Could not listen to host:port :%s", s.server.Addr)
+			s.failStatus = err
+		}
+	}()
+}
+
+// Stop the partial signature server gracefully.
+func (s *Server) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// Status checks for any service failure conditions.
+func (s *Server) Status() error {
+	return s.failStatus
+}
+
+// PartialSignature signs a sign request of a peer with the key share of the server, once the request passes the
+// same checks as the sign requests of the validator client itself.
+func (s *Server) PartialSignature(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		httputil.HandleError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	requester, ok := s.authenticate(r)
+	if !ok {
+		httputil.HandleError(w, "Invalid auth token", http.StatusUnauthorized)
+		return
+	}
+	body := &PartialSignatureRequest{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(body); err != nil {
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	encoded, err := hexutil.Decode(body.SignRequest)
+	if err != nil {
+		httputil.HandleError(w, "Could not decode sign request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := &validatorpb.SignRequest{}
+	if err := proto.Unmarshal(encoded, req); err != nil {
+		httputil.HandleError(w, "Could not unmarshal sign request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	sig, err := s.signer.sign(r.Context(), req, requester.Index)
+	switch {
+	case errors.Is(err, errNotLeader), errors.Is(err, errExitNotApproved), errors.Is(err, errRegistrationNotApproved):
+		log.WithError(err).WithField("peerIndex", requester.Index).Warn("Refused to sign request of peer")
+		httputil.HandleError(w, err.Error(), http.StatusForbidden)
+		return
+	case errors.Is(err, errSlashingProtection):
+		log.WithError(err).WithField("signingRoot", hexutil.Encode(req.SigningRoot)).Warn(
+			"Refused to sign slashable request of peer")
+		httputil.HandleError(w, err.Error(), http.StatusForbidden)
+		return
+	case errors.Is(err, errInvalidSignRequest), errors.Is(err, errUnsupportedSignature):
+		httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not sign request: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &PartialSignatureResponse{
+		Index:     strconv.FormatUint(s.shareIndex, 10),
+		Signature: hexutil.Encode(sig.Marshal()),
+	})
+}
+
+// authenticate returns the peer whose auth token the request holds. Every token is compared, so that the time taken
+// does not depend on which peer the token belongs to.
+func (s *Server) authenticate(r *http.Request) (*Peer, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return nil, false
+	}
+	var requester *Peer
+	for _, p := range s.peers {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(p.AuthToken)) == 1 {
+			requester = p
+		}
+	}
+	return requester, requester != nil
+}
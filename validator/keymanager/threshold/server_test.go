@@ -0,0 +1,116 @@
+package threshold
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestServer_PartialSignature(t *testing.T) {
+	c := setupCluster(t, 2, 2)
+	s := c.servers[0]
+	token := testAuthToken(1, 2)
+	pubKey := c.secretKey.PublicKey().Marshal()
+
+	encodedRequest := func(t *testing.T, pubKey []byte) []byte {
+		encoded, err := proto.Marshal(attestationSignRequest(t, pubKey, testAttestationData(1, 1)))
+		require.NoError(t, err)
+		body, err := json.Marshal(&PartialSignatureRequest{SignRequest: hexutil.Encode(encoded)})
+		require.NoError(t, err)
+		return body
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		token   string
+		body    []byte
+		code    int
+		message string
+	}{
+		{name: "wrong method", method: http.MethodGet, token: token, code: http.StatusMethodNotAllowed, message: "Method not allowed"},
+		{name: "no auth token", method: http.MethodPost, body: encodedRequest(t, pubKey), code: http.StatusUnauthorized, message: "Invalid auth token"},
+		{name: "wrong auth token", method: http.MethodPost, token: "wrong", body: encodedRequest(t, pubKey), code: http.StatusUnauthorized, message: "Invalid auth token"},
+		{name: "own auth token of peer", method: http.MethodPost, token: testAuthToken(2, 3), body: encodedRequest(t, pubKey), code: http.StatusUnauthorized, message: "Invalid auth token"},
+		{name: "invalid body", method: http.MethodPost, token: token, body: []byte("{"), code: http.StatusBadRequest, message: "Could not decode request body"},
+		{name: "invalid sign request", method: http.MethodPost, token: token, body: []byte(`{"sign_request":"0x01"}`), code: http.StatusBadRequest, message: "Could not unmarshal sign request"},
+		{name: "unknown public key", method: http.MethodPost, token: token, body: encodedRequest(t, c.configs[0].Peers[0].PublicKey), code: http.StatusBadRequest, message: "unknown public key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, PartialSignaturePath, bytes.NewReader(tt.body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			writer := httptest.NewRecorder()
+			s.PartialSignature(writer, req)
+			require.Equal(t, tt.code, writer.Code)
+			errJson := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), errJson))
+			assert.StringContains(t, tt.message, errJson.Message)
+		})
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, PartialSignaturePath, bytes.NewReader(encodedRequest(t, pubKey)))
+		req.Header.Set("Authorization", "Bearer "+token)
+		writer := httptest.NewRecorder()
+		s.PartialSignature(writer, req)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &PartialSignatureResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "1", resp.Index)
+		sigBytes, err := hexutil.Decode(resp.Signature)
+		require.NoError(t, err)
+		sig, err := bls.SignatureFromBytes(sigBytes)
+		require.NoError(t, err)
+		signingRoot := attestationSignRequest(t, pubKey, testAttestationData(1, 1)).SigningRoot
+		assert.Equal(t, true, sig.Verify(c.configs[0].ShareSecretKey.PublicKey(), signingRoot))
+	})
+
+	t.Run("slashable", func(t *testing.T) {
+		encoded, err := proto.Marshal(attestationSignRequest(t, pubKey, testAttestationData(1, 2)))
+		require.NoError(t, err)
+		body, err := json.Marshal(&PartialSignatureRequest{SignRequest: hexutil.Encode(encoded)})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, PartialSignaturePath, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		writer := httptest.NewRecorder()
+		s.PartialSignature(writer, req)
+		require.Equal(t, http.StatusForbidden, writer.Code)
+		errJson := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), errJson))
+		assert.StringContains(t, "refused by slashing protection", errJson.Message)
+	})
+}
+
+func TestServer_PartialSignature_NotLeader(t *testing.T) {
+	c := setupCluster(t, 2, 2)
+	pubKey := c.secretKey.PublicKey().Marshal()
+	// The block of slot 1 is proposed by key share 2, which does not ask key share 1 for a partial signature of a
+	// block of slot 2.
+	blk := util.NewBeaconBlock().Block
+	blk.Slot = 2
+	encoded, err := proto.Marshal(blockSignRequest(t, pubKey, blk))
+	require.NoError(t, err)
+	body, err := json.Marshal(&PartialSignatureRequest{SignRequest: hexutil.Encode(encoded)})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, PartialSignaturePath, bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+testAuthToken(1, 2))
+	writer := httptest.NewRecorder()
+	c.servers[0].PartialSignature(writer, req)
+	require.Equal(t, http.StatusForbidden, writer.Code)
+	errJson := &httputil.DefaultJsonError{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), errJson))
+	assert.StringContains(t, "not the proposal leader of the slot", errJson.Message)
+}
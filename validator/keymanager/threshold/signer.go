@@ -0,0 +1,260 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	fssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+)
+
+var (
+	errInvalidSignRequest      = errors.New("invalid sign request")
+	errSlashingProtection      = errors.New("refused by slashing protection")
+	errUnsupportedSignature    = errors.New("unsupported sign request object")
+	errNotLeader               = errors.New("not the proposal leader of the slot")
+	errExitNotApproved         = errors.New("voluntary exit not approved by the operator")
+	errRegistrationNotApproved = errors.New("validator registration not approved by the operator")
+
+	// signLock serializes the slashing protection checks with the signatures they allow, across the keymanager and
+	// the server signing with the same key share.
+	signLock sync.Mutex
+)
+
+// shareSigner signs sign requests with a key share, for this validator client as well as for its peers. It only
+// signs objects whose signing root it can recompute, so that a peer cannot get a share of a signature of an object
+// the slashing protection did not see.
+type shareSigner struct {
+	secretKey          bls.SecretKey
+	shareIndex         uint64
+	groupPublicKey     [fieldparams.BLSPubkeyLength]byte
+	slashingProtection SlashingProtection
+	// shareIndices are the sorted indices of all the key shares, which take turns leading the block proposals.
+	shareIndices         []uint64
+	approvedExits        map[ApprovedExit]bool
+	approvedRegistration *ApprovedRegistration
+}
+
+// sign returns the partial signature of the key share for a sign request of the given key share, once the request
+// is verified and the slashing protection allows it.
+func (s *shareSigner) sign(ctx context.Context, req *validatorpb.SignRequest, requester uint64) (bls.Signature, error) {
+	if req == nil {
+		return nil, errors.Wrap(errInvalidSignRequest, "nil sign request")
+	}
+	if !bytes.Equal(req.PublicKey, s.groupPublicKey[:]) {
+		return nil, errors.Wrapf(errInvalidSignRequest, "unknown public key %#x", req.PublicKey)
+	}
+	obj, err := signedObject(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.SignatureDomain) < 4 || !bytes.Equal(req.SignatureDomain[:4], obj.domainType[:]) {
+		return nil, errors.Wrapf(errInvalidSignRequest, "signature domain %#x does not match a %s", req.SignatureDomain, obj.name)
+	}
+	signingRoot, err := signing.ComputeSigningRoot(obj.root, req.SignatureDomain)
+	if err != nil {
+		return nil, errors.Wrap(errInvalidSignRequest, err.Error())
+	}
+	if !bytes.Equal(req.SigningRoot, signingRoot[:]) {
+		return nil, errors.Wrapf(errInvalidSignRequest, "signing root %#x does not match the %s", req.SigningRoot, obj.name)
+	}
+	if err := s.authorize(obj, requester); err != nil {
+		return nil, err
+	}
+
+	signLock.Lock()
+	defer signLock.Unlock()
+	if err := s.protect(ctx, obj, signingRoot); err != nil {
+		return nil, errors.Wrap(errSlashingProtection, err.Error())
+	}
+	return s.secretKey.Sign(signingRoot[:]), nil
+}
+
+// authorize checks that the key share may sign the object for the requester. Only the leader of a slot proposes its
+// block, so that the validator clients never race each other into proposing conflicting blocks, and the voluntary
+// exits and validator registrations requested by peers must be approved by the operator of this validator client.
+func (s *shareSigner) authorize(obj *signRequestObject, requester uint64) error {
+	switch {
+	case obj.block != nil:
+		if leader := s.leader(obj.block.Slot()); requester != leader {
+			return errors.Wrapf(errNotLeader, "key share %d requested a block at slot %d led by key share %d",
+				requester, obj.block.Slot(), leader)
+		}
+	case obj.exit != nil:
+		exit := ApprovedExit{Epoch: obj.exit.Epoch, ValidatorIndex: obj.exit.ValidatorIndex}
+		if requester != s.shareIndex && !s.approvedExits[exit] {
+			return errors.Wrapf(errExitNotApproved, "key share %d requested an exit of validator %d at epoch %d",
+				requester, exit.ValidatorIndex, exit.Epoch)
+		}
+	case obj.registration != nil:
+		if requester != s.shareIndex && !s.registrationApproved(obj.registration) {
+			return errors.Wrapf(errRegistrationNotApproved, "key share %d requested a registration with fee recipient %#x and gas limit %d",
+				requester, obj.registration.FeeRecipient, obj.registration.GasLimit)
+		}
+	}
+	return nil
+}
+
+// registrationApproved returns true if a validator registration of the validator has the fee recipient and gas limit
+// approved by the operator.
+func (s *shareSigner) registrationApproved(reg *ethpb.ValidatorRegistrationV1) bool {
+	approved := s.approvedRegistration
+	return approved != nil &&
+		bytes.Equal(reg.Pubkey, s.groupPublicKey[:]) &&
+		bytes.Equal(reg.FeeRecipient, approved.FeeRecipient[:]) &&
+		reg.GasLimit == approved.GasLimit
+}
+
+// leader returns the index of the key share proposing the block of a slot.
+func (s *shareSigner) leader(slot primitives.Slot) uint64 {
+	return s.shareIndices[uint64(slot)%uint64(len(s.shareIndices))]
+}
+
+// protect runs the slashing protection checks of blocks and attestations, which also record them as signed.
+func (s *shareSigner) protect(ctx context.Context, obj *signRequestObject, signingRoot [32]byte) error {
+	switch {
+	case obj.block != nil:
+		signedBlock, err := blocks.BuildSignedBeaconBlock(obj.block, make([]byte, fieldparams.BLSSignatureLength))
+		if err != nil {
+			return err
+		}
+		return s.slashingProtection.SlashableProposalCheck(ctx, s.groupPublicKey, signedBlock, signingRoot, false, nil)
+	case obj.attestationData != nil:
+		indexedAtt := &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{},
+			Data:             obj.attestationData,
+			Signature:        make([]byte, fieldparams.BLSSignatureLength),
+		}
+		return s.slashingProtection.SlashableAttestationCheck(ctx, indexedAtt, s.groupPublicKey, signingRoot, false, nil)
+	default:
+		return nil
+	}
+}
+
+// signRequestObject is the object of a sign request, with the domain type it must be signed with.
+type signRequestObject struct {
+	name       string
+	root       fssz.HashRoot
+	domainType [4]byte
+	// block and attestationData are set for the objects covered by slashing protection.
+	block           interfaces.ReadOnlyBeaconBlock
+	attestationData *ethpb.AttestationData
+	exit            *ethpb.VoluntaryExit
+	registration    *ethpb.ValidatorRegistrationV1
+}
+
+// signedObject returns the object of a sign request.
+func signedObject(req *validatorpb.SignRequest) (*signRequestObject, error) {
+	cfg := params.BeaconConfig()
+	var blk interface{}
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		blk = o.Block
+	case *validatorpb.SignRequest_BlockAltair:
+		blk = o.BlockAltair
+	case *validatorpb.SignRequest_BlockBellatrix:
+		blk = o.BlockBellatrix
+	case *validatorpb.SignRequest_BlindedBlockBellatrix:
+		blk = o.BlindedBlockBellatrix
+	case *validatorpb.SignRequest_BlockCapella:
+		blk = o.BlockCapella
+	case *validatorpb.SignRequest_BlindedBlockCapella:
+		blk = o.BlindedBlockCapella
+	case *validatorpb.SignRequest_BlockDeneb:
+		blk = o.BlockDeneb
+	case *validatorpb.SignRequest_BlindedBlockDeneb:
+		blk = o.BlindedBlockDeneb
+	case *validatorpb.SignRequest_BlockElectra:
+		blk = o.BlockElectra
+	case *validatorpb.SignRequest_BlindedBlockElectra:
+		blk = o.BlindedBlockElectra
+	case *validatorpb.SignRequest_AttestationData:
+		if o.AttestationData == nil {
+			return nil, errors.Wrap(errInvalidSignRequest, "nil attestation data")
+		}
+		return &signRequestObject{
+			name:            "attestation data",
+			root:            o.AttestationData,
+			domainType:      cfg.DomainBeaconAttester,
+			attestationData: o.AttestationData,
+		}, nil
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		if o.AggregateAttestationAndProof == nil {
+			return nil, errors.Wrap(errInvalidSignRequest, "nil aggregate attestation and proof")
+		}
+		return &signRequestObject{
+			name:       "aggregate attestation and proof",
+			root:       o.AggregateAttestationAndProof,
+			domainType: cfg.DomainAggregateAndProof,
+		}, nil
+	case *validatorpb.SignRequest_Exit:
+		if o.Exit == nil {
+			return nil, errors.Wrap(errInvalidSignRequest, "nil voluntary exit")
+		}
+		return &signRequestObject{name: "voluntary exit", root: o.Exit, domainType: cfg.DomainVoluntaryExit, exit: o.Exit}, nil
+	case *validatorpb.SignRequest_Slot:
+		slot := primitives.SSZUint64(o.Slot)
+		return &signRequestObject{name: "selection proof slot", root: &slot, domainType: cfg.DomainSelectionProof}, nil
+	case *validatorpb.SignRequest_Epoch:
+		epoch := primitives.SSZUint64(o.Epoch)
+		return &signRequestObject{name: "randao reveal epoch", root: &epoch, domainType: cfg.DomainRandao}, nil
+	case *validatorpb.SignRequest_SyncAggregatorSelectionData:
+		if o.SyncAggregatorSelectionData == nil {
+			return nil, errors.Wrap(errInvalidSignRequest, "nil sync aggregator selection data")
+		}
+		return &signRequestObject{
+			name:       "sync aggregator selection data",
+			root:       o.SyncAggregatorSelectionData,
+			domainType: cfg.DomainSyncCommitteeSelectionProof,
+		}, nil
+	case *validatorpb.SignRequest_ContributionAndProof:
+		if o.ContributionAndProof == nil {
+			return nil, errors.Wrap(errInvalidSignRequest, "nil contribution and proof")
+		}
+		return &signRequestObject{
+			name:       "contribution and proof",
+			root:       o.ContributionAndProof,
+			domainType: cfg.DomainContributionAndProof,
+		}, nil
+	case *validatorpb.SignRequest_SyncMessageBlockRoot:
+		if len(o.SyncMessageBlockRoot) != fieldparams.RootLength {
+			return nil, errors.Wrap(errInvalidSignRequest, "invalid sync message block root")
+		}
+		root := primitives.SSZBytes(o.SyncMessageBlockRoot)
+		return &signRequestObject{name: "sync message block root", root: &root, domainType: cfg.DomainSyncCommittee}, nil
+	case *validatorpb.SignRequest_Registration:
+		if o.Registration == nil {
+			return nil, errors.Wrap(errInvalidSignRequest, "nil validator registration")
+		}
+		return &signRequestObject{
+			name:         "validator registration",
+			root:         o.Registration,
+			domainType:   cfg.DomainApplicationBuilder,
+			registration: o.Registration,
+		}, nil
+	default:
+		return nil, errors.Wrap(errUnsupportedSignature, fmt.Sprintf("%T", req.Object))
+	}
+
+	wb, err := blocks.NewBeaconBlock(blk)
+	if err != nil {
+		return nil, errors.Wrap(errInvalidSignRequest, err.Error())
+	}
+	return &signRequestObject{
+		name:       "block",
+		root:       wb,
+		domainType: cfg.DomainBeaconProposer,
+		block:      wb,
+	}, nil
+}
@@ -120,6 +120,8 @@ const (
 	Derived
 	// Web3Signer keymanager capable of signing data using a remote signer called Web3Signer.
 	Web3Signer
+	// Threshold keymanager holding a share of a key, signing with a threshold of the other key shares.
+	Threshold
 )
 
 // IncorrectPasswordErrMsg defines a common error string representing an EIP-2335
@@ -139,6 +141,8 @@ func (k Kind) String() string {
 		return "direct"
 	case Web3Signer:
 		return "web3signer"
+	case Threshold:
+		return "threshold"
 	default:
 		return fmt.Sprintf("%d", int(k))
 	}
@@ -153,6 +157,8 @@ func ParseKind(k string) (Kind, error) {
 		return Local, nil
 	case "web3signer":
 		return Web3Signer, nil
+	case "threshold":
+		return Threshold, nil
 	default:
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
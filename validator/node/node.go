@@ -33,6 +33,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer/loader"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/slice"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
@@ -53,6 +54,7 @@ import (
 	g "github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/threshold"
 	"github.com/prysmaticlabs/prysm/v5/validator/rpc"
 	"github.com/prysmaticlabs/prysm/v5/validator/web"
 	"github.com/sirupsen/logrus"
@@ -258,11 +260,17 @@ func (c *ValidatorClient) getLegacyDatabaseLocation(
 func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context, router *mux.Router) error {
 	isInteropNumValidatorsSet := cliCtx.IsSet(flags.InteropNumValidators.Name)
 	isWeb3SignerURLFlagSet := cliCtx.IsSet(flags.Web3SignerURLFlag.Name)
+	isThresholdConfigFileSet := cliCtx.IsSet(flags.ThresholdConfigFileFlag.Name)
+	if isWeb3SignerURLFlagSet && isThresholdConfigFileSet {
+		return fmt.Errorf("%s cannot be used with %s", flags.ThresholdConfigFileFlag.Name, flags.Web3SignerURLFlag.Name)
+	}
 
 	if !isInteropNumValidatorsSet {
 		// Custom Check For Web3Signer
 		if isWeb3SignerURLFlagSet {
 			c.wallet = wallet.NewWalletForWeb3Signer()
+		} else if isThresholdConfigFileSet {
+			c.wallet = wallet.NewWalletForThreshold()
 		} else {
 			w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
 				return nil, wallet.ErrNoWalletFound
@@ -282,6 +290,9 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context, router *mux.Rou
 	if err := c.initializeDB(cliCtx); err != nil {
 		return errors.Wrapf(err, "could not initialize database")
 	}
+	if err := c.registerBackupService(cliCtx); err != nil {
+		return err
+	}
 
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		if err := c.registerPrometheusService(cliCtx); err != nil {
@@ -306,6 +317,8 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context, router *mux.Rout
 	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
 		// Custom Check For Web3Signer
 		c.wallet = wallet.NewWalletForWeb3Signer()
+	} else if cliCtx.IsSet(flags.ThresholdConfigFileFlag.Name) {
+		c.wallet = wallet.NewWalletForThreshold()
 	} else {
 		// Read the wallet password file from the cli context.
 		if err := setWalletPasswordFilePath(cliCtx); err != nil {
@@ -325,6 +338,9 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context, router *mux.Rout
 	if err := c.initializeDB(cliCtx); err != nil {
 		return errors.Wrapf(err, "could not initialize database")
 	}
+	if err := c.registerBackupService(cliCtx); err != nil {
+		return err
+	}
 
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		if err := c.registerPrometheusService(cliCtx); err != nil {
@@ -356,7 +372,8 @@ func (c *ValidatorClient) initializeDB(cliCtx *cli.Context) error {
 	kvDataFile := filepath.Join(kvDataDir, kv.ProtectionDbFileName)
 	walletDir := cliCtx.String(flags.WalletDirFlag.Name)
 	isInteropNumValidatorsSet := cliCtx.IsSet(flags.InteropNumValidators.Name)
-	isWeb3SignerURLFlagSet := cliCtx.IsSet(flags.Web3SignerURLFlag.Name)
+	// Neither web3signer nor threshold keymanagers keep their accounts in the wallet directory.
+	isWeb3SignerURLFlagSet := cliCtx.IsSet(flags.Web3SignerURLFlag.Name) || cliCtx.IsSet(flags.ThresholdConfigFileFlag.Name)
 	clearFlag := cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearFlag := cliCtx.Bool(cmd.ForceClearDB.Name)
 
@@ -463,14 +480,44 @@ func (c *ValidatorClient) initializeDB(cliCtx *cli.Context) error {
 	return nil
 }
 
+// registerBackupService registers the service writing the scheduled database backups, which also writes the
+// backups requested from the webhook.
+func (c *ValidatorClient) registerBackupService(cliCtx *cli.Context) error {
+	interval := cliCtx.Duration(cmd.BackupIntervalFlag.Name)
+	if interval == 0 && !cliCtx.IsSet(cmd.EnableBackupWebhookFlag.Name) {
+		return nil
+	}
+	outputDir := cliCtx.String(cmd.BackupWebhookOutputDir.Name)
+	if outputDir == "" {
+		outputDir = filepath.Join(c.db.DatabasePath(), "backups")
+	}
+	svc, err := backup.NewService(cliCtx.Context, &backup.Config{
+		Exporter:  c.db,
+		Database:  "validatordb",
+		OutputDir: outputDir,
+		Interval:  interval,
+		Retention: cliCtx.Int(cmd.BackupRetentionFlag.Name),
+		Compress:  cliCtx.Bool(cmd.BackupCompressFlag.Name),
+		FullEvery: cliCtx.Int(cmd.BackupFullEveryFlag.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create backup service")
+	}
+	return c.services.RegisterService(svc)
+}
+
 func (c *ValidatorClient) registerPrometheusService(cliCtx *cli.Context) error {
 	var additionalHandlers []prometheus.Handler
 	if cliCtx.IsSet(cmd.EnableBackupWebhookFlag.Name) {
+		var backupService *backup.Service
+		if err := c.services.FetchService(&backupService); err != nil {
+			return err
+		}
 		additionalHandlers = append(
 			additionalHandlers,
 			prometheus.Handler{
 				Path:    "/db/backup",
-				Handler: backup.Handler(c.db, cliCtx.String(cmd.BackupWebhookOutputDir.Name)),
+				Handler: backup.Handler(backupService, cliCtx.String(cmd.BackupWebhookOutputDir.Name)),
 			},
 		)
 	}
@@ -513,6 +560,11 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		return err
 	}
 
+	thresholdConfig, err := c.registerThresholdServer(c.cliCtx)
+	if err != nil {
+		return err
+	}
+
 	ps, err := proposerSettings(c.cliCtx, c.db)
 	if err != nil {
 		return err
@@ -534,12 +586,15 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		GraffitiStruct:          graffitiStruct,
 		InteropKmConfig:         interopKmConfig,
 		Web3SignerConfig:        web3signerConfig,
+		ThresholdConfig:         thresholdConfig,
 		ProposerSettings:        ps,
 		ValidatorsRegBatchSize:  c.cliCtx.Int(flags.ValidatorsRegistrationBatchSizeFlag.Name),
 		UseWeb:                  c.cliCtx.Bool(flags.EnableWebFlag.Name),
 		LogValidatorPerformance: !c.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name),
 		EmitAccountMetrics:      !c.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name),
 		Distributed:             c.cliCtx.Bool(flags.EnableDistributed.Name),
+		DoppelgangerEpochs:      primitives.Epoch(c.cliCtx.Uint64(flags.DoppelgangerEpochsFlag.Name)),
+		DutyHistoryRetention:    primitives.Epoch(c.cliCtx.Uint64(flags.DutyHistoryRetentionFlag.Name)),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -548,21 +603,53 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 	return c.services.RegisterService(validatorService)
 }
 
+// registerThresholdServer loads the configuration of the threshold keymanager, if any, and registers the server
+// answering the partial signature requests of its peers. The server checks these requests against the slashing
+// protection database of the validator client.
+func (c *ValidatorClient) registerThresholdServer(cliCtx *cli.Context) (*threshold.SetupConfig, error) {
+	if !cliCtx.IsSet(flags.ThresholdConfigFileFlag.Name) {
+		return nil, nil
+	}
+	// The minimal database refuses to sign the same attestation twice, while the keymanager checks every request
+	// before the validator client checks it again.
+	if _, ok := c.db.(*filesystem.Store); ok {
+		return nil, fmt.Errorf("%s requires the complete slashing protection database", flags.ThresholdConfigFileFlag.Name)
+	}
+	cfg, err := threshold.LoadSetupConfig(cliCtx.String(flags.ThresholdConfigFileFlag.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load threshold keymanager config")
+	}
+	cfg.SlashingProtection = c.db
+	server, err := threshold.NewServer(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create threshold partial signature server")
+	}
+	if err := c.services.RegisterService(server); err != nil {
+		return nil, errors.Wrap(err, "could not register threshold partial signature server")
+	}
+	return cfg, nil
+}
+
 func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error) {
 	var web3signerConfig *remoteweb3signer.SetupConfig
 	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
-		urlStr := cliCtx.String(flags.Web3SignerURLFlag.Name)
-		u, err := url.ParseRequestURI(urlStr)
+		baseEndpoint, err := web3SignerURL(cliCtx.String(flags.Web3SignerURLFlag.Name))
 		if err != nil {
-			return nil, errors.Wrapf(err, "web3signer url %s is invalid", urlStr)
-		}
-		if u.Scheme == "" || u.Host == "" {
-			return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", urlStr)
+			return nil, err
 		}
 		web3signerConfig = &remoteweb3signer.SetupConfig{
-			BaseEndpoint:          u.String(),
+			BaseEndpoint:          baseEndpoint,
 			GenesisValidatorsRoot: nil,
 		}
+		for _, urls := range cliCtx.StringSlice(flags.Web3SignerAdditionalURLsFlag.Name) {
+			for _, urlStr := range strings.Split(urls, ",") {
+				endpoint, err := web3SignerURL(urlStr)
+				if err != nil {
+					return nil, err
+				}
+				web3signerConfig.AdditionalEndpoints = append(web3signerConfig.AdditionalEndpoints, endpoint)
+			}
+		}
 		if cliCtx.IsSet(flags.WalletPasswordFileFlag.Name) {
 			log.Warnf("%s was provided while using web3signer and will be ignored", flags.WalletPasswordFileFlag.Name)
 		}
@@ -596,6 +683,17 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 	return web3signerConfig, nil
 }
 
+func web3SignerURL(urlStr string) (string, error) {
+	u, err := url.ParseRequestURI(urlStr)
+	if err != nil {
+		return "", errors.Wrapf(err, "web3signer url %s is invalid", urlStr)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", urlStr)
+	}
+	return u.String(), nil
+}
+
 func proposerSettings(cliCtx *cli.Context, db iface.ValidatorDB) (*proposer.Settings, error) {
 	l, err := loader.NewProposerSettingsLoader(
 		cliCtx,
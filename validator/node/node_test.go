@@ -18,9 +18,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/threshold"
 	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli/v2"
 )
@@ -221,6 +223,7 @@ func TestWeb3SignerConfig(t *testing.T) {
 
 	type args struct {
 		baseURL          string
+		additionalURLs   []string
 		publicKeysOrURLs []string
 	}
 	tests := []struct {
@@ -259,6 +262,31 @@ func TestWeb3SignerConfig(t *testing.T) {
 				ProvidedPublicKeys:    nil,
 			},
 		},
+		{
+			name: "happy path with additional urls",
+			args: &args{
+				baseURL:          "http://localhost:8545",
+				additionalURLs:   []string{"http://localhost:8546,https://localhost:8547"},
+				publicKeysOrURLs: []string{"http://localhost:8545/api/v1/eth2/publicKeys"},
+			},
+			want: &remoteweb3signer.SetupConfig{
+				BaseEndpoint:          "http://localhost:8545",
+				AdditionalEndpoints:   []string{"http://localhost:8546", "https://localhost:8547"},
+				GenesisValidatorsRoot: nil,
+				PublicKeysURL:         "http://localhost:8545/api/v1/eth2/publicKeys",
+				ProvidedPublicKeys:    nil,
+			},
+		},
+		{
+			name: "Bad additional URL",
+			args: &args{
+				baseURL:          "http://localhost:8545",
+				additionalURLs:   []string{"localhost:8546"},
+				publicKeysOrURLs: []string{"http://localhost:8545/api/v1/eth2/publicKeys"},
+			},
+			want:       nil,
+			wantErrMsg: "web3signer url must be in the format of http(s)://host:port url used: localhost:8546",
+		},
 		{
 			name: "Bad base URL",
 			args: &args{
@@ -327,6 +355,10 @@ func TestWeb3SignerConfig(t *testing.T) {
 			}
 			err := c.Apply(set)
 			require.NoError(t, err)
+			require.NoError(t, flags.Web3SignerAdditionalURLsFlag.Apply(set))
+			for _, u := range tt.args.additionalURLs {
+				require.NoError(t, set.Set(flags.Web3SignerAdditionalURLsFlag.Name, u))
+			}
 			require.NoError(t, set.Set(flags.Web3SignerURLFlag.Name, tt.args.baseURL))
 			for _, key := range tt.args.publicKeysOrURLs {
 				require.NoError(t, set.Set(flags.Web3SignerPublicValidatorKeysFlag.Name, key))
@@ -341,3 +373,26 @@ func TestWeb3SignerConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisterThresholdServer(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.ThresholdConfigFileFlag.Name, "", "")
+	c := &ValidatorClient{}
+
+	cfg, err := c.registerThresholdServer(cli.NewContext(&app, set, nil))
+	require.NoError(t, err)
+	assert.Equal(t, (*threshold.SetupConfig)(nil), cfg)
+
+	require.NoError(t, set.Set(flags.ThresholdConfigFileFlag.Name, filepath.Join(t.TempDir(), "threshold.json")))
+	c.db, err = filesystem.NewStore(t.TempDir(), nil)
+	require.NoError(t, err)
+	_, err = c.registerThresholdServer(cli.NewContext(&app, set, nil))
+	assert.ErrorContains(t, "requires the complete slashing protection database", err)
+
+	c.db, err = kv.NewKVStore(context.Background(), t.TempDir(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, c.db.Close()) })
+	_, err = c.registerThresholdServer(cli.NewContext(&app, set, nil))
+	assert.ErrorContains(t, "could not read threshold config file", err)
+}
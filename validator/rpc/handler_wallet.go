@@ -11,6 +11,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
+	cryptokeystore "github.com/prysmaticlabs/prysm/v5/crypto/keystore"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/io/prompt"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
@@ -19,7 +20,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/tyler-smith/go-bip39"
 	"github.com/tyler-smith/go-bip39/wordlists"
-	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 	"go.opencensus.io/trace"
 )
 
@@ -156,6 +156,8 @@ func (s *Server) WalletConfig(w http.ResponseWriter, r *http.Request) {
 		keymanagerKind = importedKeymanagerKind
 	case keymanager.Web3Signer:
 		keymanagerKind = web3signerKeymanagerKind
+	case keymanager.Threshold:
+		keymanagerKind = thresholdKeymanagerKind
 	}
 	httputil.WriteJson(w, &WalletResponse{
 		WalletPath:     s.walletDir,
@@ -295,7 +297,6 @@ func (*Server) ValidateKeystores(w http.ResponseWriter, r *http.Request) {
 		httputil.HandleError(w, "No keystores included in request", http.StatusBadRequest)
 		return
 	}
-	decryptor := keystorev4.New()
 	for i := 0; i < len(req.Keystores); i++ {
 		encoded := req.Keystores[i]
 		keystore := &keymanager.Keystore{}
@@ -306,7 +307,7 @@ func (*Server) ValidateKeystores(w http.ResponseWriter, r *http.Request) {
 		if keystore.Description == "" && keystore.Name != "" {
 			keystore.Description = keystore.Name
 		}
-		if _, err := decryptor.Decrypt(keystore.Crypto, req.KeystoresPassword); err != nil {
+		if _, err := cryptokeystore.DecryptEIP2335(keystore.Crypto, req.KeystoresPassword); err != nil {
 			doesNotDecrypt := strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg)
 			if doesNotDecrypt {
 				httputil.HandleError(w, fmt.Sprintf("Password for keystore with public key %s is incorrect. "+
@@ -0,0 +1,139 @@
+package rpc
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetDutiesHistory returns the duties performed by a validating key, and their outcome, as recorded in the
+// validator database. The history can be filtered by slot with start_slot and end_slot, by unix time with
+// start_time and end_time, and by kind of duty with type.
+func (s *Server) GetDutiesHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.web.duties.GetDutiesHistory")
+	defer span.End()
+
+	if s.db == nil {
+		httputil.HandleError(w, "Could not find validator database", http.StatusServiceUnavailable)
+		return
+	}
+	_, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	rawStartSlot, startSlot, ok := shared.UintFromQuery(w, r, "start_slot", false)
+	if !ok {
+		return
+	}
+	rawEndSlot, endSlot, ok := shared.UintFromQuery(w, r, "end_slot", false)
+	if !ok {
+		return
+	}
+	if rawEndSlot == "" {
+		endSlot = math.MaxUint64
+	}
+	rawStartTime, startTime, ok := shared.UintFromQuery(w, r, "start_time", false)
+	if !ok {
+		return
+	}
+	rawEndTime, endTime, ok := shared.UintFromQuery(w, r, "end_time", false)
+	if !ok {
+		return
+	}
+	if (rawStartSlot != "" || rawEndSlot != "") && (rawStartTime != "" || rawEndTime != "") {
+		httputil.HandleError(w, "Slot and time filters cannot be used together", http.StatusBadRequest)
+		return
+	}
+	if rawStartTime != "" || rawEndTime != "" {
+		genesis, err := s.nodeClient.Genesis(ctx, &emptypb.Empty{})
+		if err != nil {
+			httputil.HandleError(w, errors.Wrap(err, "Genesis call failed").Error(), http.StatusInternalServerError)
+			return
+		}
+		genesisTime := uint64(genesis.GenesisTime.Seconds)
+		if rawStartTime != "" {
+			startSlot = uint64(slotAtTime(genesisTime, startTime))
+		}
+		if rawEndTime != "" {
+			if endTime < genesisTime {
+				httputil.WriteJson(w, &GetDutiesHistoryResponse{Data: []*DutyRecord{}})
+				return
+			}
+			endSlot = uint64(slotAtTime(genesisTime, endTime))
+		}
+	}
+	if startSlot > endSlot {
+		httputil.HandleError(w, "Start of the range is after its end", http.StatusBadRequest)
+		return
+	}
+
+	var kind common.DutyKind
+	if rawKind := r.URL.Query().Get("type"); rawKind != "" {
+		var err error
+		kind, err = common.DutyKindFromString(rawKind)
+		if err != nil {
+			httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	records, err := s.db.DutyHistory(ctx, bytesutil.ToBytes48(pubkey), primitives.Slot(startSlot), primitives.Slot(endSlot))
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not get duty history").Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*DutyRecord, 0, len(records))
+	for _, record := range records {
+		if kind != 0 && record.Kind != kind {
+			continue
+		}
+		data = append(data, DutyRecordFromDB(record))
+	}
+	httputil.WriteJson(w, &GetDutiesHistoryResponse{Data: data})
+}
+
+// slotAtTime returns the slot of a unix time, or 0 when the time is before genesis.
+func slotAtTime(genesisTime, t uint64) primitives.Slot {
+	if t < genesisTime {
+		return 0
+	}
+	return primitives.Slot((t - genesisTime) / params.BeaconConfig().SecondsPerSlot)
+}
+
+// DutyRecordFromDB converts a duty record of the validator database to its API representation.
+func DutyRecordFromDB(record *common.DutyRecord) *DutyRecord {
+	r := &DutyRecord{
+		Slot:         strconv.FormatUint(uint64(record.Slot), 10),
+		Type:         record.Kind.String(),
+		Submitted:    record.Submitted,
+		PayloadValue: record.PayloadValue,
+	}
+	if len(record.BlockRoot) > 0 {
+		r.BlockRoot = hexutil.Encode(record.BlockRoot)
+	}
+	if record.Outcome != nil {
+		r.Outcome = &AttestationOutcome{
+			Included:      record.Outcome.Included,
+			CorrectSource: record.Outcome.CorrectSource,
+			CorrectTarget: record.Outcome.CorrectTarget,
+			CorrectHead:   record.Outcome.CorrectHead,
+		}
+		if record.Outcome.InclusionDistance != 0 {
+			r.Outcome.InclusionDistance = strconv.FormatUint(uint64(record.Outcome.InclusionDistance), 10)
+		}
+	}
+	return r
+}
@@ -0,0 +1,113 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestServer_GetDutiesHistory(t *testing.T) {
+	for _, isSlashingProtectionMinimal := range []bool{false, true} {
+		t.Run(fmt.Sprintf("slashing protection minimal: %v", isSlashingProtectionMinimal), func(t *testing.T) {
+			ctx := context.Background()
+			pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+			validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, isSlashingProtectionMinimal)
+			require.NoError(t, validatorDB.SaveDutyRecords(ctx, []*common.DutyRecord{
+				{
+					PubKey:    pubKey,
+					Kind:      common.AttestationDuty,
+					Slot:      10,
+					Submitted: true,
+					BlockRoot: []byte{2},
+					Outcome: &common.AttestationOutcome{
+						Included:          true,
+						InclusionDistance: 1,
+						CorrectSource:     true,
+						CorrectTarget:     true,
+						CorrectHead:       true,
+					},
+				},
+				{PubKey: pubKey, Kind: common.ProposalDuty, Slot: 12, Submitted: true, BlockRoot: []byte{3}, PayloadValue: "100"},
+				{PubKey: pubKey, Kind: common.AttestationDuty, Slot: 40},
+			}))
+
+			genesisTime := uint64(1000)
+			ctrl := gomock.NewController(t)
+			nodeClient := validatormock.NewMockNodeClient(ctrl)
+			nodeClient.EXPECT().Genesis(gomock.Any(), gomock.Any()).Return(&ethpb.Genesis{
+				GenesisTime: &timestamppb.Timestamp{Seconds: int64(genesisTime)},
+			}, nil).AnyTimes()
+			s := &Server{db: validatorDB, nodeClient: nodeClient}
+
+			get := func(query string) (int, *GetDutiesHistoryResponse) {
+				req := httptest.NewRequest(http.MethodGet, "/eth/v1/validator/{pubkey}/duties_history"+query, nil)
+				req = mux.SetURLVars(req, map[string]string{"pubkey": fmt.Sprintf("%#x", pubKey)})
+				w := httptest.NewRecorder()
+				w.Body = &bytes.Buffer{}
+				s.GetDutiesHistory(w, req)
+				resp := &GetDutiesHistoryResponse{}
+				if w.Code == http.StatusOK {
+					require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+				}
+				return w.Code, resp
+			}
+
+			code, resp := get("")
+			require.Equal(t, http.StatusOK, code)
+			require.Equal(t, 3, len(resp.Data))
+			assert.DeepEqual(t, &DutyRecord{
+				Slot:      "10",
+				Type:      "attestation",
+				Submitted: true,
+				BlockRoot: "0x02",
+				Outcome: &AttestationOutcome{
+					Included:          true,
+					InclusionDistance: "1",
+					CorrectSource:     true,
+					CorrectTarget:     true,
+					CorrectHead:       true,
+				},
+			}, resp.Data[0])
+			assert.Equal(t, "100", resp.Data[1].PayloadValue)
+			assert.Equal(t, false, resp.Data[2].Submitted)
+
+			code, resp = get("?start_slot=11&end_slot=40&type=attestation")
+			require.Equal(t, http.StatusOK, code)
+			require.Equal(t, 1, len(resp.Data))
+			assert.Equal(t, "40", resp.Data[0].Slot)
+
+			secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+			code, resp = get(fmt.Sprintf("?start_time=%d&end_time=%d", genesisTime+11*secondsPerSlot, genesisTime+12*secondsPerSlot))
+			require.Equal(t, http.StatusOK, code)
+			require.Equal(t, 1, len(resp.Data))
+			assert.Equal(t, "proposal", resp.Data[0].Type)
+
+			code, resp = get(fmt.Sprintf("?end_time=%d", genesisTime-1))
+			require.Equal(t, http.StatusOK, code)
+			assert.Equal(t, 0, len(resp.Data))
+
+			code, _ = get("?type=unknown")
+			assert.Equal(t, http.StatusBadRequest, code)
+			code, _ = get("?start_slot=20&end_slot=10")
+			assert.Equal(t, http.StatusBadRequest, code)
+			code, _ = get("?start_slot=1&end_time=10")
+			assert.Equal(t, http.StatusBadRequest, code)
+		})
+	}
+}
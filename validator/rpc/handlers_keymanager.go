@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -23,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/prysmaticlabs/prysm/v5/validator/client"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
 	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
@@ -841,6 +843,157 @@ func (s *Server) DeleteGasLimit(w http.ResponseWriter, r *http.Request) {
 	httputil.HandleError(w, fmt.Sprintf("No gas limit found for pubkey %q", rawPubkey), http.StatusNotFound)
 }
 
+// GetBuilderSettings returns the builder configuration used for proposals and registrations by public key.
+func (s *Server) GetBuilderSettings(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.GetBuilderSettings")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	rawPubkey, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	data := &BuilderSettings{
+		Pubkey:   rawPubkey,
+		GasLimit: fmt.Sprintf("%d", params.BeaconConfig().DefaultBuilderGasLimit),
+	}
+	if config := builderConfigForPubkey(s.validatorService.ProposerSettings(), bytesutil.ToBytes48(pubkey)); config != nil {
+		data.Enabled = config.Enabled
+		data.GasLimit = fmt.Sprintf("%d", config.GasLimit)
+		if config.BoostFactor != nil {
+			data.BoostFactor = fmt.Sprintf("%d", *config.BoostFactor)
+		}
+	}
+	httputil.WriteJson(w, &GetBuilderSettingsResponse{Data: data})
+}
+
+// SetBuilderSettings updates the builder enablement and boost factor by public key.
+// Fields that are not present in the request are left unchanged.
+func (s *Server) SetBuilderSettings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.SetBuilderSettings")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	_, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	var req SetBuilderSettingsRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var boostFactor uint64
+	if req.BoostFactor != nil {
+		var valid bool
+		boostFactor, valid = shared.ValidateUint(w, "boost_factor", *req.BoostFactor)
+		if !valid {
+			return
+		}
+	}
+
+	settings := s.validatorService.ProposerSettings()
+	if settings == nil {
+		settings = &proposer.Settings{}
+	}
+	if settings.ProposeConfig == nil {
+		settings.ProposeConfig = make(map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option)
+	}
+	option, found := settings.ProposeConfig[bytesutil.ToBytes48(pubkey)]
+	if !found || option == nil {
+		if settings.DefaultConfig != nil {
+			option = settings.DefaultConfig.Clone()
+		} else {
+			option = &proposer.Option{}
+		}
+	}
+	if option.BuilderConfig == nil {
+		option.BuilderConfig = &proposer.BuilderConfig{
+			GasLimit: validator.Uint64(params.BeaconConfig().DefaultBuilderGasLimit),
+		}
+	}
+	if req.Enabled != nil {
+		option.BuilderConfig.Enabled = *req.Enabled
+	}
+	if req.BoostFactor != nil {
+		option.BuilderConfig.BoostFactor = &boostFactor
+	}
+	// Validator registrations are only sent for keys with a fee recipient, so enabling the builder without one would silently do nothing.
+	if option.BuilderConfig.Enabled && option.FeeRecipientConfig == nil {
+		httputil.HandleError(w, "A fee recipient must be set for the public key before enabling the builder", http.StatusBadRequest)
+		return
+	}
+	settings.ProposeConfig[bytesutil.ToBytes48(pubkey)] = option
+
+	// save the settings
+	if err := s.validatorService.SetProposerSettings(ctx, settings); err != nil {
+		httputil.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// DeleteBuilderSettings removes the builder configuration of a public key so that the default configuration applies.
+// It responds "not found" when the public key has no builder configuration of its own.
+func (s *Server) DeleteBuilderSettings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.DeleteBuilderSettings")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	rawPubkey, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	settings := s.validatorService.ProposerSettings()
+	if settings != nil && settings.ProposeConfig != nil {
+		option, found := settings.ProposeConfig[bytesutil.ToBytes48(pubkey)]
+		if found && option != nil && option.BuilderConfig != nil {
+			option.BuilderConfig = nil
+			// save the settings
+			if err := s.validatorService.SetProposerSettings(ctx, settings); err != nil {
+				httputil.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	httputil.HandleError(w, fmt.Sprintf("No builder settings found for pubkey %q", rawPubkey), http.StatusNotFound)
+}
+
+// builderConfigForPubkey resolves the builder configuration for a key, preferring the key's own
+// configuration over the default one.
+func builderConfigForPubkey(settings *proposer.Settings, pubkey [fieldparams.BLSPubkeyLength]byte) *proposer.BuilderConfig {
+	if settings == nil {
+		return nil
+	}
+	if option, ok := settings.ProposeConfig[pubkey]; ok && option != nil && option.BuilderConfig != nil {
+		return option.BuilderConfig
+	}
+	if settings.DefaultConfig != nil {
+		return settings.DefaultConfig.BuilderConfig
+	}
+	return nil
+}
+
 func (s *Server) GetGraffiti(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.Graffiti")
 	defer span.End()
@@ -923,3 +1076,70 @@ func (s *Server) DeleteGraffiti(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// GetDoppelgangerStatus returns the doppelganger protection status of a validating key.
+func (s *Server) GetDoppelgangerStatus(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.GetDoppelgangerStatus")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready.", http.StatusServiceUnavailable)
+		return
+	}
+	rawPubkey, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	status, err := s.validatorService.DoppelgangerStatus(ctx, bytesutil.ToBytes48(pubkey))
+	if err != nil {
+		handleDoppelgangerError(w, err)
+		return
+	}
+	data := &DoppelgangerStatus{
+		Pubkey:  rawPubkey,
+		State:   status.State.String(),
+		CanSign: status.State.CanSign(),
+	}
+	if status.State != iface.DoppelgangerDisabled {
+		data.RegisteredEpoch = strconv.FormatUint(uint64(status.RegisteredEpoch), 10)
+		data.SafeEpoch = strconv.FormatUint(uint64(status.SafeEpoch), 10)
+	}
+	if status.State == iface.DoppelgangerDetected {
+		data.DetectedEpoch = strconv.FormatUint(uint64(status.DetectedEpoch), 10)
+	}
+	httputil.WriteJson(w, &GetDoppelgangerStatusResponse{Data: data})
+}
+
+// OverrideDoppelganger allows a validating key to sign right away, whether it is within its doppelganger window or
+// a doppelganger was detected for it.
+func (s *Server) OverrideDoppelganger(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.OverrideDoppelganger")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready.", http.StatusServiceUnavailable)
+		return
+	}
+	_, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	if err := s.validatorService.OverrideDoppelganger(ctx, bytesutil.ToBytes48(pubkey)); err != nil {
+		handleDoppelgangerError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func handleDoppelgangerError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, client.ErrUnknownValidatingKey):
+		httputil.HandleError(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, client.ErrDoppelgangerDisabled):
+		httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+	default:
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+	}
+}
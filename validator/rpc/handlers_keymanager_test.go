@@ -1310,6 +1310,278 @@ func TestServer_DeleteGasLimit(t *testing.T) {
 	}
 }
 
+func TestServer_GetBuilderSettings(t *testing.T) {
+	ctx := context.Background()
+	pubkey1, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	require.NoError(t, err)
+	pubkey2, err := hexutil.Decode("0x1234567878903438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	require.NoError(t, err)
+	boostFactor := uint64(150)
+	settings := &proposer.Settings{
+		ProposeConfig: map[[48]byte]*proposer.Option{
+			bytesutil.ToBytes48(pubkey1): {
+				BuilderConfig: &proposer.BuilderConfig{
+					Enabled:     true,
+					GasLimit:    123456789,
+					BoostFactor: &boostFactor,
+					Relays:      []string{"https://relay.example.com"},
+				},
+			},
+		},
+		DefaultConfig: &proposer.Option{
+			BuilderConfig: &proposer.BuilderConfig{GasLimit: 987654321},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		settings *proposer.Settings
+		pubkey   []byte
+		want     *BuilderSettings
+	}{
+		{
+			name:     "specific pubkey",
+			settings: settings,
+			pubkey:   pubkey1,
+			want: &BuilderSettings{
+				Pubkey:      hexutil.Encode(pubkey1),
+				Enabled:     true,
+				GasLimit:    "123456789",
+				BoostFactor: "150",
+			},
+		},
+		{
+			name:     "falls back to default config",
+			settings: settings,
+			pubkey:   pubkey2,
+			want: &BuilderSettings{
+				Pubkey:   hexutil.Encode(pubkey2),
+				GasLimit: "987654321",
+			},
+		},
+		{
+			name:   "no proposer settings",
+			pubkey: pubkey1,
+			want: &BuilderSettings{
+				Pubkey:   hexutil.Encode(pubkey1),
+				GasLimit: fmt.Sprintf("%d", params.BeaconConfig().DefaultBuilderGasLimit),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mock.Validator{}
+			require.NoError(t, m.SetProposerSettings(ctx, tt.settings))
+			vs, err := client.NewValidatorService(ctx, &client.Config{
+				Validator: m,
+			})
+			require.NoError(t, err)
+			s := &Server{
+				validatorService: vs,
+			}
+			req := httptest.NewRequest(http.MethodGet, "/eth/v1/validator/{pubkey}/builder", nil)
+			req = mux.SetURLVars(req, map[string]string{"pubkey": hexutil.Encode(tt.pubkey)})
+			w := httptest.NewRecorder()
+			w.Body = &bytes.Buffer{}
+			s.GetBuilderSettings(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			resp := &GetBuilderSettingsResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+			assert.DeepEqual(t, tt.want, resp.Data)
+		})
+	}
+}
+
+func TestServer_SetBuilderSettings(t *testing.T) {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	pubkey1, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	require.NoError(t, err)
+	pubkey2, err := hexutil.Decode("0xbedefeaa94e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2cdddddddddddddddddddddddd")
+	require.NoError(t, err)
+	feeRecipient := &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")}
+	boostFactor := uint64(20)
+
+	tests := []struct {
+		name     string
+		pubkey   []byte
+		settings func() *proposer.Settings
+		body     string
+		wantErr  string
+		want     *proposer.BuilderConfig
+	}{
+		{
+			name:   "partial update keeps existing fields",
+			pubkey: pubkey1,
+			settings: func() *proposer.Settings {
+				return &proposer.Settings{
+					ProposeConfig: map[[48]byte]*proposer.Option{
+						bytesutil.ToBytes48(pubkey1): {
+							FeeRecipientConfig: feeRecipient,
+							BuilderConfig: &proposer.BuilderConfig{
+								Enabled:  true,
+								GasLimit: 123456789,
+								Relays:   []string{"https://relay.example.com"},
+							},
+						},
+					},
+				}
+			},
+			body: `{"boost_factor":"20"}`,
+			want: &proposer.BuilderConfig{
+				Enabled:     true,
+				GasLimit:    123456789,
+				BoostFactor: &boostFactor,
+				Relays:      []string{"https://relay.example.com"},
+			},
+		},
+		{
+			name:   "new key is cloned from default config",
+			pubkey: pubkey2,
+			settings: func() *proposer.Settings {
+				return &proposer.Settings{
+					DefaultConfig: &proposer.Option{
+						FeeRecipientConfig: feeRecipient,
+						BuilderConfig:      &proposer.BuilderConfig{GasLimit: 987654321},
+					},
+				}
+			},
+			body: `{"enabled":true}`,
+			want: &proposer.BuilderConfig{
+				Enabled:  true,
+				GasLimit: 987654321,
+			},
+		},
+		{
+			name:   "disable without fee recipient",
+			pubkey: pubkey1,
+			settings: func() *proposer.Settings {
+				return nil
+			},
+			body: `{"enabled":false}`,
+			want: &proposer.BuilderConfig{
+				GasLimit: validator.Uint64(params.BeaconConfig().DefaultBuilderGasLimit),
+			},
+		},
+		{
+			name:   "enable without fee recipient",
+			pubkey: pubkey1,
+			settings: func() *proposer.Settings {
+				return nil
+			},
+			body:    `{"enabled":true}`,
+			wantErr: "A fee recipient must be set",
+		},
+		{
+			name:   "invalid boost factor",
+			pubkey: pubkey1,
+			settings: func() *proposer.Settings {
+				return nil
+			},
+			body:    `{"boost_factor":"-1"}`,
+			wantErr: "boost_factor is invalid",
+		},
+	}
+	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s/isSlashingProtectionMinimal:%v", tt.name, isSlashingProtectionMinimal), func(t *testing.T) {
+				m := &mock.Validator{}
+				require.NoError(t, m.SetProposerSettings(ctx, tt.settings()))
+				validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, isSlashingProtectionMinimal)
+				vs, err := client.NewValidatorService(ctx, &client.Config{
+					Validator: m,
+					DB:        validatorDB,
+				})
+				require.NoError(t, err)
+				s := &Server{
+					validatorService: vs,
+					db:               validatorDB,
+				}
+
+				req := httptest.NewRequest(http.MethodPost, "/eth/v1/validator/{pubkey}/builder", bytes.NewBufferString(tt.body))
+				req = mux.SetURLVars(req, map[string]string{"pubkey": hexutil.Encode(tt.pubkey)})
+				w := httptest.NewRecorder()
+				w.Body = &bytes.Buffer{}
+
+				s.SetBuilderSettings(w, req)
+
+				if tt.wantErr != "" {
+					assert.Equal(t, http.StatusBadRequest, w.Code)
+					require.StringContains(t, tt.wantErr, w.Body.String())
+					return
+				}
+				require.Equal(t, http.StatusAccepted, w.Code)
+				got := s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(tt.pubkey)].BuilderConfig
+				assert.DeepEqual(t, tt.want, got)
+			})
+		}
+	}
+}
+
+func TestServer_DeleteBuilderSettings(t *testing.T) {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	pubkey1, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	require.NoError(t, err)
+	pubkey2, err := hexutil.Decode("0xbedefeaa94e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2cdddddddddddddddddddddddd")
+	require.NoError(t, err)
+	boostFactor := uint64(20)
+
+	m := &mock.Validator{}
+	require.NoError(t, m.SetProposerSettings(ctx, &proposer.Settings{
+		ProposeConfig: map[[48]byte]*proposer.Option{
+			bytesutil.ToBytes48(pubkey1): {
+				BuilderConfig: &proposer.BuilderConfig{
+					Enabled:     true,
+					GasLimit:    123456789,
+					BoostFactor: &boostFactor,
+					Relays:      []string{"https://relay.example.com"},
+				},
+			},
+		},
+		DefaultConfig: &proposer.Option{
+			BuilderConfig: &proposer.BuilderConfig{GasLimit: 987654321},
+		},
+	}))
+	validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false)
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Validator: m,
+		DB:        validatorDB,
+	})
+	require.NoError(t, err)
+	s := &Server{
+		validatorService: vs,
+		db:               validatorDB,
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/eth/v1/validator/{pubkey}/builder", nil)
+	req = mux.SetURLVars(req, map[string]string{"pubkey": hexutil.Encode(pubkey1)})
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.DeleteBuilderSettings(w, req)
+	require.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, (*proposer.BuilderConfig)(nil), s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(pubkey1)].BuilderConfig)
+
+	// The default configuration now applies to the key.
+	req = httptest.NewRequest(http.MethodGet, "/eth/v1/validator/{pubkey}/builder", nil)
+	req = mux.SetURLVars(req, map[string]string{"pubkey": hexutil.Encode(pubkey1)})
+	w = httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.GetBuilderSettings(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &GetBuilderSettingsResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.DeepEqual(t, &BuilderSettings{Pubkey: hexutil.Encode(pubkey1), GasLimit: "987654321"}, resp.Data)
+
+	for _, pubkey := range [][]byte{pubkey1, pubkey2} {
+		req = httptest.NewRequest(http.MethodDelete, "/eth/v1/validator/{pubkey}/builder", nil)
+		req = mux.SetURLVars(req, map[string]string{"pubkey": hexutil.Encode(pubkey)})
+		w = httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.DeleteBuilderSettings(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		require.StringContains(t, "No builder settings found", w.Body.String())
+	}
+}
+
 func TestServer_ListRemoteKeys(t *testing.T) {
 	ctx := context.Background()
 	w := wallet.NewWalletForWeb3Signer()
@@ -1943,3 +2215,50 @@ func TestServer_Graffiti(t *testing.T) {
 	s.DeleteGraffiti(w, req)
 	require.Equal(t, http.StatusOK, w.Code)
 }
+
+func TestServer_Doppelganger(t *testing.T) {
+	vs, err := client.NewValidatorService(context.Background(), &client.Config{
+		Validator: &mock.Validator{},
+	})
+	require.NoError(t, err)
+	s := &Server{
+		validatorService: vs,
+	}
+	pubkey := "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
+
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1/validator/{pubkey}/doppelganger", nil)
+	req = mux.SetURLVars(req, map[string]string{"pubkey": pubkey})
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.GetDoppelgangerStatus(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &GetDoppelgangerStatusResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, pubkey, resp.Data.Pubkey)
+	assert.Equal(t, "disabled", resp.Data.State)
+	assert.Equal(t, true, resp.Data.CanSign)
+
+	req = httptest.NewRequest(http.MethodPost, "/eth/v1/validator/{pubkey}/doppelganger", nil)
+	req = mux.SetURLVars(req, map[string]string{"pubkey": pubkey})
+	w = httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.OverrideDoppelganger(w, req)
+	require.Equal(t, http.StatusAccepted, w.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/eth/v1/validator/{pubkey}/doppelganger", nil)
+	req = mux.SetURLVars(req, map[string]string{"pubkey": pubkey})
+	w = httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.GetDoppelgangerStatus(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, "overridden", resp.Data.State)
+	assert.Equal(t, "0", resp.Data.SafeEpoch)
+
+	req = httptest.NewRequest(http.MethodGet, "/eth/v1/validator/{pubkey}/doppelganger", nil)
+	req = mux.SetURLVars(req, map[string]string{"pubkey": "0x1234"})
+	w = httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.GetDoppelgangerStatus(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
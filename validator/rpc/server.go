@@ -201,6 +201,9 @@ func (s *Server) InitializeRoutes() error {
 	s.router.HandleFunc("/eth/v1/validator/{pubkey}/gas_limit", s.GetGasLimit).Methods(http.MethodGet)
 	s.router.HandleFunc("/eth/v1/validator/{pubkey}/gas_limit", s.SetGasLimit).Methods(http.MethodPost)
 	s.router.HandleFunc("/eth/v1/validator/{pubkey}/gas_limit", s.DeleteGasLimit).Methods(http.MethodDelete)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/builder", s.GetBuilderSettings).Methods(http.MethodGet)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/builder", s.SetBuilderSettings).Methods(http.MethodPost)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/builder", s.DeleteBuilderSettings).Methods(http.MethodDelete)
 	s.router.HandleFunc("/eth/v1/validator/{pubkey}/feerecipient", s.ListFeeRecipientByPubkey).Methods(http.MethodGet)
 	s.router.HandleFunc("/eth/v1/validator/{pubkey}/feerecipient", s.SetFeeRecipientByPubkey).Methods(http.MethodPost)
 	s.router.HandleFunc("/eth/v1/validator/{pubkey}/feerecipient", s.DeleteFeeRecipientByPubkey).Methods(http.MethodDelete)
@@ -208,6 +211,9 @@ func (s *Server) InitializeRoutes() error {
 	s.router.HandleFunc("/eth/v1/validator/{pubkey}/graffiti", s.GetGraffiti).Methods(http.MethodGet)
 	s.router.HandleFunc("/eth/v1/validator/{pubkey}/graffiti", s.SetGraffiti).Methods(http.MethodPost)
 	s.router.HandleFunc("/eth/v1/validator/{pubkey}/graffiti", s.DeleteGraffiti).Methods(http.MethodDelete)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/doppelganger", s.GetDoppelgangerStatus).Methods(http.MethodGet)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/doppelganger", s.OverrideDoppelganger).Methods(http.MethodPost)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/duties_history", s.GetDutiesHistory).Methods(http.MethodGet)
 
 	// auth endpoint
 	s.router.HandleFunc(api.WebUrlPrefix+"initialize", s.Initialize).Methods(http.MethodGet)
@@ -19,9 +19,12 @@ func TestServer_InitializeRoutes(t *testing.T) {
 		"/eth/v1/keystores":                          {http.MethodGet, http.MethodPost, http.MethodDelete},
 		"/eth/v1/remotekeys":                         {http.MethodGet, http.MethodPost, http.MethodDelete},
 		"/eth/v1/validator/{pubkey}/gas_limit":       {http.MethodGet, http.MethodPost, http.MethodDelete},
+		"/eth/v1/validator/{pubkey}/builder":         {http.MethodGet, http.MethodPost, http.MethodDelete},
 		"/eth/v1/validator/{pubkey}/feerecipient":    {http.MethodGet, http.MethodPost, http.MethodDelete},
 		"/eth/v1/validator/{pubkey}/voluntary_exit":  {http.MethodPost},
 		"/eth/v1/validator/{pubkey}/graffiti":        {http.MethodGet, http.MethodPost, http.MethodDelete},
+		"/eth/v1/validator/{pubkey}/doppelganger":    {http.MethodGet, http.MethodPost},
+		"/eth/v1/validator/{pubkey}/duties_history":  {http.MethodGet},
 		"/v2/validator/health/version":               {http.MethodGet},
 		"/v2/validator/health/logs/validator/stream": {http.MethodGet},
 		"/v2/validator/health/logs/beacon/stream":    {http.MethodGet},
@@ -62,6 +62,23 @@ type SetGasLimitRequest struct {
 	GasLimit string `json:"gas_limit"`
 }
 
+type BuilderSettings struct {
+	Pubkey      string `json:"pubkey"`
+	Enabled     bool   `json:"enabled"`
+	GasLimit    string `json:"gas_limit"`
+	BoostFactor string `json:"boost_factor,omitempty"`
+}
+
+type GetBuilderSettingsResponse struct {
+	Data *BuilderSettings `json:"data"`
+}
+
+// SetBuilderSettingsRequest only updates the fields that are present in the request.
+type SetBuilderSettingsRequest struct {
+	Enabled     *bool   `json:"enabled"`
+	BoostFactor *string `json:"boost_factor"`
+}
+
 // remote keymanager api
 type ListRemoteKeysResponse struct {
 	Data []*RemoteKey `json:"data"`
@@ -109,6 +126,42 @@ type GraffitiData struct {
 	Graffiti string `json:"graffiti"`
 }
 
+// Doppelganger keymanager api
+type GetDoppelgangerStatusResponse struct {
+	Data *DoppelgangerStatus `json:"data"`
+}
+
+type DoppelgangerStatus struct {
+	Pubkey          string `json:"pubkey"`
+	State           string `json:"state"`
+	CanSign         bool   `json:"can_sign"`
+	RegisteredEpoch string `json:"registered_epoch,omitempty"`
+	SafeEpoch       string `json:"safe_epoch,omitempty"`
+	DetectedEpoch   string `json:"detected_epoch,omitempty"`
+}
+
+// Duty history api
+type GetDutiesHistoryResponse struct {
+	Data []*DutyRecord `json:"data"`
+}
+
+type DutyRecord struct {
+	Slot         string              `json:"slot"`
+	Type         string              `json:"type"`
+	Submitted    bool                `json:"submitted"`
+	BlockRoot    string              `json:"block_root,omitempty"`
+	PayloadValue string              `json:"payload_value,omitempty"`
+	Outcome      *AttestationOutcome `json:"outcome,omitempty"`
+}
+
+type AttestationOutcome struct {
+	Included          bool   `json:"included"`
+	InclusionDistance string `json:"inclusion_distance,omitempty"`
+	CorrectSource     bool   `json:"correct_source"`
+	CorrectTarget     bool   `json:"correct_target"`
+	CorrectHead       bool   `json:"correct_head"`
+}
+
 type BeaconStatusResponse struct {
 	BeaconNodeEndpoint     string     `json:"beacon_node_endpoint"`
 	Connected              bool       `json:"connected"`
@@ -125,6 +178,7 @@ const (
 	derivedKeymanagerKind    KeymanagerKind = "DERIVED"
 	importedKeymanagerKind   KeymanagerKind = "IMPORTED"
 	web3signerKeymanagerKind KeymanagerKind = "WEB3SIGNER"
+	thresholdKeymanagerKind  KeymanagerKind = "THRESHOLD"
 )
 
 type CreateWalletRequest struct {
@@ -1,15 +1,19 @@
 package history
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/progress"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/prysmaticlabs/prysm/v5/validator/db"
 	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
 	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
@@ -68,7 +72,7 @@ func ExportStandardProtectionJSON(
 		if err != nil {
 			return nil, errors.Wrap(err, "could not convert public key to hex string")
 		}
-		signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKey)
+		signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKey, 0)
 		if err != nil {
 			return nil, errors.Wrapf(err, "could not retrieve signed blocks for public key %s", pubKeyHex)
 		}
@@ -94,7 +98,7 @@ func ExportStandardProtectionJSON(
 		if err != nil {
 			return nil, errors.Wrap(err, "could not convert public key to hex string")
 		}
-		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKey)
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKey, 0)
 		if err != nil {
 			return nil, errors.Wrapf(err, "could not retrieve signed attestations for public key %s", pubKeyHex)
 		}
@@ -127,7 +131,127 @@ func ExportStandardProtectionJSON(
 	return interchangeJSON, nil
 }
 
-func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*format.SignedAttestation, error) {
+// ExportFilter restricts an export to some public keys, or to the history signed since an epoch.
+type ExportFilter struct {
+	// PubKeys to export. All public keys of the database are exported if it is empty.
+	PubKeys [][fieldparams.BLSPubkeyLength]byte
+	// SinceEpoch skips the attestations targeting, and the blocks proposed in, an earlier epoch.
+	SinceEpoch primitives.Epoch
+}
+
+// StreamStandardProtectionJSON writes the slashing protection data of a validator database to w as an
+// EIP-3076 compliant JSON, one public key at a time, so that the data of every key is never held in memory.
+// It returns the number of public keys written.
+func StreamStandardProtectionJSON(
+	ctx context.Context,
+	validatorDB db.Database,
+	w io.Writer,
+	filter *ExportFilter,
+) (int, error) {
+	if filter == nil {
+		filter = &ExportFilter{}
+	}
+	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get genesis validators root from DB")
+	}
+	if genesisValidatorsRoot == nil || !bytesutil.IsValidRoot(genesisValidatorsRoot) {
+		return 0, errors.New(
+			"genesis validators root is empty, perhaps you are not connected to your beacon node",
+		)
+	}
+	genesisRootHex, err := helpers.RootToHexString(genesisValidatorsRoot)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not convert genesis validators root to hex string")
+	}
+
+	pubKeys, err := exportedPublicKeys(ctx, validatorDB, filter.PubKeys)
+	if err != nil {
+		return 0, err
+	}
+	sinceSlot, err := slots.EpochStart(filter.SinceEpoch)
+	if err != nil {
+		return 0, err
+	}
+
+	enc, err := format.NewEncoder(w, format.Metadata{
+		InterchangeFormatVersion: format.InterchangeFormatVersion,
+		GenesisValidatorsRoot:    genesisRootHex,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "could not write slashing protection JSON")
+	}
+	bar := progress.InitializeProgressBar(len(pubKeys), "Exporting slashing protection history by validator public key")
+	for _, pubKey := range pubKeys {
+		pubKeyHex, err := helpers.PubKeyToHexString(pubKey[:])
+		if err != nil {
+			return 0, errors.Wrap(err, "could not convert public key to hex string")
+		}
+		signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKey, sinceSlot)
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not retrieve signed blocks for public key %s", pubKeyHex)
+		}
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKey, filter.SinceEpoch)
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not retrieve signed attestations for public key %s", pubKeyHex)
+		}
+		data := &format.ProtectionData{
+			Pubkey:             pubKeyHex,
+			SignedBlocks:       signedBlocks,
+			SignedAttestations: signedAttestations,
+		}
+		if data.SignedAttestations == nil {
+			data.SignedAttestations = make([]*format.SignedAttestation, 0)
+		}
+		if err := enc.Encode(data); err != nil {
+			return 0, errors.Wrap(err, "could not write slashing protection JSON")
+		}
+		if err := bar.Add(1); err != nil {
+			return 0, err
+		}
+	}
+	if err := enc.Close(); err != nil {
+		return 0, errors.Wrap(err, "could not write slashing protection JSON")
+	}
+	return len(pubKeys), nil
+}
+
+// exportedPublicKeys returns the sorted public keys of the database having a proposal or attestation history,
+// restricted to the wanted ones if any.
+func exportedPublicKeys(
+	ctx context.Context,
+	validatorDB db.Database,
+	wanted [][fieldparams.BLSPubkeyLength]byte,
+) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	proposedPublicKeys, err := validatorDB.ProposedPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve proposer public keys from DB")
+	}
+	attestedPublicKeys, err := validatorDB.AttestedPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve attested public keys from DB")
+	}
+	wantedMap := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(wanted))
+	for _, k := range wanted {
+		wantedMap[k] = true
+	}
+	seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	for _, k := range append(proposedPublicKeys, attestedPublicKeys...) {
+		if seen[k] || (len(wanted) > 0 && !wantedMap[k]) {
+			continue
+		}
+		seen[k] = true
+		pubKeys = append(pubKeys, k)
+	}
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) < 0
+	})
+	return pubKeys, nil
+}
+
+// signedAttestationsByPubKey returns the attestations of a public key targeting the since epoch or a later one.
+func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte, since primitives.Epoch) ([]*format.SignedAttestation, error) {
 	// If a key does not have an attestation history in our database, we return nil.
 	// This way, a user will be able to export their slashing protection history
 	// even if one of their keys does not have a history of signed attestations.
@@ -153,6 +277,9 @@ func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pu
 				continue
 			}
 		}
+		if att.Target < since {
+			continue
+		}
 		var root string
 		if len(att.SigningRoot) != 0 {
 			root, err = helpers.RootToHexString(att.SigningRoot)
@@ -169,7 +296,8 @@ func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pu
 	return signedAttestations, nil
 }
 
-func signedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*format.SignedBlock, error) {
+// signedBlocksByPubKey returns the blocks of a public key proposed at the since slot or a later one.
+func signedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte, since primitives.Slot) ([]*format.SignedBlock, error) {
 	// If a key does not have a lowest or highest signed proposal history
 	// in our database, we return an empty list. This way, a user will be able to export
 	// their slashing protection history even if one of their keys does not have a history
@@ -183,6 +311,9 @@ func signedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [
 		if ctx.Err() != nil {
 			return nil, errors.Wrap(err, "context canceled")
 		}
+		if proposal.Slot < since {
+			continue
+		}
 		signingRootHex, err := helpers.RootToHexString(proposal.SigningRoot)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not convert signing root to hex string")
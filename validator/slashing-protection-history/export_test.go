@@ -43,7 +43,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 			validatorDB := dbtest.SetupDB(t, pubKeys, isSlashingProtectionMinimal)
 
 			// No attestation history stored should return empty.
-			signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+			signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], 0)
 			require.NoError(t, err)
 			assert.Equal(t, 0, len(signedAttestations))
 
@@ -61,7 +61,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 			)))
 
 			// We then retrieve the signed attestations and expect a correct result.
-			signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+			signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], 0)
 			require.NoError(t, err)
 
 			wanted := []*format.SignedAttestation{
@@ -101,7 +101,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 		validatorDB := dbtest.SetupDB(t, pubKeys, isSlashingProtectionMinimal)
 
 		// No attestation history stored should return empty.
-		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], 0)
 		require.NoError(t, err)
 		assert.Equal(t, 0, len(signedAttestations))
 
@@ -123,7 +123,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 
 		// We then retrieve the signed attestations and expect to have
 		// skipped the 0th, corrupted entry.
-		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], 0)
 		require.NoError(t, err)
 
 		wanted := []*format.SignedAttestation{
@@ -148,7 +148,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 		validatorDB := dbtest.SetupDB(t, pubKeys, isSlashingProtectionMinimal)
 
 		// No attestation history stored should return empty.
-		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], 0)
 		require.NoError(t, err)
 		assert.Equal(t, 0, len(signedAttestations))
 
@@ -170,7 +170,7 @@ func Test_getSignedAttestationsByPubKey(t *testing.T) {
 
 		// We then retrieve the signed attestations and do not expect changes
 		// as the bug only manifests in the genesis epoch.
-		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+		signedAttestations, err = signedAttestationsByPubKey(ctx, validatorDB, pubKeys[0], 0)
 		require.NoError(t, err)
 
 		wanted := []*format.SignedAttestation{
@@ -199,7 +199,7 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 			validatorDB := dbtest.SetupDB(t, pubKeys, isSlashingProtectionMinimal)
 
 			// No highest and/or lowest signed blocks will return empty.
-			signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKeys[0])
+			signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKeys[0], 0)
 			require.NoError(t, err)
 			assert.Equal(t, 0, len(signedBlocks))
 
@@ -219,7 +219,7 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 
 			// We expect a valid proposal history containing slot 1 and slot 5 only
 			// when we attempt to retrieve it from disk.
-			signedBlocks, err = signedBlocksByPubKey(ctx, validatorDB, pubKeys[0])
+			signedBlocks, err = signedBlocksByPubKey(ctx, validatorDB, pubKeys[0], 0)
 			require.NoError(t, err)
 
 			wanted := []*format.SignedBlock{
@@ -7,11 +7,14 @@ const InterchangeFormatVersion = "5"
 // EIPSlashingProtectionFormat string representation of a standard
 // format for representing validator slashing protection db data.
 type EIPSlashingProtectionFormat struct {
-	Metadata struct {
-		InterchangeFormatVersion string `json:"interchange_format_version"`
-		GenesisValidatorsRoot    string `json:"genesis_validators_root"`
-	} `json:"metadata"`
-	Data []*ProtectionData `json:"data"`
+	Metadata Metadata          `json:"metadata"`
+	Data     []*ProtectionData `json:"data"`
+}
+
+// Metadata field for the standard slashing protection format.
+type Metadata struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	GenesisValidatorsRoot    string `json:"genesis_validators_root"`
 }
 
 // ProtectionData field for the standard slashing protection format.
@@ -0,0 +1,167 @@
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Encoder writes a standard slashing protection JSON one public key at a time,
+// so that exports never hold the data of every key in memory.
+type Encoder struct {
+	w     io.Writer
+	count int
+}
+
+// NewEncoder writes the metadata of the slashing protection JSON to w.
+// Data of every public key is then written with Encode, and the JSON is completed with Close.
+func NewEncoder(w io.Writer, metadata Metadata) (*Encoder, error) {
+	enc, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fmt.Fprintf(w, "{\n\"metadata\": %s,\n\"data\": [", enc); err != nil {
+		return nil, err
+	}
+	return &Encoder{w: w}, nil
+}
+
+// Encode writes the data of a public key.
+func (e *Encoder) Encode(data *ProtectionData) error {
+	enc, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	sep := ",\n"
+	if e.count == 0 {
+		sep = "\n"
+	}
+	if _, err := fmt.Fprintf(e.w, "%s%s", sep, enc); err != nil {
+		return err
+	}
+	e.count++
+	return nil
+}
+
+// Close completes the slashing protection JSON. It does not close the underlying writer.
+func (e *Encoder) Close() error {
+	_, err := io.WriteString(e.w, "\n]\n}\n")
+	return err
+}
+
+// Decoder reads a standard slashing protection JSON one public key at a time,
+// so that imports never hold the data of every key in memory.
+//
+// The metadata is usually written before the data. If it is not, the data is buffered
+// in memory until the metadata is read, as the data cannot be used without it.
+type Decoder struct {
+	dec      *json.Decoder
+	metadata Metadata
+	hasData  bool
+	buffered []*ProtectionData
+	inData   bool
+}
+
+// NewDecoder reads the slashing protection JSON from r until its metadata, and the start of its data, are found.
+func NewDecoder(r io.Reader) (*Decoder, error) {
+	d := &Decoder{dec: json.NewDecoder(r)}
+	if err := d.expectDelim('{'); err != nil {
+		return nil, err
+	}
+	foundMetadata := false
+	for d.dec.More() {
+		tok, err := d.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch tok {
+		case "metadata":
+			if err := d.dec.Decode(&d.metadata); err != nil {
+				return nil, fmt.Errorf("could not decode metadata: %w", err)
+			}
+			foundMetadata = true
+			if d.hasData {
+				return d, nil
+			}
+		case "data":
+			if foundMetadata {
+				return d, d.startData()
+			}
+			if err := d.dec.Decode(&d.buffered); err != nil {
+				return nil, fmt.Errorf("could not decode data: %w", err)
+			}
+			d.hasData = d.buffered != nil
+		default:
+			var skipped json.RawMessage
+			if err := d.dec.Decode(&skipped); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !foundMetadata {
+		return nil, fmt.Errorf("no metadata found")
+	}
+	return d, nil
+}
+
+// Metadata of the slashing protection JSON.
+func (d *Decoder) Metadata() Metadata {
+	return d.metadata
+}
+
+// HasData returns false if the data of the slashing protection JSON is missing or null.
+func (d *Decoder) HasData() bool {
+	return d.hasData
+}
+
+// Next returns the data of the next public key, or io.EOF once all of them were read.
+// Public keys are returned in the order of the JSON, and may be repeated. Null entries are returned as nil.
+func (d *Decoder) Next() (*ProtectionData, error) {
+	if len(d.buffered) > 0 {
+		data := d.buffered[0]
+		d.buffered = d.buffered[1:]
+		return data, nil
+	}
+	if !d.inData {
+		return nil, io.EOF
+	}
+	if !d.dec.More() {
+		d.inData = false
+		if err := d.expectDelim(']'); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	var data *ProtectionData
+	if err := d.dec.Decode(&data); err != nil {
+		return nil, fmt.Errorf("could not decode data: %w", err)
+	}
+	return data, nil
+}
+
+func (d *Decoder) startData() error {
+	tok, err := d.dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("data is not a list")
+	}
+	d.hasData = true
+	d.inData = true
+	return nil
+}
+
+func (d *Decoder) expectDelim(want json.Delim) error {
+	tok, err := d.dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("invalid slashing protection JSON, expected %s", want)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestEncoder_Decoder(t *testing.T) {
+	metadata := Metadata{InterchangeFormatVersion: InterchangeFormatVersion, GenesisValidatorsRoot: "0x01"}
+	data := []*ProtectionData{
+		{Pubkey: "0xaa", SignedBlocks: []*SignedBlock{{Slot: "1"}}, SignedAttestations: []*SignedAttestation{}},
+		{Pubkey: "0xbb", SignedBlocks: []*SignedBlock{}, SignedAttestations: []*SignedAttestation{{SourceEpoch: "1", TargetEpoch: "2"}}},
+	}
+	var buf bytes.Buffer
+	enc, err := NewEncoder(&buf, metadata)
+	require.NoError(t, err)
+	for _, d := range data {
+		require.NoError(t, enc.Encode(d))
+	}
+	require.NoError(t, enc.Close())
+
+	// The streamed JSON is a regular slashing protection JSON.
+	full := &EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), full))
+	assert.DeepEqual(t, metadata, full.Metadata)
+	assert.DeepEqual(t, data, full.Data)
+
+	dec, err := NewDecoder(&buf)
+	require.NoError(t, err)
+	assert.DeepEqual(t, metadata, dec.Metadata())
+	assert.Equal(t, true, dec.HasData())
+	for _, want := range data {
+		got, err := dec.Next()
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, got)
+	}
+	_, err = dec.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestDecoder_DataBeforeMetadata(t *testing.T) {
+	dec, err := NewDecoder(strings.NewReader(`{"data": [{"pubkey": "0xaa"}, null], "extra": 1, "metadata": {"genesis_validators_root": "0x01"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "0x01", dec.Metadata().GenesisValidatorsRoot)
+	got, err := dec.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "0xaa", got.Pubkey)
+	got, err = dec.Next()
+	require.NoError(t, err)
+	assert.Equal(t, true, got == nil)
+	_, err = dec.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestDecoder_Invalid(t *testing.T) {
+	dec, err := NewDecoder(strings.NewReader(`{"metadata": {}, "data": null}`))
+	require.NoError(t, err)
+	assert.Equal(t, false, dec.HasData())
+
+	_, err = NewDecoder(strings.NewReader(`{"data": []}`))
+	require.ErrorContains(t, "no metadata found", err)
+	_, err = NewDecoder(strings.NewReader(`[]`))
+	require.ErrorContains(t, "invalid slashing protection JSON", err)
+	dec, err = NewDecoder(strings.NewReader(`{"metadata": {}, "data": [{"pubkey": 1}]}`))
+	require.NoError(t, err)
+	_, err = dec.Next()
+	require.ErrorContains(t, "could not decode data", err)
+}
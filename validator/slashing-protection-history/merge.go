@@ -0,0 +1,333 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/slashings"
+	"github.com/prysmaticlabs/prysm/v5/validator/db"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+// MergeReport describes what an import of a slashing protection JSON changes in a validator database,
+// or would change when it is a dry run.
+type MergeReport struct {
+	DryRun bool         `json:"dry_run"`
+	Keys   []*KeyReport `json:"keys"`
+}
+
+// KeyReport describes what an import changes in the slashing protection history of a public key.
+type KeyReport struct {
+	Pubkey                string `json:"pubkey"`
+	NewSignedBlocks       int    `json:"new_signed_blocks"`
+	NewSignedAttestations int    `json:"new_signed_attestations"`
+	// Conflicts are the imported records which are slashable with respect to the history of the key,
+	// or to the other imported records of the key.
+	Conflicts []string `json:"conflicts,omitempty"`
+	// Watermarks which move forward with the imported records.
+	Watermarks []*WatermarkMove `json:"watermarks,omitempty"`
+}
+
+// WatermarkMove of the highest signed slot or epoch of a public key.
+type WatermarkMove struct {
+	Name string `json:"name"`
+	// From is empty when the public key has no history yet.
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+}
+
+// Names of the watermarks of a public key.
+const (
+	ProposalSlotWatermark = "proposal_slot"
+	SourceEpochWatermark  = "source_epoch"
+	TargetEpochWatermark  = "target_epoch"
+)
+
+// MergeStandardProtectionJSON imports an EIP-3076 compliant JSON into a validator database, and reports for
+// every public key the new records, the conflicting records and the watermarks which move. The report is built
+// from the history of the database before the import starts, so the JSON is streamed a first time to build the
+// report, then rewound and streamed again to import it. When dryRun is true, the report is built without importing
+// anything.
+//
+// Every entry of the JSON is compared with the history of the database before the import. Entries repeating
+// a public key are reported together, but are not compared with each other.
+func MergeStandardProtectionJSON(ctx context.Context, validatorDB db.Database, r io.ReadSeeker, dryRun bool) (*MergeReport, error) {
+	if dryRun {
+		return buildMergeReport(ctx, validatorDB, r, true)
+	}
+	start, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read slashing protection JSON")
+	}
+	report, err := buildMergeReport(ctx, validatorDB, r, false)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.Seek(start, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "could not rewind slashing protection JSON")
+	}
+	if err := validatorDB.ImportStandardProtectionJSON(ctx, r); err != nil {
+		return nil, errors.Wrap(err, "could not import slashing protection JSON")
+	}
+	return report, nil
+}
+
+func buildMergeReport(ctx context.Context, validatorDB db.Database, r io.Reader, dryRun bool) (*MergeReport, error) {
+	dec, err := format.NewDecoder(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode slashing protection JSON")
+	}
+	report := &MergeReport{DryRun: dryRun, Keys: make([]*KeyReport, 0)}
+	if !dec.HasData() {
+		return report, nil
+	}
+	if dryRun {
+		if err := checkMetadata(ctx, validatorDB, dec.Metadata()); err != nil {
+			return nil, errors.Wrap(err, "slashing protection JSON metadata was incorrect")
+		}
+	}
+	reportsByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte]*KeyReport)
+	for {
+		data, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode slashing protection JSON")
+		}
+		if data == nil {
+			continue
+		}
+		pubKey, err := helpers.PubKeyFromHex(data.Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid public key: %w", data.Pubkey, err)
+		}
+		keyReport, ok := reportsByPubKey[pubKey]
+		if !ok {
+			keyReport = &KeyReport{Pubkey: data.Pubkey}
+			reportsByPubKey[pubKey] = keyReport
+			report.Keys = append(report.Keys, keyReport)
+		}
+		if err := mergeProtectionData(ctx, validatorDB, pubKey, data, keyReport); err != nil {
+			return nil, errors.Wrapf(err, "could not compare slashing protection data of public key %s", data.Pubkey)
+		}
+	}
+	return report, nil
+}
+
+// checkMetadata verifies the metadata the same way an import does, without saving the genesis validators root.
+func checkMetadata(ctx context.Context, validatorDB db.Database, metadata format.Metadata) error {
+	if metadata.InterchangeFormatVersion != format.InterchangeFormatVersion {
+		return fmt.Errorf(
+			"slashing protection JSON version '%s' is not supported, wanted '%s'",
+			metadata.InterchangeFormatVersion,
+			format.InterchangeFormatVersion,
+		)
+	}
+	gvr, err := helpers.RootFromHex(metadata.GenesisValidatorsRoot)
+	if err != nil {
+		return fmt.Errorf("%#x is not a valid root: %w", metadata.GenesisValidatorsRoot, err)
+	}
+	dbGvr, err := validatorDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve genesis validators root to db")
+	}
+	if dbGvr != nil && !bytes.Equal(dbGvr, gvr[:]) {
+		return errors.New("genesis validators root doesn't match the one that is stored in slashing protection db")
+	}
+	return nil
+}
+
+// watermark is the highest signed slot or epoch of a public key.
+type watermark struct {
+	name  string
+	set   bool
+	value uint64
+}
+
+func (w *watermark) observe(v uint64) {
+	if !w.set || v > w.value {
+		w.set = true
+		w.value = v
+	}
+}
+
+func mergeProtectionData(
+	ctx context.Context,
+	validatorDB db.Database,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	data *format.ProtectionData,
+	report *KeyReport,
+) error {
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	attestations, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	slotMark := &watermark{name: ProposalSlotWatermark}
+	sourceMark := &watermark{name: SourceEpochWatermark}
+	targetMark := &watermark{name: TargetEpochWatermark}
+
+	rootsBySlot := make(map[primitives.Slot][]byte, len(proposals))
+	for _, p := range proposals {
+		rootsBySlot[p.Slot] = p.SigningRoot
+		slotMark.observe(uint64(p.Slot))
+	}
+	existing := make([]attestationRecord, len(attestations))
+	rootsByTarget := make(map[primitives.Epoch][]byte, len(attestations))
+	for i, a := range attestations {
+		existing[i] = attestationRecord{source: a.Source, target: a.Target, signingRoot: a.SigningRoot}
+		rootsByTarget[a.Target] = a.SigningRoot
+		sourceMark.observe(uint64(a.Source))
+		targetMark.observe(uint64(a.Target))
+	}
+	before := []watermark{*slotMark, *sourceMark, *targetMark}
+
+	for _, b := range data.SignedBlocks {
+		if b == nil {
+			continue
+		}
+		slot, err := helpers.SlotFromString(b.Slot)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid slot: %w", b.Slot, err)
+		}
+		root, err := optionalRoot(b.SigningRoot)
+		if err != nil {
+			return err
+		}
+		if existingRoot, ok := rootsBySlot[slot]; ok {
+			if slashings.SigningRootsDiffer(existingRoot, root) {
+				report.Conflicts = append(report.Conflicts, fmt.Sprintf("double proposal at slot %d", slot))
+			}
+			continue
+		}
+		rootsBySlot[slot] = root
+		report.NewSignedBlocks++
+		slotMark.observe(uint64(slot))
+	}
+
+	imported := make([]attestationRecord, 0, len(data.SignedAttestations))
+	for _, a := range data.SignedAttestations {
+		if a == nil {
+			continue
+		}
+		source, err := helpers.EpochFromString(a.SourceEpoch)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid epoch: %w", a.SourceEpoch, err)
+		}
+		target, err := helpers.EpochFromString(a.TargetEpoch)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid epoch: %w", a.TargetEpoch, err)
+		}
+		root, err := optionalRoot(a.SigningRoot)
+		if err != nil {
+			return err
+		}
+		imported = append(imported, attestationRecord{source: source, target: target, signingRoot: root})
+	}
+	existingIndex := newSurroundIndex(existing)
+	importedIndex := newSurroundIndex(imported)
+	for _, a := range imported {
+		if existingRoot, ok := rootsByTarget[a.target]; ok {
+			if slashings.SigningRootsDiffer(existingRoot, a.signingRoot) {
+				report.Conflicts = append(report.Conflicts, fmt.Sprintf("double vote with target epoch %d", a.target))
+			}
+			continue
+		}
+		rootsByTarget[a.target] = a.signingRoot
+		if existingIndex.surrounds(a) || importedIndex.surrounds(a) {
+			report.Conflicts = append(report.Conflicts,
+				fmt.Sprintf("surround vote with source epoch %d and target epoch %d", a.source, a.target))
+		}
+		report.NewSignedAttestations++
+		sourceMark.observe(uint64(a.source))
+		targetMark.observe(uint64(a.target))
+	}
+
+	for i, after := range []*watermark{slotMark, sourceMark, targetMark} {
+		if !after.set || (before[i].set && after.value == before[i].value) {
+			continue
+		}
+		move := &WatermarkMove{Name: after.name, To: fmt.Sprintf("%d", after.value)}
+		if before[i].set {
+			move.From = fmt.Sprintf("%d", before[i].value)
+		}
+		report.Watermarks = append(report.Watermarks, move)
+	}
+	return nil
+}
+
+func optionalRoot(hex string) ([]byte, error) {
+	// Signing roots are optional in the standard JSON file.
+	if hex == "" {
+		return nil, nil
+	}
+	root, err := helpers.RootFromHex(hex)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid root: %w", hex, err)
+	}
+	return root[:], nil
+}
+
+type attestationRecord struct {
+	source      primitives.Epoch
+	target      primitives.Epoch
+	signingRoot []byte
+}
+
+// surroundIndex finds the attestations surrounding, or surrounded by, a given attestation in
+// logarithmic time, by sorting the attestations by source epoch.
+type surroundIndex struct {
+	sources []primitives.Epoch
+	// maxTargets[i] is the highest target epoch of the attestations up to i.
+	maxTargets []primitives.Epoch
+	// minTargets[i] is the lowest target epoch of the attestations from i.
+	minTargets []primitives.Epoch
+}
+
+func newSurroundIndex(records []attestationRecord) *surroundIndex {
+	sorted := make([]attestationRecord, len(records))
+	copy(sorted, records)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].source < sorted[j].source })
+	idx := &surroundIndex{
+		sources:    make([]primitives.Epoch, len(sorted)),
+		maxTargets: make([]primitives.Epoch, len(sorted)),
+		minTargets: make([]primitives.Epoch, len(sorted)),
+	}
+	for i, r := range sorted {
+		idx.sources[i] = r.source
+		idx.maxTargets[i] = r.target
+		if i > 0 && idx.maxTargets[i-1] > r.target {
+			idx.maxTargets[i] = idx.maxTargets[i-1]
+		}
+	}
+	for i := len(sorted) - 1; i >= 0; i-- {
+		idx.minTargets[i] = sorted[i].target
+		if i < len(sorted)-1 && idx.minTargets[i+1] < sorted[i].target {
+			idx.minTargets[i] = idx.minTargets[i+1]
+		}
+	}
+	return idx
+}
+
+// surrounds returns true if an attestation of the index surrounds the given one, or is surrounded by it.
+func (idx *surroundIndex) surrounds(a attestationRecord) bool {
+	// An attestation with a lower source and a higher target surrounds it.
+	i := sort.Search(len(idx.sources), func(i int) bool { return idx.sources[i] >= a.source })
+	if i > 0 && idx.maxTargets[i-1] > a.target {
+		return true
+	}
+	// An attestation with a higher source and a lower target is surrounded by it.
+	i = sort.Search(len(idx.sources), func(i int) bool { return idx.sources[i] > a.source })
+	return i < len(idx.sources) && idx.minTargets[i] < a.target
+}
@@ -0,0 +1,199 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+func mergeTestJSON(t *testing.T, gvr [32]byte, data ...*format.ProtectionData) []byte {
+	enc, err := json.Marshal(&format.EIPSlashingProtectionFormat{
+		Metadata: format.Metadata{
+			InterchangeFormatVersion: format.InterchangeFormatVersion,
+			GenesisValidatorsRoot:    fmt.Sprintf("%#x", gvr),
+		},
+		Data: data,
+	})
+	require.NoError(t, err)
+	return enc
+}
+
+func TestMergeStandardProtectionJSON(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	gvr := [32]byte{1}
+	validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, false)
+
+	first := mergeTestJSON(t, gvr, &format.ProtectionData{
+		Pubkey:       fmt.Sprintf("%#x", pubKey),
+		SignedBlocks: []*format.SignedBlock{{Slot: "10", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})}},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "2", TargetEpoch: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+		},
+	})
+
+	// A dry run reports the new records without importing them.
+	report, err := MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(first), true)
+	require.NoError(t, err)
+	assert.Equal(t, true, report.DryRun)
+	require.Equal(t, 1, len(report.Keys))
+	assert.Equal(t, 1, report.Keys[0].NewSignedBlocks)
+	assert.Equal(t, 1, report.Keys[0].NewSignedAttestations)
+	assert.Equal(t, 0, len(report.Keys[0].Conflicts))
+	assert.DeepEqual(t, []*WatermarkMove{
+		{Name: ProposalSlotWatermark, To: "10"},
+		{Name: SourceEpochWatermark, To: "2"},
+		{Name: TargetEpochWatermark, To: "5"},
+	}, report.Keys[0].Watermarks)
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(proposals))
+	root, err := validatorDB.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(root))
+
+	report, err = MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(first), false)
+	require.NoError(t, err)
+	assert.Equal(t, false, report.DryRun)
+	assert.Equal(t, 1, report.Keys[0].NewSignedBlocks)
+	proposals, err = validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposals))
+	assert.Equal(t, primitives.Slot(10), proposals[0].Slot)
+
+	// Records already in the database are neither new nor conflicting, and slashable ones are reported.
+	second := mergeTestJSON(t, gvr, &format.ProtectionData{
+		Pubkey: fmt.Sprintf("%#x", pubKey),
+		SignedBlocks: []*format.SignedBlock{
+			{Slot: "10", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+			{Slot: "12"},
+		},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "2", TargetEpoch: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+			{SourceEpoch: "1", TargetEpoch: "6"},
+			{SourceEpoch: "6", TargetEpoch: "7"},
+		},
+	})
+	report, err = MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(second), true)
+	require.NoError(t, err)
+	keyReport := report.Keys[0]
+	assert.Equal(t, 1, keyReport.NewSignedBlocks)
+	assert.Equal(t, 2, keyReport.NewSignedAttestations)
+	assert.DeepEqual(t, []string{"surround vote with source epoch 1 and target epoch 6"}, keyReport.Conflicts)
+	assert.DeepEqual(t, []*WatermarkMove{
+		{Name: ProposalSlotWatermark, From: "10", To: "12"},
+		{Name: SourceEpochWatermark, From: "2", To: "6"},
+		{Name: TargetEpochWatermark, From: "5", To: "7"},
+	}, keyReport.Watermarks)
+
+	double := mergeTestJSON(t, gvr, &format.ProtectionData{
+		Pubkey:             fmt.Sprintf("%#x", pubKey),
+		SignedBlocks:       []*format.SignedBlock{{Slot: "10", SigningRoot: fmt.Sprintf("%#x", [32]byte{3})}},
+		SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "3", TargetEpoch: "5"}},
+	})
+	report, err = MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(double), true)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"double proposal at slot 10", "double vote with target epoch 5"}, report.Keys[0].Conflicts)
+	assert.Equal(t, 0, len(report.Keys[0].Watermarks))
+}
+
+func TestMergeStandardProtectionJSON_ImportFailure(t *testing.T) {
+	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
+		t.Run(fmt.Sprintf("isSlashingProtectionMinimal=%v", isSlashingProtectionMinimal), func(t *testing.T) {
+			ctx := context.Background()
+			pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+			validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, isSlashingProtectionMinimal)
+			gvr := [32]byte{2}
+			require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, gvr[:]))
+
+			// The genesis validators root does not match the database.
+			data := mergeTestJSON(t, [32]byte{1}, &format.ProtectionData{Pubkey: fmt.Sprintf("%#x", pubKey)})
+			_, err := MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(data), true)
+			require.ErrorContains(t, "genesis validators root doesn't match", err)
+			_, err = MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(data), false)
+			require.ErrorContains(t, "could not import slashing protection JSON", err)
+
+			invalid := fmt.Sprintf(`{"metadata": {"interchange_format_version": "5", "genesis_validators_root": "%#x"}, "data": [{"pubkey": 1}]}`, gvr)
+			_, err = MergeStandardProtectionJSON(ctx, validatorDB, strings.NewReader(invalid), true)
+			require.ErrorContains(t, "could not decode slashing protection JSON", err)
+			_, err = MergeStandardProtectionJSON(ctx, validatorDB, strings.NewReader(invalid), false)
+			require.ErrorContains(t, "could not decode slashing protection JSON", err)
+		})
+	}
+}
+
+func TestStreamStandardProtectionJSON_Filter(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	validatorDB := dbtest.SetupDB(t, pubKeys, false)
+	gvr := [32]byte{1}
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, gvr[:]))
+	for _, pubKey := range pubKeys {
+		for _, slot := range []primitives.Slot{10, 100} {
+			require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, slot, []byte{1}))
+		}
+	}
+
+	// Every key is exported, sorted.
+	var buf bytes.Buffer
+	count, err := StreamStandardProtectionJSON(ctx, validatorDB, &buf, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	exported := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), exported))
+	require.Equal(t, 2, len(exported.Data))
+	assert.Equal(t, fmt.Sprintf("%#x", pubKeys[0]), exported.Data[0].Pubkey)
+	assert.Equal(t, 2, len(exported.Data[0].SignedBlocks))
+
+	// Only the filtered key is exported, from the given epoch.
+	buf.Reset()
+	count, err = StreamStandardProtectionJSON(ctx, validatorDB, &buf, &ExportFilter{
+		PubKeys:    [][fieldparams.BLSPubkeyLength]byte{pubKeys[1]},
+		SinceEpoch: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	exported = &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), exported))
+	require.Equal(t, 1, len(exported.Data))
+	assert.Equal(t, fmt.Sprintf("%#x", pubKeys[1]), exported.Data[0].Pubkey)
+	require.Equal(t, 1, len(exported.Data[0].SignedBlocks))
+	assert.Equal(t, "100", exported.Data[0].SignedBlocks[0].Slot)
+}
+
+func TestMergeStandardProtectionJSON_ReportsHistoryBeforeImport(t *testing.T) {
+	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
+		t.Run(fmt.Sprintf("isSlashingProtectionMinimal=%v", isSlashingProtectionMinimal), func(t *testing.T) {
+			ctx := context.Background()
+			pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 32)
+			data := make([]*format.ProtectionData, len(pubKeys))
+			for i := range pubKeys {
+				pubKeys[i][0] = byte(i + 1)
+				data[i] = &format.ProtectionData{
+					Pubkey:       fmt.Sprintf("%#x", pubKeys[i]),
+					SignedBlocks: []*format.SignedBlock{{Slot: "10", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})}},
+				}
+			}
+			validatorDB := dbtest.SetupDB(t, pubKeys, isSlashingProtectionMinimal)
+
+			// Every key is compared with its history before the import, however far the import went.
+			report, err := MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(mergeTestJSON(t, [32]byte{1}, data...)), false)
+			require.NoError(t, err)
+			require.Equal(t, len(pubKeys), len(report.Keys))
+			for _, keyReport := range report.Keys {
+				assert.Equal(t, 1, keyReport.NewSignedBlocks)
+				assert.Equal(t, 0, len(keyReport.Conflicts))
+			}
+		})
+	}
+}